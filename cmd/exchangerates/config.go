@@ -0,0 +1,30 @@
+package exchangerates
+
+import (
+	"github.com/spf13/pflag"
+
+	"personaapp/pkg/postgresql"
+)
+
+const (
+	formatCSV = "csv"
+	formatECB = "ecb"
+)
+
+type Config struct {
+	Postgres     postgresql.Config
+	File         string
+	Format       string
+	BaseCurrency string
+}
+
+func (c *Config) Flags() *pflag.FlagSet {
+	f := pflag.NewFlagSet("ExchangeRatesConfig", pflag.PanicOnError)
+
+	f.AddFlagSet(c.Postgres.Flags("PostgresConfig", "postgres"))
+	f.StringVar(&c.File, "exchange_rates_file", "", "File to load the exchange rates from")
+	f.StringVar(&c.Format, "exchange_rates_format", "", "Format of the file, csv or ecb, by the extension when empty")
+	f.StringVar(&c.BaseCurrency, "exchange_rates_base_currency", "UAH", "Currency the salaries are normalized to")
+
+	return f
+}
@@ -0,0 +1,103 @@
+package exchangerates
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	currencyController "personaapp/internal/controllers/currency/controller"
+	currencyStorage "personaapp/internal/controllers/currency/storage"
+	"personaapp/pkg/closeable"
+	"personaapp/pkg/flag"
+	"personaapp/pkg/postgresql"
+)
+
+func Command() *cobra.Command {
+	var config Config
+
+	cmd := &cobra.Command{
+		Use:   "exchange_rates",
+		Short: "Load the exchange rates salaries are normalized with from a CSV or an ECB XML file",
+		RunE:  run(&config),
+	}
+	cmd.Flags().AddFlagSet(config.Flags())
+
+	return cmd
+}
+
+func run(cfg *Config) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logger, _ := zap.NewProduction()
+
+		defer func() {
+			err := logger.Sync() // flushes buffer, if any
+			if err != nil {
+				log.Println(err) //nolint todo think about errors mapper/parser service
+			}
+		}()
+
+		sugar := logger.Sugar()
+
+		if err := flag.BindEnv(cmd); err != nil {
+			return errors.WithStack(err)
+		}
+
+		rates, err := readExchangeRates(cfg)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		pg, err := postgresql.New(&cfg.Postgres)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closeable.CloseWithErrorLogging(sugar, pg)
+
+		cc := currencyController.New(currencyStorage.New(pg))
+		if err := cc.PutExchangeRates(context.Background(), cfg.BaseCurrency, rates); err != nil {
+			return errors.WithStack(err)
+		}
+
+		sugar.Infow("exchange rates loaded", "base_currency", cfg.BaseCurrency, "count", len(rates))
+
+		return nil
+	}
+}
+
+func readExchangeRates(cfg *Config) ([]*currencyController.ExchangeRate, error) {
+	if cfg.File == "" {
+		return nil, errors.New("exchange rates file is required")
+	}
+
+	format := cfg.Format
+	if format == "" {
+		format = formatCSV
+
+		if strings.EqualFold(filepath.Ext(cfg.File), ".xml") {
+			format = formatECB
+		}
+	}
+
+	b, err := ioutil.ReadFile(cfg.File)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch format {
+	case formatCSV:
+		rates, err := currencyController.ParseCSV(bytes.NewReader(b))
+		return rates, errors.WithStack(err)
+	case formatECB:
+		rates, err := currencyController.ParseECB(bytes.NewReader(b), cfg.BaseCurrency)
+		return rates, errors.WithStack(err)
+	default:
+		return nil, errors.Newf("unknown exchange rates format=%s", format)
+	}
+}
@@ -0,0 +1,39 @@
+package importvacancies
+
+import (
+	"github.com/spf13/pflag"
+
+	notificationController "personaapp/internal/controllers/notification/controller"
+	outboxController "personaapp/internal/controllers/outbox/controller"
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+	"personaapp/pkg/postgresql"
+)
+
+const (
+	formatCSV = "csv"
+	formatXML = "xml"
+)
+
+type Config struct {
+	NotificationController notificationController.Config
+	OutboxController       outboxController.Config
+	VacancyController      vacancyController.Config
+	Postgres               postgresql.Config
+	File                   string
+	Format                 string
+	CompanyID              string
+}
+
+func (c *Config) Flags() *pflag.FlagSet {
+	f := pflag.NewFlagSet("ImportVacanciesConfig", pflag.PanicOnError)
+
+	f.AddFlagSet(c.NotificationController.Flags("NotificationControllerConfig"))
+	f.AddFlagSet(c.OutboxController.Flags("OutboxControllerConfig"))
+	f.AddFlagSet(c.VacancyController.Flags("VacancyControllerConfig"))
+	f.AddFlagSet(c.Postgres.Flags("PostgresConfig", "postgres"))
+	f.StringVar(&c.File, "vacancy_import_file", "", "Job feed file to import the vacancies from")
+	f.StringVar(&c.Format, "vacancy_import_format", "", "Format of the file, csv or xml, by the extension when empty")
+	f.StringVar(&c.CompanyID, "vacancy_import_company_id", "", "Company the vacancies are imported for")
+
+	return f
+}
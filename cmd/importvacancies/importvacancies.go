@@ -0,0 +1,123 @@
+package importvacancies
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	notificationController "personaapp/internal/controllers/notification/controller"
+	notificationStorage "personaapp/internal/controllers/notification/storage"
+	outboxController "personaapp/internal/controllers/outbox/controller"
+	outboxStorage "personaapp/internal/controllers/outbox/storage"
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+	vacancyStorage "personaapp/internal/controllers/vacancy/storage"
+	"personaapp/pkg/closeable"
+	"personaapp/pkg/flag"
+	"personaapp/pkg/postgresql"
+)
+
+func Command() *cobra.Command {
+	var config Config
+
+	cmd := &cobra.Command{
+		Use:   "import_vacancies",
+		Short: "Import the vacancies of a company from a CSV or an XML job feed",
+		RunE:  run(&config),
+	}
+	cmd.Flags().AddFlagSet(config.Flags())
+
+	return cmd
+}
+
+func run(cfg *Config) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logger, _ := zap.NewProduction()
+
+		defer func() {
+			err := logger.Sync() // flushes buffer, if any
+			if err != nil {
+				log.Println(err) //nolint todo think about errors mapper/parser service
+			}
+		}()
+
+		sugar := logger.Sugar()
+
+		if err := flag.BindEnv(cmd); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if cfg.CompanyID == "" {
+			return errors.New("vacancy import company id is required")
+		}
+
+		rows, err := readImportRows(cfg)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		pg, err := postgresql.New(&cfg.Postgres)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closeable.CloseWithErrorLogging(sugar, pg)
+
+		// Notifications and events are only enqueued here, the server delivers and publishes them
+		nc := notificationController.New(&cfg.NotificationController, notificationStorage.New(pg), nil, nil)
+		oc := outboxController.New(&cfg.OutboxController, outboxStorage.New(pg), nil)
+		vc := vacancyController.New(&cfg.VacancyController, vacancyStorage.New(pg), nc, oc, nil)
+
+		report, err := vc.ImportVacancies(context.Background(), nil, cfg.CompanyID, rows)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for _, rowErr := range report.Errors {
+			sugar.Warnw("vacancy not imported",
+				"line", rowErr.Line, "external_id", rowErr.ExternalID, "field", rowErr.Field, "error", rowErr.Err)
+		}
+
+		sugar.Infow("vacancies imported",
+			"company_id", cfg.CompanyID, "created", report.Created, "updated", report.Updated,
+			"failed", len(report.Errors))
+
+		return nil
+	}
+}
+
+func readImportRows(cfg *Config) ([]*vacancyController.ImportRow, error) {
+	if cfg.File == "" {
+		return nil, errors.New("vacancy import file is required")
+	}
+
+	format := cfg.Format
+	if format == "" {
+		format = formatCSV
+
+		if strings.EqualFold(filepath.Ext(cfg.File), ".xml") {
+			format = formatXML
+		}
+	}
+
+	b, err := ioutil.ReadFile(cfg.File)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch format {
+	case formatCSV:
+		rows, err := vacancyController.ParseImportCSV(bytes.NewReader(b))
+		return rows, errors.WithStack(err)
+	case formatXML:
+		rows, err := vacancyController.ParseImportXML(bytes.NewReader(b))
+		return rows, errors.WithStack(err)
+	default:
+		return nil, errors.Newf("unknown vacancy import format=%s", format)
+	}
+}
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"personaapp/cmd/exchangerates"
+	"personaapp/cmd/importvacancies"
 	"personaapp/cmd/migrate"
 	"personaapp/cmd/server"
+	"personaapp/cmd/worker"
 
 	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
@@ -12,6 +15,9 @@ func Run() error {
 	rootCMD := &cobra.Command{}
 	rootCMD.AddCommand(server.Command())
 	rootCMD.AddCommand(migrate.Command())
+	rootCMD.AddCommand(worker.Command())
+	rootCMD.AddCommand(exchangerates.Command())
+	rootCMD.AddCommand(importvacancies.Command())
 
 	return errors.WithStack(rootCMD.Execute())
 }
@@ -4,23 +4,52 @@ import (
 	"github.com/spf13/pflag"
 
 	authController "personaapp/internal/controllers/auth/controller"
+	notificationController "personaapp/internal/controllers/notification/controller"
+	outboxController "personaapp/internal/controllers/outbox/controller"
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+	"personaapp/internal/feed"
+	"personaapp/internal/mail"
+	"personaapp/internal/scheduler"
 	"personaapp/pkg/grpc"
+	"personaapp/pkg/nats"
 	"personaapp/pkg/postgresql"
+	"personaapp/pkg/push"
+	"personaapp/pkg/redis"
 )
 
 type Config struct {
-	AuthController authController.Config
-	Postgres       postgresql.Config
-	Server         grpc.Config
-	Environment    string
+	AuthController         authController.Config
+	NotificationController notificationController.Config
+	OutboxController       outboxController.Config
+	VacancyController      vacancyController.Config
+	Postgres               postgresql.Config
+	Server                 grpc.Config
+	Feed                   feed.Config
+	Scheduler              scheduler.Config
+	FCM                    push.FCMConfig
+	APNs                   push.APNsConfig
+	Mail                   mail.Config
+	Nats                   nats.Config
+	Redis                  redis.Config
+	Environment            string
 }
 
 func (c *Config) Flags() *pflag.FlagSet {
 	f := pflag.NewFlagSet("ServerConfig", pflag.PanicOnError)
 
 	f.AddFlagSet(c.AuthController.Flags("AuthControllerConfig"))
+	f.AddFlagSet(c.NotificationController.Flags("NotificationControllerConfig"))
+	f.AddFlagSet(c.OutboxController.Flags("OutboxControllerConfig"))
+	f.AddFlagSet(c.VacancyController.Flags("VacancyControllerConfig"))
 	f.AddFlagSet(c.Postgres.Flags("PostgresConfig", "postgres"))
 	f.AddFlagSet(c.Server.Flags("ServerConfig", "server"))
+	f.AddFlagSet(c.Feed.Flags("FeedConfig"))
+	f.AddFlagSet(c.Scheduler.Flags("SchedulerConfig"))
+	f.AddFlagSet(c.FCM.Flags("fcm"))
+	f.AddFlagSet(c.APNs.Flags("apns"))
+	f.AddFlagSet(c.Mail.Flags("mail"))
+	f.AddFlagSet(c.Nats.Flags("nats"))
+	f.AddFlagSet(c.Redis.Flags("redis"))
 	f.StringVar(&c.Environment, "environment", "dev", "Test environment variable")
 
 	return f
@@ -1,10 +1,16 @@
 package server
 
 import (
+	"context"
 	"log"
 	"net"
+	"net/http"
+	"personaapp/internal/feed"
+	"personaapp/internal/mail"
+	"personaapp/internal/scheduler"
 	"personaapp/internal/server"
 	"personaapp/pkg/closeable"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
@@ -13,6 +19,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	applicationController "personaapp/internal/controllers/application/controller"
+	applicationStorage "personaapp/internal/controllers/application/storage"
 	authController "personaapp/internal/controllers/auth/controller"
 	authStorage "personaapp/internal/controllers/auth/storage"
 	cityController "personaapp/internal/controllers/city/controller"
@@ -21,16 +29,28 @@ import (
 	companyStorage "personaapp/internal/controllers/company/storage"
 	cvController "personaapp/internal/controllers/cv/controller"
 	cvStorage "personaapp/internal/controllers/cv/storage"
+	matchingController "personaapp/internal/controllers/matching/controller"
+	matchingStorage "personaapp/internal/controllers/matching/storage"
+	notificationController "personaapp/internal/controllers/notification/controller"
+	notificationStorage "personaapp/internal/controllers/notification/storage"
+	outboxController "personaapp/internal/controllers/outbox/controller"
+	outboxStorage "personaapp/internal/controllers/outbox/storage"
 	vacancyController "personaapp/internal/controllers/vacancy/controller"
 	vacancyStorage "personaapp/internal/controllers/vacancy/storage"
 	pkgcmd "personaapp/pkg/cmd"
 	"personaapp/pkg/flag"
+	apiapplication "personaapp/pkg/grpcapi/application"
 	apiauth "personaapp/pkg/grpcapi/auth"
 	apicity "personaapp/pkg/grpcapi/city"
 	apicompany "personaapp/pkg/grpcapi/company"
 	apicv "personaapp/pkg/grpcapi/cv"
+	apinotification "personaapp/pkg/grpcapi/notification"
 	apivacancy "personaapp/pkg/grpcapi/vacancy"
+	"personaapp/pkg/nats"
+	"personaapp/pkg/periodic"
 	"personaapp/pkg/postgresql"
+	"personaapp/pkg/push"
+	"personaapp/pkg/redis"
 )
 
 func Command() *cobra.Command {
@@ -73,7 +93,33 @@ func run(cfg *Config) func(cmd *cobra.Command, args []string) error {
 		// nolint TODO: not sure if there should be defer, but I guess so
 		defer closeable.CloseWithErrorLogging(sugar, pg)
 
-		srv := createControllers(pg, cfg)
+		bus, err := nats.New(cfg.Nats)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer bus.Close()
+
+		nc, err := newNotificationController(pg, cfg, sugar)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		oc := outboxController.New(&cfg.OutboxController, outboxStorage.New(pg), bus)
+
+		var facetsCache vacancyController.FacetsCache
+
+		if cfg.Redis.Addr != "" {
+			rs, err := redis.NewStorage(&cfg.Redis)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer closeable.CloseWithErrorLogging(sugar, rs.Client)
+
+			facetsCache = rs
+		}
+
+		vc := newVacancyController(pg, &cfg.VacancyController, nc, oc, facetsCache)
+		srv := createControllers(pg, cfg, nc, oc, vc)
 
 		ln, err := net.Listen("tcp", cfg.Server.Address)
 		if err != nil {
@@ -91,9 +137,51 @@ func run(cfg *Config) func(cmd *cobra.Command, args []string) error {
 			return nil
 		})
 
+		var feedServer *http.Server
+		if cfg.Feed.Address != "" {
+			feedServer = &http.Server{Addr: cfg.Feed.Address, Handler: feed.New(&cfg.Feed, vc, sugar)}
+
+			g.Go(func() error {
+				if err := feedServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					return errors.WithStack(err)
+				}
+				return nil
+			})
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ncfg, ocfg := &cfg.NotificationController, &cfg.OutboxController
+		runWorkers(ctx, g, sugar, []worker{
+			{name: "push notifications delivery", interval: ncfg.DeliveryInterval, fn: nc.DeliverPending},
+			{name: "notification emails delivery", interval: ncfg.DeliveryInterval, fn: nc.DeliverPendingEmails},
+			{name: "notification digests", interval: ncfg.DigestInterval, fn: nc.SendDueDigests},
+			{name: "outbox events publishing", interval: ocfg.PublishInterval, fn: oc.PublishPending},
+			{name: "vacancies expiry", interval: cfg.VacancyController.ExpiryInterval, fn: vc.CloseExpiredVacancies},
+			{name: "vacancy stats rollup", interval: cfg.VacancyController.StatsRollupInterval, fn: vc.RollupVacancyStats},
+		})
+
+		// The scheduled jobs are run by one of the server instances at a time
+		sched := scheduler.New(pg.AdvisoryLock(cfg.Scheduler.LockKey), sugar, []*scheduler.Job{
+			{
+				Name:     "scheduled vacancies publishing",
+				Interval: cfg.VacancyController.ScheduledPublishInterval,
+				Fn:       vc.PublishScheduledVacancies,
+			},
+		})
+		g.Go(func() error {
+			return errors.WithStack(sched.Run(ctx))
+		})
+
 		pkgcmd.Await()
+		cancel()
 		grpcServer.GracefulStop()
 
+		if feedServer != nil {
+			if err := feedServer.Shutdown(context.Background()); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
 		if err := g.Wait(); err != nil {
 			return errors.WithStack(err)
 		}
@@ -102,41 +190,139 @@ func run(cfg *Config) func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+type worker struct {
+	name     string
+	interval time.Duration
+	fn       func(ctx context.Context) (int, error)
+}
+
+func runWorkers(ctx context.Context, g *errgroup.Group, sugar *zap.SugaredLogger, workers []worker) {
+	for _, w := range workers {
+		w := w
+
+		g.Go(func() error {
+			periodic.Run(
+				ctx,
+				w.interval,
+				func(ctx context.Context) error {
+					_, err := w.fn(ctx)
+					return err
+				},
+				func(err error) {
+					sugar.Errorw("worker failed", "worker", w.name, "error", err)
+				},
+			)
+			return nil
+		})
+	}
+}
+
 func registerServer(grpcServer *grpc.Server, srv *server.Server) {
 	apiauth.RegisterPersonaAppAuthServer(grpcServer, srv)
 	apicompany.RegisterPersonaAppCompanyServer(grpcServer, srv)
 	apivacancy.RegisterPersonaAppVacancyServer(grpcServer, srv)
 	apicity.RegisterPersonaAppCityServer(grpcServer, srv)
 	apicv.RegisterPersonaAppCVServer(grpcServer, srv)
+	apinotification.RegisterPersonaAppNotificationServer(grpcServer, srv)
+	apiapplication.RegisterPersonaAppApplicationsServer(grpcServer, srv)
 	reflection.Register(grpcServer)
 }
 
-func createControllers(pg *postgresql.Storage, cfg *Config) *server.Server {
+func createControllers(
+	pg *postgresql.Storage,
+	cfg *Config,
+	nc *notificationController.Controller,
+	oc *outboxController.Controller,
+	vc *vacancyController.Controller,
+) *server.Server {
 	return server.New(
-		newAuthController(pg, &cfg.AuthController),
-		newCompanyController(pg),
-		newVacancyController(pg),
+		newAuthController(pg, &cfg.AuthController, oc),
+		newCompanyController(pg, oc),
+		vc,
 		newCityController(pg),
-		newCVController(pg),
+		newCVController(pg, oc),
+		nc,
+		newApplicationController(pg, nc),
+		newMatchingController(pg),
 	)
 }
 
-func newAuthController(pg *postgresql.Storage, cfg *authController.Config) *authController.Controller {
-	return authController.New(cfg, authStorage.New(pg))
+func newAuthController(
+	pg *postgresql.Storage,
+	cfg *authController.Config,
+	oc *outboxController.Controller,
+) *authController.Controller {
+	return authController.New(cfg, authStorage.New(pg), oc)
 }
 
-func newCompanyController(pg *postgresql.Storage) *companyController.Controller {
-	return companyController.New(companyStorage.New(pg))
+func newCompanyController(pg *postgresql.Storage, oc *outboxController.Controller) *companyController.Controller {
+	return companyController.New(companyStorage.New(pg), oc)
 }
 
-func newVacancyController(pg *postgresql.Storage) *vacancyController.Controller {
-	return vacancyController.New(vacancyStorage.New(pg))
+func newVacancyController(
+	pg *postgresql.Storage,
+	cfg *vacancyController.Config,
+	nc *notificationController.Controller,
+	oc *outboxController.Controller,
+	facetsCache vacancyController.FacetsCache,
+) *vacancyController.Controller {
+	return vacancyController.New(cfg, vacancyStorage.New(pg), nc, oc, facetsCache)
 }
 
 func newCityController(pg *postgresql.Storage) *cityController.Controller {
 	return cityController.New(cityStorage.New(pg))
 }
 
-func newCVController(pg *postgresql.Storage) *cvController.Controller {
-	return cvController.New(cvStorage.New(pg))
+func newCVController(pg *postgresql.Storage, oc *outboxController.Controller) *cvController.Controller {
+	return cvController.New(cvStorage.New(pg), oc)
+}
+
+func newApplicationController(
+	pg *postgresql.Storage,
+	nc *notificationController.Controller,
+) *applicationController.Controller {
+	return applicationController.New(applicationStorage.New(pg), nc)
+}
+
+func newMatchingController(pg *postgresql.Storage) *matchingController.Controller {
+	return matchingController.New(matchingStorage.New(pg))
+}
+
+func newNotificationController(
+	pg *postgresql.Storage,
+	cfg *Config,
+	sugar *zap.SugaredLogger,
+) (*notificationController.Controller, error) {
+	senders := make(map[notificationController.Platform]notificationController.PushSender)
+
+	if cfg.FCM.CredentialsFile != "" {
+		fcm, err := push.NewFCMSender(&cfg.FCM, sugar)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		senders[notificationController.PlatformAndroid] = fcm
+	}
+
+	if cfg.APNs.KeyFile != "" {
+		apns, err := push.NewAPNsSender(&cfg.APNs, sugar)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		senders[notificationController.PlatformIOS] = apns
+	}
+
+	var mailer notificationController.EmailSender
+	if cfg.Mail.User != "" {
+		sender := mail.NewSender(cfg.Mail.User, cfg.Mail.Password)
+		mailer = &sender
+	}
+
+	return notificationController.New(
+		&cfg.NotificationController,
+		notificationStorage.New(pg),
+		senders,
+		mailer,
+	), nil
 }
@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"github.com/spf13/pflag"
+
+	notificationController "personaapp/internal/controllers/notification/controller"
+	"personaapp/internal/mail"
+	"personaapp/pkg/nats"
+	"personaapp/pkg/postgresql"
+)
+
+type Config struct {
+	NotificationController notificationController.Config
+	Postgres               postgresql.Config
+	Mail                   mail.Config
+	Nats                   nats.Config
+	Queue                  string
+	MetricsAddress         string
+}
+
+func (c *Config) Flags() *pflag.FlagSet {
+	f := pflag.NewFlagSet("WorkerConfig", pflag.PanicOnError)
+
+	f.AddFlagSet(c.NotificationController.Flags("NotificationControllerConfig"))
+	f.AddFlagSet(c.Postgres.Flags("PostgresConfig", "postgres"))
+	f.AddFlagSet(c.Mail.Flags("mail"))
+	f.AddFlagSet(c.Nats.Flags("nats"))
+	f.StringVar(&c.Queue, "queue", "personaapp-worker", "Queue group shared by the workers")
+	f.StringVar(&c.MetricsAddress, "metrics_address", ":9100", "Prometheus metrics listen address")
+
+	return f
+}
@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"personaapp/internal/consumer"
+	matchingController "personaapp/internal/controllers/matching/controller"
+	matchingStorage "personaapp/internal/controllers/matching/storage"
+	notificationController "personaapp/internal/controllers/notification/controller"
+	notificationStorage "personaapp/internal/controllers/notification/storage"
+	"personaapp/internal/mail"
+	"personaapp/pkg/closeable"
+	pkgcmd "personaapp/pkg/cmd"
+	"personaapp/pkg/flag"
+	"personaapp/pkg/nats"
+	"personaapp/pkg/postgresql"
+)
+
+func Command() *cobra.Command {
+	var config Config
+
+	cmd := &cobra.Command{
+		Use:   "worker",
+		Short: "Start a Persona App events consumer",
+		RunE:  run(&config),
+	}
+	cmd.Flags().AddFlagSet(config.Flags())
+
+	return cmd
+}
+
+func run(cfg *Config) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logger, _ := zap.NewProduction()
+
+		defer func() {
+			err := logger.Sync() // flushes buffer, if any
+			if err != nil {
+				log.Println(err) //nolint todo think about errors mapper/parser service
+			}
+		}()
+
+		sugar := logger.Sugar()
+		sugar.Info("starting worker")
+
+		defer sugar.Info("stopping worker")
+
+		if err := flag.BindEnv(cmd); err != nil {
+			return errors.WithStack(err)
+		}
+
+		pg, err := postgresql.New(&cfg.Postgres)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer closeable.CloseWithErrorLogging(sugar, pg)
+
+		bus, err := nats.New(cfg.Nats)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer bus.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		c := consumer.New(newNotificationController(pg, cfg), matchingController.New(matchingStorage.New(pg)))
+
+		subs, err := c.Subscribe(ctx, bus, cfg.Queue, func(err error) {
+			sugar.Errorw("event handling failed", "error", err)
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		metricsServer := &http.Server{Addr: cfg.MetricsAddress, Handler: mux}
+
+		g := &errgroup.Group{}
+		g.Go(func() error {
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				return errors.WithStack(err)
+			}
+			return nil
+		})
+
+		pkgcmd.Await()
+
+		for _, sub := range subs {
+			if err := sub.Unsubscribe(); err != nil {
+				sugar.Errorw("failed to unsubscribe", "subject", sub.Subject, "error", err)
+			}
+		}
+
+		cancel()
+
+		if err := metricsServer.Shutdown(context.Background()); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := g.Wait(); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}
+}
+
+// newNotificationController creates a controller which only enqueues notifications,
+// the delivery is left to the server.
+func newNotificationController(pg *postgresql.Storage, cfg *Config) *notificationController.Controller {
+	var mailer notificationController.EmailSender
+	if cfg.Mail.User != "" {
+		sender := mail.NewSender(cfg.Mail.User, cfg.Mail.Password)
+		mailer = &sender
+	}
+
+	return notificationController.New(
+		&cfg.NotificationController,
+		notificationStorage.New(pg),
+		nil,
+		mailer,
+	)
+}
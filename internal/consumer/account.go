@@ -0,0 +1,21 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+
+	notificationController "personaapp/internal/controllers/notification/controller"
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+func (c *Consumer) handleAccountRegistered(ctx context.Context, payload proto.Message) error {
+	e := payload.(*apievent.AccountRegisteredV1)
+
+	return errors.WithStack(c.nc.Notify(ctx, e.GetAccountId(), &notificationController.Notification{
+		EventType: notificationController.EventTypeSystem,
+		Title:     "Welcome to Persona",
+		Body:      "Your account is ready, complete your profile to get started",
+	}))
+}
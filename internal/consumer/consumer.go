@@ -0,0 +1,59 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	notificationController "personaapp/internal/controllers/notification/controller"
+	"personaapp/pkg/nats"
+	"personaapp/pkg/nats/event"
+)
+
+type NotificationController interface {
+	Notify(ctx context.Context, accountID string, n *notificationController.Notification) error
+}
+
+type MatchingController interface {
+	MatchCV(ctx context.Context, cvID string) error
+	MatchVacancy(ctx context.Context, vacancyID string) error
+}
+
+// Consumer hosts the handlers of the domain events published by the server.
+type Consumer struct {
+	nc NotificationController
+	mc MatchingController
+}
+
+func New(nc NotificationController, mc MatchingController) *Consumer {
+	return &Consumer{nc: nc, mc: mc}
+}
+
+// Subscribe subscribes the handlers within the queue group, so every event is handled by a single worker.
+// Handler errors which are left after all the retries are reported to onErr.
+func (c *Consumer) Subscribe(
+	ctx context.Context,
+	bus *nats.Bus,
+	queue string,
+	onErr func(error),
+) ([]*nats.Subscription, error) {
+	handlers := map[string]nats.Handler{
+		event.SubjectAccountRegistered: c.handleAccountRegistered,
+		event.SubjectCVUpdated:         c.handleCVUpdated,
+		event.SubjectVacancyCreated:    c.handleVacancyCreated,
+		event.SubjectVacancyUpdated:    c.handleVacancyUpdated,
+	}
+
+	subs := make([]*nats.Subscription, 0, len(handlers))
+
+	for subject, h := range handlers {
+		sub, err := bus.QueueSubscribe(ctx, subject, queue, h, onErr)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		subs = append(subs, sub)
+	}
+
+	return subs, nil
+}
@@ -0,0 +1,45 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+
+	matchingController "personaapp/internal/controllers/matching/controller"
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+// handleCVUpdated recomputes the matches of the cv from its current state. A cv deleted since is skipped,
+// its matches are deleted with it.
+func (c *Consumer) handleCVUpdated(ctx context.Context, payload proto.Message) error {
+	e := payload.(*apievent.CVUpdatedV1)
+
+	if err := c.mc.MatchCV(ctx, e.GetCvId()); errors.Cause(err) != matchingController.ErrCVNotFound {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *Consumer) handleVacancyCreated(ctx context.Context, payload proto.Message) error {
+	e := payload.(*apievent.VacancyCreatedV1)
+
+	return errors.WithStack(c.matchVacancy(ctx, e.GetVacancy().GetId()))
+}
+
+func (c *Consumer) handleVacancyUpdated(ctx context.Context, payload proto.Message) error {
+	e := payload.(*apievent.VacancyUpdatedV1)
+
+	return errors.WithStack(c.matchVacancy(ctx, e.GetVacancy().GetId()))
+}
+
+// matchVacancy recomputes the matches of the vacancy from its current state. A vacancy deleted since
+// is skipped, its matches are deleted with it.
+func (c *Consumer) matchVacancy(ctx context.Context, vacancyID string) error {
+	if err := c.mc.MatchVacancy(ctx, vacancyID); errors.Cause(err) != matchingController.ErrVacancyNotFound {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,532 @@
+package controller
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/application/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const maxMessageLength = 5000
+
+var (
+	ErrApplicationNotFound = errors.New("application not found")
+	ErrVacancyNotFound     = errors.New("vacancy not found")
+	ErrVacancyNotOpen      = errors.New("vacancy is not open for applications")
+	ErrCVNotFound          = errors.New("cv not found")
+	ErrAlreadyApplied      = errors.New("already applied to the vacancy")
+	ErrInvalidMessage      = errors.New("invalid cover message")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+
+	ErrPipelineStageNotFound    = errors.New("pipeline stage not found")
+	ErrPipelineStageNotEmpty    = errors.New("pipeline stage has applications")
+	ErrInvalidPipelineStages    = errors.New("invalid pipeline stages")
+	ErrInvalidPipelineStageName = errors.New("invalid pipeline stage name")
+	ErrInvalidPipelineStageKind = errors.New("invalid pipeline stage kind")
+	ErrInvalidApplicationsMove  = errors.New("invalid applications move")
+	ErrInvalidRejectionReason   = errors.New("invalid rejection reason")
+	ErrInvalidMessageTemplate   = errors.New("invalid message template")
+)
+
+type Storage interface {
+	TxGetVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*storage.Vacancy, error)
+	TxGetCVSnapshot(ctx context.Context, tx pkgtx.Tx, personaID, cvID string) (*storage.CVSnapshot, error)
+
+	TxPutApplication(ctx context.Context, tx pkgtx.Tx, a *storage.Application) (string, error)
+	TxWithdrawApplication(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		personaID string,
+		applicationID string,
+		withdrawnAt time.Time,
+	) error
+	TxGetPersonaApplications(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		personaID string,
+		cursor *storage.Cursor,
+		limit int,
+	) ([]*storage.MyApplication, *storage.Cursor, error)
+	TxGetVacancyApplicants(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		cursor *storage.Cursor,
+		limit int,
+	) ([]*storage.Applicant, *storage.Cursor, error)
+
+	TxEnsurePipelineStages(ctx context.Context, tx pkgtx.Tx, companyID string, stages []*storage.PipelineStage) error
+	TxGetPipelineStages(ctx context.Context, tx pkgtx.Tx, companyID string) ([]*storage.PipelineStage, error)
+	TxLockPipelineStages(ctx context.Context, tx pkgtx.Tx, companyID string) ([]*storage.PipelineStage, error)
+	TxReplacePipelineStages(ctx context.Context, tx pkgtx.Tx, companyID string, stages []*storage.PipelineStage) error
+
+	TxLockApplicationsForMove(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		companyID string,
+		applicationIDs []string,
+	) ([]*storage.ApplicationMove, error)
+	TxMoveApplications(ctx context.Context, tx pkgtx.Tx, applicationIDs []string, stageID string, movedAt time.Time) error
+	TxPutStageHistory(ctx context.Context, tx pkgtx.Tx, h *storage.StageHistory) error
+	TxGetStageHistory(ctx context.Context, tx pkgtx.Tx, applicationID string) ([]*storage.StageHistory, error)
+	TxGetApplicationCompanyID(ctx context.Context, tx pkgtx.Tx, applicationID string) (string, error)
+	TxGetPipelineCounts(ctx context.Context, tx pkgtx.Tx, vacancyID string) (map[string]int, error)
+	TxGetPipelineApplicants(ctx context.Context, tx pkgtx.Tx, vacancyID string, perStage int) ([]*storage.Applicant, error)
+
+	BeginTx(ctx context.Context) (pkgtx.Tx, error)
+	NoTx() pkgtx.Tx
+}
+
+// ApplicationNotifier enqueues a notification to the candidate about a change of the application.
+type ApplicationNotifier interface {
+	TxNotifyApplicationUpdate(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		accountID string,
+		applicationID string,
+		vacancyID string,
+		title string,
+		body string,
+	) error
+}
+
+type Controller struct {
+	s        Storage
+	notifier ApplicationNotifier
+}
+
+// New creates the controller, candidates are not notified about the moves of their applications when notifier is nil.
+func New(s Storage, notifier ApplicationNotifier) *Controller {
+	return &Controller{s: s, notifier: notifier}
+}
+
+type ApplicationID string
+
+type Cursor string
+
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted ApplicationStatus = "submitted"
+	ApplicationStatusWithdrawn ApplicationStatus = "withdrawn"
+)
+
+type CVExperience struct {
+	CompanyName string
+	DateFrom    *time.Time
+	DateTill    *time.Time
+	Position    string
+	Description string
+}
+
+type CVEducation struct {
+	Institution string
+	DateFrom    *time.Time
+	DateTill    *time.Time
+	Speciality  string
+	Description string
+}
+
+// CVSnapshot is the cv as it was when the persona applied.
+type CVSnapshot struct {
+	ID                   string
+	Position             string
+	WorkMonthsExperience int32
+	MinSalary            int32
+	MaxSalary            int32
+	JobTypes             []string
+	JobKinds             []string
+	Experiences          []*CVExperience
+	Educations           []*CVEducation
+	CustomSections       []string
+	UpdatedAt            time.Time
+	// Currency is an ISO 4217 code, SalaryPeriod is hour, month or year. SalaryGross tells whether
+	// the salary is before taxes, nil when not specified.
+	Currency     string
+	SalaryPeriod string
+	SalaryGross  *bool
+}
+
+type Application struct {
+	ID        string
+	VacancyID string
+	PersonaID string
+	// CVID is empty once the cv is deleted, the snapshot stays
+	CVID        string
+	CV          *CVSnapshot
+	Message     string
+	Status      ApplicationStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	WithdrawnAt *time.Time
+}
+
+type MyApplication struct {
+	Application
+	VacancyTitle string
+	CompanyID    string
+	CompanyTitle string
+}
+
+type Applicant struct {
+	Application
+	PersonaName      string
+	PersonaAvatarURL string
+	Stage            PipelineStage
+}
+
+// ApplyToVacancy sends a snapshot of the persona cv to a published vacancy.
+// A persona applies to a vacancy once, though a withdrawn application may be sent again.
+func (c *Controller) ApplyToVacancy(
+	ctx context.Context,
+	personaID string,
+	vacancyID string,
+	cvID string,
+	message string,
+) (ApplicationID, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return "", errors.WithStack(ErrVacancyNotFound)
+	}
+
+	if _, err := uuid.FromString(cvID); err != nil {
+		return "", errors.WithStack(ErrCVNotFound)
+	}
+
+	if utf8.RuneCountInString(message) > maxMessageLength {
+		return "", errors.WithStack(ErrInvalidMessage)
+	}
+
+	var applicationID string
+
+	err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		now := time.Now()
+
+		v, err := c.s.TxGetVacancy(ctx, tx, vacancyID)
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrVacancyNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		if v.CompanyID == "" || v.Status != storage.VacancyStatusPublished ||
+			v.ExpiresAt == nil || !v.ExpiresAt.After(now) {
+			return errors.WithStack(ErrVacancyNotOpen)
+		}
+
+		snapshot, err := c.s.TxGetCVSnapshot(ctx, tx, personaID, cvID)
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrCVNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		stage, err := c.txGetNewStage(ctx, tx, v.CompanyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		applicationID, err = c.s.TxPutApplication(ctx, tx, &storage.Application{
+			ID:         uuid.NewV4().String(),
+			VacancyID:  vacancyID,
+			PersonaID:  personaID,
+			CVID:       &cvID,
+			StageID:    stage.ID,
+			CVSnapshot: snapshot,
+			Message:    message,
+			Status:     storage.ApplicationStatusSubmitted,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrAlreadyApplied:
+			return errors.WithStack(ErrAlreadyApplied)
+		default:
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.s.TxPutStageHistory(ctx, tx, &storage.StageHistory{
+			ID:            uuid.NewV4().String(),
+			ApplicationID: applicationID,
+			ToStageID:     &stage.ID,
+			ToStageName:   stage.Name,
+			MovedBy:       &personaID,
+			CreatedAt:     now,
+		}))
+	})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return ApplicationID(applicationID), nil
+}
+
+// WithdrawApplication withdraws a submitted application of the persona, the company doesn't see it anymore.
+func (c *Controller) WithdrawApplication(ctx context.Context, personaID string, applicationID string) error {
+	if _, err := uuid.FromString(applicationID); err != nil {
+		return errors.WithStack(ErrApplicationNotFound)
+	}
+
+	err := c.s.TxWithdrawApplication(ctx, c.s.NoTx(), personaID, applicationID, time.Now())
+
+	switch errors.Cause(err) {
+	case nil:
+		return nil
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrApplicationNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+}
+
+// ListMyApplications lists the applications of the persona whatever their status, the newest first.
+func (c *Controller) ListMyApplications(
+	ctx context.Context,
+	personaID string,
+	cursor *Cursor,
+	limit int,
+) ([]*MyApplication, *Cursor, error) {
+	storageCursor, err := toStorageCursor(cursor, personaID)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	maxLimit := 100
+	if limit > maxLimit || limit <= 0 {
+		limit = maxLimit
+	}
+
+	sas, nextCursor, err := c.s.TxGetPersonaApplications(ctx, c.s.NoTx(), personaID, storageCursor, limit)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	applications := make([]*MyApplication, len(sas))
+
+	for idx, sa := range sas {
+		a, err := fromStorageApplication(&sa.Application)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		applications[idx] = &MyApplication{
+			Application:  *a,
+			VacancyTitle: sa.VacancyTitle,
+			CompanyID:    sa.CompanyID,
+			CompanyTitle: sa.CompanyTitle,
+		}
+	}
+
+	next, err := toCursor(nextCursor, personaID)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return applications, next, nil
+}
+
+// ListVacancyApplicants lists the submitted applications to a vacancy of the company, the newest first.
+func (c *Controller) ListVacancyApplicants(
+	ctx context.Context,
+	companyID string,
+	vacancyID string,
+	cursor *Cursor,
+	limit int,
+) ([]*Applicant, *Cursor, error) {
+	if err := c.checkVacancyCompany(ctx, companyID, vacancyID); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	storageCursor, err := toStorageCursor(cursor, vacancyID)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	maxLimit := 100
+	if limit > maxLimit || limit <= 0 {
+		limit = maxLimit
+	}
+
+	sas, nextCursor, err := c.s.TxGetVacancyApplicants(ctx, c.s.NoTx(), vacancyID, storageCursor, limit)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	applicants := make([]*Applicant, len(sas))
+
+	for idx, sa := range sas {
+		a, err := fromStorageApplicant(sa)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		applicants[idx] = a
+	}
+
+	next, err := toCursor(nextCursor, vacancyID)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return applicants, next, nil
+}
+
+// checkVacancyCompany returns ErrVacancyNotFound unless the vacancy belongs to the company.
+func (c *Controller) checkVacancyCompany(ctx context.Context, companyID string, vacancyID string) error {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return errors.WithStack(ErrVacancyNotFound)
+	}
+
+	v, err := c.s.TxGetVacancy(ctx, c.s.NoTx(), vacancyID)
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrVacancyNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+
+	if v.CompanyID != companyID {
+		return errors.WithStack(ErrVacancyNotFound)
+	}
+
+	return nil
+}
+
+// txGetNewStage returns the stage of the company pipeline new applications start at.
+func (c *Controller) txGetNewStage(ctx context.Context, tx pkgtx.Tx, companyID string) (*storage.PipelineStage, error) {
+	stages, err := c.txGetPipelineStages(ctx, tx, companyID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, ps := range stages {
+		if ps.Kind == storage.PipelineStageKindNew {
+			return ps, nil
+		}
+	}
+
+	return nil, errors.Newf("company=%s has no new pipeline stage", companyID)
+}
+
+func fromStorageApplicant(sa *storage.Applicant) (*Applicant, error) {
+	a, err := fromStorageApplication(&sa.Application)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	kind, err := fromStoragePipelineStageKind(sa.StageKind)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &Applicant{
+		Application:      *a,
+		PersonaName:      sa.PersonaName,
+		PersonaAvatarURL: sa.PersonaAvatarURL,
+		Stage:            PipelineStage{ID: sa.StageID, Name: sa.StageName, Kind: kind},
+	}, nil
+}
+
+func fromStorageApplication(sa *storage.Application) (*Application, error) {
+	status, err := fromStorageApplicationStatus(sa.Status)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	a := &Application{
+		ID:          sa.ID,
+		VacancyID:   sa.VacancyID,
+		PersonaID:   sa.PersonaID,
+		CV:          fromStorageCVSnapshot(sa.CVSnapshot),
+		Message:     sa.Message,
+		Status:      status,
+		CreatedAt:   sa.CreatedAt,
+		UpdatedAt:   sa.UpdatedAt,
+		WithdrawnAt: sa.WithdrawnAt,
+	}
+
+	if sa.CVID != nil {
+		a.CVID = *sa.CVID
+	}
+
+	return a, nil
+}
+
+func fromStorageCVSnapshot(s *storage.CVSnapshot) *CVSnapshot {
+	if s == nil {
+		return nil
+	}
+
+	// The snapshots taken before the cvs had the salary terms are in UAH a month
+	currency, salaryPeriod := s.Currency, "month"
+	if currency == "" {
+		currency = "UAH"
+	}
+
+	switch s.SalaryPeriod {
+	case "salary_period_hour":
+		salaryPeriod = "hour"
+	case "salary_period_year":
+		salaryPeriod = "year"
+	}
+
+	experiences := make([]*CVExperience, len(s.Experiences))
+	for idx, e := range s.Experiences {
+		experiences[idx] = &CVExperience{
+			CompanyName: e.CompanyName,
+			DateFrom:    e.DateFrom,
+			DateTill:    e.DateTill,
+			Position:    e.Position,
+			Description: e.Description,
+		}
+	}
+
+	educations := make([]*CVEducation, len(s.Educations))
+	for idx, e := range s.Educations {
+		educations[idx] = &CVEducation{
+			Institution: e.Institution,
+			DateFrom:    e.DateFrom,
+			DateTill:    e.DateTill,
+			Speciality:  e.Speciality,
+			Description: e.Description,
+		}
+	}
+
+	return &CVSnapshot{
+		ID:                   s.ID,
+		Position:             s.Position,
+		WorkMonthsExperience: s.WorkMonthsExperience,
+		MinSalary:            s.MinSalary,
+		MaxSalary:            s.MaxSalary,
+		Currency:             currency,
+		SalaryPeriod:         salaryPeriod,
+		SalaryGross:          s.SalaryGross,
+		JobTypes:             s.JobTypes,
+		JobKinds:             s.JobKinds,
+		Experiences:          experiences,
+		Educations:           educations,
+		CustomSections:       s.CustomSections,
+		UpdatedAt:            s.UpdatedAt,
+	}
+}
+
+func fromStorageApplicationStatus(s storage.ApplicationStatus) (ApplicationStatus, error) {
+	switch s {
+	case storage.ApplicationStatusSubmitted:
+		return ApplicationStatusSubmitted, nil
+	case storage.ApplicationStatusWithdrawn:
+		return ApplicationStatusWithdrawn, nil
+	default:
+		return "", errors.Newf("unknown application status=%s", s)
+	}
+}
@@ -0,0 +1,552 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	sqlMigrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+
+	"personaapp/internal/controllers/application/controller"
+	"personaapp/internal/controllers/application/storage"
+	authController "personaapp/internal/controllers/auth/controller"
+	companyController "personaapp/internal/controllers/company/controller"
+	companyStorage "personaapp/internal/controllers/company/storage"
+	cvController "personaapp/internal/controllers/cv/controller"
+	cvStorage "personaapp/internal/controllers/cv/storage"
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+	vacancyStorage "personaapp/internal/controllers/vacancy/storage"
+	"personaapp/internal/testutils"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var authCfg = &authController.Config{
+	TokenExpiration:   5 * time.Minute,
+	PrivateSigningKey: "signkey",
+	TokenValidityGap:  15 * time.Second,
+}
+
+var vacancyCfg = &vacancyController.Config{
+	VacancyLifetime: 24 * time.Hour,
+	ExpiryInterval:  time.Minute,
+	ExpiryBatchSize: 10,
+}
+
+type applicationNotification struct {
+	AccountID     string
+	ApplicationID string
+	Body          string
+}
+
+type applicationRecorder struct {
+	notifications []applicationNotification
+}
+
+func (r *applicationRecorder) TxNotifyApplicationUpdate(
+	_ context.Context,
+	_ pkgtx.Tx,
+	accountID string,
+	applicationID string,
+	_ string,
+	_ string,
+	body string,
+) error {
+	r.notifications = append(r.notifications, applicationNotification{
+		AccountID:     accountID,
+		ApplicationID: applicationID,
+		Body:          body,
+	})
+
+	return nil
+}
+
+func initStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
+
+	return storage.New(pg), pg.Close
+}
+
+func cleanup(t *testing.T) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Down))
+}
+
+func TestController_Applications(t *testing.T) {
+	s, closer := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+	pg := testutils.EnsurePostgres(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+		if err := pg.Close(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	c := controller.New(s, nil)
+	ac := authController.New(authCfg, as, nil)
+	cc := companyController.New(companyStorage.New(pg), nil)
+	vc := vacancyController.New(vacancyCfg, vacancyStorage.New(pg), nil, nil, nil)
+	cvc := cvController.New(cvStorage.New(pg), nil)
+
+	register := func(email string, phone string, account authController.AccountType) string {
+		token, err := ac.Register(context.TODO(), &authController.RegisterData{
+			Email:    email,
+			Phone:    phone,
+			Account:  account,
+			Password: "Password1488",
+		})
+		require.NoError(t, err)
+
+		claims, err := ac.GetAuthClaims(context.TODO(), token.Token)
+		require.NoError(t, err)
+
+		if account == authController.AccountTypeCompany {
+			companyTitle := "Company"
+			require.NoError(t, cc.Update(context.TODO(), &companyController.CompanyData{
+				ID:    claims.AccountID,
+				Title: &companyTitle,
+			}))
+		}
+
+		return claims.AccountID
+	}
+
+	companyID := register("apply.company@gmail.com", "+380503000080", authController.AccountTypeCompany)
+	otherCompanyID := register("apply.other@gmail.com", "+380503000081", authController.AccountTypeCompany)
+	personaID := register("apply.persona@gmail.com", "+380503000082", authController.AccountTypePersona)
+	otherPersonaID := register("apply.other.persona@gmail.com", "+380503000083", authController.AccountTypePersona)
+
+	putVacancy := func(title string, publish bool) string {
+		vacancyID, err := vc.PutVacancy(context.TODO(), companyID, nil, &vacancyController.VacancyDetails{
+			Vacancy: vacancyController.Vacancy{
+				Title:     title,
+				Phone:     "+380503000084",
+				MinSalary: 10000,
+				MaxSalary: 20000,
+				CompanyID: companyID,
+			},
+			Description:          "Description",
+			WorkMonthsExperience: 12,
+			Type:                 vacancyController.VacancyTypeNormal,
+		}, []string{}, []string{})
+		require.NoError(t, err)
+
+		if publish {
+			require.NoError(t, vc.PublishVacancy(context.TODO(), string(vacancyID), nil))
+		}
+
+		return string(vacancyID)
+	}
+
+	putCV := func(personaID string, position string) string {
+		cvID, err := cvc.PutCV(context.TODO(), nil, &cvController.CV{
+			PersonaID:            personaID,
+			Position:             position,
+			WorkMonthsExperience: 24,
+			MinSalary:            15000,
+			MaxSalary:            25000,
+		})
+		require.NoError(t, err)
+
+		return string(cvID)
+	}
+
+	vacancyID := putVacancy("Golang developer", true)
+	secondVacancyID := putVacancy("Python developer", true)
+	draftID := putVacancy("Designer", false)
+
+	cvID := putCV(personaID, "Developer")
+	_, err := cvc.PutExperience(context.TODO(), nil, &cvController.CVExperience{
+		CvID:        cvID,
+		CompanyName: "Previous company",
+		DateFrom:    time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateTill:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Position:    "Junior developer",
+		Description: "Backend",
+	})
+	require.NoError(t, err)
+
+	otherCVID := putCV(otherPersonaID, "Analyst")
+
+	var applicationID string
+
+	t.Run("apply", func(t *testing.T) {
+		id, err := c.ApplyToVacancy(context.TODO(), personaID, vacancyID, cvID, "Hello")
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+
+		applicationID = string(id)
+
+		_, err = c.ApplyToVacancy(context.TODO(), personaID, vacancyID, cvID, "Hello again")
+		require.Equal(t, controller.ErrAlreadyApplied, errors.Cause(err))
+
+		_, err = c.ApplyToVacancy(context.TODO(), otherPersonaID, vacancyID, otherCVID, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("apply invalid", func(t *testing.T) {
+		_, err := c.ApplyToVacancy(context.TODO(), personaID, draftID, cvID, "")
+		require.Equal(t, controller.ErrVacancyNotOpen, errors.Cause(err))
+
+		_, err = c.ApplyToVacancy(context.TODO(), personaID, "not uuid", cvID, "")
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+
+		_, err = c.ApplyToVacancy(context.TODO(), personaID, secondVacancyID, otherCVID, "")
+		require.Equal(t, controller.ErrCVNotFound, errors.Cause(err))
+
+		message := make([]rune, 5001)
+		for idx := range message {
+			message[idx] = 'м'
+		}
+
+		_, err = c.ApplyToVacancy(context.TODO(), personaID, secondVacancyID, cvID, string(message))
+		require.Equal(t, controller.ErrInvalidMessage, errors.Cause(err))
+	})
+
+	t.Run("cv snapshot", func(t *testing.T) {
+		_, err := cvc.PutCV(context.TODO(), &cvID, &cvController.CV{
+			PersonaID: personaID,
+			Position:  "Senior developer",
+		})
+		require.NoError(t, err)
+
+		applications, _, err := c.ListMyApplications(context.TODO(), personaID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, applications, 1)
+		require.Equal(t, applicationID, applications[0].ID)
+		require.Equal(t, "Golang developer", applications[0].VacancyTitle)
+		require.Equal(t, "Company", applications[0].CompanyTitle)
+		require.Equal(t, controller.ApplicationStatusSubmitted, applications[0].Status)
+		require.Equal(t, cvID, applications[0].CVID)
+		require.Equal(t, "Developer", applications[0].CV.Position)
+		require.Equal(t, int32(24), applications[0].CV.WorkMonthsExperience)
+		require.Len(t, applications[0].CV.Experiences, 1)
+		require.Equal(t, "Previous company", applications[0].CV.Experiences[0].CompanyName)
+	})
+
+	t.Run("list applicants", func(t *testing.T) {
+		applicants, cursor, err := c.ListVacancyApplicants(context.TODO(), companyID, vacancyID, nil, 1)
+		require.NoError(t, err)
+		require.NotNil(t, cursor)
+		require.Len(t, applicants, 1)
+		require.Equal(t, otherPersonaID, applicants[0].PersonaID)
+
+		applicants, cursor, err = c.ListVacancyApplicants(context.TODO(), companyID, vacancyID, cursor, 1)
+		require.NoError(t, err)
+		require.Nil(t, cursor)
+		require.Len(t, applicants, 1)
+		require.Equal(t, personaID, applicants[0].PersonaID)
+		require.Equal(t, "Hello", applicants[0].Message)
+
+		_, _, err = c.ListVacancyApplicants(context.TODO(), otherCompanyID, vacancyID, nil, 10)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+
+		_, cursor, err = c.ListVacancyApplicants(context.TODO(), companyID, vacancyID, nil, 1)
+		require.NoError(t, err)
+
+		_, _, err = c.ListMyApplications(context.TODO(), personaID, cursor, 1)
+		require.Equal(t, controller.ErrInvalidCursor, errors.Cause(err))
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		err := c.WithdrawApplication(context.TODO(), otherPersonaID, applicationID)
+		require.Equal(t, controller.ErrApplicationNotFound, errors.Cause(err))
+
+		require.NoError(t, c.WithdrawApplication(context.TODO(), personaID, applicationID))
+
+		err = c.WithdrawApplication(context.TODO(), personaID, applicationID)
+		require.Equal(t, controller.ErrApplicationNotFound, errors.Cause(err))
+
+		applicants, _, err := c.ListVacancyApplicants(context.TODO(), companyID, vacancyID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, applicants, 1)
+		require.Equal(t, otherPersonaID, applicants[0].PersonaID)
+
+		applications, _, err := c.ListMyApplications(context.TODO(), personaID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, applications, 1)
+		require.Equal(t, controller.ApplicationStatusWithdrawn, applications[0].Status)
+		require.NotNil(t, applications[0].WithdrawnAt)
+	})
+
+	t.Run("apply again after withdrawal", func(t *testing.T) {
+		id, err := c.ApplyToVacancy(context.TODO(), personaID, vacancyID, cvID, "Changed my mind")
+		require.NoError(t, err)
+		require.Equal(t, applicationID, string(id))
+
+		applications, _, err := c.ListMyApplications(context.TODO(), personaID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, applications, 1)
+		require.Equal(t, controller.ApplicationStatusSubmitted, applications[0].Status)
+		require.Equal(t, "Senior developer", applications[0].CV.Position)
+		require.Nil(t, applications[0].WithdrawnAt)
+	})
+
+	t.Run("cv deleted", func(t *testing.T) {
+		require.NoError(t, cvc.DeleteCV(context.TODO(), cvID))
+
+		applications, _, err := c.ListMyApplications(context.TODO(), personaID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, applications, 1)
+		require.Empty(t, applications[0].CVID)
+		require.Equal(t, "Senior developer", applications[0].CV.Position)
+	})
+}
+
+func TestController_Pipeline(t *testing.T) {
+	s, closer := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+	pg := testutils.EnsurePostgres(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+		if err := pg.Close(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	recorder := &applicationRecorder{}
+	c := controller.New(s, recorder)
+	ac := authController.New(authCfg, as, nil)
+	cc := companyController.New(companyStorage.New(pg), nil)
+	vc := vacancyController.New(vacancyCfg, vacancyStorage.New(pg), nil, nil, nil)
+	cvc := cvController.New(cvStorage.New(pg), nil)
+
+	register := func(email string, phone string, account authController.AccountType) string {
+		token, err := ac.Register(context.TODO(), &authController.RegisterData{
+			Email:    email,
+			Phone:    phone,
+			Account:  account,
+			Password: "Password1488",
+		})
+		require.NoError(t, err)
+
+		claims, err := ac.GetAuthClaims(context.TODO(), token.Token)
+		require.NoError(t, err)
+
+		if account == authController.AccountTypeCompany {
+			companyTitle := "Company"
+			require.NoError(t, cc.Update(context.TODO(), &companyController.CompanyData{
+				ID:    claims.AccountID,
+				Title: &companyTitle,
+			}))
+		}
+
+		return claims.AccountID
+	}
+
+	companyID := register("pipeline.company@gmail.com", "+380503000090", authController.AccountTypeCompany)
+	otherCompanyID := register("pipeline.other@gmail.com", "+380503000091", authController.AccountTypeCompany)
+	personaIDs := []string{
+		register("pipeline.persona1@gmail.com", "+380503000092", authController.AccountTypePersona),
+		register("pipeline.persona2@gmail.com", "+380503000093", authController.AccountTypePersona),
+		register("pipeline.persona3@gmail.com", "+380503000094", authController.AccountTypePersona),
+	}
+
+	vacancyID, err := vc.PutVacancy(context.TODO(), companyID, nil, &vacancyController.VacancyDetails{
+		Vacancy: vacancyController.Vacancy{
+			Title:     "Golang developer",
+			Phone:     "+380503000095",
+			MinSalary: 10000,
+			MaxSalary: 20000,
+			CompanyID: companyID,
+		},
+		Description:          "Description",
+		WorkMonthsExperience: 12,
+		Type:                 vacancyController.VacancyTypeNormal,
+	}, []string{}, []string{})
+	require.NoError(t, err)
+	require.NoError(t, vc.PublishVacancy(context.TODO(), string(vacancyID), nil))
+
+	applicationIDs := make([]string, len(personaIDs))
+
+	for idx, personaID := range personaIDs {
+		cvID, err := cvc.PutCV(context.TODO(), nil, &cvController.CV{PersonaID: personaID, Position: "Developer"})
+		require.NoError(t, err)
+
+		id, err := c.ApplyToVacancy(context.TODO(), personaID, string(vacancyID), string(cvID), "")
+		require.NoError(t, err)
+
+		applicationIDs[idx] = string(id)
+	}
+
+	stageByKind := func(stages []*controller.PipelineStage, kind controller.PipelineStageKind) *controller.PipelineStage {
+		for _, ps := range stages {
+			if ps.Kind == kind {
+				return ps
+			}
+		}
+
+		t.Fatalf("no %s stage", kind)
+
+		return nil
+	}
+
+	var stages []*controller.PipelineStage
+
+	t.Run("default stages", func(t *testing.T) {
+		stages, err = c.GetPipelineStages(context.TODO(), companyID)
+		require.NoError(t, err)
+		require.Len(t, stages, 6)
+		require.Equal(t, "New", stages[0].Name)
+		require.Equal(t, controller.PipelineStageKindNew, stages[0].Kind)
+		require.Equal(t, controller.PipelineStageKindRejected, stages[5].Kind)
+
+		otherStages, err := c.GetPipelineStages(context.TODO(), otherCompanyID)
+		require.NoError(t, err)
+		require.Len(t, otherStages, 6)
+		require.NotEqual(t, stages[0].ID, otherStages[0].ID)
+	})
+
+	t.Run("update stages invalid", func(t *testing.T) {
+		_, err := c.UpdatePipelineStages(context.TODO(), companyID, []*controller.PipelineStage{
+			{Name: "New", Kind: controller.PipelineStageKindNew},
+			{Name: "Hired", Kind: controller.PipelineStageKindHired},
+		})
+		require.Equal(t, controller.ErrInvalidPipelineStages, errors.Cause(err))
+
+		_, err = c.UpdatePipelineStages(context.TODO(), companyID, []*controller.PipelineStage{
+			{Name: "New", Kind: controller.PipelineStageKindNew},
+			{Name: "New", Kind: controller.PipelineStageKindHired},
+			{Name: "Rejected", Kind: controller.PipelineStageKindRejected},
+		})
+		require.Equal(t, controller.ErrInvalidPipelineStageName, errors.Cause(err))
+
+		_, err = c.UpdatePipelineStages(context.TODO(), companyID, []*controller.PipelineStage{
+			{Name: "New", Kind: controller.PipelineStageKindNew},
+			{Name: "Unknown", Kind: "unknown"},
+			{Name: "Hired", Kind: controller.PipelineStageKindHired},
+			{Name: "Rejected", Kind: controller.PipelineStageKindRejected},
+		})
+		require.Equal(t, controller.ErrInvalidPipelineStageKind, errors.Cause(err))
+	})
+
+	t.Run("update stages", func(t *testing.T) {
+		newStage := stageByKind(stages, controller.PipelineStageKindNew)
+		hired := stageByKind(stages, controller.PipelineStageKindHired)
+		rejected := stageByKind(stages, controller.PipelineStageKindRejected)
+
+		updated, err := c.UpdatePipelineStages(context.TODO(), companyID, []*controller.PipelineStage{
+			{ID: newStage.ID, Name: "Applied", Kind: controller.PipelineStageKindNew},
+			{Name: "Test task", Kind: controller.PipelineStageKindScreening},
+			{ID: hired.ID, Name: "Hired", Kind: controller.PipelineStageKindHired},
+			{ID: rejected.ID, Name: "Rejected", Kind: controller.PipelineStageKindRejected},
+		})
+		require.NoError(t, err)
+		require.Len(t, updated, 4)
+		require.Equal(t, newStage.ID, updated[0].ID)
+		require.NotEmpty(t, updated[1].ID)
+
+		stages, err = c.GetPipelineStages(context.TODO(), companyID)
+		require.NoError(t, err)
+		require.Equal(t, updated, stages)
+		require.Equal(t, "Applied", stages[0].Name)
+		require.Equal(t, "Test task", stages[1].Name)
+	})
+
+	t.Run("move applications", func(t *testing.T) {
+		screening := stageByKind(stages, controller.PipelineStageKindScreening)
+
+		err := c.MoveApplications(context.TODO(), otherCompanyID, otherCompanyID, &controller.ApplicationsMove{
+			ApplicationIDs: applicationIDs,
+			StageID:        screening.ID,
+		})
+		require.Equal(t, controller.ErrPipelineStageNotFound, errors.Cause(err))
+
+		err = c.MoveApplications(context.TODO(), companyID, companyID, &controller.ApplicationsMove{
+			ApplicationIDs:  applicationIDs[:2],
+			StageID:         screening.ID,
+			RejectionReason: "Not a fit",
+		})
+		require.Equal(t, controller.ErrInvalidRejectionReason, errors.Cause(err))
+
+		err = c.MoveApplications(context.TODO(), companyID, companyID, &controller.ApplicationsMove{
+			ApplicationIDs:  applicationIDs[:2],
+			StageID:         screening.ID,
+			NotifyCandidate: true,
+			MessageTemplate: "{{.Unknown}}",
+		})
+		require.Equal(t, controller.ErrInvalidMessageTemplate, errors.Cause(err))
+
+		require.NoError(t, c.MoveApplications(context.TODO(), companyID, companyID, &controller.ApplicationsMove{
+			ApplicationIDs:  applicationIDs[:2],
+			StageID:         screening.ID,
+			NotifyCandidate: true,
+			MessageTemplate: "{{.VacancyTitle}}: {{.StageName}}",
+		}))
+		require.Len(t, recorder.notifications, 2)
+		require.Equal(t, "Golang developer: Test task", recorder.notifications[0].Body)
+
+		_, err = c.UpdatePipelineStages(context.TODO(), companyID, []*controller.PipelineStage{
+			stages[0],
+			stages[2],
+			stages[3],
+		})
+		require.Equal(t, controller.ErrPipelineStageNotEmpty, errors.Cause(err))
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		recorder.notifications = nil
+		rejected := stageByKind(stages, controller.PipelineStageKindRejected)
+
+		require.NoError(t, c.MoveApplications(context.TODO(), companyID, companyID, &controller.ApplicationsMove{
+			ApplicationIDs:  []string{applicationIDs[0]},
+			StageID:         rejected.ID,
+			RejectionReason: "We hired someone else.",
+			NotifyCandidate: true,
+		}))
+		require.Len(t, recorder.notifications, 1)
+		require.Equal(t, personaIDs[0], recorder.notifications[0].AccountID)
+		require.Equal(t, applicationIDs[0], recorder.notifications[0].ApplicationID)
+		require.Contains(t, recorder.notifications[0].Body, "We hired someone else.")
+	})
+
+	t.Run("history", func(t *testing.T) {
+		_, err := c.GetApplicationHistory(context.TODO(), otherCompanyID, applicationIDs[0])
+		require.Equal(t, controller.ErrApplicationNotFound, errors.Cause(err))
+
+		moves, err := c.GetApplicationHistory(context.TODO(), companyID, applicationIDs[0])
+		require.NoError(t, err)
+		require.Len(t, moves, 3)
+		require.Empty(t, moves[0].FromStageID)
+		require.Equal(t, "New", moves[0].ToStageName)
+		require.Equal(t, "Applied", moves[1].FromStageName)
+		require.Equal(t, "Test task", moves[1].ToStageName)
+		require.Equal(t, "Rejected", moves[2].ToStageName)
+		require.Equal(t, "We hired someone else.", moves[2].RejectionReason)
+		require.Equal(t, companyID, moves[2].MovedBy)
+	})
+
+	t.Run("pipeline", func(t *testing.T) {
+		_, err := c.GetPipeline(context.TODO(), otherCompanyID, string(vacancyID), 10)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+
+		columns, err := c.GetPipeline(context.TODO(), companyID, string(vacancyID), 10)
+		require.NoError(t, err)
+		require.Len(t, columns, 4)
+		require.Equal(t, 1, columns[0].Count)
+		require.Len(t, columns[0].Applicants, 1)
+		require.Equal(t, personaIDs[2], columns[0].Applicants[0].PersonaID)
+		require.Equal(t, 1, columns[1].Count)
+		require.Equal(t, "Test task", columns[1].Applicants[0].Stage.Name)
+		require.Equal(t, 0, columns[2].Count)
+		require.Empty(t, columns[2].Applicants)
+		require.Equal(t, 1, columns[3].Count)
+	})
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/application/storage"
+)
+
+// cursorData binds the position to the listed persona or vacancy, so the next page can't be requested for others.
+type cursorData struct {
+	PrevCreatedAt string `json:"created_at"`
+	PrevID        string `json:"id"`
+	Owner         string `json:"owner"`
+}
+
+func toStorageCursor(cursor *Cursor, owner string) (*storage.Cursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(*cursor))
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	var cd cursorData
+	if err := json.Unmarshal(decoded, &cd); err != nil || cd.Owner != owner {
+		return nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	prevCreatedAt, err := time.Parse(time.RFC3339Nano, cd.PrevCreatedAt)
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	return &storage.Cursor{PrevCreatedAt: prevCreatedAt, PrevID: cd.PrevID}, nil
+}
+
+func toCursor(cursor *storage.Cursor, owner string) (*Cursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(cursorData{
+		PrevCreatedAt: cursor.PrevCreatedAt.Format(time.RFC3339Nano),
+		PrevID:        cursor.PrevID,
+		Owner:         owner,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	c := Cursor(base64.StdEncoding.EncodeToString(data))
+
+	return &c, nil
+}
@@ -0,0 +1,666 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/application/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const (
+	maxPipelineStages        = 20
+	maxPipelineStageName     = 255
+	maxMovedApplications     = 100
+	maxRejectionReasonLength = 1000
+	maxApplicantsPerStage    = 100
+)
+
+type PipelineStageKind string
+
+const (
+	PipelineStageKindNew       PipelineStageKind = "new"
+	PipelineStageKindScreening PipelineStageKind = "screening"
+	PipelineStageKindInterview PipelineStageKind = "interview"
+	PipelineStageKindOffer     PipelineStageKind = "offer"
+	PipelineStageKindHired     PipelineStageKind = "hired"
+	PipelineStageKindRejected  PipelineStageKind = "rejected"
+)
+
+// PipelineStage is a column of the company pipeline. A company may name its stages and add more of the
+// screening, interview and offer kinds, but it always has exactly one new, hired and rejected stage.
+// New applications start at the new stage.
+type PipelineStage struct {
+	// ID is empty for a stage to be created
+	ID   string
+	Name string
+	Kind PipelineStageKind
+}
+
+// defaultPipelineStages are given to a company on its first use of the pipeline.
+var defaultPipelineStages = []*PipelineStage{
+	{Name: "New", Kind: PipelineStageKindNew},
+	{Name: "Screening", Kind: PipelineStageKindScreening},
+	{Name: "Interview", Kind: PipelineStageKindInterview},
+	{Name: "Offer", Kind: PipelineStageKindOffer},
+	{Name: "Hired", Kind: PipelineStageKindHired},
+	{Name: "Rejected", Kind: PipelineStageKindRejected},
+}
+
+// ApplicationsMove moves applications to a vacancy of the company to the stage.
+type ApplicationsMove struct {
+	ApplicationIDs []string
+	StageID        string
+	// RejectionReason is only accepted when moving to the rejected stage
+	RejectionReason string
+	NotifyCandidate bool
+	// MessageTemplate is a text/template of the notification sent to the candidate with the fields of
+	// MessageTemplateData, the default one of the stage kind is used when empty
+	MessageTemplate string
+}
+
+// MessageTemplateData is what the message template of a move may refer to.
+type MessageTemplateData struct {
+	CandidateName   string
+	VacancyTitle    string
+	CompanyTitle    string
+	StageName       string
+	RejectionReason string
+}
+
+const (
+	defaultMoveMessage = `Your application to {{.VacancyTitle}} at {{.CompanyTitle}} ` +
+		`has moved to the {{.StageName}} stage.`
+	defaultRejectionMessage = `Thank you for your interest in {{.VacancyTitle}} at {{.CompanyTitle}}. ` +
+		`Unfortunately we decided not to move forward with your application.` +
+		`{{if .RejectionReason}} {{.RejectionReason}}{{end}}`
+)
+
+type StageMove struct {
+	// FromStageName is empty for the move the application started the pipeline with
+	FromStageID     string
+	FromStageName   string
+	ToStageID       string
+	ToStageName     string
+	RejectionReason string
+	MovedBy         string
+	CreatedAt       time.Time
+}
+
+type PipelineColumn struct {
+	Stage PipelineStage
+	// Count is the number of applications at the stage, Applicants may list only the most recently moved ones
+	Count      int
+	Applicants []*Applicant
+}
+
+// GetPipelineStages lists the stages of the company pipeline in their order.
+func (c *Controller) GetPipelineStages(ctx context.Context, companyID string) ([]*PipelineStage, error) {
+	var stages []*storage.PipelineStage
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		var err error
+
+		stages, err = c.txGetPipelineStages(ctx, tx, companyID)
+
+		return errors.WithStack(err)
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return fromStoragePipelineStages(stages)
+}
+
+// UpdatePipelineStages replaces the stages of the company pipeline with stages in the given order.
+// Stages are matched by their ids, ErrPipelineStageNotEmpty is returned for a dropped stage with applications.
+func (c *Controller) UpdatePipelineStages(
+	ctx context.Context,
+	companyID string,
+	stages []*PipelineStage,
+) ([]*PipelineStage, error) {
+	if err := validatePipelineStages(stages); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var result []*storage.PipelineStage
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		if _, err := c.txGetPipelineStages(ctx, tx, companyID); err != nil {
+			return errors.WithStack(err)
+		}
+
+		current, err := c.s.TxLockPipelineStages(ctx, tx, companyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		currentByID := make(map[string]*storage.PipelineStage, len(current))
+		for _, ps := range current {
+			currentByID[ps.ID] = ps
+		}
+
+		now := time.Now()
+		sps := make([]*storage.PipelineStage, len(stages))
+
+		for idx, ps := range stages {
+			kind, err := toStoragePipelineStageKind(ps.Kind)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sps[idx] = &storage.PipelineStage{
+				ID:        ps.ID,
+				CompanyID: companyID,
+				Name:      ps.Name,
+				Kind:      kind,
+				Position:  idx + 1,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+
+			if ps.ID == "" {
+				sps[idx].ID = uuid.NewV4().String()
+				continue
+			}
+
+			cps, ok := currentByID[ps.ID]
+			if !ok {
+				return errors.WithStack(ErrPipelineStageNotFound)
+			}
+
+			sps[idx].CreatedAt = cps.CreatedAt
+		}
+
+		switch err := c.s.TxReplacePipelineStages(ctx, tx, companyID, sps); errors.Cause(err) {
+		case nil:
+		case storage.ErrStageNotEmpty:
+			return errors.WithStack(ErrPipelineStageNotEmpty)
+		default:
+			return errors.WithStack(err)
+		}
+
+		result = sps
+
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return fromStoragePipelineStages(result)
+}
+
+func validatePipelineStages(stages []*PipelineStage) error {
+	if len(stages) == 0 || len(stages) > maxPipelineStages {
+		return errors.WithStack(ErrInvalidPipelineStages)
+	}
+
+	var (
+		names = make(map[string]struct{}, len(stages))
+		ids   = make(map[string]struct{}, len(stages))
+		kinds = make(map[PipelineStageKind]int)
+	)
+
+	for _, ps := range stages {
+		if ps == nil {
+			return errors.WithStack(ErrInvalidPipelineStages)
+		}
+
+		if n := utf8.RuneCountInString(ps.Name); n == 0 || n > maxPipelineStageName {
+			return errors.WithStack(ErrInvalidPipelineStageName)
+		}
+
+		if _, ok := names[ps.Name]; ok {
+			return errors.WithStack(ErrInvalidPipelineStageName)
+		}
+
+		names[ps.Name] = struct{}{}
+
+		if ps.ID != "" {
+			if _, err := uuid.FromString(ps.ID); err != nil {
+				return errors.WithStack(ErrPipelineStageNotFound)
+			}
+
+			if _, ok := ids[ps.ID]; ok {
+				return errors.WithStack(ErrInvalidPipelineStages)
+			}
+
+			ids[ps.ID] = struct{}{}
+		}
+
+		if _, err := toStoragePipelineStageKind(ps.Kind); err != nil {
+			return errors.WithStack(err)
+		}
+
+		kinds[ps.Kind]++
+	}
+
+	for _, kind := range []PipelineStageKind{
+		PipelineStageKindNew,
+		PipelineStageKindHired,
+		PipelineStageKindRejected,
+	} {
+		if kinds[kind] != 1 {
+			return errors.WithStack(ErrInvalidPipelineStages)
+		}
+	}
+
+	return nil
+}
+
+// txGetPipelineStages returns the stages of the company creating the default ones on its first use of the pipeline.
+func (c *Controller) txGetPipelineStages(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+) ([]*storage.PipelineStage, error) {
+	defaults := make([]*storage.PipelineStage, len(defaultPipelineStages))
+
+	for idx, ps := range defaultPipelineStages {
+		kind, err := toStoragePipelineStageKind(ps.Kind)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		defaults[idx] = &storage.PipelineStage{ID: uuid.NewV4().String(), Name: ps.Name, Kind: kind}
+	}
+
+	if err := c.s.TxEnsurePipelineStages(ctx, tx, companyID, defaults); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	stages, err := c.s.TxGetPipelineStages(ctx, tx, companyID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return stages, nil
+}
+
+// MoveApplications moves the applications to the stage keeping the moves in their history. The move is done for
+// all the applications or none of them, ErrApplicationNotFound is returned if some of them are withdrawn or
+// were not sent to a vacancy of the company. Applications which already are at the stage are left as they are.
+func (c *Controller) MoveApplications(
+	ctx context.Context,
+	companyID string,
+	movedBy string,
+	move *ApplicationsMove,
+) error {
+	ids, err := validateApplicationsMove(move)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var message *template.Template
+
+	if move.NotifyCandidate {
+		if message, err = parseMessageTemplate(move.MessageTemplate); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		stages, err := c.txGetPipelineStages(ctx, tx, companyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		var stage *storage.PipelineStage
+
+		for _, ps := range stages {
+			if ps.ID == move.StageID {
+				stage = ps
+				break
+			}
+		}
+
+		if stage == nil {
+			return errors.WithStack(ErrPipelineStageNotFound)
+		}
+
+		if move.RejectionReason != "" && stage.Kind != storage.PipelineStageKindRejected {
+			return errors.WithStack(ErrInvalidRejectionReason)
+		}
+
+		if move.NotifyCandidate && move.MessageTemplate == "" {
+			defaultMessage := defaultMoveMessage
+			if stage.Kind == storage.PipelineStageKindRejected {
+				defaultMessage = defaultRejectionMessage
+			}
+
+			if message, err = parseMessageTemplate(defaultMessage); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		moves, err := c.s.TxLockApplicationsForMove(ctx, tx, companyID, ids)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if len(moves) != len(ids) {
+			return errors.WithStack(ErrApplicationNotFound)
+		}
+
+		now := time.Now()
+		movedIDs := make([]string, 0, len(moves))
+
+		for _, m := range moves {
+			if m.StageID == stage.ID {
+				continue
+			}
+
+			h := &storage.StageHistory{
+				ID:            uuid.NewV4().String(),
+				ApplicationID: m.ID,
+				FromStageID:   &m.StageID,
+				FromStageName: &m.StageName,
+				ToStageID:     &stage.ID,
+				ToStageName:   stage.Name,
+				MovedBy:       &movedBy,
+				CreatedAt:     now,
+			}
+
+			if move.RejectionReason != "" {
+				h.RejectionReason = &move.RejectionReason
+			}
+
+			if err := c.s.TxPutStageHistory(ctx, tx, h); err != nil {
+				return errors.WithStack(err)
+			}
+
+			if message != nil && c.notifier != nil {
+				if err := c.txNotifyCandidate(ctx, tx, message, m, stage, move.RejectionReason); err != nil {
+					return errors.WithStack(err)
+				}
+			}
+
+			movedIDs = append(movedIDs, m.ID)
+		}
+
+		if len(movedIDs) == 0 {
+			return nil
+		}
+
+		return errors.WithStack(c.s.TxMoveApplications(ctx, tx, movedIDs, stage.ID, now))
+	}))
+}
+
+func validateApplicationsMove(move *ApplicationsMove) ([]string, error) {
+	if move == nil || len(move.ApplicationIDs) == 0 || len(move.ApplicationIDs) > maxMovedApplications {
+		return nil, errors.WithStack(ErrInvalidApplicationsMove)
+	}
+
+	if _, err := uuid.FromString(move.StageID); err != nil {
+		return nil, errors.WithStack(ErrPipelineStageNotFound)
+	}
+
+	if utf8.RuneCountInString(move.RejectionReason) > maxRejectionReasonLength {
+		return nil, errors.WithStack(ErrInvalidRejectionReason)
+	}
+
+	if utf8.RuneCountInString(move.MessageTemplate) > maxMessageLength {
+		return nil, errors.WithStack(ErrInvalidMessageTemplate)
+	}
+
+	seen := make(map[string]struct{}, len(move.ApplicationIDs))
+	ids := make([]string, 0, len(move.ApplicationIDs))
+
+	for _, id := range move.ApplicationIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return nil, errors.WithStack(ErrApplicationNotFound)
+		}
+
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func parseMessageTemplate(text string) (*template.Template, error) {
+	t, err := template.New("message").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidMessageTemplate)
+	}
+
+	// a template referring to unknown fields fails on the first candidate otherwise
+	if err := t.Execute(&bytes.Buffer{}, &MessageTemplateData{}); err != nil {
+		return nil, errors.WithStack(ErrInvalidMessageTemplate)
+	}
+
+	return t, nil
+}
+
+func (c *Controller) txNotifyCandidate(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	message *template.Template,
+	m *storage.ApplicationMove,
+	stage *storage.PipelineStage,
+	rejectionReason string,
+) error {
+	var body bytes.Buffer
+
+	if err := message.Execute(&body, &MessageTemplateData{
+		CandidateName:   m.PersonaName,
+		VacancyTitle:    m.VacancyTitle,
+		CompanyTitle:    m.CompanyTitle,
+		StageName:       stage.Name,
+		RejectionReason: rejectionReason,
+	}); err != nil {
+		return errors.WithStack(ErrInvalidMessageTemplate)
+	}
+
+	if body.Len() == 0 {
+		return errors.WithStack(ErrInvalidMessageTemplate)
+	}
+
+	return errors.WithStack(c.notifier.TxNotifyApplicationUpdate(
+		ctx,
+		tx,
+		m.PersonaID,
+		m.ID,
+		m.VacancyID,
+		"Your application has been updated",
+		body.String(),
+	))
+}
+
+// GetApplicationHistory lists the stages the application went through, the earliest first.
+func (c *Controller) GetApplicationHistory(
+	ctx context.Context,
+	companyID string,
+	applicationID string,
+) ([]*StageMove, error) {
+	if _, err := uuid.FromString(applicationID); err != nil {
+		return nil, errors.WithStack(ErrApplicationNotFound)
+	}
+
+	applicationCompanyID, err := c.s.TxGetApplicationCompanyID(ctx, c.s.NoTx(), applicationID)
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, errors.WithStack(ErrApplicationNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	if applicationCompanyID != companyID {
+		return nil, errors.WithStack(ErrApplicationNotFound)
+	}
+
+	history, err := c.s.TxGetStageHistory(ctx, c.s.NoTx(), applicationID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	moves := make([]*StageMove, len(history))
+
+	for idx, h := range history {
+		moves[idx] = &StageMove{
+			FromStageID:     stringValue(h.FromStageID),
+			FromStageName:   stringValue(h.FromStageName),
+			ToStageID:       stringValue(h.ToStageID),
+			ToStageName:     h.ToStageName,
+			RejectionReason: stringValue(h.RejectionReason),
+			MovedBy:         stringValue(h.MovedBy),
+			CreatedAt:       h.CreatedAt,
+		}
+	}
+
+	return moves, nil
+}
+
+// GetPipeline groups the submitted applications to a vacancy of the company by their stage.
+// Every stage lists up to perStage applicants which were moved there most recently.
+func (c *Controller) GetPipeline(
+	ctx context.Context,
+	companyID string,
+	vacancyID string,
+	perStage int,
+) ([]*PipelineColumn, error) {
+	if err := c.checkVacancyCompany(ctx, companyID, vacancyID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if perStage > maxApplicantsPerStage || perStage <= 0 {
+		perStage = maxApplicantsPerStage
+	}
+
+	var (
+		stages     []*storage.PipelineStage
+		counts     map[string]int
+		applicants []*storage.Applicant
+	)
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		var err error
+
+		if stages, err = c.txGetPipelineStages(ctx, tx, companyID); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if counts, err = c.s.TxGetPipelineCounts(ctx, tx, vacancyID); err != nil {
+			return errors.WithStack(err)
+		}
+
+		applicants, err = c.s.TxGetPipelineApplicants(ctx, tx, vacancyID, perStage)
+
+		return errors.WithStack(err)
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	columns := make([]*PipelineColumn, len(stages))
+	columnsByStage := make(map[string]*PipelineColumn, len(stages))
+
+	for idx, ps := range stages {
+		stage, err := fromStoragePipelineStage(ps)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		columns[idx] = &PipelineColumn{
+			Stage:      *stage,
+			Count:      counts[ps.ID],
+			Applicants: make([]*Applicant, 0),
+		}
+		columnsByStage[ps.ID] = columns[idx]
+	}
+
+	for _, sa := range applicants {
+		column, ok := columnsByStage[sa.StageID]
+		if !ok {
+			continue
+		}
+
+		a, err := fromStorageApplicant(sa)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		column.Applicants = append(column.Applicants, a)
+	}
+
+	return columns, nil
+}
+
+func fromStoragePipelineStages(sps []*storage.PipelineStage) ([]*PipelineStage, error) {
+	stages := make([]*PipelineStage, len(sps))
+
+	for idx, sps := range sps {
+		ps, err := fromStoragePipelineStage(sps)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		stages[idx] = ps
+	}
+
+	return stages, nil
+}
+
+func fromStoragePipelineStage(ps *storage.PipelineStage) (*PipelineStage, error) {
+	kind, err := fromStoragePipelineStageKind(ps.Kind)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &PipelineStage{ID: ps.ID, Name: ps.Name, Kind: kind}, nil
+}
+
+func toStoragePipelineStageKind(k PipelineStageKind) (storage.PipelineStageKind, error) {
+	switch k {
+	case PipelineStageKindNew:
+		return storage.PipelineStageKindNew, nil
+	case PipelineStageKindScreening:
+		return storage.PipelineStageKindScreening, nil
+	case PipelineStageKindInterview:
+		return storage.PipelineStageKindInterview, nil
+	case PipelineStageKindOffer:
+		return storage.PipelineStageKindOffer, nil
+	case PipelineStageKindHired:
+		return storage.PipelineStageKindHired, nil
+	case PipelineStageKindRejected:
+		return storage.PipelineStageKindRejected, nil
+	default:
+		return "", errors.WithStack(ErrInvalidPipelineStageKind)
+	}
+}
+
+func fromStoragePipelineStageKind(k storage.PipelineStageKind) (PipelineStageKind, error) {
+	switch k {
+	case storage.PipelineStageKindNew:
+		return PipelineStageKindNew, nil
+	case storage.PipelineStageKindScreening:
+		return PipelineStageKindScreening, nil
+	case storage.PipelineStageKindInterview:
+		return PipelineStageKindInterview, nil
+	case storage.PipelineStageKindOffer:
+		return PipelineStageKindOffer, nil
+	case storage.PipelineStageKindHired:
+		return PipelineStageKindHired, nil
+	case storage.PipelineStageKindRejected:
+		return PipelineStageKindRejected, nil
+	default:
+		return "", errors.Newf("unknown pipeline stage kind=%s", k)
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
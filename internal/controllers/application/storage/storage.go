@@ -0,0 +1,1161 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+
+	"personaapp/pkg/postgresql"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var (
+	ErrNotFound       = errors.New("not found")
+	ErrAlreadyApplied = errors.New("already applied")
+	ErrStageNotEmpty  = errors.New("stage not empty")
+)
+
+type Storage struct {
+	*postgresql.Storage
+}
+
+func New(db *postgresql.Storage) *Storage {
+	return &Storage{db}
+}
+
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted ApplicationStatus = "application_status_submitted"
+	ApplicationStatusWithdrawn ApplicationStatus = "application_status_withdrawn"
+)
+
+type PipelineStageKind string
+
+const (
+	PipelineStageKindNew       PipelineStageKind = "pipeline_stage_kind_new"
+	PipelineStageKindScreening PipelineStageKind = "pipeline_stage_kind_screening"
+	PipelineStageKindInterview PipelineStageKind = "pipeline_stage_kind_interview"
+	PipelineStageKindOffer     PipelineStageKind = "pipeline_stage_kind_offer"
+	PipelineStageKindHired     PipelineStageKind = "pipeline_stage_kind_hired"
+	PipelineStageKindRejected  PipelineStageKind = "pipeline_stage_kind_rejected"
+)
+
+type VacancyStatus string
+
+const VacancyStatusPublished VacancyStatus = "vacancy_status_published"
+
+type Vacancy struct {
+	ID        string
+	CompanyID string
+	Status    VacancyStatus
+	ExpiresAt *time.Time
+}
+
+type PipelineStage struct {
+	ID        string
+	CompanyID string
+	Name      string
+	Kind      PipelineStageKind
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type StageHistory struct {
+	ID              string
+	ApplicationID   string
+	FromStageID     *string
+	FromStageName   *string
+	ToStageID       *string
+	ToStageName     string
+	RejectionReason *string
+	MovedBy         *string
+	CreatedAt       time.Time
+}
+
+// ApplicationMove is a submitted application locked to be moved to another stage with what its notification needs.
+type ApplicationMove struct {
+	ID           string
+	PersonaID    string
+	PersonaName  string
+	VacancyID    string
+	VacancyTitle string
+	CompanyTitle string
+	StageID      string
+	StageName    string
+}
+
+type CVExperience struct {
+	CompanyName string     `json:"company_name"`
+	DateFrom    *time.Time `json:"date_from"`
+	DateTill    *time.Time `json:"date_till"`
+	Position    string     `json:"position"`
+	Description string     `json:"description"`
+}
+
+type CVEducation struct {
+	Institution string     `json:"institution"`
+	DateFrom    *time.Time `json:"date_from"`
+	DateTill    *time.Time `json:"date_till"`
+	Speciality  string     `json:"speciality"`
+	Description string     `json:"description"`
+}
+
+// CVSnapshot is the copy of the cv stored with an application, it's kept as is when the cv changes or is deleted.
+type CVSnapshot struct {
+	ID                   string          `json:"id"`
+	Position             string          `json:"position"`
+	WorkMonthsExperience int32           `json:"work_months_experience"`
+	MinSalary            int32           `json:"min_salary"`
+	MaxSalary            int32           `json:"max_salary"`
+	JobTypes             []string        `json:"job_types"`
+	JobKinds             []string        `json:"job_kinds"`
+	Experiences          []*CVExperience `json:"experiences"`
+	Educations           []*CVEducation  `json:"educations"`
+	CustomSections       []string        `json:"custom_sections"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+	// The salary terms are empty in the snapshots taken before the cvs had them
+	Currency     string `json:"currency"`
+	SalaryPeriod string `json:"salary_period"`
+	SalaryGross  *bool  `json:"salary_gross"`
+}
+
+type Application struct {
+	ID          string
+	VacancyID   string
+	PersonaID   string
+	CVID        *string
+	StageID     string
+	CVSnapshot  *CVSnapshot
+	Message     string
+	Status      ApplicationStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	WithdrawnAt *time.Time
+}
+
+// MyApplication is an application listed to the persona with the vacancy it was sent to.
+type MyApplication struct {
+	Application
+	VacancyTitle string
+	CompanyID    string
+	CompanyTitle string
+}
+
+// Applicant is an application listed to the company with the persona who sent it.
+type Applicant struct {
+	Application
+	PersonaName      string
+	PersonaAvatarURL string
+	StageName        string
+	StageKind        PipelineStageKind
+}
+
+type Cursor struct {
+	PrevCreatedAt time.Time
+	PrevID        string
+}
+
+func (s *Storage) TxGetVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*Vacancy, error) {
+	c := postgresql.FromTx(tx)
+
+	var v Vacancy
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT id, coalesce(company_id::text, ''), status, expires_at
+				FROM vacancy
+				WHERE id = $1`,
+		vacancyID,
+	).Scan(&v.ID, &v.CompanyID, &v.Status, &v.ExpiresAt)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errors.WithStack(ErrNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return &v, nil
+}
+
+// TxGetCVSnapshot reads the cv with its sections, ErrNotFound is returned when the persona doesn't own the cv.
+func (s *Storage) TxGetCVSnapshot(ctx context.Context, tx pkgtx.Tx, personaID, cvID string) (*CVSnapshot, error) {
+	c := postgresql.FromTx(tx)
+
+	var cv CVSnapshot
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT id, coalesce(position, ''), coalesce(work_months_experience, 0),
+					coalesce(min_salary, 0), coalesce(max_salary, 0), currency, salary_period, salary_gross, updated_at
+				FROM cv
+				WHERE id = $1 AND persona_id = $2`,
+		cvID,
+		personaID,
+	).Scan(
+		&cv.ID,
+		&cv.Position,
+		&cv.WorkMonthsExperience,
+		&cv.MinSalary,
+		&cv.MaxSalary,
+		&cv.Currency,
+		&cv.SalaryPeriod,
+		&cv.SalaryGross,
+		&cv.UpdatedAt,
+	)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errors.WithStack(ErrNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	if cv.JobTypes, err = s.txGetCVStrings(
+		ctx,
+		tx,
+		`SELECT jt.name
+			FROM cv_job_types AS cjt
+			JOIN job_type AS jt ON jt.id = cjt.job_type_id
+			WHERE cjt.cv_id = $1
+			ORDER BY jt.name`,
+		cvID,
+	); err != nil {
+		return nil, err
+	}
+
+	if cv.JobKinds, err = s.txGetCVStrings(
+		ctx,
+		tx,
+		`SELECT jk.name
+			FROM cv_job_kinds AS cjk
+			JOIN job_kind AS jk ON jk.id = cjk.job_kind_id
+			WHERE cjk.cv_id = $1
+			ORDER BY jk.name`,
+		cvID,
+	); err != nil {
+		return nil, err
+	}
+
+	if cv.CustomSections, err = s.txGetCVStrings(
+		ctx,
+		tx,
+		`SELECT description
+			FROM custom_section
+			WHERE cv_id = $1
+			ORDER BY id`,
+		cvID,
+	); err != nil {
+		return nil, err
+	}
+
+	if cv.Experiences, err = s.txGetCVExperiences(ctx, tx, cvID); err != nil {
+		return nil, err
+	}
+
+	if cv.Educations, err = s.txGetCVEducations(ctx, tx, cvID); err != nil {
+		return nil, err
+	}
+
+	return &cv, nil
+}
+
+func (s *Storage) txGetCVStrings(ctx context.Context, tx pkgtx.Tx, query, cvID string) (_ []string, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(ctx, query, cvID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	values := make([]string, 0)
+
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		values = append(values, value)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return values, nil
+}
+
+func (s *Storage) txGetCVExperiences(ctx context.Context, tx pkgtx.Tx, cvID string) (_ []*CVExperience, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT company_name, date_from, date_till, position, coalesce(description, '')
+				FROM experience
+				WHERE cv_id = $1
+				ORDER BY date_from DESC NULLS LAST, id`,
+		cvID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	experiences := make([]*CVExperience, 0)
+
+	for rows.Next() {
+		var e CVExperience
+		if err := rows.Scan(&e.CompanyName, &e.DateFrom, &e.DateTill, &e.Position, &e.Description); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		experiences = append(experiences, &e)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return experiences, nil
+}
+
+func (s *Storage) txGetCVEducations(ctx context.Context, tx pkgtx.Tx, cvID string) (_ []*CVEducation, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT institution, date_from, date_till, speciality, coalesce(description, '')
+				FROM education
+				WHERE cv_id = $1
+				ORDER BY date_from DESC NULLS LAST, id`,
+		cvID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	educations := make([]*CVEducation, 0)
+
+	for rows.Next() {
+		var e CVEducation
+		if err := rows.Scan(&e.Institution, &e.DateFrom, &e.DateTill, &e.Speciality, &e.Description); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		educations = append(educations, &e)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return educations, nil
+}
+
+// TxPutApplication inserts the application, a withdrawn application of the persona to the same vacancy
+// is submitted again keeping its id. ErrAlreadyApplied is returned when a submitted one exists.
+func (s *Storage) TxPutApplication(ctx context.Context, tx pkgtx.Tx, a *Application) (string, error) {
+	c := postgresql.FromTx(tx)
+
+	snapshot, err := json.Marshal(a.CVSnapshot)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var id string
+	err = c.QueryRowContext(
+		ctx,
+		`INSERT INTO application (id, vacancy_id, persona_id, cv_id, cv_snapshot, message, status,
+					created_at, updated_at, withdrawn_at, stage_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULL, $10)
+				ON CONFLICT (vacancy_id, persona_id) DO UPDATE SET
+					cv_id = EXCLUDED.cv_id,
+					stage_id = EXCLUDED.stage_id,
+					cv_snapshot = EXCLUDED.cv_snapshot,
+					message = EXCLUDED.message,
+					status = EXCLUDED.status,
+					created_at = EXCLUDED.created_at,
+					updated_at = EXCLUDED.updated_at,
+					withdrawn_at = NULL
+				WHERE application.status = 'application_status_withdrawn'
+				RETURNING id`,
+		a.ID,
+		a.VacancyID,
+		a.PersonaID,
+		a.CVID,
+		snapshot,
+		a.Message,
+		a.Status,
+		a.CreatedAt,
+		a.UpdatedAt,
+		a.StageID,
+	).Scan(&id)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return "", errors.WithStack(ErrAlreadyApplied)
+	default:
+		return "", errors.WithStack(err)
+	}
+
+	return id, nil
+}
+
+// TxWithdrawApplication withdraws a submitted application of the persona,
+// ErrNotFound is returned when there is no such one.
+func (s *Storage) TxWithdrawApplication(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	personaID string,
+	applicationID string,
+	withdrawnAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`UPDATE application SET
+					status = 'application_status_withdrawn',
+					updated_at = $3,
+					withdrawn_at = $3
+				WHERE id = $1 AND persona_id = $2 AND status = 'application_status_submitted'`,
+		applicationID,
+		personaID,
+		withdrawnAt,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
+	return nil
+}
+
+// TxGetPersonaApplications lists the applications of the persona newest first.
+func (s *Storage) TxGetPersonaApplications(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	personaID string,
+	cursor *Cursor,
+	limit int,
+) (_ []*MyApplication, _ *Cursor, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	prevCreatedAt, prevID := cursorArgs(cursor)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT a.id, a.vacancy_id, a.persona_id, a.cv_id, a.stage_id, a.cv_snapshot, a.message, a.status,
+					a.created_at, a.updated_at, a.withdrawn_at, v.title, v.company_id, coalesce(c.title, '')
+				FROM application AS a
+				JOIN vacancy AS v ON v.id = a.vacancy_id
+				LEFT JOIN company AS c ON c.auth_id = v.company_id
+				WHERE a.persona_id = $1
+					AND ($2::timestamptz IS NULL OR (a.created_at, a.id) < ($2::timestamptz, $3::uuid))
+				ORDER BY a.created_at DESC, a.id DESC
+				LIMIT $4`,
+		personaID,
+		prevCreatedAt,
+		prevID,
+		limit+1,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	applications := make([]*MyApplication, 0, limit)
+
+	for rows.Next() {
+		var (
+			a        MyApplication
+			snapshot []byte
+		)
+		if err := rows.Scan(
+			&a.ID,
+			&a.VacancyID,
+			&a.PersonaID,
+			&a.CVID,
+			&a.StageID,
+			&snapshot,
+			&a.Message,
+			&a.Status,
+			&a.CreatedAt,
+			&a.UpdatedAt,
+			&a.WithdrawnAt,
+			&a.VacancyTitle,
+			&a.CompanyID,
+			&a.CompanyTitle,
+		); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		if err := json.Unmarshal(snapshot, &a.CVSnapshot); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		applications = append(applications, &a)
+	}
+	if rows.Err() != nil {
+		return nil, nil, errors.WithStack(rows.Err())
+	}
+
+	if len(applications) <= limit {
+		return applications, nil, nil
+	}
+
+	applications = applications[:limit]
+	last := applications[limit-1]
+
+	return applications, &Cursor{PrevCreatedAt: last.CreatedAt, PrevID: last.ID}, nil
+}
+
+const applicantColumns = `a.id, a.vacancy_id, a.persona_id, a.cv_id, a.stage_id, a.cv_snapshot, a.message,
+	a.status, a.created_at, a.updated_at, a.withdrawn_at, coalesce(p.name, ''), coalesce(p.avatar_url, ''),
+	ps.name, ps.kind`
+
+func scanApplicant(rows *sql.Rows) (*Applicant, error) {
+	var (
+		a        Applicant
+		snapshot []byte
+	)
+	if err := rows.Scan(
+		&a.ID,
+		&a.VacancyID,
+		&a.PersonaID,
+		&a.CVID,
+		&a.StageID,
+		&snapshot,
+		&a.Message,
+		&a.Status,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+		&a.WithdrawnAt,
+		&a.PersonaName,
+		&a.PersonaAvatarURL,
+		&a.StageName,
+		&a.StageKind,
+	); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := json.Unmarshal(snapshot, &a.CVSnapshot); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &a, nil
+}
+
+// TxGetVacancyApplicants lists the submitted applications to the vacancy newest first.
+func (s *Storage) TxGetVacancyApplicants(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	cursor *Cursor,
+	limit int,
+) (_ []*Applicant, _ *Cursor, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	prevCreatedAt, prevID := cursorArgs(cursor)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT `+applicantColumns+`
+				FROM application AS a
+				JOIN pipeline_stage AS ps ON ps.id = a.stage_id
+				LEFT JOIN persona AS p ON p.auth_id = a.persona_id
+				WHERE a.vacancy_id = $1 AND a.status = 'application_status_submitted'
+					AND ($2::timestamptz IS NULL OR (a.created_at, a.id) < ($2::timestamptz, $3::uuid))
+				ORDER BY a.created_at DESC, a.id DESC
+				LIMIT $4`,
+		vacancyID,
+		prevCreatedAt,
+		prevID,
+		limit+1,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	applicants := make([]*Applicant, 0, limit)
+
+	for rows.Next() {
+		a, err := scanApplicant(rows)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		applicants = append(applicants, a)
+	}
+	if rows.Err() != nil {
+		return nil, nil, errors.WithStack(rows.Err())
+	}
+
+	if len(applicants) <= limit {
+		return applicants, nil, nil
+	}
+
+	applicants = applicants[:limit]
+	last := applicants[limit-1]
+
+	return applicants, &Cursor{PrevCreatedAt: last.CreatedAt, PrevID: last.ID}, nil
+}
+
+func cursorArgs(cursor *Cursor) (*time.Time, *string) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	return &cursor.PrevCreatedAt, &cursor.PrevID
+}
+
+// TxEnsurePipelineStages creates the stages for a company which has none yet.
+func (s *Storage) TxEnsurePipelineStages(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+	stages []*PipelineStage,
+) error {
+	c := postgresql.FromTx(tx)
+
+	var (
+		ids   = make([]string, len(stages))
+		names = make([]string, len(stages))
+		kinds = make([]string, len(stages))
+	)
+
+	for idx, ps := range stages {
+		ids[idx] = ps.ID
+		names[idx] = ps.Name
+		kinds[idx] = string(ps.Kind)
+	}
+
+	// concurrent requests insert the same names, the unique index lets only one of them through
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO pipeline_stage (id, company_id, name, kind, position, created_at, updated_at)
+				SELECT s.id, $1, s.name, s.kind, s.position, $5, $5
+				FROM unnest($2::uuid[], $3::text[], $4::e_pipeline_stage_kind[])
+					WITH ORDINALITY AS s (id, name, kind, position)
+				WHERE NOT EXISTS (SELECT 1 FROM pipeline_stage WHERE company_id = $1)
+				ON CONFLICT DO NOTHING`,
+		companyID,
+		pq.Array(ids),
+		pq.Array(names),
+		pq.Array(kinds),
+		time.Now(),
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxGetPipelineStages lists the stages of the company in their order.
+func (s *Storage) TxGetPipelineStages(ctx context.Context, tx pkgtx.Tx, companyID string) ([]*PipelineStage, error) {
+	return s.txGetPipelineStages(ctx, tx, companyID, "")
+}
+
+// TxLockPipelineStages is TxGetPipelineStages locking the stages until the end of the transaction.
+func (s *Storage) TxLockPipelineStages(ctx context.Context, tx pkgtx.Tx, companyID string) ([]*PipelineStage, error) {
+	return s.txGetPipelineStages(ctx, tx, companyID, "FOR UPDATE")
+}
+
+func (s *Storage) txGetPipelineStages(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+	lock string,
+) (_ []*PipelineStage, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id, company_id, name, kind, position, created_at, updated_at
+				FROM pipeline_stage
+				WHERE company_id = $1
+				ORDER BY position
+				`+lock,
+		companyID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	stages := make([]*PipelineStage, 0)
+
+	for rows.Next() {
+		var ps PipelineStage
+		if err := rows.Scan(
+			&ps.ID,
+			&ps.CompanyID,
+			&ps.Name,
+			&ps.Kind,
+			&ps.Position,
+			&ps.CreatedAt,
+			&ps.UpdatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		stages = append(stages, &ps)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return stages, nil
+}
+
+// TxReplacePipelineStages makes stages the only stages of the company. Stages which are not listed are deleted,
+// ErrStageNotEmpty is returned when some of them still have applications.
+func (s *Storage) TxReplacePipelineStages(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+	stages []*PipelineStage,
+) error {
+	c := postgresql.FromTx(tx)
+
+	ids := make([]string, len(stages))
+	for idx, ps := range stages {
+		ids[idx] = ps.ID
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM pipeline_stage AS ps
+				WHERE ps.company_id = $1 AND ps.id <> ALL($2::uuid[])
+					AND NOT EXISTS (SELECT 1 FROM application WHERE stage_id = ps.id)`,
+		companyID,
+		pq.Array(ids),
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	var kept int
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT count(*) FROM pipeline_stage WHERE company_id = $1 AND id <> ALL($2::uuid[])`,
+		companyID,
+		pq.Array(ids),
+	).Scan(&kept); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if kept > 0 {
+		return errors.WithStack(ErrStageNotEmpty)
+	}
+
+	// the names are released first, so stages may swap their names
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE pipeline_stage SET name = id::text WHERE company_id = $1`,
+		companyID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, ps := range stages {
+		if _, err := c.ExecContext(
+			ctx,
+			`WITH upsert AS (
+					UPDATE pipeline_stage SET
+						name = $3,
+						kind = $4,
+						position = $5,
+						updated_at = $7
+					WHERE id = $1 AND company_id = $2
+					RETURNING id
+				)
+				INSERT INTO pipeline_stage (id, company_id, name, kind, position, created_at, updated_at)
+				SELECT $1, $2, $3, $4, $5, $6, $7
+				WHERE NOT EXISTS (SELECT * FROM upsert)`,
+			ps.ID,
+			companyID,
+			ps.Name,
+			ps.Kind,
+			ps.Position,
+			ps.CreatedAt,
+			ps.UpdatedAt,
+		); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// TxLockApplicationsForMove locks the submitted applications to the vacancies of the company among applicationIDs.
+func (s *Storage) TxLockApplicationsForMove(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+	applicationIDs []string,
+) (_ []*ApplicationMove, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT a.id, a.persona_id, coalesce(p.name, ''), a.vacancy_id, v.title, coalesce(c.title, ''),
+					a.stage_id, ps.name
+				FROM application AS a
+				JOIN vacancy AS v ON v.id = a.vacancy_id
+				JOIN pipeline_stage AS ps ON ps.id = a.stage_id
+				LEFT JOIN persona AS p ON p.auth_id = a.persona_id
+				LEFT JOIN company AS c ON c.auth_id = v.company_id
+				WHERE a.id = ANY($2::uuid[]) AND v.company_id = $1 AND a.status = 'application_status_submitted'
+				ORDER BY a.id
+				FOR UPDATE OF a`,
+		companyID,
+		pq.Array(applicationIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	moves := make([]*ApplicationMove, 0, len(applicationIDs))
+
+	for rows.Next() {
+		var m ApplicationMove
+		if err := rows.Scan(
+			&m.ID,
+			&m.PersonaID,
+			&m.PersonaName,
+			&m.VacancyID,
+			&m.VacancyTitle,
+			&m.CompanyTitle,
+			&m.StageID,
+			&m.StageName,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		moves = append(moves, &m)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return moves, nil
+}
+
+func (s *Storage) TxMoveApplications(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	applicationIDs []string,
+	stageID string,
+	movedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE application SET
+					stage_id = $2,
+					updated_at = $3
+				WHERE id = ANY($1::uuid[])`,
+		pq.Array(applicationIDs),
+		stageID,
+		movedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxPutStageHistory(ctx context.Context, tx pkgtx.Tx, h *StageHistory) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO application_stage_history (id, application_id, from_stage_id, from_stage_name,
+					to_stage_id, to_stage_name, rejection_reason, moved_by, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		h.ID,
+		h.ApplicationID,
+		h.FromStageID,
+		h.FromStageName,
+		h.ToStageID,
+		h.ToStageName,
+		h.RejectionReason,
+		h.MovedBy,
+		h.CreatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxGetStageHistory lists the moves of the application in the order they were made.
+func (s *Storage) TxGetStageHistory(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	applicationID string,
+) (_ []*StageHistory, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id, application_id, from_stage_id, from_stage_name, to_stage_id, to_stage_name,
+					rejection_reason, moved_by, created_at
+				FROM application_stage_history
+				WHERE application_id = $1
+				ORDER BY created_at, id`,
+		applicationID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	history := make([]*StageHistory, 0)
+
+	for rows.Next() {
+		var h StageHistory
+		if err := rows.Scan(
+			&h.ID,
+			&h.ApplicationID,
+			&h.FromStageID,
+			&h.FromStageName,
+			&h.ToStageID,
+			&h.ToStageName,
+			&h.RejectionReason,
+			&h.MovedBy,
+			&h.CreatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		history = append(history, &h)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return history, nil
+}
+
+// TxGetApplicationCompanyID returns the company owning the vacancy the application was sent to.
+func (s *Storage) TxGetApplicationCompanyID(ctx context.Context, tx pkgtx.Tx, applicationID string) (string, error) {
+	c := postgresql.FromTx(tx)
+
+	var companyID string
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT coalesce(v.company_id::text, '')
+				FROM application AS a
+				JOIN vacancy AS v ON v.id = a.vacancy_id
+				WHERE a.id = $1`,
+		applicationID,
+	).Scan(&companyID)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return "", errors.WithStack(ErrNotFound)
+	default:
+		return "", errors.WithStack(err)
+	}
+
+	return companyID, nil
+}
+
+// TxGetPipelineCounts counts the submitted applications to the vacancy by their stage.
+func (s *Storage) TxGetPipelineCounts(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+) (_ map[string]int, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT stage_id, count(*)
+				FROM application
+				WHERE vacancy_id = $1 AND status = 'application_status_submitted'
+				GROUP BY stage_id`,
+		vacancyID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	counts := make(map[string]int)
+
+	for rows.Next() {
+		var (
+			stageID string
+			count   int
+		)
+		if err := rows.Scan(&stageID, &count); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		counts[stageID] = count
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return counts, nil
+}
+
+// TxGetPipelineApplicants returns up to perStage submitted applications to the vacancy from every stage,
+// the most recently moved first.
+func (s *Storage) TxGetPipelineApplicants(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	perStage int,
+) (_ []*Applicant, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT `+applicantColumns+`
+				FROM (
+					SELECT application.*,
+						row_number() OVER (PARTITION BY stage_id ORDER BY updated_at DESC, id DESC) AS stage_rank
+					FROM application
+					WHERE vacancy_id = $1 AND status = 'application_status_submitted'
+				) AS a
+				JOIN pipeline_stage AS ps ON ps.id = a.stage_id
+				LEFT JOIN persona AS p ON p.auth_id = a.persona_id
+				WHERE a.stage_rank <= $2
+				ORDER BY ps.position, a.stage_rank`,
+		vacancyID,
+		perStage,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	applicants := make([]*Applicant, 0)
+
+	for rows.Next() {
+		a, err := scanApplicant(rows)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		applicants = append(applicants, a)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return applicants, nil
+}
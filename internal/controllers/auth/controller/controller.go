@@ -13,6 +13,8 @@ import (
 	"github.com/spf13/pflag"
 	"golang.org/x/crypto/bcrypt"
 
+	apievent "personaapp/pkg/grpcapi/event"
+	"personaapp/pkg/nats/event"
 	pkgtx "personaapp/pkg/tx"
 )
 
@@ -120,13 +122,28 @@ type Storage interface {
 	NoTx() pkgtx.Tx
 }
 
+// EventEmitter stores a domain event in the transactional outbox.
+type EventEmitter interface {
+	TxEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error
+}
+
 type Controller struct {
-	cfg *Config
-	s   Storage
+	cfg     *Config
+	s       Storage
+	emitter EventEmitter
+}
+
+// New creates the controller, domain events are dropped when emitter is nil.
+func New(cfg *Config, s Storage, emitter EventEmitter) *Controller {
+	return &Controller{cfg: cfg, s: s, emitter: emitter}
 }
 
-func New(cfg *Config, s Storage) *Controller {
-	return &Controller{cfg: cfg, s: s}
+func (c *Controller) txEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error {
+	if c.emitter == nil {
+		return nil
+	}
+
+	return errors.WithStack(c.emitter.TxEmit(ctx, tx, e))
 }
 
 type RegisterData struct {
@@ -215,6 +232,19 @@ func toStorageAccount(at AccountType) (storage.AccountType, error) {
 	}
 }
 
+func toEventAccountType(at AccountType) apievent.AccountType {
+	switch at {
+	case AccountTypeCompany:
+		return apievent.AccountType_ACCOUNT_TYPE_COMPANY
+	case AccountTypePersona:
+		return apievent.AccountType_ACCOUNT_TYPE_PERSONA
+	case AccountTypeAdmin:
+		return apievent.AccountType_ACCOUNT_TYPE_ADMIN
+	default:
+		return apievent.AccountType_ACCOUNT_TYPE_UNKNOWN
+	}
+}
+
 func fromStorageAccount(at storage.AccountType) (AccountType, error) {
 	switch at {
 	case storage.AccountTypeCompany:
@@ -387,7 +417,16 @@ func (c *Controller) Register(ctx context.Context, rd *RegisterData) (*AuthToken
 			CreatedAt:    now,
 			UpdatedAt:    now,
 		}
-		return errors.WithStack(c.s.TxPutAuth(ctx, tx, ad))
+		if err := c.s.TxPutAuth(ctx, tx, ad); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txEmit(ctx, tx, event.AccountRegistered(&apievent.AccountRegisteredV1{
+			AccountId:   accountID,
+			AccountType: toEventAccountType(rd.Account),
+			Email:       rd.Email,
+			Phone:       rd.Phone,
+		})))
 	}); err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -593,7 +632,14 @@ func putAuthUpdatedMail(
 			}
 		}
 
-		return errors.WithStack(c.s.TxPutAuth(ctx, tx, ad))
+		if err := c.s.TxPutAuth(ctx, tx, ad); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txEmit(ctx, tx, event.EmailChanged(&apievent.EmailChangedV1{
+			AccountId: ad.AccountID,
+			Email:     email,
+		})))
 	}
 }
 
@@ -33,7 +33,7 @@ func TestRegister(t *testing.T) {
 		}
 	}()
 
-	c := controller.New(authCfg, s)
+	c := controller.New(authCfg, s, nil)
 
 	t.Run("two accounts with empty phone", func(t *testing.T) {
 		_, err := c.Register(context.TODO(), &controller.RegisterData{
@@ -62,7 +62,7 @@ func TestUpdateAuthEmailAndPhone(t *testing.T) {
 		}
 	}()
 
-	ac := controller.New(authCfg, as)
+	ac := controller.New(authCfg, as, nil)
 
 	rd := controller.RegisterData{
 		Email:    "companytest3@gmail.com",
@@ -6,6 +6,8 @@ import (
 	"github.com/cockroachdb/errors"
 	uuid "github.com/satori/go.uuid"
 	"personaapp/internal/controllers/company/storage"
+	apievent "personaapp/pkg/grpcapi/event"
+	"personaapp/pkg/nats/event"
 	pkgtx "personaapp/pkg/tx"
 	"time"
 )
@@ -62,16 +64,36 @@ type Storage interface {
 		authID string,
 	) error
 
+	TxPutCompanyFollow(ctx context.Context, tx pkgtx.Tx, accountID string, companyID string, followedAt time.Time) error
+	TxDeleteCompanyFollow(ctx context.Context, tx pkgtx.Tx, accountID string, companyID string) error
+	TxCountCompanyFollows(ctx context.Context, tx pkgtx.Tx, accountID string) (int, error)
+	TxGetFollowedCompanies(ctx context.Context, tx pkgtx.Tx, accountID string) ([]*storage.CompanyData, error)
+
 	BeginTx(ctx context.Context) (pkgtx.Tx, error)
 	NoTx() pkgtx.Tx
 }
 
+// EventEmitter stores a domain event in the transactional outbox.
+type EventEmitter interface {
+	TxEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error
+}
+
 type Controller struct {
-	s Storage
+	s       Storage
+	emitter EventEmitter
 }
 
-func New(s Storage) *Controller {
-	return &Controller{s: s}
+// New creates the controller, domain events are dropped when emitter is nil.
+func New(s Storage, emitter EventEmitter) *Controller {
+	return &Controller{s: s, emitter: emitter}
+}
+
+func (c *Controller) txEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error {
+	if c.emitter == nil {
+		return nil
+	}
+
+	return errors.WithStack(c.emitter.TxEmit(ctx, tx, e))
 }
 
 type CompanyData struct {
@@ -193,7 +215,12 @@ func (c *Controller) Update(ctx context.Context, cd *CompanyData) error {
 			return errors.WithStack(err)
 		}
 
-		return nil
+		return errors.WithStack(c.txEmit(ctx, tx, event.CompanyUpdated(&apievent.CompanyUpdatedV1{
+			CompanyId:   scd.ID,
+			Title:       scd.Title,
+			Description: scd.Description,
+			LogoUrl:     scd.LogoURL,
+		})))
 	}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -2,6 +2,7 @@ package controller_test
 
 import (
 	"context"
+	"github.com/cockroachdb/errors"
 	uuid "github.com/satori/go.uuid"
 	"personaapp/internal/controllers/company/storage"
 	"testing"
@@ -36,7 +37,7 @@ func TestGetNonExistingCompany(t *testing.T) {
 		}
 	}()
 
-	cc := companyController.New(cs)
+	cc := companyController.New(cs, nil)
 
 	t.Run("normal flow", func(t *testing.T) {
 		company, err := cc.Get(context.Background(), "nonexistingcompany")
@@ -53,7 +54,7 @@ func TestGetExistingButNotCompletedCompany(t *testing.T) {
 		}
 	}()
 
-	ac := authController.New(authCfg, as)
+	ac := authController.New(authCfg, as, nil)
 
 	cs, companyCloser := InitStorage(t)
 	defer func() {
@@ -62,7 +63,7 @@ func TestGetExistingButNotCompletedCompany(t *testing.T) {
 		}
 	}()
 
-	cc := companyController.New(cs)
+	cc := companyController.New(cs, nil)
 
 	rd := authController.RegisterData{
 		Email:    "companytest@gmail.com",
@@ -91,7 +92,7 @@ func TestUpdateExistingCompany(t *testing.T) {
 		}
 	}()
 
-	ac := authController.New(authCfg, as)
+	ac := authController.New(authCfg, as, nil)
 
 	cs, companyCloser := InitStorage(t)
 	defer func() {
@@ -100,7 +101,7 @@ func TestUpdateExistingCompany(t *testing.T) {
 		}
 	}()
 
-	cc := companyController.New(cs)
+	cc := companyController.New(cs, nil)
 
 	rd := authController.RegisterData{
 		Email:    "companytest1@gmail.com",
@@ -144,7 +145,7 @@ func TestUpdateNonExistingCompany(t *testing.T) {
 		}
 	}()
 
-	cc := companyController.New(cs)
+	cc := companyController.New(cs, nil)
 
 	cd := companyController.CompanyData{
 		ID:          "nonexistingid",
@@ -166,7 +167,7 @@ func TestUpdateCompanyActivityFields(t *testing.T) {
 		}
 	}()
 
-	ac := authController.New(authCfg, as)
+	ac := authController.New(authCfg, as, nil)
 
 	cs, companyCloser := InitStorage(t)
 	defer func() {
@@ -175,7 +176,7 @@ func TestUpdateCompanyActivityFields(t *testing.T) {
 		}
 	}()
 
-	cc := companyController.New(cs)
+	cc := companyController.New(cs, nil)
 
 	rd := authController.RegisterData{
 		Email:    "companytest2@gmail.com",
@@ -254,7 +255,7 @@ func TestGetAndUpdateActivityFields(t *testing.T) {
 		}
 	}()
 
-	cc := companyController.New(cs)
+	cc := companyController.New(cs, nil)
 
 	title := "Title"
 	iconURL := "https://logourl.com"
@@ -292,3 +293,75 @@ func TestGetAndUpdateActivityFields(t *testing.T) {
 	})
 
 }
+
+func TestFollowCompanies(t *testing.T) {
+	as, authCloser := testutils.InitAuthStorage(t)
+	defer func() {
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	ac := authController.New(authCfg, as, nil)
+
+	cs, companyCloser := InitStorage(t)
+	defer func() {
+		if err := companyCloser(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	cc := companyController.New(cs, nil)
+
+	register := func(email string, phone string, account authController.AccountType) string {
+		token, err := ac.Register(context.Background(), &authController.RegisterData{
+			Email:    email,
+			Phone:    phone,
+			Account:  account,
+			Password: "Password2",
+		})
+		require.NoError(t, err)
+
+		if account == authController.AccountTypeCompany {
+			title := email
+			require.NoError(t, cc.Update(context.Background(), &companyController.CompanyData{
+				ID:    token.AccountID,
+				Title: &title,
+			}))
+		}
+
+		return token.AccountID
+	}
+
+	firstID := register("follow.first@gmail.com", "+380500000031", authController.AccountTypeCompany)
+	secondID := register("follow.second@gmail.com", "+380500000032", authController.AccountTypeCompany)
+	personaID := register("follow.persona@gmail.com", "+380500000033", authController.AccountTypePersona)
+
+	t.Run("follow", func(t *testing.T) {
+		require.NoError(t, cc.FollowCompany(context.Background(), personaID, firstID))
+		require.NoError(t, cc.FollowCompany(context.Background(), personaID, secondID))
+		require.NoError(t, cc.FollowCompany(context.Background(), personaID, firstID))
+
+		err := cc.FollowCompany(context.Background(), personaID, uuid.NewV4().String())
+		require.Equal(t, companyController.ErrCompanyNotFound, errors.Cause(err))
+
+		companies, err := cc.ListFollowedCompanies(context.Background(), personaID)
+		require.NoError(t, err)
+		require.Len(t, companies, 2)
+		require.Equal(t, secondID, companies[0].ID)
+		require.Equal(t, "follow.second@gmail.com", companies[0].Title)
+		require.Equal(t, firstID, companies[1].ID)
+	})
+
+	t.Run("unfollow", func(t *testing.T) {
+		require.NoError(t, cc.UnfollowCompany(context.Background(), personaID, secondID))
+
+		err := cc.UnfollowCompany(context.Background(), personaID, secondID)
+		require.Equal(t, companyController.ErrCompanyFollowNotFound, errors.Cause(err))
+
+		companies, err := cc.ListFollowedCompanies(context.Background(), personaID)
+		require.NoError(t, err)
+		require.Len(t, companies, 1)
+		require.Equal(t, firstID, companies[0].ID)
+	})
+}
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/company/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const maxFollowedCompaniesPerAccount = 500
+
+var (
+	ErrCompanyFollowNotFound          = errors.New("company follow not found")
+	ErrFollowedCompaniesLimitExceeded = errors.New("followed companies limit exceeded")
+)
+
+// FollowCompany follows the company for the account, following it again does nothing.
+func (c *Controller) FollowCompany(ctx context.Context, accountID string, companyID string) error {
+	if _, err := uuid.FromString(companyID); err != nil {
+		return errors.WithStack(ErrCompanyNotFound)
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		switch _, err := c.s.TxGetCompanyByID(ctx, tx, companyID); errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrCompanyNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		count, err := c.s.TxCountCompanyFollows(ctx, tx, accountID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if count >= maxFollowedCompaniesPerAccount {
+			return errors.WithStack(ErrFollowedCompaniesLimitExceeded)
+		}
+
+		return errors.WithStack(c.s.TxPutCompanyFollow(ctx, tx, accountID, companyID, time.Now()))
+	}))
+}
+
+func (c *Controller) UnfollowCompany(ctx context.Context, accountID string, companyID string) error {
+	if _, err := uuid.FromString(companyID); err != nil {
+		return errors.WithStack(ErrCompanyFollowNotFound)
+	}
+
+	switch err := c.s.TxDeleteCompanyFollow(ctx, c.s.NoTx(), accountID, companyID); errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrCompanyFollowNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// ListFollowedCompanies lists the companies followed by the account, the most recently followed first.
+func (c *Controller) ListFollowedCompanies(ctx context.Context, accountID string) ([]*Company, error) {
+	cds, err := c.s.TxGetFollowedCompanies(ctx, c.s.NoTx(), accountID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	companies := make([]*Company, len(cds))
+
+	for idx, cd := range cds {
+		companies[idx] = &Company{
+			ID:          cd.ID,
+			Title:       cd.Title,
+			Description: cd.Description,
+			LogoURL:     cd.LogoURL,
+		}
+	}
+
+	return companies, nil
+}
@@ -344,3 +344,125 @@ func (s *Storage) TxGetActivityFields(
 
 	return afs, nil
 }
+
+// TxPutCompanyFollow follows the company for the account, following it again keeps the first time it was followed.
+func (s *Storage) TxPutCompanyFollow(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	companyID string,
+	followedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO company_follow (account_id, company_id, created_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING`,
+		accountID,
+		companyID,
+		followedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteCompanyFollow(ctx context.Context, tx pkgtx.Tx, accountID string, companyID string) error {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`DELETE FROM company_follow
+			WHERE account_id = $1 AND company_id = $2`,
+		accountID,
+		companyID,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxCountCompanyFollows(ctx context.Context, tx pkgtx.Tx, accountID string) (int, error) {
+	c := postgresql.FromTx(tx)
+
+	var count int
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT count(*)
+			FROM company_follow
+			WHERE account_id = $1`,
+		accountID,
+	).Scan(&count); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// TxGetFollowedCompanies returns the companies followed by the account, the most recently followed first.
+func (s *Storage) TxGetFollowedCompanies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+) (_ []*CompanyData, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT c.auth_id, coalesce(c.title, ''), coalesce(c.description, ''), coalesce(c.logo_url, ''),
+				c.created_at, c.updated_at
+			FROM company_follow AS f
+			INNER JOIN company AS c
+			ON c.auth_id = f.company_id
+			WHERE f.account_id = $1
+			ORDER BY f.created_at DESC, c.auth_id`,
+		accountID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	cs := make([]*CompanyData, 0)
+
+	for rows.Next() {
+		var cd CompanyData
+
+		err := rows.Scan(&cd.ID, &cd.Title, &cd.Description, &cd.LogoURL, &cd.CreatedAt, &cd.UpdatedAt)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		cs = append(cs, &cd)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return cs, nil
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/currency/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var (
+	ErrInvalidCurrency      = errors.New("invalid currency")
+	ErrInvalidExchangeRate  = errors.New("invalid exchange rate")
+	ErrInvalidExchangeRates = errors.New("invalid exchange rates")
+)
+
+type Storage interface {
+	TxReplaceExchangeRates(ctx context.Context, tx pkgtx.Tx, rates []*storage.ExchangeRate, updatedAt time.Time) error
+	TxGetExchangeRates(ctx context.Context, tx pkgtx.Tx) ([]*storage.ExchangeRate, error)
+
+	BeginTx(ctx context.Context) (pkgtx.Tx, error)
+	NoTx() pkgtx.Tx
+}
+
+type Controller struct {
+	s Storage
+}
+
+func New(s Storage) *Controller {
+	return &Controller{s: s}
+}
+
+// ExchangeRate is the price of a unit of the currency, an ISO 4217 code, in the base currency.
+type ExchangeRate struct {
+	Currency  string
+	Rate      float64
+	UpdatedAt time.Time
+}
+
+// PutExchangeRates replaces the exchange rates the salaries are normalized with. The rates are the prices
+// in the base currency, which is added with the rate of 1 unless it is given.
+func (c *Controller) PutExchangeRates(ctx context.Context, base string, rates []*ExchangeRate) error {
+	if !govalidator.IsISO4217(base) {
+		return errors.WithStack(ErrInvalidCurrency)
+	}
+
+	byCurrency := map[string]float64{base: 1}
+
+	for _, r := range rates {
+		if !govalidator.IsISO4217(r.Currency) {
+			return errors.WithStack(ErrInvalidCurrency)
+		}
+
+		if r.Rate <= 0 || (r.Currency == base && r.Rate != 1) {
+			return errors.WithStack(ErrInvalidExchangeRate)
+		}
+
+		byCurrency[r.Currency] = r.Rate
+	}
+
+	srs := make([]*storage.ExchangeRate, 0, len(byCurrency))
+	for currency, rate := range byCurrency {
+		srs = append(srs, &storage.ExchangeRate{Currency: currency, Rate: rate})
+	}
+
+	sort.Slice(srs, func(i, j int) bool { return srs[i].Currency < srs[j].Currency })
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		return errors.WithStack(c.s.TxReplaceExchangeRates(ctx, tx, srs, time.Now()))
+	}))
+}
+
+func (c *Controller) GetExchangeRates(ctx context.Context) ([]*ExchangeRate, error) {
+	srs, err := c.s.TxGetExchangeRates(ctx, c.s.NoTx())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rates := make([]*ExchangeRate, len(srs))
+
+	for idx, r := range srs {
+		rates[idx] = &ExchangeRate{Currency: r.Currency, Rate: r.Rate, UpdatedAt: r.UpdatedAt}
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,119 @@
+package controller_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	sqlMigrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+
+	"personaapp/internal/controllers/currency/controller"
+	"personaapp/internal/controllers/currency/storage"
+	"personaapp/internal/testutils"
+)
+
+func initStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
+
+	return storage.New(pg), pg.Close
+}
+
+func cleanup(t *testing.T) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Down))
+}
+
+func TestParseCSV(t *testing.T) {
+	rates, err := controller.ParseCSV(strings.NewReader("currency,rate\nusd, 40.5\neur,44\n"))
+	require.NoError(t, err)
+	require.Equal(t, []*controller.ExchangeRate{
+		{Currency: "USD", Rate: 40.5},
+		{Currency: "EUR", Rate: 44},
+	}, rates)
+
+	_, err = controller.ParseCSV(strings.NewReader("usd,40\neur,forty-four\n"))
+	require.Equal(t, controller.ErrInvalidExchangeRates, errors.Cause(err))
+
+	_, err = controller.ParseCSV(strings.NewReader("usd,40,1\n"))
+	require.Equal(t, controller.ErrInvalidExchangeRates, errors.Cause(err))
+}
+
+func TestParseECB(t *testing.T) {
+	const ecb = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope
+	xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01"
+	xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2020-06-01">
+			<Cube currency="USD" rate="1.25"/>
+			<Cube currency="PLN" rate="5"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+	rates, err := controller.ParseECB(strings.NewReader(ecb), "PLN")
+	require.NoError(t, err)
+
+	byCurrency := map[string]float64{}
+	for _, r := range rates {
+		byCurrency[r.Currency] = r.Rate
+	}
+
+	require.Equal(t, map[string]float64{"EUR": 5, "USD": 4, "PLN": 1}, byCurrency)
+
+	_, err = controller.ParseECB(strings.NewReader(ecb), "UAH")
+	require.Equal(t, controller.ErrInvalidExchangeRates, errors.Cause(err))
+
+	_, err = controller.ParseECB(strings.NewReader("not xml"), "EUR")
+	require.Equal(t, controller.ErrInvalidExchangeRates, errors.Cause(err))
+}
+
+func TestController_PutExchangeRates(t *testing.T) {
+	s, closer := initStorage(t)
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	c := controller.New(s)
+
+	rates, err := c.GetExchangeRates(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	require.Equal(t, "UAH", rates[0].Currency)
+	require.Equal(t, float64(1), rates[0].Rate)
+
+	require.NoError(t, c.PutExchangeRates(context.TODO(), "UAH", []*controller.ExchangeRate{
+		{Currency: "USD", Rate: 40.25},
+		{Currency: "EUR", Rate: 44},
+	}))
+
+	rates, err = c.GetExchangeRates(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+
+	for idx, expected := range []controller.ExchangeRate{
+		{Currency: "EUR", Rate: 44},
+		{Currency: "UAH", Rate: 1},
+		{Currency: "USD", Rate: 40.25},
+	} {
+		require.Equal(t, expected.Currency, rates[idx].Currency)
+		require.Equal(t, expected.Rate, rates[idx].Rate)
+		require.False(t, rates[idx].UpdatedAt.IsZero())
+	}
+
+	err = c.PutExchangeRates(context.TODO(), "uah", nil)
+	require.Equal(t, controller.ErrInvalidCurrency, errors.Cause(err))
+
+	err = c.PutExchangeRates(context.TODO(), "UAH", []*controller.ExchangeRate{{Currency: "USD", Rate: 0}})
+	require.Equal(t, controller.ErrInvalidExchangeRate, errors.Cause(err))
+
+	err = c.PutExchangeRates(context.TODO(), "UAH", []*controller.ExchangeRate{{Currency: "UAH", Rate: 2}})
+	require.Equal(t, controller.ErrInvalidExchangeRate, errors.Cause(err))
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const ecbReferenceCurrency = "EUR"
+
+// ParseCSV reads the exchange rates from the lines of a currency code and its price in the base currency,
+// the header line is optional.
+func ParseCSV(r io.Reader) ([]*ExchangeRate, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	cr.TrimLeadingSpace = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidExchangeRates)
+	}
+
+	rates := make([]*ExchangeRate, 0, len(records))
+
+	for idx, record := range records {
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			if idx == 0 {
+				continue
+			}
+
+			return nil, errors.WithStack(ErrInvalidExchangeRates)
+		}
+
+		rates = append(rates, &ExchangeRate{Currency: strings.ToUpper(strings.TrimSpace(record[0])), Rate: rate})
+	}
+
+	return rates, nil
+}
+
+type ecbEnvelope struct {
+	Days []struct {
+		Rates []struct {
+			Currency string  `xml:"currency,attr"`
+			Rate     float64 `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECB reads the euro foreign exchange reference rates published by the European Central Bank and converts
+// the latest ones to the prices in the base currency, which has to be quoted there unless it is the euro.
+func ParseECB(r io.Reader, base string) ([]*ExchangeRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, errors.WithStack(ErrInvalidExchangeRates)
+	}
+
+	if len(envelope.Days) == 0 {
+		return nil, errors.WithStack(ErrInvalidExchangeRates)
+	}
+
+	// Units of the currencies a euro is worth
+	perEuro := map[string]float64{ecbReferenceCurrency: 1}
+
+	for _, r := range envelope.Days[0].Rates {
+		if r.Rate <= 0 {
+			return nil, errors.WithStack(ErrInvalidExchangeRates)
+		}
+
+		perEuro[r.Currency] = r.Rate
+	}
+
+	basePerEuro, ok := perEuro[base]
+	if !ok {
+		return nil, errors.WithStack(ErrInvalidExchangeRates)
+	}
+
+	rates := make([]*ExchangeRate, 0, len(perEuro))
+
+	for currency, rate := range perEuro {
+		rates = append(rates, &ExchangeRate{Currency: currency, Rate: basePerEuro / rate})
+	}
+
+	return rates, nil
+}
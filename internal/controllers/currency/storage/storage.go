@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+
+	"personaapp/pkg/postgresql"
+	pkgtx "personaapp/pkg/tx"
+)
+
+type Storage struct {
+	*postgresql.Storage
+}
+
+func New(db *postgresql.Storage) *Storage {
+	return &Storage{db}
+}
+
+// ExchangeRate is the price of a currency unit in the base currency.
+type ExchangeRate struct {
+	Currency  string
+	Rate      float64
+	UpdatedAt time.Time
+}
+
+// TxReplaceExchangeRates replaces all the exchange rates with the ones given, updated at updatedAt.
+func (s *Storage) TxReplaceExchangeRates(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	rates []*ExchangeRate,
+	updatedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(ctx, `DELETE FROM exchange_rate`); err != nil {
+		return errors.WithStack(err)
+	}
+
+	currencies := make([]string, len(rates))
+	values := make([]float64, len(rates))
+
+	for idx, r := range rates {
+		currencies[idx] = r.Currency
+		values[idx] = r.Rate
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO exchange_rate (currency, rate, updated_at)
+			SELECT r.currency, r.rate, $3
+			FROM unnest($1::char(3)[], $2::numeric[]) AS r(currency, rate)`,
+		pq.Array(currencies),
+		pq.Array(values),
+		updatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxGetExchangeRates(ctx context.Context, tx pkgtx.Tx) (_ []*ExchangeRate, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT currency, rate, updated_at
+			FROM exchange_rate
+			ORDER BY currency ASC`,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	rates := make([]*ExchangeRate, 0)
+
+	for rows.Next() {
+		var r ExchangeRate
+		if err := rows.Scan(&r.Currency, &r.Rate, &r.UpdatedAt); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		rates = append(rates, &r)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return rates, nil
+}
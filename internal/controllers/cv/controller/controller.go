@@ -3,6 +3,8 @@ package controller
 import (
 	"context"
 	"personaapp/internal/controllers/cv/storage"
+	apievent "personaapp/pkg/grpcapi/event"
+	"personaapp/pkg/nats/event"
 	pkgtx "personaapp/pkg/tx"
 	"time"
 
@@ -137,12 +139,57 @@ type Storage interface {
 	NoTx() pkgtx.Tx
 }
 
+// EventEmitter stores a domain event in the transactional outbox.
+type EventEmitter interface {
+	TxEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error
+}
+
 type Controller struct {
-	s Storage
+	s       Storage
+	emitter EventEmitter
+}
+
+// New creates the controller, domain events are dropped when emitter is nil.
+func New(s Storage, emitter EventEmitter) *Controller {
+	return &Controller{s: s, emitter: emitter}
+}
+
+func (c *Controller) txEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error {
+	if c.emitter == nil {
+		return nil
+	}
+
+	return errors.WithStack(c.emitter.TxEmit(ctx, tx, e))
 }
 
-func New(s Storage) *Controller {
-	return &Controller{s: s}
+// txEmitCVUpdated emits the cv as it is within the transaction. It's emitted on the changes of the job kinds
+// and types too, the cv is matched with the vacancies by them.
+func (c *Controller) txEmitCVUpdated(ctx context.Context, tx pkgtx.Tx, cvID string) error {
+	cv, err := c.s.TxGetCV(ctx, tx, cvID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrCVNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+
+	terms, err := fromStorageCVSalary(cv.CVSalary)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(c.txEmit(ctx, tx, event.CVUpdated(&apievent.CVUpdatedV1{
+		CvId:                 cv.ID,
+		PersonaId:            cv.PersonaID,
+		Position:             cv.Position,
+		WorkMonthsExperience: cv.WorkMonthsExperience,
+		MinSalary:            cv.MinSalary,
+		MaxSalary:            cv.MaxSalary,
+		Currency:             terms.Currency,
+		SalaryPeriod:         string(terms.SalaryPeriod),
+	})))
 }
 
 type StoryEpisode struct {
@@ -203,6 +250,30 @@ type CVJobType struct {
 	Name string
 }
 
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHour  SalaryPeriod = "hour"
+	SalaryPeriodMonth SalaryPeriod = "month"
+	SalaryPeriodYear  SalaryPeriod = "year"
+)
+
+const defaultCurrency = "UAH"
+
+var (
+	ErrInvalidCVCurrency     = errors.New("invalid cv currency")
+	ErrInvalidCVSalaryPeriod = errors.New("invalid cv salary period")
+)
+
+// CVSalary holds the terms of the salary range. Currency is an ISO 4217 code, UAH when empty, and
+// SalaryPeriod is a month when empty. SalaryGross tells whether the salary is before taxes, nil when
+// not specified.
+type CVSalary struct {
+	Currency     string
+	SalaryPeriod SalaryPeriod
+	SalaryGross  *bool
+}
+
 type CV struct {
 	ID                   string
 	PersonaID            string
@@ -210,6 +281,7 @@ type CV struct {
 	WorkMonthsExperience int32
 	MinSalary            int32
 	MaxSalary            int32
+	CVSalary
 }
 
 type CVShort struct {
@@ -218,6 +290,7 @@ type CVShort struct {
 	WorkMonthsExperience int32
 	MinSalary            int32
 	MaxSalary            int32
+	CVSalary
 }
 
 type StoriesEpisodeID string
@@ -376,7 +449,8 @@ func (c *Controller) PutCVJobTypes(
 				return errors.WithStack(err)
 			}
 		}
-		return nil
+
+		return errors.WithStack(c.txEmitCVUpdated(ctx, tx, cvID))
 	}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -420,7 +494,7 @@ func (c *Controller) DeleteCVJobTypes(
 			return errors.WithStack(err)
 		}
 
-		return nil
+		return errors.WithStack(c.txEmitCVUpdated(ctx, tx, cvID))
 	}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -522,7 +596,8 @@ func (c *Controller) PutCVJobKinds(
 				return errors.WithStack(err)
 			}
 		}
-		return nil
+
+		return errors.WithStack(c.txEmitCVUpdated(ctx, tx, cvID))
 	}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -566,7 +641,7 @@ func (c *Controller) DeleteCVJobKinds(
 			return errors.WithStack(err)
 		}
 
-		return nil
+		return errors.WithStack(c.txEmitCVUpdated(ctx, tx, cvID))
 	}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -1013,6 +1088,11 @@ func (c *Controller) PutCV(
 ) (CVID, error) {
 	var ID CVID
 
+	salary, err := toStorageCVSalary(cv.CVSalary)
+	if err != nil {
+		return ID, errors.WithStack(err)
+	}
+
 	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
 		if cvID != nil {
 			switch _, err := c.s.TxGetCV(ctx, tx, *cvID); errors.Cause(err) {
@@ -1034,9 +1114,14 @@ func (c *Controller) PutCV(
 			WorkMonthsExperience: cv.WorkMonthsExperience,
 			MinSalary:            cv.MinSalary,
 			MaxSalary:            cv.MaxSalary,
+			CVSalary:             salary,
 		}
 
-		return errors.WithStack(c.s.TxPutCV(ctx, tx, &cv))
+		if err := c.s.TxPutCV(ctx, tx, &cv); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txEmitCVUpdated(ctx, tx, cv.ID))
 	}); err != nil {
 		return ID, errors.WithStack(err)
 	}
@@ -1045,6 +1130,10 @@ func (c *Controller) PutCV(
 }
 
 func (c *Controller) GetCV(ctx context.Context, cvID string) (*CV, error) {
+	if _, err := uuid.FromString(cvID); err != nil {
+		return nil, errors.WithStack(ErrCVNotFound)
+	}
+
 	// Get vacancy details from DB
 	cv, err := c.s.TxGetCV(ctx, c.s.NoTx(), cvID)
 
@@ -1056,6 +1145,11 @@ func (c *Controller) GetCV(ctx context.Context, cvID string) (*CV, error) {
 		return nil, errors.WithStack(err)
 	}
 
+	salary, err := fromStorageCVSalary(cv.CVSalary)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	return &CV{
 		ID:                   cv.ID,
 		PersonaID:            cv.PersonaID,
@@ -1063,6 +1157,7 @@ func (c *Controller) GetCV(ctx context.Context, cvID string) (*CV, error) {
 		WorkMonthsExperience: cv.WorkMonthsExperience,
 		MinSalary:            cv.MinSalary,
 		MaxSalary:            cv.MaxSalary,
+		CVSalary:             salary,
 	}, nil
 }
 
@@ -1081,12 +1176,18 @@ func (c *Controller) GetCVs(
 
 	cvShorts := make([]*CVShort, len(cvs))
 	for idx, cvShort := range cvs {
+		salary, err := fromStorageCVSalary(cvShort.CVSalary)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
 		cvShorts[idx] = &CVShort{
 			ID:                   cvShort.ID,
 			Position:             cvShort.Position,
 			WorkMonthsExperience: cvShort.WorkMonthsExperience,
 			MinSalary:            cvShort.MinSalary,
 			MaxSalary:            cvShort.MaxSalary,
+			CVSalary:             salary,
 		}
 	}
 
@@ -1113,3 +1214,43 @@ func (c *Controller) DeleteCV(
 
 	return nil
 }
+
+func toStorageCVSalary(s CVSalary) (storage.CVSalary, error) {
+	ss := storage.CVSalary{Currency: s.Currency, SalaryGross: s.SalaryGross}
+
+	if ss.Currency == "" {
+		ss.Currency = defaultCurrency
+	} else if !govalidator.IsISO4217(ss.Currency) {
+		return ss, errors.WithStack(ErrInvalidCVCurrency)
+	}
+
+	switch s.SalaryPeriod {
+	case SalaryPeriodHour:
+		ss.SalaryPeriod = storage.SalaryPeriodHour
+	case SalaryPeriodMonth, "":
+		ss.SalaryPeriod = storage.SalaryPeriodMonth
+	case SalaryPeriodYear:
+		ss.SalaryPeriod = storage.SalaryPeriodYear
+	default:
+		return ss, errors.WithStack(ErrInvalidCVSalaryPeriod)
+	}
+
+	return ss, nil
+}
+
+func fromStorageCVSalary(ss storage.CVSalary) (CVSalary, error) {
+	s := CVSalary{Currency: ss.Currency, SalaryGross: ss.SalaryGross}
+
+	switch ss.SalaryPeriod {
+	case storage.SalaryPeriodHour:
+		s.SalaryPeriod = SalaryPeriodHour
+	case storage.SalaryPeriodMonth:
+		s.SalaryPeriod = SalaryPeriodMonth
+	case storage.SalaryPeriodYear:
+		s.SalaryPeriod = SalaryPeriodYear
+	default:
+		return s, errors.Newf("unknown salary period=%s", ss.SalaryPeriod)
+	}
+
+	return s, nil
+}
@@ -46,8 +46,8 @@ func TestController_PutCV(t *testing.T) {
 		cleanup(t)
 	}()
 
-	c := controller.New(s)
-	ac := authController.New(authCfg, as)
+	c := controller.New(s, nil)
+	ac := authController.New(authCfg, as, nil)
 
 	t.Run("create new cv", func(t *testing.T) {
 		token, err := ac.Register(context.TODO(), &authController.RegisterData{
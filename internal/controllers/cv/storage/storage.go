@@ -83,6 +83,21 @@ type StoryEpisode struct {
 	MediaURL string
 }
 
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHour  SalaryPeriod = "salary_period_hour"
+	SalaryPeriodMonth SalaryPeriod = "salary_period_month"
+	SalaryPeriodYear  SalaryPeriod = "salary_period_year"
+)
+
+// CVSalary holds the salary terms of the salary range, Currency is an ISO 4217 code.
+type CVSalary struct {
+	Currency     string
+	SalaryPeriod SalaryPeriod
+	SalaryGross  *bool
+}
+
 type CV struct {
 	ID                   string
 	PersonaID            string
@@ -90,8 +105,9 @@ type CV struct {
 	WorkMonthsExperience int32
 	MinSalary            int32
 	MaxSalary            int32
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
+	CVSalary
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type CVShort struct {
@@ -100,6 +116,7 @@ type CVShort struct {
 	WorkMonthsExperience int32
 	MinSalary            int32
 	MaxSalary            int32
+	CVSalary
 }
 
 /**
@@ -1113,12 +1130,17 @@ func (s *Storage) TxPutCV(ctx context.Context, tx pkgtx.Tx, cv *CV) error {
 					work_months_experience = $4,
 					min_salary = $5,
 					max_salary = $6,
-					updated_at = $8
+					updated_at = $8,
+					currency = $9,
+					salary_period = $10,
+					salary_gross = $11
 				WHERE id = $1
-				RETURNING id, persona_id, position, work_months_experience, min_salary, max_salary, created_at, updated_at
+				RETURNING id, persona_id, position, work_months_experience, min_salary, max_salary, created_at, updated_at,
+					currency, salary_period, salary_gross
 			)
-			INSERT INTO cv (id, persona_id, position, work_months_experience, min_salary, max_salary, created_at, updated_at)
-			SELECT $1, $2, $3, $4, $5, $6, $7, $8
+			INSERT INTO cv (id, persona_id, position, work_months_experience, min_salary, max_salary, created_at, updated_at,
+					currency, salary_period, salary_gross)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 			WHERE NOT EXISTS (SELECT * FROM upsert)`,
 		cv.ID,
 		cv.PersonaID,
@@ -1128,6 +1150,9 @@ func (s *Storage) TxPutCV(ctx context.Context, tx pkgtx.Tx, cv *CV) error {
 		cv.MaxSalary,
 		cv.CreatedAt,
 		cv.UpdatedAt,
+		cv.Currency,
+		cv.SalaryPeriod,
+		cv.SalaryGross,
 	); err != nil {
 		return errors.WithStack(err)
 	}
@@ -1141,7 +1166,8 @@ func (s *Storage) TxGetCV(ctx context.Context, tx pkgtx.Tx, cvID string) (*CV, e
 	var cv CV
 	err := c.QueryRowContext(
 		ctx,
-		`SELECT id, persona_id, position, work_months_experience, min_salary, max_salary, created_at, updated_at
+		`SELECT id, persona_id, position, work_months_experience, min_salary, max_salary, currency, salary_period,
+					salary_gross, created_at, updated_at
 				FROM cv
 				WHERE id = $1`,
 		cvID,
@@ -1152,6 +1178,9 @@ func (s *Storage) TxGetCV(ctx context.Context, tx pkgtx.Tx, cvID string) (*CV, e
 		&cv.WorkMonthsExperience,
 		&cv.MinSalary,
 		&cv.MaxSalary,
+		&cv.Currency,
+		&cv.SalaryPeriod,
+		&cv.SalaryGross,
 		&cv.CreatedAt,
 		&cv.UpdatedAt,
 	)
@@ -1176,7 +1205,7 @@ func (s *Storage) TxGetCVs(
 
 	rows, err := c.QueryContext(
 		ctx,
-		`SELECT id, position, work_months_experience, min_salary, max_salary, 
+		`SELECT id, position, work_months_experience, min_salary, max_salary, currency, salary_period, salary_gross
 			FROM cv
 			WHERE cv.persona_id = $1`,
 		personaID,
@@ -1189,7 +1218,16 @@ func (s *Storage) TxGetCVs(
 
 	for rows.Next() {
 		var cv CVShort
-		if err := rows.Scan(&cv.ID, &cv.Position, &cv.WorkMonthsExperience, &cv.MinSalary, &cv.MaxSalary); err != nil {
+		if err := rows.Scan(
+			&cv.ID,
+			&cv.Position,
+			&cv.WorkMonthsExperience,
+			&cv.MinSalary,
+			&cv.MaxSalary,
+			&cv.Currency,
+			&cv.SalaryPeriod,
+			&cv.SalaryGross,
+		); err != nil {
 			_ = rows.Close()
 			return nil, errors.WithStack(err)
 		}
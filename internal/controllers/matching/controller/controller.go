@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/matching/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const (
+	// The newest candidates are scored, the older ones are unlikely to be shown anyway
+	matchCandidates = 500
+	// The worse matches aren't kept
+	minMatchScore = 0.2
+
+	defaultRecommendations = 20
+	maxRecommendations     = 50
+
+	vacancyTypeRemote = "vacancy_type_remote"
+)
+
+var (
+	ErrCVNotFound      = errors.New("cv not found")
+	ErrVacancyNotFound = errors.New("vacancy not found")
+)
+
+type Storage interface {
+	TxGetCV(ctx context.Context, tx pkgtx.Tx, cvID string) (*storage.CV, error)
+	TxGetVacancyCandidateCVs(ctx context.Context, tx pkgtx.Tx, vacancyID string, limit int) ([]*storage.CV, error)
+	TxGetVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*storage.Vacancy, error)
+	TxGetCVCandidateVacancies(ctx context.Context, tx pkgtx.Tx, cvID string, limit int) ([]*storage.Vacancy, error)
+
+	TxReplaceCVMatches(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		cvID string,
+		matches []*storage.Match,
+		computedAt time.Time,
+	) error
+	TxReplaceVacancyMatches(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		matches []*storage.Match,
+		computedAt time.Time,
+	) error
+	TxGetCVMatches(ctx context.Context, tx pkgtx.Tx, cvID string, limit int) ([]*storage.Match, error)
+	TxGetVacancyCandidates(ctx context.Context, tx pkgtx.Tx, vacancyID string, limit int) ([]*storage.Candidate, error)
+
+	BeginTx(ctx context.Context) (pkgtx.Tx, error)
+	NoTx() pkgtx.Tx
+}
+
+type Controller struct {
+	s Storage
+}
+
+func New(s Storage) *Controller {
+	return &Controller{s: s}
+}
+
+type Match struct {
+	CVID      string
+	VacancyID string
+	MatchScore
+	ComputedAt time.Time
+}
+
+// Candidate is a match of a vacancy with the cv summed up.
+type Candidate struct {
+	Match
+	PersonaID            string
+	Position             string
+	WorkMonthsExperience int32
+}
+
+// MatchCV recomputes the matches of the cv with the live vacancies which may fit it.
+func (c *Controller) MatchCV(ctx context.Context, cvID string) error {
+	if _, err := uuid.FromString(cvID); err != nil {
+		return errors.WithStack(ErrCVNotFound)
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		cv, err := c.s.TxGetCV(ctx, tx, cvID)
+
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrCVNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		vs, err := c.s.TxGetCVCandidateVacancies(ctx, tx, cvID, matchCandidates)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		features := toCVFeatures(cv)
+		matches := make([]*storage.Match, 0, len(vs))
+
+		for _, v := range vs {
+			if ms := Score(features, toVacancyFeatures(v)); ms.Score >= minMatchScore {
+				matches = append(matches, toStorageMatch(cv.ID, v.ID, ms))
+			}
+		}
+
+		return errors.WithStack(c.s.TxReplaceCVMatches(ctx, tx, cvID, matches, time.Now()))
+	}))
+}
+
+// MatchVacancy recomputes the matches of the vacancy with the cvs which may fit it. The matches are kept
+// whatever the vacancy status is, the recommendations skip the vacancies which aren't live.
+func (c *Controller) MatchVacancy(ctx context.Context, vacancyID string) error {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return errors.WithStack(ErrVacancyNotFound)
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		v, err := c.s.TxGetVacancy(ctx, tx, vacancyID)
+
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrVacancyNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		cvs, err := c.s.TxGetVacancyCandidateCVs(ctx, tx, vacancyID, matchCandidates)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		features := toVacancyFeatures(v)
+		matches := make([]*storage.Match, 0, len(cvs))
+
+		for _, cv := range cvs {
+			if ms := Score(toCVFeatures(cv), features); ms.Score >= minMatchScore {
+				matches = append(matches, toStorageMatch(cv.ID, v.ID, ms))
+			}
+		}
+
+		return errors.WithStack(c.s.TxReplaceVacancyMatches(ctx, tx, vacancyID, matches, time.Now()))
+	}))
+}
+
+// GetRecommendedVacancies returns up to limit best matches of the cv with the live vacancies.
+func (c *Controller) GetRecommendedVacancies(ctx context.Context, cvID string, limit int) ([]*Match, error) {
+	if _, err := uuid.FromString(cvID); err != nil {
+		return nil, errors.WithStack(ErrCVNotFound)
+	}
+
+	sms, err := c.s.TxGetCVMatches(ctx, c.s.NoTx(), cvID, recommendationsLimit(limit))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	matches := make([]*Match, len(sms))
+	for idx, sm := range sms {
+		matches[idx] = fromStorageMatch(sm)
+	}
+
+	return matches, nil
+}
+
+// GetRecommendedCandidates returns up to limit best matches of the vacancy with the cvs.
+func (c *Controller) GetRecommendedCandidates(ctx context.Context, vacancyID string, limit int) ([]*Candidate, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	}
+
+	scs, err := c.s.TxGetVacancyCandidates(ctx, c.s.NoTx(), vacancyID, recommendationsLimit(limit))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	candidates := make([]*Candidate, len(scs))
+	for idx, sc := range scs {
+		candidates[idx] = &Candidate{
+			Match:                *fromStorageMatch(&sc.Match),
+			PersonaID:            sc.PersonaID,
+			Position:             sc.Position,
+			WorkMonthsExperience: sc.WorkMonthsExperience,
+		}
+	}
+
+	return candidates, nil
+}
+
+func recommendationsLimit(limit int) int {
+	if limit <= 0 {
+		return defaultRecommendations
+	}
+
+	if limit > maxRecommendations {
+		return maxRecommendations
+	}
+
+	return limit
+}
+
+func toCVFeatures(cv *storage.CV) *CVFeatures {
+	return &CVFeatures{
+		Position:             cv.Position,
+		WorkMonthsExperience: cv.WorkMonthsExperience,
+		MinSalary:            cv.NormalizedMinSalary,
+		MaxSalary:            cv.NormalizedMaxSalary,
+		JobKinds:             cv.JobKinds,
+		JobTypes:             cv.JobTypes,
+	}
+}
+
+func toVacancyFeatures(v *storage.Vacancy) *VacancyFeatures {
+	return &VacancyFeatures{
+		Title:                v.Title,
+		Remote:               v.Type == vacancyTypeRemote,
+		WorkMonthsExperience: v.WorkMonthsExperience,
+		MinSalary:            v.NormalizedMinSalary,
+		MaxSalary:            v.NormalizedMaxSalary,
+		Categories:           v.Categories,
+	}
+}
+
+func toStorageMatch(cvID string, vacancyID string, ms MatchScore) *storage.Match {
+	return &storage.Match{
+		CVID:            cvID,
+		VacancyID:       vacancyID,
+		Score:           ms.Score,
+		JobKindsScore:   ms.JobKinds,
+		JobTypeScore:    ms.JobType,
+		ExperienceScore: ms.Experience,
+		SalaryScore:     ms.Salary,
+		PositionScore:   ms.Position,
+	}
+}
+
+func fromStorageMatch(sm *storage.Match) *Match {
+	return &Match{
+		CVID:      sm.CVID,
+		VacancyID: sm.VacancyID,
+		MatchScore: MatchScore{
+			Score:      sm.Score,
+			JobKinds:   sm.JobKindsScore,
+			JobType:    sm.JobTypeScore,
+			Experience: sm.ExperienceScore,
+			Salary:     sm.SalaryScore,
+			Position:   sm.PositionScore,
+		},
+		ComputedAt: sm.ComputedAt,
+	}
+}
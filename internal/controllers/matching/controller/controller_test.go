@@ -0,0 +1,318 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	sqlMigrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+
+	authController "personaapp/internal/controllers/auth/controller"
+	companyController "personaapp/internal/controllers/company/controller"
+	companyStorage "personaapp/internal/controllers/company/storage"
+	cvController "personaapp/internal/controllers/cv/controller"
+	cvStorage "personaapp/internal/controllers/cv/storage"
+	"personaapp/internal/controllers/matching/controller"
+	"personaapp/internal/controllers/matching/storage"
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+	vacancyStorage "personaapp/internal/controllers/vacancy/storage"
+	"personaapp/internal/testutils"
+)
+
+var authCfg = &authController.Config{
+	TokenExpiration:   5 * time.Minute,
+	PrivateSigningKey: "signkey",
+	TokenValidityGap:  15 * time.Second,
+}
+
+var vacancyCfg = &vacancyController.Config{
+	VacancyLifetime: 24 * time.Hour,
+	ExpiryInterval:  time.Minute,
+	ExpiryBatchSize: 10,
+}
+
+func initStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
+
+	return storage.New(pg), pg.Close
+}
+
+func cleanup(t *testing.T) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Down))
+}
+
+func salary(v float64) *float64 {
+	return &v
+}
+
+func TestScore(t *testing.T) {
+	cv := &controller.CVFeatures{
+		Position:             "Golang developer",
+		WorkMonthsExperience: 12,
+		MinSalary:            salary(30000),
+		MaxSalary:            salary(50000),
+		JobKinds:             []string{"Development", "Design"},
+		JobTypes:             []string{"Remote work"},
+	}
+
+	t.Run("every feature", func(t *testing.T) {
+		ms := controller.Score(cv, &controller.VacancyFeatures{
+			Title:                "Senior Golang Developer",
+			Remote:               true,
+			WorkMonthsExperience: 24,
+			MinSalary:            salary(20000),
+			MaxSalary:            salary(40000),
+			Categories:           []string{"Backend", "development"},
+		})
+
+		require.InDelta(t, 0.5, ms.JobKinds, 1e-9)
+		require.InDelta(t, 1, ms.JobType, 1e-9)
+		require.InDelta(t, 0.5, ms.Experience, 1e-9)
+		require.InDelta(t, 1, ms.Salary, 1e-9)
+		require.InDelta(t, 1, ms.Position, 1e-9)
+		require.InDelta(t, 0.3*0.5+0.1+0.2*0.5+0.2+0.2, ms.Score, 1e-9)
+	})
+
+	t.Run("unknown features", func(t *testing.T) {
+		ms := controller.Score(&controller.CVFeatures{}, &controller.VacancyFeatures{
+			Title:                "Designer",
+			WorkMonthsExperience: 12,
+		})
+
+		require.Equal(t, controller.MatchScore{}, ms)
+	})
+
+	t.Run("partial fit", func(t *testing.T) {
+		ms := controller.Score(cv, &controller.VacancyFeatures{
+			Title:      "Python developer",
+			Remote:     false,
+			MinSalary:  salary(15000),
+			Categories: []string{"Design"},
+		})
+
+		require.InDelta(t, 0.5, ms.JobKinds, 1e-9)
+		require.InDelta(t, 0, ms.JobType, 1e-9)
+		require.InDelta(t, 1, ms.Experience, 1e-9)
+		require.InDelta(t, 0.5, ms.Salary, 1e-9)
+		require.InDelta(t, 0.5, ms.Position, 1e-9)
+	})
+}
+
+func TestController_Matching(t *testing.T) {
+	s, closer := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+	pg := testutils.EnsurePostgres(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+		if err := pg.Close(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	c := controller.New(s)
+	ac := authController.New(authCfg, as, nil)
+	cc := companyController.New(companyStorage.New(pg), nil)
+	vc := vacancyController.New(vacancyCfg, vacancyStorage.New(pg), nil, nil, nil)
+	cvc := cvController.New(cvStorage.New(pg), nil)
+
+	register := func(email string, phone string, account authController.AccountType) string {
+		token, err := ac.Register(context.TODO(), &authController.RegisterData{
+			Email:    email,
+			Phone:    phone,
+			Account:  account,
+			Password: "Password1488",
+		})
+		require.NoError(t, err)
+
+		claims, err := ac.GetAuthClaims(context.TODO(), token.Token)
+		require.NoError(t, err)
+
+		if account == authController.AccountTypeCompany {
+			companyTitle := "Company"
+			require.NoError(t, cc.Update(context.TODO(), &companyController.CompanyData{
+				ID:    claims.AccountID,
+				Title: &companyTitle,
+			}))
+		}
+
+		return claims.AccountID
+	}
+
+	companyID := register("match.company@gmail.com", "+380503000125", authController.AccountTypeCompany)
+	personaID := register("match.persona@gmail.com", "+380503000126", authController.AccountTypePersona)
+
+	developmentID, err := vc.PutVacancyCategory(context.TODO(), nil, &vacancyController.VacancyCategory{
+		Title:   "Development",
+		IconURL: "https://s3.bucket.org/1.jpg",
+	})
+	require.NoError(t, err)
+
+	parentID := string(developmentID)
+	backendID, err := vc.PutVacancyCategory(context.TODO(), nil, &vacancyController.VacancyCategory{
+		ParentID: &parentID,
+		Title:    "Backend",
+		IconURL:  "https://s3.bucket.org/2.jpg",
+	})
+	require.NoError(t, err)
+
+	putVacancy := func(title string, categoriesIDs []string, publish bool) string {
+		vacancyID, err := vc.PutVacancy(context.TODO(), companyID, nil, &vacancyController.VacancyDetails{
+			Vacancy: vacancyController.Vacancy{
+				Title:     title,
+				Phone:     "+380503000127",
+				MinSalary: 30000,
+				MaxSalary: 40000,
+				CompanyID: companyID,
+			},
+			Description:          "Description",
+			WorkMonthsExperience: 24,
+			Type:                 vacancyController.VacancyTypeRemote,
+		}, categoriesIDs, []string{})
+		require.NoError(t, err)
+
+		if publish {
+			require.NoError(t, vc.PublishVacancy(context.TODO(), string(vacancyID), nil))
+		}
+
+		return string(vacancyID)
+	}
+
+	// The backend vacancy is in the development subtree the job kind is named after
+	vacancyID := putVacancy("Senior Golang developer", []string{string(backendID)}, true)
+	putVacancy("Golang developer", []string{string(backendID)}, false)
+	putVacancy("Designer", []string{}, true)
+
+	jobKindID, err := cvc.PutJobKind(context.TODO(), nil, &cvController.JobKind{Name: "Development"})
+	require.NoError(t, err)
+
+	jobTypeID, err := cvc.PutJobType(context.TODO(), nil, &cvController.JobType{Name: "Remote work"})
+	require.NoError(t, err)
+
+	cvID, err := cvc.PutCV(context.TODO(), nil, &cvController.CV{
+		PersonaID:            personaID,
+		Position:             "Golang developer",
+		WorkMonthsExperience: 12,
+		MinSalary:            35000,
+		MaxSalary:            45000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, cvc.PutCVJobKinds(context.TODO(), string(cvID), []string{string(jobKindID)}))
+	require.NoError(t, cvc.PutCVJobTypes(context.TODO(), string(cvID), []string{string(jobTypeID)}))
+
+	expected := controller.MatchScore{
+		Score:      0.3 + 0.1 + 0.2*0.5 + 0.2 + 0.2,
+		JobKinds:   1,
+		JobType:    1,
+		Experience: 0.5,
+		Salary:     1,
+		Position:   1,
+	}
+
+	requireScore := func(t *testing.T, ms controller.MatchScore) {
+		require.InDelta(t, expected.Score, ms.Score, 1e-9)
+		require.InDelta(t, expected.JobKinds, ms.JobKinds, 1e-9)
+		require.InDelta(t, expected.JobType, ms.JobType, 1e-9)
+		require.InDelta(t, expected.Experience, ms.Experience, 1e-9)
+		require.InDelta(t, expected.Salary, ms.Salary, 1e-9)
+		require.InDelta(t, expected.Position, ms.Position, 1e-9)
+	}
+
+	t.Run("match cv", func(t *testing.T) {
+		require.NoError(t, c.MatchCV(context.TODO(), string(cvID)))
+
+		matches, err := c.GetRecommendedVacancies(context.TODO(), string(cvID), 0)
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		require.Equal(t, vacancyID, matches[0].VacancyID)
+		require.Equal(t, string(cvID), matches[0].CVID)
+		requireScore(t, matches[0].MatchScore)
+	})
+
+	t.Run("match vacancy", func(t *testing.T) {
+		require.NoError(t, c.MatchVacancy(context.TODO(), vacancyID))
+
+		candidates, err := c.GetRecommendedCandidates(context.TODO(), vacancyID, 0)
+		require.NoError(t, err)
+		require.Len(t, candidates, 1)
+		require.Equal(t, string(cvID), candidates[0].CVID)
+		require.Equal(t, personaID, candidates[0].PersonaID)
+		require.Equal(t, "Golang developer", candidates[0].Position)
+		require.Equal(t, int32(12), candidates[0].WorkMonthsExperience)
+		requireScore(t, candidates[0].MatchScore)
+	})
+
+	t.Run("repost isn't recommended", func(t *testing.T) {
+		linkCfg := *vacancyCfg
+		linkCfg.DuplicatePolicy = vacancyController.DuplicatePolicyLink
+		linkCfg.DuplicateSimilarity = 0.8
+
+		lvc := vacancyController.New(&linkCfg, vacancyStorage.New(pg), nil, nil, nil)
+
+		repostID, err := lvc.PutVacancy(context.TODO(), companyID, nil, &vacancyController.VacancyDetails{
+			Vacancy: vacancyController.Vacancy{
+				Title:     "Senior Golang developer",
+				Phone:     "+380503000127",
+				MinSalary: 30000,
+				MaxSalary: 40000,
+				CompanyID: companyID,
+			},
+			Description:          "Description",
+			WorkMonthsExperience: 24,
+			Type:                 vacancyController.VacancyTypeRemote,
+		}, []string{string(backendID)}, []string{})
+		require.NoError(t, err)
+		require.NoError(t, lvc.PublishVacancy(context.TODO(), string(repostID), nil))
+
+		require.NoError(t, c.MatchCV(context.TODO(), string(cvID)))
+
+		matches, err := c.GetRecommendedVacancies(context.TODO(), string(cvID), 0)
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		require.Equal(t, vacancyID, matches[0].VacancyID)
+
+		require.NoError(t, lvc.CloseVacancy(context.TODO(), string(repostID)))
+	})
+
+	t.Run("recommended vacancies are live", func(t *testing.T) {
+		require.NoError(t, vc.PauseVacancy(context.TODO(), vacancyID))
+		require.NoError(t, c.MatchVacancy(context.TODO(), vacancyID))
+
+		matches, err := c.GetRecommendedVacancies(context.TODO(), string(cvID), 0)
+		require.NoError(t, err)
+		require.Empty(t, matches)
+
+		candidates, err := c.GetRecommendedCandidates(context.TODO(), vacancyID, 0)
+		require.NoError(t, err)
+		require.Len(t, candidates, 1)
+	})
+
+	t.Run("deleted vacancy", func(t *testing.T) {
+		require.NoError(t, vc.DeleteVacancy(context.TODO(), vacancyID))
+
+		candidates, err := c.GetRecommendedCandidates(context.TODO(), vacancyID, 0)
+		require.NoError(t, err)
+		require.Empty(t, candidates)
+
+		err = c.MatchVacancy(context.TODO(), vacancyID)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+
+	t.Run("unknown cv", func(t *testing.T) {
+		err := c.MatchCV(context.TODO(), "8d5b9ba5-0a34-4ab8-8a5f-2a6e4e1f3f1b")
+		require.Equal(t, controller.ErrCVNotFound, errors.Cause(err))
+
+		_, err = c.GetRecommendedVacancies(context.TODO(), "not a uuid", 0)
+		require.Equal(t, controller.ErrCVNotFound, errors.Cause(err))
+	})
+}
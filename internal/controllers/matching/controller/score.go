@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	jobKindsWeight   = 0.3
+	jobTypeWeight    = 0.1
+	experienceWeight = 0.2
+	salaryWeight     = 0.2
+	positionWeight   = 0.2
+
+	// A job type of a cv fits a remote vacancy when its name has this word, a normal one otherwise
+	remoteJobTypeWord = "remote"
+)
+
+// CVFeatures are the features of a cv a vacancy is matched with. The salaries are monthly in the base
+// currency, nil when unknown.
+type CVFeatures struct {
+	Position             string
+	WorkMonthsExperience int32
+	MinSalary            *float64
+	MaxSalary            *float64
+	JobKinds             []string
+	JobTypes             []string
+}
+
+// VacancyFeatures are the features of a vacancy a cv is matched with. The salaries are monthly in the base
+// currency, nil when unknown. Categories are the titles of the categories of the vacancy and of their
+// ancestors in any locale.
+type VacancyFeatures struct {
+	Title                string
+	Remote               bool
+	WorkMonthsExperience int32
+	MinSalary            *float64
+	MaxSalary            *float64
+	Categories           []string
+}
+
+// MatchScore explains the score of a cv for a vacancy. Every component is from 0 to 1, unknown features
+// score 0, and Score is their weighted sum.
+type MatchScore struct {
+	Score float64
+	// JobKinds is the share of the job kinds of the cv named as a category of the vacancy
+	JobKinds float64
+	// JobType is 1 when a job type of the cv fits the vacancy type
+	JobType float64
+	// Experience is the share of the required experience the cv has
+	Experience float64
+	// Salary is the share of the salary expected by the cv the vacancy offers
+	Salary float64
+	// Position is the share of the words of the cv position in the vacancy title
+	Position float64
+}
+
+// Score scores the cv for the vacancy, see MatchScore.
+func Score(cv *CVFeatures, v *VacancyFeatures) MatchScore {
+	ms := MatchScore{
+		JobKinds:   jobKindsScore(cv.JobKinds, v.Categories),
+		JobType:    jobTypeScore(cv.JobTypes, v.Remote),
+		Experience: experienceScore(cv.WorkMonthsExperience, v.WorkMonthsExperience),
+		Salary:     salaryScore(cv, v),
+		Position:   containedShare(words(cv.Position), words(v.Title)),
+	}
+
+	ms.Score = jobKindsWeight*ms.JobKinds +
+		jobTypeWeight*ms.JobType +
+		experienceWeight*ms.Experience +
+		salaryWeight*ms.Salary +
+		positionWeight*ms.Position
+
+	return ms
+}
+
+func jobKindsScore(jobKinds []string, categories []string) float64 {
+	lowered := make([]string, len(categories))
+	for idx, c := range categories {
+		lowered[idx] = strings.ToLower(strings.TrimSpace(c))
+	}
+
+	kinds := make([]string, len(jobKinds))
+	for idx, k := range jobKinds {
+		kinds[idx] = strings.ToLower(strings.TrimSpace(k))
+	}
+
+	return containedShare(kinds, lowered)
+}
+
+func jobTypeScore(jobTypes []string, remote bool) float64 {
+	for _, jt := range jobTypes {
+		if containsWord(words(jt), remoteJobTypeWord) == remote {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func experienceScore(has int32, required int32) float64 {
+	if required <= 0 {
+		return 1
+	}
+
+	return math.Min(1, float64(has)/float64(required))
+}
+
+// salaryScore compares the lowest salary the cv expects with the highest one the vacancy offers.
+func salaryScore(cv *CVFeatures, v *VacancyFeatures) float64 {
+	expected := firstKnown(cv.MinSalary, cv.MaxSalary)
+	offered := firstKnown(v.MaxSalary, v.MinSalary)
+
+	if expected == nil || offered == nil || *expected <= 0 {
+		return 0
+	}
+
+	return math.Min(1, *offered / *expected)
+}
+
+func firstKnown(a *float64, b *float64) *float64 {
+	if a != nil {
+		return a
+	}
+
+	return b
+}
+
+// containedShare returns the share of the distinct elements of a found in b, 0 when a is empty.
+func containedShare(a []string, b []string) float64 {
+	set := make(map[string]bool, len(b))
+	for _, s := range b {
+		set[s] = true
+	}
+
+	seen := make(map[string]bool, len(a))
+	found := 0
+
+	for _, s := range a {
+		if seen[s] {
+			continue
+		}
+
+		seen[s] = true
+
+		if set[s] {
+			found++
+		}
+	}
+
+	if len(seen) == 0 {
+		return 0
+	}
+
+	return float64(found) / float64(len(seen))
+}
+
+func containsWord(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+
+	return false
+}
+
+// words returns the lowercased words of the text, the one letter ones are skipped.
+func words(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	ws := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if utf8.RuneCountInString(f) > 1 {
+			ws = append(ws, f)
+		}
+	}
+
+	return ws
+}
@@ -0,0 +1,537 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+
+	"personaapp/pkg/postgresql"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Storage struct {
+	*postgresql.Storage
+}
+
+func New(db *postgresql.Storage) *Storage {
+	return &Storage{db}
+}
+
+// CV is a cv with the features it's matched by. The salaries are monthly in the base currency,
+// nil when unknown.
+type CV struct {
+	ID                   string
+	PersonaID            string
+	Position             string
+	WorkMonthsExperience int32
+	NormalizedMinSalary  *float64
+	NormalizedMaxSalary  *float64
+	JobKinds             []string
+	JobTypes             []string
+}
+
+// Vacancy is a vacancy with the features it's matched by. The salaries are monthly in the base currency,
+// nil when unknown. Categories are the titles, the localized ones included, of the categories of the vacancy
+// and of their ancestors.
+type Vacancy struct {
+	ID                   string
+	Title                string
+	Type                 string
+	WorkMonthsExperience int32
+	NormalizedMinSalary  *float64
+	NormalizedMaxSalary  *float64
+	Categories           []string
+}
+
+type Match struct {
+	CVID            string
+	VacancyID       string
+	Score           float64
+	JobKindsScore   float64
+	JobTypeScore    float64
+	ExperienceScore float64
+	SalaryScore     float64
+	PositionScore   float64
+	ComputedAt      time.Time
+}
+
+// Candidate is a match of a vacancy with the cv summed up.
+type Candidate struct {
+	Match
+	PersonaID            string
+	Position             string
+	WorkMonthsExperience int32
+}
+
+const cvColumns = `cv.id, cv.persona_id, coalesce(cv.position, ''), coalesce(cv.work_months_experience, 0),
+	normalized_salary(nullif(cv.min_salary, 0), cv.currency, cv.salary_period),
+	normalized_salary(nullif(cv.max_salary, 0), cv.currency, cv.salary_period),
+	array(
+		SELECT jk.name FROM cv_job_kinds AS cjk
+		INNER JOIN job_kind AS jk ON jk.id = cjk.job_kind_id
+		WHERE cjk.cv_id = cv.id
+		ORDER BY jk.name
+	),
+	array(
+		SELECT jt.name FROM cv_job_types AS cjt
+		INNER JOIN job_type AS jt ON jt.id = cjt.job_type_id
+		WHERE cjt.cv_id = cv.id
+		ORDER BY jt.name
+	)`
+
+const vacancyColumns = `v.id, v.title, v.type, v.work_months_experience,
+	normalized_salary(nullif(v.min_salary, 0), v.currency, v.salary_period),
+	normalized_salary(nullif(v.max_salary, 0), v.currency, v.salary_period),
+	array(
+		SELECT DISTINCT t.title FROM vacancies_categories AS vcs
+		INNER JOIN vacancy_category AS c ON c.id = vcs.category_id
+		INNER JOIN vacancy_category AS vc ON c.path LIKE vc.path || '%'
+		CROSS JOIN LATERAL (
+			SELECT vc.title UNION SELECT title FROM vacancy_category_title WHERE category_id = vc.id
+		) AS t (title)
+		WHERE vcs.vacancy_id = v.id
+		ORDER BY t.title
+	)`
+
+// matchCandidateCondition matches the cv aliased as cv and the vacancy aliased as v which have a job kind
+// of the cv named as a category of the vacancy or an ancestor of one, or the vacancy matching the position.
+const matchCandidateCondition = `(
+	EXISTS (
+		SELECT 1 FROM vacancies_categories AS vcs
+		INNER JOIN vacancy_category AS c ON c.id = vcs.category_id
+		INNER JOIN vacancy_category AS vc ON c.path LIKE vc.path || '%'
+		LEFT JOIN vacancy_category_title AS vct ON vct.category_id = vc.id
+		INNER JOIN cv_job_kinds AS cjk ON cjk.cv_id = cv.id
+		INNER JOIN job_kind AS jk ON jk.id = cjk.job_kind_id
+		WHERE vcs.vacancy_id = v.id AND lower(jk.name) IN (lower(vc.title), lower(vct.title))
+	)
+	OR v.search_vector @@ plainto_tsquery('vacancy_search', coalesce(cv.position, ''))
+)`
+
+// liveVacancyCondition matches the vacancies listed to anybody as the vacancy storage does, a repost is hidden
+// while its original is live.
+const liveVacancyCondition = `v.status = 'vacancy_status_published' AND v.expires_at > now()
+	AND (v.duplicate_of IS NULL OR NOT EXISTS (
+		SELECT 1 FROM vacancy AS o
+		WHERE o.id = v.duplicate_of AND o.status = 'vacancy_status_published' AND o.expires_at > now()
+	))`
+
+func (s *Storage) TxGetCV(ctx context.Context, tx pkgtx.Tx, cvID string) (*CV, error) {
+	cvs, err := s.txGetCVs(ctx, tx, `cv.id = $1`, `cv.id`, cvID, 1)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(cvs) == 0 {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+
+	return cvs[0], nil
+}
+
+// TxGetVacancyCandidateCVs returns up to limit last updated cvs which may match the vacancy, see
+// matchCandidateCondition.
+func (s *Storage) TxGetVacancyCandidateCVs(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	limit int,
+) ([]*CV, error) {
+	cvs, err := s.txGetCVs(
+		ctx,
+		tx,
+		`cv.id IN (
+			SELECT cv.id FROM cv, vacancy AS v
+			WHERE v.id = $1 AND `+matchCandidateCondition+`
+		)`,
+		`cv.updated_at DESC, cv.id`,
+		vacancyID,
+		limit,
+	)
+
+	return cvs, errors.WithStack(err)
+}
+
+// txGetCVs returns up to limit cvs aliased as cv matching the condition on the id given as $1.
+func (s *Storage) txGetCVs(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	condition string,
+	order string,
+	id string,
+	limit int,
+) (_ []*CV, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT `+cvColumns+`
+			FROM cv
+			WHERE `+condition+`
+			ORDER BY `+order+`
+			LIMIT $2`,
+		id,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	cvs := make([]*CV, 0)
+
+	for rows.Next() {
+		var cv CV
+		if err := rows.Scan(
+			&cv.ID,
+			&cv.PersonaID,
+			&cv.Position,
+			&cv.WorkMonthsExperience,
+			&cv.NormalizedMinSalary,
+			&cv.NormalizedMaxSalary,
+			pq.Array(&cv.JobKinds),
+			pq.Array(&cv.JobTypes),
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		cvs = append(cvs, &cv)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return cvs, nil
+}
+
+func (s *Storage) TxGetVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*Vacancy, error) {
+	vs, err := s.txGetVacancies(ctx, tx, `v.id = $1`, `v.id`, vacancyID, 1)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(vs) == 0 {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+
+	return vs[0], nil
+}
+
+// TxGetCVCandidateVacancies returns up to limit newest live vacancies which may match the cv, see
+// matchCandidateCondition.
+func (s *Storage) TxGetCVCandidateVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	cvID string,
+	limit int,
+) ([]*Vacancy, error) {
+	vs, err := s.txGetVacancies(
+		ctx,
+		tx,
+		liveVacancyCondition+` AND v.id IN (
+			SELECT v.id FROM cv, vacancy AS v
+			WHERE cv.id = $1 AND `+matchCandidateCondition+`
+		)`,
+		`v.listed_at DESC, v.position DESC`,
+		cvID,
+		limit,
+	)
+
+	return vs, errors.WithStack(err)
+}
+
+// txGetVacancies returns up to limit vacancies aliased as v matching the condition on the id given as $1.
+func (s *Storage) txGetVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	condition string,
+	order string,
+	id string,
+	limit int,
+) (_ []*Vacancy, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT `+vacancyColumns+`
+			FROM vacancy AS v
+			WHERE `+condition+`
+			ORDER BY `+order+`
+			LIMIT $2`,
+		id,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	vs := make([]*Vacancy, 0)
+
+	for rows.Next() {
+		var v Vacancy
+		if err := rows.Scan(
+			&v.ID,
+			&v.Title,
+			&v.Type,
+			&v.WorkMonthsExperience,
+			&v.NormalizedMinSalary,
+			&v.NormalizedMaxSalary,
+			pq.Array(&v.Categories),
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		vs = append(vs, &v)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return vs, nil
+}
+
+/**
+Matches part start
+*/
+
+// TxReplaceCVMatches replaces the matches of the cv with the ones given, computed at computedAt.
+func (s *Storage) TxReplaceCVMatches(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	cvID string,
+	matches []*Match,
+	computedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(ctx, `DELETE FROM cv_vacancy_match WHERE cv_id = $1`, cvID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(s.txPutMatches(ctx, tx, matches, computedAt))
+}
+
+// TxReplaceVacancyMatches replaces the matches of the vacancy with the ones given, computed at computedAt.
+func (s *Storage) TxReplaceVacancyMatches(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	matches []*Match,
+	computedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(ctx, `DELETE FROM cv_vacancy_match WHERE vacancy_id = $1`, vacancyID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(s.txPutMatches(ctx, tx, matches, computedAt))
+}
+
+func (s *Storage) txPutMatches(ctx context.Context, tx pkgtx.Tx, matches []*Match, computedAt time.Time) error {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	c := postgresql.FromTx(tx)
+
+	var (
+		cvIDs            = make([]string, len(matches))
+		vacancyIDs       = make([]string, len(matches))
+		scores           = make([]float64, len(matches))
+		jobKindsScores   = make([]float64, len(matches))
+		jobTypeScores    = make([]float64, len(matches))
+		experienceScores = make([]float64, len(matches))
+		salaryScores     = make([]float64, len(matches))
+		positionScores   = make([]float64, len(matches))
+	)
+
+	for idx, m := range matches {
+		cvIDs[idx] = m.CVID
+		vacancyIDs[idx] = m.VacancyID
+		scores[idx] = m.Score
+		jobKindsScores[idx] = m.JobKindsScore
+		jobTypeScores[idx] = m.JobTypeScore
+		experienceScores[idx] = m.ExperienceScore
+		salaryScores[idx] = m.SalaryScore
+		positionScores[idx] = m.PositionScore
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO cv_vacancy_match (cv_id, vacancy_id, score, job_kinds_score, job_type_score, experience_score,
+				salary_score, position_score, computed_at)
+			SELECT m.cv_id, m.vacancy_id, m.score, m.job_kinds_score, m.job_type_score, m.experience_score,
+				m.salary_score, m.position_score, $9
+			FROM unnest($1::uuid[], $2::uuid[], $3::numeric[], $4::numeric[], $5::numeric[], $6::numeric[],
+				$7::numeric[], $8::numeric[])
+				AS m(cv_id, vacancy_id, score, job_kinds_score, job_type_score, experience_score, salary_score,
+					position_score)
+			ON CONFLICT (cv_id, vacancy_id) DO UPDATE SET
+				score = EXCLUDED.score,
+				job_kinds_score = EXCLUDED.job_kinds_score,
+				job_type_score = EXCLUDED.job_type_score,
+				experience_score = EXCLUDED.experience_score,
+				salary_score = EXCLUDED.salary_score,
+				position_score = EXCLUDED.position_score,
+				computed_at = EXCLUDED.computed_at`,
+		pq.Array(cvIDs),
+		pq.Array(vacancyIDs),
+		pq.Array(scores),
+		pq.Array(jobKindsScores),
+		pq.Array(jobTypeScores),
+		pq.Array(experienceScores),
+		pq.Array(salaryScores),
+		pq.Array(positionScores),
+		computedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+const matchColumns = `m.cv_id, m.vacancy_id, m.score, m.job_kinds_score, m.job_type_score, m.experience_score,
+	m.salary_score, m.position_score, m.computed_at`
+
+// TxGetCVMatches returns up to limit matches of the cv with the live vacancies, the best first.
+func (s *Storage) TxGetCVMatches(ctx context.Context, tx pkgtx.Tx, cvID string, limit int) (_ []*Match, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT `+matchColumns+`
+			FROM cv_vacancy_match AS m
+			INNER JOIN vacancy AS v ON v.id = m.vacancy_id
+			WHERE m.cv_id = $1 AND `+liveVacancyCondition+`
+			ORDER BY m.score DESC, v.listed_at DESC, v.position DESC
+			LIMIT $2`,
+		cvID,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	ms := make([]*Match, 0)
+
+	for rows.Next() {
+		var m Match
+		if err := rows.Scan(matchDest(&m)...); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		ms = append(ms, &m)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return ms, nil
+}
+
+// TxGetVacancyCandidates returns up to limit matches of the vacancy with the cvs, the best first.
+func (s *Storage) TxGetVacancyCandidates(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	limit int,
+) (_ []*Candidate, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT `+matchColumns+`, cv.persona_id, coalesce(cv.position, ''), coalesce(cv.work_months_experience, 0)
+			FROM cv_vacancy_match AS m
+			INNER JOIN cv ON cv.id = m.cv_id
+			WHERE m.vacancy_id = $1
+			ORDER BY m.score DESC, cv.updated_at DESC, cv.id
+			LIMIT $2`,
+		vacancyID,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	cs := make([]*Candidate, 0)
+
+	for rows.Next() {
+		var c Candidate
+		if err := rows.Scan(append(matchDest(&c.Match), &c.PersonaID, &c.Position, &c.WorkMonthsExperience)...); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		cs = append(cs, &c)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return cs, nil
+}
+
+// matchDest returns the scan destinations of matchColumns.
+func matchDest(m *Match) []interface{} {
+	return []interface{}{
+		&m.CVID,
+		&m.VacancyID,
+		&m.Score,
+		&m.JobKindsScore,
+		&m.JobTypeScore,
+		&m.ExperienceScore,
+		&m.SalaryScore,
+		&m.PositionScore,
+		&m.ComputedAt,
+	}
+}
+
+/**
+Matches part end
+*/
@@ -0,0 +1,519 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/spf13/pflag"
+
+	"personaapp/internal/controllers/notification/storage"
+	"personaapp/pkg/push"
+	pkgtx "personaapp/pkg/tx"
+)
+
+type Platform string
+
+const (
+	PlatformAndroid Platform = "android"
+	PlatformIOS     Platform = "ios"
+)
+
+func init() {
+	govalidator.CustomTypeTagMap.Set("device_platform", func(i interface{}, o interface{}) bool {
+		if p, ok := i.(Platform); ok {
+			switch p {
+			case PlatformAndroid, PlatformIOS:
+				return true
+			}
+		}
+
+		return false
+	})
+
+	govalidator.CustomTypeTagMap.Set("event_type", func(i interface{}, o interface{}) bool {
+		if et, ok := i.(EventType); ok {
+			_, err := toStorageEventType(et)
+			return err == nil
+		}
+
+		return false
+	})
+}
+
+var (
+	ErrDeviceNotFound               = errors.New("device not found")
+	ErrInvalidDevice                = errors.New("invalid device struct")
+	ErrInvalidDevicePlatform        = errors.New("invalid device platform")
+	ErrInvalidPushToken             = errors.New("invalid push token")
+	ErrInvalidNotification          = errors.New("invalid notification struct")
+	ErrInvalidNotificationTitle     = errors.New("invalid notification title")
+	ErrInvalidNotificationBody      = errors.New("invalid notification body")
+	ErrInvalidNotificationEventType = errors.New("invalid notification event type")
+)
+
+type Config struct {
+	DeliveryInterval    time.Duration
+	DeliveryBatchSize   int
+	DeliveryLease       time.Duration
+	DeliveryMaxAttempts int
+	DigestInterval      time.Duration
+	DigestHour          int
+}
+
+func (c *Config) Flags(name string) *pflag.FlagSet {
+	f := pflag.NewFlagSet(name, pflag.PanicOnError)
+
+	f.DurationVar(&c.DeliveryInterval, "notification_delivery_interval", 5*time.Second, "Notifications poll interval")
+	f.IntVar(&c.DeliveryBatchSize, "notification_delivery_batch_size", 100, "Notifications delivered per poll")
+	f.DurationVar(&c.DeliveryLease, "notification_delivery_lease", time.Minute, "Claimed notification lease")
+	f.IntVar(&c.DeliveryMaxAttempts, "notification_delivery_max_attempts", 5, "Delivery attempts per notification")
+	f.DurationVar(&c.DigestInterval, "notification_digest_interval", 5*time.Minute, "Due digests polling interval")
+	f.IntVar(&c.DigestHour, "notification_digest_hour", 9, "Local hour of the account time zone digests are sent at")
+
+	return f
+}
+
+// EmailSender delivers an html email, see mail.Sender.
+type EmailSender interface {
+	SendHTMLMail(dest []string, subject, bodyMessage string) error
+}
+
+// PushSender delivers a message to a single device push token.
+// Senders must return push.ErrInvalidToken when the provider reports the token as no longer valid.
+type PushSender interface {
+	Send(ctx context.Context, token string, msg *push.Message) error
+}
+
+type Storage interface {
+	TxPutDevice(ctx context.Context, tx pkgtx.Tx, device *storage.Device) (string, error)
+	TxDeleteDevice(ctx context.Context, tx pkgtx.Tx, accountID, pushToken string) error
+	TxDeleteDevicesByPushTokens(ctx context.Context, tx pkgtx.Tx, pushTokens []string) error
+	TxGetDevicesByAccountIDs(ctx context.Context, tx pkgtx.Tx, accountIDs []string) ([]*storage.Device, error)
+
+	TxPutNotification(ctx context.Context, tx pkgtx.Tx, n *storage.Notification) error
+	TxClaimPendingNotifications(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		now time.Time,
+		leaseUntil time.Time,
+		maxAttempts int,
+		limit int,
+	) ([]*storage.Notification, error)
+	TxMarkNotificationsPushed(ctx context.Context, tx pkgtx.Tx, notificationIDs []string, pushedAt time.Time) error
+
+	TxGetPreferences(ctx context.Context, tx pkgtx.Tx, accountID string) (*storage.Preferences, error)
+	TxLockPreferences(ctx context.Context, tx pkgtx.Tx, accountID string) (*storage.Preferences, error)
+	TxPutPreferences(ctx context.Context, tx pkgtx.Tx, p *storage.Preferences) error
+	TxSetLastDigestAt(ctx context.Context, tx pkgtx.Tx, accountID string, lastDigestAt time.Time) error
+	TxGetChannelSettings(ctx context.Context, tx pkgtx.Tx, accountID string) ([]*storage.ChannelSetting, error)
+	TxPutChannelSettings(ctx context.Context, tx pkgtx.Tx, accountID string, settings []*storage.ChannelSetting) error
+	TxDeleteChannelSettings(ctx context.Context, tx pkgtx.Tx, accountID string) error
+
+	TxPutEmail(ctx context.Context, tx pkgtx.Tx, e *storage.Email) error
+	TxClaimPendingEmails(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		now time.Time,
+		leaseUntil time.Time,
+		maxAttempts int,
+		limit int,
+	) ([]*storage.Email, error)
+	TxGetPendingDigestEmails(ctx context.Context, tx pkgtx.Tx, accountID string) ([]*storage.Email, error)
+	TxGetAccountsWithPendingDigest(ctx context.Context, tx pkgtx.Tx) ([]string, error)
+	TxMarkEmailsSent(ctx context.Context, tx pkgtx.Tx, emailIDs []string, sentAt time.Time) error
+	TxGetAccountEmails(ctx context.Context, tx pkgtx.Tx, accountIDs []string) (map[string]string, error)
+
+	BeginTx(ctx context.Context) (pkgtx.Tx, error)
+	NoTx() pkgtx.Tx
+}
+
+type Controller struct {
+	cfg     *Config
+	s       Storage
+	senders map[Platform]PushSender
+	mailer  EmailSender
+}
+
+// New creates a notification controller. Devices of platforms without a sender are skipped on delivery,
+// the email channel is disabled when mailer is nil.
+func New(cfg *Config, s Storage, senders map[Platform]PushSender, mailer EmailSender) *Controller {
+	return &Controller{cfg: cfg, s: s, senders: senders, mailer: mailer}
+}
+
+type DeviceID string
+
+type Device struct {
+	Platform  Platform `valid:"device_platform,required"`
+	PushToken string   `valid:"stringlength(1|4096),required"`
+}
+
+func (d *Device) validate() error {
+	if d == nil {
+		return errors.WithStack(ErrInvalidDevice)
+	}
+
+	var fieldErrors = []struct {
+		Field        string
+		DefaultError error
+	}{
+		{Field: "Platform", DefaultError: ErrInvalidDevicePlatform},
+		{Field: "PushToken", DefaultError: ErrInvalidPushToken},
+	}
+
+	if valid, err := govalidator.ValidateStruct(d); !valid {
+		for _, fe := range fieldErrors {
+			if govalidator.ErrorByField(err, fe.Field) != "" {
+				return errors.WithStack(fe.DefaultError)
+			}
+		}
+
+		return errors.New("device struct is filled with some invalid data")
+	}
+
+	return nil
+}
+
+type Notification struct {
+	EventType EventType `valid:"event_type,required"`
+	Title     string    `valid:"stringlength(1|255),required"`
+	Body      string    `valid:"required"`
+	Data      map[string]string
+}
+
+func (n *Notification) validate() error {
+	if n == nil {
+		return errors.WithStack(ErrInvalidNotification)
+	}
+
+	var fieldErrors = []struct {
+		Field        string
+		DefaultError error
+	}{
+		{Field: "EventType", DefaultError: ErrInvalidNotificationEventType},
+		{Field: "Title", DefaultError: ErrInvalidNotificationTitle},
+		{Field: "Body", DefaultError: ErrInvalidNotificationBody},
+	}
+
+	if valid, err := govalidator.ValidateStruct(n); !valid {
+		for _, fe := range fieldErrors {
+			if govalidator.ErrorByField(err, fe.Field) != "" {
+				return errors.WithStack(fe.DefaultError)
+			}
+		}
+
+		return errors.New("notification struct is filled with some invalid data")
+	}
+
+	return nil
+}
+
+func toStoragePlatform(p Platform) (storage.Platform, error) {
+	switch p {
+	case PlatformAndroid:
+		return storage.PlatformAndroid, nil
+	case PlatformIOS:
+		return storage.PlatformIOS, nil
+	default:
+		return "", errors.New("invalid device platform")
+	}
+}
+
+func fromStoragePlatform(p storage.Platform) (Platform, error) {
+	switch p {
+	case storage.PlatformAndroid:
+		return PlatformAndroid, nil
+	case storage.PlatformIOS:
+		return PlatformIOS, nil
+	default:
+		return "", errors.New("invalid device platform")
+	}
+}
+
+// RegisterDevice binds the push token to the account. Registering a known token again
+// refreshes it and moves it to the account if the device changed hands.
+func (c *Controller) RegisterDevice(ctx context.Context, accountID string, device *Device) (DeviceID, error) {
+	if err := device.validate(); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	platform, err := toStoragePlatform(device.Platform)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	now := time.Now()
+
+	id, err := c.s.TxPutDevice(ctx, c.s.NoTx(), &storage.Device{
+		ID:        uuid.NewV4().String(),
+		AccountID: accountID,
+		Platform:  platform,
+		PushToken: device.PushToken,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return DeviceID(id), nil
+}
+
+func (c *Controller) UnregisterDevice(ctx context.Context, accountID string, pushToken string) error {
+	switch err := c.s.TxDeleteDevice(ctx, c.s.NoTx(), accountID, pushToken); errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrDeviceNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Notify enqueues the notification for delivery over the channels the account has enabled for its event type.
+func (c *Controller) Notify(ctx context.Context, accountID string, n *Notification) error {
+	return c.TxNotify(ctx, c.s.NoTx(), accountID, n)
+}
+
+// TxNotify is Notify for callers which have to enqueue the notification atomically with their own changes.
+// Push notifications are postponed until the end of quiet hours, digest eligible emails wait for the next digest.
+func (c *Controller) TxNotify(ctx context.Context, tx pkgtx.Tx, accountID string, n *Notification) error {
+	if err := n.validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	eventType, err := toStorageEventType(n.EventType)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	prefs, settings, err := c.txGetPreferences(ctx, tx, accountID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	now := time.Now()
+
+	if isChannelEnabled(settings, eventType, storage.ChannelPush) {
+		data := n.Data
+		if data == nil {
+			data = map[string]string{}
+		}
+
+		if err := c.s.TxPutNotification(ctx, tx, &storage.Notification{
+			ID:           uuid.NewV4().String(),
+			AccountID:    accountID,
+			Title:        n.Title,
+			Body:         n.Body,
+			Data:         data,
+			DeliverAfter: quietHoursEnd(now, prefs),
+			CreatedAt:    now,
+		}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if c.mailer != nil && isChannelEnabled(settings, eventType, storage.ChannelEmail) {
+		if err := c.s.TxPutEmail(ctx, tx, &storage.Email{
+			ID:        uuid.NewV4().String(),
+			AccountID: accountID,
+			EventType: eventType,
+			Subject:   n.Title,
+			Body:      n.Body,
+			Digest:    prefs.DigestMode != storage.DigestModeOff && isDigestEligible(eventType),
+			CreatedAt: now,
+		}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// TxNotifyVacancyAlert notifies the account about the vacancy matching its saved search.
+func (c *Controller) TxNotifyVacancyAlert(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	alertID string,
+	vacancyID string,
+	vacancyTitle string,
+) error {
+	return c.TxNotify(ctx, tx, accountID, &Notification{
+		EventType: EventTypeVacancyAlert,
+		Title:     "New vacancy matches your search",
+		Body:      vacancyTitle,
+		Data: map[string]string{
+			"alert_id":   alertID,
+			"vacancy_id": vacancyID,
+		},
+	})
+}
+
+// TxNotifyVacancyModeration notifies the company about the moderator approving or rejecting its vacancy.
+func (c *Controller) TxNotifyVacancyModeration(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	vacancyID string,
+	vacancyTitle string,
+	decision string,
+	reasons []string,
+	comment string,
+) error {
+	title := "Your vacancy is approved"
+	if decision != "approved" {
+		title = "Your vacancy is rejected"
+	}
+
+	return c.TxNotify(ctx, tx, accountID, &Notification{
+		EventType: EventTypeCompanyUpdate,
+		Title:     title,
+		Body:      vacancyTitle,
+		Data: map[string]string{
+			"vacancy_id": vacancyID,
+			"decision":   decision,
+			"reasons":    strings.Join(reasons, ","),
+			"comment":    comment,
+		},
+	})
+}
+
+// TxNotifyApplicationUpdate notifies the candidate about a change of the application made by the company.
+func (c *Controller) TxNotifyApplicationUpdate(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	applicationID string,
+	vacancyID string,
+	title string,
+	body string,
+) error {
+	return c.TxNotify(ctx, tx, accountID, &Notification{
+		EventType: EventTypeApplicationUpdate,
+		Title:     title,
+		Body:      body,
+		Data: map[string]string{
+			"application_id": applicationID,
+			"vacancy_id":     vacancyID,
+		},
+	})
+}
+
+// DeliverPending pushes a batch of pending notifications and returns the number of notifications delivered.
+// Tokens rejected by the provider are removed. A notification which failed on some device for any other reason
+// is retried for all its devices once its lease expires, until the attempts limit is reached.
+func (c *Controller) DeliverPending(ctx context.Context) (int, error) {
+	var notifications []*storage.Notification
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		now := time.Now()
+
+		ns, err := c.s.TxClaimPendingNotifications(
+			ctx,
+			tx,
+			now,
+			now.Add(c.cfg.DeliveryLease),
+			c.cfg.DeliveryMaxAttempts,
+			c.cfg.DeliveryBatchSize,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		notifications = ns
+
+		return nil
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if len(notifications) == 0 {
+		return 0, nil
+	}
+
+	accountIDs := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		accountIDs = append(accountIDs, n.AccountID)
+	}
+
+	devices, err := c.s.TxGetDevicesByAccountIDs(ctx, c.s.NoTx(), accountIDs)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	devicesByAccount := make(map[string][]*storage.Device)
+	for _, d := range devices {
+		devicesByAccount[d.AccountID] = append(devicesByAccount[d.AccountID], d)
+	}
+
+	var (
+		sendErrs      error
+		pushedIDs     = make([]string, 0, len(notifications))
+		invalidTokens = make([]string, 0)
+		invalidSet    = make(map[string]struct{})
+	)
+
+	for _, n := range notifications {
+		msg := &push.Message{Title: n.Title, Body: n.Body, Data: n.Data}
+		failed := false
+
+		for _, d := range devicesByAccount[n.AccountID] {
+			if _, ok := invalidSet[d.PushToken]; ok {
+				continue
+			}
+
+			platform, err := fromStoragePlatform(d.Platform)
+			if err != nil {
+				return 0, errors.WithStack(err)
+			}
+
+			sender, ok := c.senders[platform]
+			if !ok {
+				continue
+			}
+
+			switch err := sender.Send(ctx, d.PushToken, msg); errors.Cause(err) {
+			case nil:
+			case push.ErrInvalidToken:
+				invalidSet[d.PushToken] = struct{}{}
+				invalidTokens = append(invalidTokens, d.PushToken)
+			default:
+				failed = true
+				sendErrs = errors.CombineErrors(sendErrs, errors.Wrapf(err, "failed to push notification=%s", n.ID))
+			}
+		}
+
+		if !failed {
+			pushedIDs = append(pushedIDs, n.ID)
+		}
+	}
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		if len(invalidTokens) > 0 {
+			if err := c.s.TxDeleteDevicesByPushTokens(ctx, tx, invalidTokens); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		if len(pushedIDs) > 0 {
+			if err := c.s.TxMarkNotificationsPushed(ctx, tx, pushedIDs, time.Now()); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if sendErrs != nil {
+		return len(pushedIDs), errors.WithStack(sendErrs)
+	}
+
+	return len(pushedIDs), nil
+}
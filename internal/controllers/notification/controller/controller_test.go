@@ -0,0 +1,398 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	sqlMigrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/require"
+
+	authController "personaapp/internal/controllers/auth/controller"
+	"personaapp/internal/controllers/notification/controller"
+	"personaapp/internal/controllers/notification/storage"
+	"personaapp/internal/testutils"
+	"personaapp/pkg/push"
+)
+
+var authCfg = &authController.Config{
+	TokenExpiration:   5 * time.Minute,
+	PrivateSigningKey: "signkey",
+	TokenValidityGap:  15 * time.Second,
+}
+
+var notificationCfg = &controller.Config{
+	DeliveryInterval:    time.Second,
+	DeliveryBatchSize:   100,
+	DeliveryLease:       time.Minute,
+	DeliveryMaxAttempts: 5,
+	DigestInterval:      time.Minute,
+	DigestHour:          9,
+}
+
+type mailRecorder struct {
+	sent []string
+}
+
+func (r *mailRecorder) SendHTMLMail(dest []string, subject, bodyMessage string) error {
+	r.sent = append(r.sent, subject)
+	return nil
+}
+
+func initStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
+
+	return storage.New(pg), pg.Close
+}
+
+func cleanup(t *testing.T) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Down))
+}
+
+func registerAccount(t *testing.T, ac *authController.Controller, phone string) string {
+	token, err := ac.Register(context.TODO(), &authController.RegisterData{
+		Email:    phone[1:] + "@gmail.com",
+		Phone:    phone,
+		Account:  authController.AccountTypePersona,
+		Password: "Password1488",
+	})
+	require.NoError(t, err)
+
+	claims, err := ac.GetAuthClaims(context.TODO(), token.Token)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.AccountID)
+
+	return claims.AccountID
+}
+
+func TestController_Devices(t *testing.T) {
+	s, closer := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	c := controller.New(notificationCfg, s, nil, nil)
+	ac := authController.New(authCfg, as, nil)
+
+	accountID := registerAccount(t, ac, "+380503040001")
+	otherAccountID := registerAccount(t, ac, "+380503040002")
+
+	t.Run("register device", func(t *testing.T) {
+		id, err := c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+			Platform:  controller.PlatformAndroid,
+			PushToken: "android-token-1",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+	})
+
+	t.Run("register same token again", func(t *testing.T) {
+		id, err := c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+			Platform:  controller.PlatformIOS,
+			PushToken: "shared-token",
+		})
+		require.NoError(t, err)
+
+		movedID, err := c.RegisterDevice(context.TODO(), otherAccountID, &controller.Device{
+			Platform:  controller.PlatformIOS,
+			PushToken: "shared-token",
+		})
+		require.NoError(t, err)
+		require.Equal(t, id, movedID)
+
+		err = c.UnregisterDevice(context.TODO(), accountID, "shared-token")
+		require.EqualError(t, errors.Cause(err), controller.ErrDeviceNotFound.Error())
+
+		require.NoError(t, c.UnregisterDevice(context.TODO(), otherAccountID, "shared-token"))
+	})
+
+	t.Run("register invalid device", func(t *testing.T) {
+		_, err := c.RegisterDevice(context.TODO(), accountID, nil)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidDevice.Error())
+
+		_, err = c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+			Platform:  "windows",
+			PushToken: "token",
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidDevicePlatform.Error())
+
+		_, err = c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+			Platform:  controller.PlatformAndroid,
+			PushToken: "",
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidPushToken.Error())
+	})
+
+	t.Run("unregister unknown device", func(t *testing.T) {
+		err := c.UnregisterDevice(context.TODO(), accountID, "unknown-token")
+		require.EqualError(t, errors.Cause(err), controller.ErrDeviceNotFound.Error())
+	})
+}
+
+func TestController_DeliverPending(t *testing.T) {
+	s, closer := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	android := push.NewRecorder()
+	ios := push.NewRecorder()
+
+	c := controller.New(notificationCfg, s, map[controller.Platform]controller.PushSender{
+		controller.PlatformAndroid: android,
+		controller.PlatformIOS:     ios,
+	}, nil)
+	ac := authController.New(authCfg, as, nil)
+
+	accountID := registerAccount(t, ac, "+380503040003")
+
+	_, err := c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+		Platform:  controller.PlatformAndroid,
+		PushToken: "android-token",
+	})
+	require.NoError(t, err)
+
+	_, err = c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+		Platform:  controller.PlatformIOS,
+		PushToken: "ios-token",
+	})
+	require.NoError(t, err)
+
+	t.Run("deliver to all devices", func(t *testing.T) {
+		err := c.Notify(context.TODO(), accountID, &controller.Notification{
+			EventType: controller.EventTypeVacancyAlert,
+			Title:     "New vacancy",
+			Body:      "A vacancy matching your search was published",
+			Data:      map[string]string{"vacancy_id": "1"},
+		})
+		require.NoError(t, err)
+
+		delivered, err := c.DeliverPending(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, delivered)
+
+		require.Len(t, android.Sent(), 1)
+		require.Equal(t, "android-token", android.Sent()[0].Token)
+		require.Equal(t, "New vacancy", android.Sent()[0].Message.Title)
+		require.Equal(t, "1", android.Sent()[0].Message.Data["vacancy_id"])
+
+		require.Len(t, ios.Sent(), 1)
+		require.Equal(t, "ios-token", ios.Sent()[0].Token)
+
+		delivered, err = c.DeliverPending(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 0, delivered)
+	})
+
+	t.Run("drop invalid tokens", func(t *testing.T) {
+		ios.Invalidate("ios-token")
+
+		err := c.Notify(context.TODO(), accountID, &controller.Notification{
+			EventType: controller.EventTypeSystem,
+			Title:     "Title",
+			Body:      "Body",
+		})
+		require.NoError(t, err)
+
+		delivered, err := c.DeliverPending(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, delivered)
+		require.Len(t, android.Sent(), 2)
+
+		err = c.UnregisterDevice(context.TODO(), accountID, "ios-token")
+		require.EqualError(t, errors.Cause(err), controller.ErrDeviceNotFound.Error())
+	})
+
+	t.Run("notify with invalid notification", func(t *testing.T) {
+		err := c.Notify(context.TODO(), accountID, &controller.Notification{Title: "Title", Body: "Body"})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidNotificationEventType.Error())
+
+		err = c.Notify(context.TODO(), accountID, &controller.Notification{
+			EventType: controller.EventTypeSystem,
+			Body:      "Body",
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidNotificationTitle.Error())
+
+		err = c.Notify(context.TODO(), accountID, &controller.Notification{
+			EventType: controller.EventTypeSystem,
+			Title:     "Title",
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidNotificationBody.Error())
+	})
+}
+
+func TestController_Preferences(t *testing.T) {
+	s, closer := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		if err := authCloser(); err != nil {
+			t.Error(err)
+		}
+		cleanup(t)
+	}()
+
+	android := push.NewRecorder()
+	mailer := &mailRecorder{}
+
+	c := controller.New(notificationCfg, s, map[controller.Platform]controller.PushSender{
+		controller.PlatformAndroid: android,
+	}, mailer)
+	ac := authController.New(authCfg, as, nil)
+
+	accountID := registerAccount(t, ac, "+380503040004")
+
+	_, err := c.RegisterDevice(context.TODO(), accountID, &controller.Device{
+		Platform:  controller.PlatformAndroid,
+		PushToken: "preferences-token",
+	})
+	require.NoError(t, err)
+
+	t.Run("default preferences", func(t *testing.T) {
+		prefs, err := c.GetPreferences(context.TODO(), accountID)
+		require.NoError(t, err)
+		require.Equal(t, "UTC", prefs.Timezone)
+		require.Equal(t, controller.DigestModeOff, prefs.DigestMode)
+		require.Nil(t, prefs.QuietHours)
+		require.Len(t, prefs.Toggles, 8)
+
+		for _, toggle := range prefs.Toggles {
+			require.True(t, toggle.Enabled)
+		}
+	})
+
+	t.Run("update preferences", func(t *testing.T) {
+		err := c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Toggles: []*controller.ChannelToggle{
+				{EventType: controller.EventTypeCompanyUpdate, Channel: controller.ChannelPush, Enabled: false},
+			},
+			Timezone:   "Europe/Kiev",
+			QuietHours: &controller.QuietHours{StartMinute: 22 * 60, EndMinute: 8 * 60},
+			DigestMode: controller.DigestModeWeekly,
+		})
+		require.NoError(t, err)
+
+		prefs, err := c.GetPreferences(context.TODO(), accountID)
+		require.NoError(t, err)
+		require.Equal(t, "Europe/Kiev", prefs.Timezone)
+		require.Equal(t, controller.DigestModeWeekly, prefs.DigestMode)
+		require.Equal(t, &controller.QuietHours{StartMinute: 22 * 60, EndMinute: 8 * 60}, prefs.QuietHours)
+
+		for _, toggle := range prefs.Toggles {
+			disabled := toggle.EventType == controller.EventTypeCompanyUpdate && toggle.Channel == controller.ChannelPush
+			require.Equal(t, !disabled, toggle.Enabled)
+		}
+	})
+
+	t.Run("update invalid preferences", func(t *testing.T) {
+		err := c.UpdatePreferences(context.TODO(), accountID, nil)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidPreferences.Error())
+
+		err = c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Timezone:   "Mars/Olympus",
+			DigestMode: controller.DigestModeOff,
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidTimezone.Error())
+
+		err = c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Timezone:   "UTC",
+			QuietHours: &controller.QuietHours{StartMinute: 60, EndMinute: 60},
+			DigestMode: controller.DigestModeOff,
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidQuietHours.Error())
+
+		err = c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Timezone:   "UTC",
+			DigestMode: "hourly",
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidDigestMode.Error())
+
+		err = c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Toggles: []*controller.ChannelToggle{
+				{EventType: controller.EventTypeSystem, Channel: controller.ChannelPush},
+				{EventType: controller.EventTypeSystem, Channel: controller.ChannelPush},
+			},
+			Timezone:   "UTC",
+			DigestMode: controller.DigestModeOff,
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidChannelToggle.Error())
+	})
+
+	t.Run("disabled channel and digest", func(t *testing.T) {
+		err := c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Toggles: []*controller.ChannelToggle{
+				{EventType: controller.EventTypeCompanyUpdate, Channel: controller.ChannelPush, Enabled: false},
+			},
+			Timezone:   "UTC",
+			DigestMode: controller.DigestModeDaily,
+		})
+		require.NoError(t, err)
+
+		err = c.Notify(context.TODO(), accountID, &controller.Notification{
+			EventType: controller.EventTypeCompanyUpdate,
+			Title:     "Company update",
+			Body:      "Company has published a new story",
+		})
+		require.NoError(t, err)
+
+		delivered, err := c.DeliverPending(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 0, delivered)
+		require.Len(t, android.Sent(), 0)
+
+		// digest eligible emails wait for the digest
+		sent, err := c.DeliverPendingEmails(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 0, sent)
+
+		err = c.Notify(context.TODO(), accountID, &controller.Notification{
+			EventType: controller.EventTypeSystem,
+			Title:     "Password changed",
+			Body:      "Your password was changed",
+		})
+		require.NoError(t, err)
+
+		sent, err = c.DeliverPendingEmails(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, sent)
+		require.Equal(t, []string{"Password changed"}, mailer.sent)
+
+		// switching digests off flushes the pending digest
+		err = c.UpdatePreferences(context.TODO(), accountID, &controller.Preferences{
+			Timezone:   "UTC",
+			DigestMode: controller.DigestModeOff,
+		})
+		require.NoError(t, err)
+
+		digests, err := c.SendDueDigests(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, digests)
+		require.Len(t, mailer.sent, 2)
+
+		digests, err = c.SendDueDigests(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 0, digests)
+	})
+}
@@ -0,0 +1,609 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/notification/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+type EventType string
+
+const (
+	EventTypeSystem            EventType = "system"
+	EventTypeVacancyAlert      EventType = "vacancy_alert"
+	EventTypeApplicationUpdate EventType = "application_update"
+	EventTypeCompanyUpdate     EventType = "company_update"
+)
+
+var eventTypes = []EventType{
+	EventTypeSystem,
+	EventTypeVacancyAlert,
+	EventTypeApplicationUpdate,
+	EventTypeCompanyUpdate,
+}
+
+// Channel is a way a notification reaches the account.
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelPush  Channel = "push"
+)
+
+var channels = []Channel{ChannelEmail, ChannelPush}
+
+type DigestMode string
+
+const (
+	DigestModeOff    DigestMode = "off"
+	DigestModeDaily  DigestMode = "daily"
+	DigestModeWeekly DigestMode = "weekly"
+)
+
+const (
+	defaultTimezone = "UTC"
+	minutesInDay    = 24 * 60
+)
+
+var (
+	ErrInvalidPreferences   = errors.New("invalid notification preferences")
+	ErrInvalidChannelToggle = errors.New("invalid channel toggle")
+	ErrInvalidTimezone      = errors.New("invalid timezone")
+	ErrInvalidQuietHours    = errors.New("invalid quiet hours")
+	ErrInvalidDigestMode    = errors.New("invalid digest mode")
+)
+
+type ChannelToggle struct {
+	EventType EventType
+	Channel   Channel
+	Enabled   bool
+}
+
+// QuietHours are minutes from the local midnight. Start greater than end spans midnight.
+type QuietHours struct {
+	StartMinute int32
+	EndMinute   int32
+}
+
+type Preferences struct {
+	Toggles    []*ChannelToggle
+	Timezone   string
+	QuietHours *QuietHours
+	DigestMode DigestMode
+}
+
+func (p *Preferences) validate() error {
+	if p == nil {
+		return errors.WithStack(ErrInvalidPreferences)
+	}
+
+	seen := make(map[ChannelToggle]struct{}, len(p.Toggles))
+
+	for _, t := range p.Toggles {
+		if t == nil {
+			return errors.WithStack(ErrInvalidChannelToggle)
+		}
+
+		if _, err := toStorageEventType(t.EventType); err != nil {
+			return errors.WithStack(ErrInvalidChannelToggle)
+		}
+
+		if _, err := toStorageChannel(t.Channel); err != nil {
+			return errors.WithStack(ErrInvalidChannelToggle)
+		}
+
+		key := ChannelToggle{EventType: t.EventType, Channel: t.Channel}
+		if _, ok := seen[key]; ok {
+			return errors.WithStack(ErrInvalidChannelToggle)
+		}
+		seen[key] = struct{}{}
+	}
+
+	if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "" {
+		return errors.WithStack(ErrInvalidTimezone)
+	}
+
+	if qh := p.QuietHours; qh != nil {
+		if qh.StartMinute < 0 || qh.StartMinute >= minutesInDay ||
+			qh.EndMinute < 0 || qh.EndMinute >= minutesInDay ||
+			qh.StartMinute == qh.EndMinute {
+			return errors.WithStack(ErrInvalidQuietHours)
+		}
+	}
+
+	if _, err := toStorageDigestMode(p.DigestMode); err != nil {
+		return errors.WithStack(ErrInvalidDigestMode)
+	}
+
+	return nil
+}
+
+func toStorageEventType(et EventType) (storage.EventType, error) {
+	switch et {
+	case EventTypeSystem:
+		return storage.EventTypeSystem, nil
+	case EventTypeVacancyAlert:
+		return storage.EventTypeVacancyAlert, nil
+	case EventTypeApplicationUpdate:
+		return storage.EventTypeApplicationUpdate, nil
+	case EventTypeCompanyUpdate:
+		return storage.EventTypeCompanyUpdate, nil
+	default:
+		return "", errors.New("invalid event type")
+	}
+}
+
+func toStorageChannel(ch Channel) (storage.Channel, error) {
+	switch ch {
+	case ChannelEmail:
+		return storage.ChannelEmail, nil
+	case ChannelPush:
+		return storage.ChannelPush, nil
+	default:
+		return "", errors.New("invalid channel")
+	}
+}
+
+func toStorageDigestMode(dm DigestMode) (storage.DigestMode, error) {
+	switch dm {
+	case DigestModeOff:
+		return storage.DigestModeOff, nil
+	case DigestModeDaily:
+		return storage.DigestModeDaily, nil
+	case DigestModeWeekly:
+		return storage.DigestModeWeekly, nil
+	default:
+		return "", errors.New("invalid digest mode")
+	}
+}
+
+func fromStorageDigestMode(dm storage.DigestMode) (DigestMode, error) {
+	switch dm {
+	case storage.DigestModeOff:
+		return DigestModeOff, nil
+	case storage.DigestModeDaily:
+		return DigestModeDaily, nil
+	case storage.DigestModeWeekly:
+		return DigestModeWeekly, nil
+	default:
+		return "", errors.New("invalid digest mode")
+	}
+}
+
+// isDigestEligible reports whether emails of the event type may wait for a digest.
+// Everything the account has to react on is sent right away.
+func isDigestEligible(et storage.EventType) bool {
+	switch et {
+	case storage.EventTypeVacancyAlert, storage.EventTypeCompanyUpdate:
+		return true
+	default:
+		return false
+	}
+}
+
+// isChannelEnabled treats channels without a stored setting as enabled.
+func isChannelEnabled(settings []*storage.ChannelSetting, et storage.EventType, ch storage.Channel) bool {
+	for _, s := range settings {
+		if s.EventType == et && s.Channel == ch {
+			return s.Enabled
+		}
+	}
+
+	return true
+}
+
+func defaultPreferences(accountID string) *storage.Preferences {
+	return &storage.Preferences{
+		AccountID:  accountID,
+		Timezone:   defaultTimezone,
+		DigestMode: storage.DigestModeOff,
+	}
+}
+
+func (c *Controller) txGetPreferences(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+) (*storage.Preferences, []*storage.ChannelSetting, error) {
+	prefs, err := c.s.TxGetPreferences(ctx, tx, accountID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		prefs = defaultPreferences(accountID)
+	default:
+		return nil, nil, errors.WithStack(err)
+	}
+
+	settings, err := c.s.TxGetChannelSettings(ctx, tx, accountID)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return prefs, settings, nil
+}
+
+// GetPreferences returns the preferences with a toggle for every event type and channel pair.
+func (c *Controller) GetPreferences(ctx context.Context, accountID string) (*Preferences, error) {
+	prefs, settings, err := c.txGetPreferences(ctx, c.s.NoTx(), accountID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	digestMode, err := fromStorageDigestMode(prefs.DigestMode)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	p := &Preferences{
+		Toggles:    make([]*ChannelToggle, 0, len(eventTypes)*len(channels)),
+		Timezone:   prefs.Timezone,
+		DigestMode: digestMode,
+	}
+
+	for _, et := range eventTypes {
+		for _, ch := range channels {
+			set, _ := toStorageEventType(et)
+			sch, _ := toStorageChannel(ch)
+
+			p.Toggles = append(p.Toggles, &ChannelToggle{
+				EventType: et,
+				Channel:   ch,
+				Enabled:   isChannelEnabled(settings, set, sch),
+			})
+		}
+	}
+
+	if prefs.QuietHoursStart != nil && prefs.QuietHoursEnd != nil {
+		p.QuietHours = &QuietHours{StartMinute: *prefs.QuietHoursStart, EndMinute: *prefs.QuietHoursEnd}
+	}
+
+	return p, nil
+}
+
+// UpdatePreferences replaces the preferences of the account. Toggles which are not listed are reset to enabled.
+func (c *Controller) UpdatePreferences(ctx context.Context, accountID string, p *Preferences) error {
+	if err := p.validate(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	digestMode, err := toStorageDigestMode(p.DigestMode)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	prefs := &storage.Preferences{
+		AccountID:  accountID,
+		Timezone:   p.Timezone,
+		DigestMode: digestMode,
+		UpdatedAt:  time.Now(),
+	}
+
+	if p.QuietHours != nil {
+		prefs.QuietHoursStart = &p.QuietHours.StartMinute
+		prefs.QuietHoursEnd = &p.QuietHours.EndMinute
+	}
+
+	settings := make([]*storage.ChannelSetting, 0, len(p.Toggles))
+	for _, t := range p.Toggles {
+		et, _ := toStorageEventType(t.EventType)
+		ch, _ := toStorageChannel(t.Channel)
+
+		settings = append(settings, &storage.ChannelSetting{EventType: et, Channel: ch, Enabled: t.Enabled})
+	}
+
+	return pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		if err := c.s.TxPutPreferences(ctx, tx, prefs); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := c.s.TxDeleteChannelSettings(ctx, tx, accountID); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if len(settings) > 0 {
+			return errors.WithStack(c.s.TxPutChannelSettings(ctx, tx, accountID, settings))
+		}
+
+		return nil
+	})
+}
+
+func loadLocation(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// quietHoursEnd returns the end of the quiet hours now falls into or nil when now is outside of them.
+func quietHoursEnd(now time.Time, prefs *storage.Preferences) *time.Time {
+	if prefs.QuietHoursStart == nil || prefs.QuietHoursEnd == nil {
+		return nil
+	}
+
+	start, end := int(*prefs.QuietHoursStart), int(*prefs.QuietHoursEnd)
+
+	local := now.In(loadLocation(prefs.Timezone))
+	minute := local.Hour()*60 + local.Minute()
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+
+	var until time.Time
+
+	switch {
+	case start < end && minute >= start && minute < end:
+		until = midnight.Add(time.Duration(end) * time.Minute)
+	case start > end && minute >= start:
+		until = midnight.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute)
+	case start > end && minute < end:
+		until = midnight.Add(time.Duration(end) * time.Minute)
+	default:
+		return nil
+	}
+
+	return &until
+}
+
+// digestDue reports whether a digest collecting emails since since is due at now.
+// Digests go out at hour local time, weekly ones on mondays. Pending digest emails
+// of an account which has switched digests off are flushed right away.
+func digestDue(now time.Time, prefs *storage.Preferences, since time.Time, hour int) bool {
+	if prefs.DigestMode == storage.DigestModeOff {
+		return true
+	}
+
+	local := now.In(loadLocation(prefs.Timezone))
+
+	slot := time.Date(local.Year(), local.Month(), local.Day(), hour, 0, 0, 0, local.Location())
+	if slot.After(local) {
+		slot = slot.AddDate(0, 0, -1)
+	}
+
+	if prefs.DigestMode == storage.DigestModeWeekly {
+		for slot.Weekday() != time.Monday {
+			slot = slot.AddDate(0, 0, -1)
+		}
+	}
+
+	return since.Before(slot)
+}
+
+// DeliverPendingEmails sends a batch of emails which are not part of a digest and returns the number of emails sent.
+func (c *Controller) DeliverPendingEmails(ctx context.Context) (int, error) {
+	if c.mailer == nil {
+		return 0, nil
+	}
+
+	var emails []*storage.Email
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		now := time.Now()
+
+		es, err := c.s.TxClaimPendingEmails(
+			ctx,
+			tx,
+			now,
+			now.Add(c.cfg.DeliveryLease),
+			c.cfg.DeliveryMaxAttempts,
+			c.cfg.DeliveryBatchSize,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		emails = es
+
+		return nil
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if len(emails) == 0 {
+		return 0, nil
+	}
+
+	accountIDs := make([]string, 0, len(emails))
+	for _, e := range emails {
+		accountIDs = append(accountIDs, e.AccountID)
+	}
+
+	addresses, err := c.s.TxGetAccountEmails(ctx, c.s.NoTx(), accountIDs)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	var (
+		sendErrs error
+		sentIDs  = make([]string, 0, len(emails))
+	)
+
+	for _, e := range emails {
+		// accounts without an email have nothing to deliver to
+		if address, ok := addresses[e.AccountID]; ok {
+			body := "<p>" + html.EscapeString(e.Body) + "</p>"
+
+			if err := c.mailer.SendHTMLMail([]string{address}, e.Subject, body); err != nil {
+				sendErrs = errors.CombineErrors(sendErrs, errors.Wrapf(err, "failed to send email=%s", e.ID))
+				continue
+			}
+		}
+
+		sentIDs = append(sentIDs, e.ID)
+	}
+
+	if len(sentIDs) > 0 {
+		if err := c.s.TxMarkEmailsSent(ctx, c.s.NoTx(), sentIDs, time.Now()); err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+
+	if sendErrs != nil {
+		return len(sentIDs), errors.WithStack(sendErrs)
+	}
+
+	return len(sentIDs), nil
+}
+
+// SendDueDigests batches pending digest emails of every account whose digest is due into a single email
+// and returns the number of digests sent.
+func (c *Controller) SendDueDigests(ctx context.Context) (int, error) {
+	if c.mailer == nil {
+		return 0, nil
+	}
+
+	accountIDs, err := c.s.TxGetAccountsWithPendingDigest(ctx, c.s.NoTx())
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	var (
+		sendErrs error
+		sent     int
+	)
+
+	for _, accountID := range accountIDs {
+		var ok bool
+
+		if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+			var err error
+			ok, err = c.txSendDigest(ctx, tx, accountID)
+
+			return errors.WithStack(err)
+		}); err != nil {
+			sendErrs = errors.CombineErrors(sendErrs, errors.Wrapf(err, "failed to send digest account=%s", accountID))
+			continue
+		}
+
+		if ok {
+			sent++
+		}
+	}
+
+	if sendErrs != nil {
+		return sent, errors.WithStack(sendErrs)
+	}
+
+	return sent, nil
+}
+
+func (c *Controller) txSendDigest(ctx context.Context, tx pkgtx.Tx, accountID string) (bool, error) {
+	prefs, err := c.s.TxLockPreferences(ctx, tx, accountID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		// another scheduler is sending the digest right now
+		return false, nil
+	default:
+		return false, errors.WithStack(err)
+	}
+
+	emails, err := c.s.TxGetPendingDigestEmails(ctx, tx, accountID)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	if len(emails) == 0 {
+		return false, nil
+	}
+
+	since := emails[0].CreatedAt
+	if prefs.LastDigestAt != nil {
+		since = *prefs.LastDigestAt
+	}
+
+	now := time.Now()
+	if !digestDue(now, prefs, since, c.cfg.DigestHour) {
+		return false, nil
+	}
+
+	addresses, err := c.s.TxGetAccountEmails(ctx, tx, []string{accountID})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	address, hasAddress := addresses[accountID]
+	if hasAddress {
+		subject, body := renderDigest(prefs.DigestMode, emails)
+
+		if err := c.mailer.SendHTMLMail([]string{address}, subject, body); err != nil {
+			return false, errors.WithStack(err)
+		}
+	}
+
+	ids := make([]string, len(emails))
+	for i, e := range emails {
+		ids[i] = e.ID
+	}
+
+	if err := c.s.TxMarkEmailsSent(ctx, tx, ids, now); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	if err := c.s.TxSetLastDigestAt(ctx, tx, accountID, now); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return hasAddress, nil
+}
+
+var digestSections = map[storage.EventType]string{
+	storage.EventTypeSystem:            "Account",
+	storage.EventTypeVacancyAlert:      "New vacancies",
+	storage.EventTypeApplicationUpdate: "Applications",
+	storage.EventTypeCompanyUpdate:     "Company updates",
+}
+
+func renderDigest(mode storage.DigestMode, emails []*storage.Email) (subject, body string) {
+	subject = "Your Persona App digest"
+
+	switch mode {
+	case storage.DigestModeDaily:
+		subject = "Your daily Persona App digest"
+	case storage.DigestModeWeekly:
+		subject = "Your weekly Persona App digest"
+	}
+
+	byType := make(map[storage.EventType][]*storage.Email)
+	for _, e := range emails {
+		byType[e.EventType] = append(byType[e.EventType], e)
+	}
+
+	var b strings.Builder
+
+	b.WriteString("<html><body>")
+
+	for _, et := range eventTypes {
+		set, _ := toStorageEventType(et)
+
+		es := byType[set]
+		if len(es) == 0 {
+			continue
+		}
+
+		b.WriteString(fmt.Sprintf("<h3>%s</h3><ul>", html.EscapeString(digestSections[set])))
+
+		for _, e := range es {
+			b.WriteString(fmt.Sprintf(
+				"<li><b>%s</b><br>%s</li>",
+				html.EscapeString(e.Subject),
+				html.EscapeString(e.Body),
+			))
+		}
+
+		b.WriteString("</ul>")
+	}
+
+	b.WriteString("</body></html>")
+
+	return subject, b.String()
+}
@@ -0,0 +1,809 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+
+	"personaapp/pkg/postgresql"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Storage struct {
+	*postgresql.Storage
+}
+
+func New(db *postgresql.Storage) *Storage {
+	return &Storage{db}
+}
+
+type Platform string
+
+const (
+	PlatformAndroid Platform = "device_platform_android"
+	PlatformIOS     Platform = "device_platform_ios"
+)
+
+type Device struct {
+	ID        string
+	AccountID string
+	Platform  Platform
+	PushToken string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Notification struct {
+	ID           string
+	AccountID    string
+	Title        string
+	Body         string
+	Data         map[string]string
+	DeliverAfter *time.Time
+	CreatedAt    time.Time
+}
+
+type EventType string
+
+const (
+	EventTypeSystem            EventType = "notification_event_type_system"
+	EventTypeVacancyAlert      EventType = "notification_event_type_vacancy_alert"
+	EventTypeApplicationUpdate EventType = "notification_event_type_application_update"
+	EventTypeCompanyUpdate     EventType = "notification_event_type_company_update"
+)
+
+type Channel string
+
+const (
+	ChannelEmail Channel = "notification_channel_email"
+	ChannelPush  Channel = "notification_channel_push"
+)
+
+type DigestMode string
+
+const (
+	DigestModeOff    DigestMode = "digest_mode_off"
+	DigestModeDaily  DigestMode = "digest_mode_daily"
+	DigestModeWeekly DigestMode = "digest_mode_weekly"
+)
+
+type Preferences struct {
+	AccountID       string
+	Timezone        string
+	QuietHoursStart *int32
+	QuietHoursEnd   *int32
+	DigestMode      DigestMode
+	LastDigestAt    *time.Time
+	UpdatedAt       time.Time
+}
+
+type ChannelSetting struct {
+	EventType EventType
+	Channel   Channel
+	Enabled   bool
+}
+
+type Email struct {
+	ID        string
+	AccountID string
+	EventType EventType
+	Subject   string
+	Body      string
+	Digest    bool
+	CreatedAt time.Time
+}
+
+/**
+Devices part start
+*/
+
+// TxPutDevice stores the device by its push token. A token re-registered by another account is moved to it,
+// the id of the already stored device is returned in that case.
+func (s *Storage) TxPutDevice(ctx context.Context, tx pkgtx.Tx, device *Device) (string, error) {
+	c := postgresql.FromTx(tx)
+
+	var id string
+	if err := c.QueryRowContext(
+		ctx,
+		`WITH upsert AS (
+				UPDATE device SET
+					account_id = $2,
+					platform = $3,
+					updated_at = $6
+				WHERE push_token = $4
+				RETURNING id
+			), inserted AS (
+				INSERT INTO device (id, account_id, platform, push_token, created_at, updated_at)
+				SELECT $1, $2, $3, $4, $5, $6
+				WHERE NOT EXISTS (SELECT * FROM upsert)
+				RETURNING id
+			)
+			SELECT id FROM upsert
+			UNION ALL
+			SELECT id FROM inserted`,
+		device.ID,
+		device.AccountID,
+		device.Platform,
+		device.PushToken,
+		device.CreatedAt,
+		device.UpdatedAt,
+	).Scan(&id); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) TxDeleteDevice(ctx context.Context, tx pkgtx.Tx, accountID, pushToken string) error {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`DELETE FROM device
+			WHERE account_id = $1 AND push_token = $2`,
+		accountID,
+		pushToken,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteDevicesByPushTokens(ctx context.Context, tx pkgtx.Tx, pushTokens []string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM device
+			WHERE push_token = ANY($1)`,
+		pq.Array(pushTokens),
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxGetDevicesByAccountIDs(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountIDs []string,
+) (_ []*Device, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id, account_id, platform, push_token, created_at, updated_at
+				FROM device
+				WHERE account_id = ANY($1::uuid[])
+				ORDER BY created_at ASC`,
+		pq.Array(accountIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	devices := make([]*Device, 0)
+
+	for rows.Next() {
+		var d Device
+		if err := rows.Scan(
+			&d.ID,
+			&d.AccountID,
+			&d.Platform,
+			&d.PushToken,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		devices = append(devices, &d)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return devices, nil
+}
+
+/**
+Notifications part start
+*/
+
+func (s *Storage) TxPutNotification(ctx context.Context, tx pkgtx.Tx, n *Notification) error {
+	c := postgresql.FromTx(tx)
+
+	data, err := json.Marshal(n.Data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO notification (id, account_id, title, body, data, deliver_after, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		n.ID,
+		n.AccountID,
+		n.Title,
+		n.Body,
+		data,
+		n.DeliverAfter,
+		n.CreatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxClaimPendingNotifications leases up to limit not yet pushed notifications until leaseUntil.
+// Rows leased by another worker are skipped, so several workers may run concurrently.
+func (s *Storage) TxClaimPendingNotifications(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	now time.Time,
+	leaseUntil time.Time,
+	maxAttempts int,
+	limit int,
+) (_ []*Notification, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`UPDATE notification SET
+					push_lease_until = $2,
+					push_attempts = push_attempts + 1
+				WHERE id IN (
+					SELECT id
+					FROM notification
+					WHERE pushed_at IS NULL
+						AND push_attempts < $3
+						AND (deliver_after IS NULL OR deliver_after <= $1)
+						AND (push_lease_until IS NULL OR push_lease_until < $1)
+					ORDER BY created_at ASC
+					LIMIT $4
+					FOR UPDATE SKIP LOCKED
+				)
+				RETURNING id, account_id, title, body, data, created_at`,
+		now,
+		leaseUntil,
+		maxAttempts,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	notifications := make([]*Notification, 0)
+
+	for rows.Next() {
+		var (
+			n    Notification
+			data []byte
+		)
+		if err := rows.Scan(
+			&n.ID,
+			&n.AccountID,
+			&n.Title,
+			&n.Body,
+			&data,
+			&n.CreatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if err := json.Unmarshal(data, &n.Data); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		notifications = append(notifications, &n)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return notifications, nil
+}
+
+func (s *Storage) TxMarkNotificationsPushed(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	notificationIDs []string,
+	pushedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE notification SET
+				pushed_at = $2,
+				push_lease_until = NULL
+			WHERE id = ANY($1::uuid[])`,
+		pq.Array(notificationIDs),
+		pushedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+/**
+Preferences part start
+*/
+
+func (s *Storage) TxGetPreferences(ctx context.Context, tx pkgtx.Tx, accountID string) (*Preferences, error) {
+	c := postgresql.FromTx(tx)
+
+	var p Preferences
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT account_id, timezone, quiet_hours_start, quiet_hours_end, digest_mode, last_digest_at, updated_at
+				FROM notification_preference
+				WHERE account_id = $1`,
+		accountID,
+	).Scan(&p.AccountID, &p.Timezone, &p.QuietHoursStart, &p.QuietHoursEnd, &p.DigestMode, &p.LastDigestAt, &p.UpdatedAt)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errors.WithStack(ErrNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return &p, nil
+}
+
+// TxLockPreferences is TxGetPreferences which also locks the row until the end of the transaction.
+// ErrNotFound is returned when the row is already locked by another transaction.
+func (s *Storage) TxLockPreferences(ctx context.Context, tx pkgtx.Tx, accountID string) (*Preferences, error) {
+	c := postgresql.FromTx(tx)
+
+	var p Preferences
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT account_id, timezone, quiet_hours_start, quiet_hours_end, digest_mode, last_digest_at, updated_at
+				FROM notification_preference
+				WHERE account_id = $1
+				FOR UPDATE SKIP LOCKED`,
+		accountID,
+	).Scan(&p.AccountID, &p.Timezone, &p.QuietHoursStart, &p.QuietHoursEnd, &p.DigestMode, &p.LastDigestAt, &p.UpdatedAt)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errors.WithStack(ErrNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return &p, nil
+}
+
+// TxPutPreferences stores the preferences. The time of the last digest is kept untouched.
+func (s *Storage) TxPutPreferences(ctx context.Context, tx pkgtx.Tx, p *Preferences) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`WITH upsert AS (
+				UPDATE notification_preference SET
+					timezone = $2,
+					quiet_hours_start = $3,
+					quiet_hours_end = $4,
+					digest_mode = $5,
+					updated_at = $6
+				WHERE account_id = $1
+				RETURNING account_id
+			)
+			INSERT INTO notification_preference (account_id, timezone, quiet_hours_start, quiet_hours_end, 
+					digest_mode, updated_at)
+			SELECT $1, $2, $3, $4, $5, $6
+			WHERE NOT EXISTS (SELECT * FROM upsert)`,
+		p.AccountID,
+		p.Timezone,
+		p.QuietHoursStart,
+		p.QuietHoursEnd,
+		p.DigestMode,
+		p.UpdatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxSetLastDigestAt(ctx context.Context, tx pkgtx.Tx, accountID string, lastDigestAt time.Time) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE notification_preference SET
+				last_digest_at = $2
+			WHERE account_id = $1`,
+		accountID,
+		lastDigestAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxGetChannelSettings(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+) (_ []*ChannelSetting, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT event_type, channel, enabled
+				FROM notification_channel_setting
+				WHERE account_id = $1`,
+		accountID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	settings := make([]*ChannelSetting, 0)
+
+	for rows.Next() {
+		var cs ChannelSetting
+		if err := rows.Scan(&cs.EventType, &cs.Channel, &cs.Enabled); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		settings = append(settings, &cs)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return settings, nil
+}
+
+func (s *Storage) TxPutChannelSettings(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	settings []*ChannelSetting,
+) error {
+	c := postgresql.FromTx(tx)
+
+	queryFormat := `INSERT
+		INTO notification_channel_setting (account_id, event_type, channel, enabled)
+		VALUES %s`
+
+	columns := 4
+	valueStrings := make([]string, len(settings))
+	valueArgs := make([]interface{}, len(settings)*columns)
+
+	for i := 0; i < len(settings); i++ {
+		offset := i * columns
+		valueStrings[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)", offset+1, offset+2, offset+3, offset+4)
+		valueArgs[offset] = accountID
+		valueArgs[offset+1] = settings[i].EventType
+		valueArgs[offset+2] = settings[i].Channel
+		valueArgs[offset+3] = settings[i].Enabled
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		fmt.Sprintf(queryFormat, strings.Join(valueStrings, ",")),
+		valueArgs...,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteChannelSettings(ctx context.Context, tx pkgtx.Tx, accountID string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM notification_channel_setting
+			WHERE account_id = $1`,
+		accountID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+/**
+Emails part start
+*/
+
+func (s *Storage) TxPutEmail(ctx context.Context, tx pkgtx.Tx, e *Email) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO notification_email (id, account_id, event_type, subject, body, digest, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		e.ID,
+		e.AccountID,
+		e.EventType,
+		e.Subject,
+		e.Body,
+		e.Digest,
+		e.CreatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxClaimPendingEmails leases up to limit not yet sent emails which are not part of a digest.
+func (s *Storage) TxClaimPendingEmails(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	now time.Time,
+	leaseUntil time.Time,
+	maxAttempts int,
+	limit int,
+) (_ []*Email, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`UPDATE notification_email SET
+					send_lease_until = $2,
+					send_attempts = send_attempts + 1
+				WHERE id IN (
+					SELECT id
+					FROM notification_email
+					WHERE sent_at IS NULL
+						AND NOT digest
+						AND send_attempts < $3
+						AND (send_lease_until IS NULL OR send_lease_until < $1)
+					ORDER BY created_at ASC
+					LIMIT $4
+					FOR UPDATE SKIP LOCKED
+				)
+				RETURNING id, account_id, event_type, subject, body, digest, created_at`,
+		now,
+		leaseUntil,
+		maxAttempts,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	return scanEmails(rows)
+}
+
+func (s *Storage) TxGetPendingDigestEmails(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+) (_ []*Email, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id, account_id, event_type, subject, body, digest, created_at
+				FROM notification_email
+				WHERE account_id = $1
+					AND sent_at IS NULL
+					AND digest
+				ORDER BY created_at ASC`,
+		accountID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	return scanEmails(rows)
+}
+
+func scanEmails(rows *sql.Rows) ([]*Email, error) {
+	emails := make([]*Email, 0)
+
+	for rows.Next() {
+		var e Email
+		if err := rows.Scan(
+			&e.ID,
+			&e.AccountID,
+			&e.EventType,
+			&e.Subject,
+			&e.Body,
+			&e.Digest,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		emails = append(emails, &e)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return emails, nil
+}
+
+// TxGetAccountsWithPendingDigest returns ids of accounts which have unsent digest emails.
+func (s *Storage) TxGetAccountsWithPendingDigest(ctx context.Context, tx pkgtx.Tx) (_ []string, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT DISTINCT account_id
+				FROM notification_email
+				WHERE sent_at IS NULL AND digest`,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	accountIDs := make([]string, 0)
+
+	for rows.Next() {
+		var accountID string
+		if err := rows.Scan(&accountID); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return accountIDs, nil
+}
+
+func (s *Storage) TxMarkEmailsSent(ctx context.Context, tx pkgtx.Tx, emailIDs []string, sentAt time.Time) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE notification_email SET
+				sent_at = $2,
+				send_lease_until = NULL
+			WHERE id = ANY($1::uuid[])`,
+		pq.Array(emailIDs),
+		sentAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxGetAccountEmails returns email addresses of the accounts keyed by account id.
+// Accounts registered without an email are omitted.
+func (s *Storage) TxGetAccountEmails(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountIDs []string,
+) (_ map[string]string, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT account_id, email
+				FROM auth
+				WHERE account_id = ANY($1::uuid[]) AND email <> ''`,
+		pq.Array(accountIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	emails := make(map[string]string)
+
+	for rows.Next() {
+		var accountID, email string
+		if err := rows.Scan(&accountID, &email); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		emails[accountID] = email
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return emails, nil
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/pflag"
+
+	"personaapp/internal/controllers/outbox/storage"
+	"personaapp/pkg/nats/event"
+	pkgtx "personaapp/pkg/tx"
+)
+
+type Config struct {
+	PublishInterval  time.Duration
+	PublishBatchSize int
+	PublishLease     time.Duration
+}
+
+func (c *Config) Flags(name string) *pflag.FlagSet {
+	f := pflag.NewFlagSet(name, pflag.PanicOnError)
+
+	f.DurationVar(&c.PublishInterval, "outbox_publish_interval", time.Second, "Pending events polling interval")
+	f.IntVar(&c.PublishBatchSize, "outbox_publish_batch_size", 100, "Events published per poll")
+	f.DurationVar(&c.PublishLease, "outbox_publish_lease", 30*time.Second, "Claimed event lease")
+
+	return f
+}
+
+// Publisher sends the encoded event to the subject, see nats.Bus.
+type Publisher interface {
+	Publish(subject string, msg []byte) error
+	Flush() error
+}
+
+type Storage interface {
+	TxPutEvent(ctx context.Context, tx pkgtx.Tx, event *storage.Event) error
+	TxClaimPendingEvents(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		now time.Time,
+		leaseUntil time.Time,
+		limit int,
+	) ([]*storage.Event, error)
+	TxDeleteEvents(ctx context.Context, tx pkgtx.Tx, eventIDs []string) error
+
+	BeginTx(ctx context.Context) (pkgtx.Tx, error)
+	NoTx() pkgtx.Tx
+}
+
+type Controller struct {
+	cfg       *Config
+	s         Storage
+	publisher Publisher
+}
+
+func New(cfg *Config, s Storage, publisher Publisher) *Controller {
+	return &Controller{cfg: cfg, s: s, publisher: publisher}
+}
+
+// TxEmit stores the event in the outbox within the caller's transaction. The event becomes visible to
+// PublishPending only once the transaction commits, so rolled back changes never leak to subscribers.
+func (c *Controller) TxEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error {
+	payload, err := e.Marshal()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(c.s.TxPutEvent(ctx, tx, &storage.Event{
+		ID:        e.ID,
+		Subject:   e.Subject,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	}))
+}
+
+// PublishPending publishes a batch of committed events in the order they were emitted and returns
+// the number of events published. Delivery is at least once: an event is removed from the outbox only
+// after the publisher has flushed it, a failed batch is retried once its lease expires.
+func (c *Controller) PublishPending(ctx context.Context) (int, error) {
+	var events []*storage.Event
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		now := time.Now()
+
+		es, err := c.s.TxClaimPendingEvents(ctx, tx, now, now.Add(c.cfg.PublishLease), c.cfg.PublishBatchSize)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		events = es
+
+		return nil
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	publishedIDs := make([]string, 0, len(events))
+
+	for _, e := range events {
+		if err := c.publisher.Publish(e.Subject, e.Payload); err != nil {
+			return 0, errors.Wrapf(err, "failed to publish event=%s", e.ID)
+		}
+
+		publishedIDs = append(publishedIDs, e.ID)
+	}
+
+	if err := c.publisher.Flush(); err != nil {
+		return 0, errors.Wrap(err, "failed to flush published events")
+	}
+
+	if err := c.s.TxDeleteEvents(ctx, c.s.NoTx(), publishedIDs); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return len(publishedIDs), nil
+}
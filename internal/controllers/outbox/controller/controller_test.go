@@ -0,0 +1,102 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	gonats "github.com/nats-io/go-nats"
+	sqlMigrate "github.com/rubenv/sql-migrate"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+
+	"personaapp/internal/controllers/outbox/controller"
+	"personaapp/internal/controllers/outbox/storage"
+	"personaapp/internal/testutils"
+	apievent "personaapp/pkg/grpcapi/event"
+	"personaapp/pkg/nats/event"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var outboxCfg = &controller.Config{
+	PublishInterval:  time.Second,
+	PublishBatchSize: 10,
+	PublishLease:     time.Minute,
+}
+
+var errRollback = errors.New("rollback")
+
+func initStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
+
+	return storage.New(pg), pg.Close
+}
+
+func cleanup(t *testing.T) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Down))
+}
+
+func TestController_PublishPending(t *testing.T) {
+	s, closer := initStorage(t)
+	bus, natsAddr, natsCloser := testutils.RunNats(t)
+
+	defer func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+		natsCloser()
+		cleanup(t)
+	}()
+
+	conn, err := gonats.Connect(natsAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sub, err := conn.SubscribeSync(event.SubjectVacancyDeleted)
+	require.NoError(t, err)
+	require.NoError(t, conn.Flush())
+
+	c := controller.New(outboxCfg, s, bus)
+
+	t.Run("publish committed events only", func(t *testing.T) {
+		committedID := uuid.NewV4().String()
+		rolledBackID := uuid.NewV4().String()
+
+		require.NoError(t, pkgtx.RunInTx(context.TODO(), s, func(ctx context.Context, tx pkgtx.Tx) error {
+			return c.TxEmit(ctx, tx, event.VacancyDeleted(&apievent.VacancyDeletedV1{VacancyId: committedID}))
+		}))
+
+		err := pkgtx.RunInTx(context.TODO(), s, func(ctx context.Context, tx pkgtx.Tx) error {
+			if err := c.TxEmit(ctx, tx, event.VacancyDeleted(&apievent.VacancyDeletedV1{VacancyId: rolledBackID})); err != nil {
+				return err
+			}
+
+			return errRollback
+		})
+		require.EqualError(t, errors.Cause(err), errRollback.Error())
+
+		published, err := c.PublishPending(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, published)
+
+		msg, err := sub.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+
+		var e apievent.VacancyDeletedV1
+		require.NoError(t, proto.Unmarshal(msg.Data, &e))
+		require.Equal(t, committedID, e.GetVacancyId())
+		require.NotEmpty(t, e.GetMeta().GetId())
+		require.NotNil(t, e.GetMeta().GetOccurredAt())
+
+		published, err = c.PublishPending(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 0, published)
+
+		_, err = sub.NextMsg(100 * time.Millisecond)
+		require.Equal(t, gonats.ErrTimeout, err)
+	})
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+
+	"personaapp/pkg/postgresql"
+	pkgtx "personaapp/pkg/tx"
+)
+
+type Storage struct {
+	*postgresql.Storage
+}
+
+func New(db *postgresql.Storage) *Storage {
+	return &Storage{db}
+}
+
+type Event struct {
+	ID        string
+	Subject   string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+func (s *Storage) TxPutEvent(ctx context.Context, tx pkgtx.Tx, event *Event) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO event_outbox (id, subject, payload, created_at)
+			VALUES ($1, $2, $3, $4)`,
+		event.ID,
+		event.Subject,
+		event.Payload,
+		event.CreatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxClaimPendingEvents leases up to limit oldest events until leaseUntil.
+// Rows leased by another worker are skipped, so several workers may run concurrently.
+func (s *Storage) TxClaimPendingEvents(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) (_ []*Event, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`WITH claimed AS (
+				UPDATE event_outbox SET
+					publish_lease_until = $2
+				WHERE id IN (
+					SELECT id
+					FROM event_outbox
+					WHERE publish_lease_until IS NULL OR publish_lease_until < $1
+					ORDER BY position ASC
+					LIMIT $3
+					FOR UPDATE SKIP LOCKED
+				)
+				RETURNING id, subject, payload, created_at, position
+			)
+			SELECT id, subject, payload, created_at
+			FROM claimed
+			ORDER BY position ASC`,
+		now,
+		leaseUntil,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	events := make([]*Event, 0)
+
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.ID, &e.Subject, &e.Payload, &e.CreatedAt); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		events = append(events, &e)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return events, nil
+}
+
+func (s *Storage) TxDeleteEvents(ctx context.Context, tx pkgtx.Tx, eventIDs []string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM event_outbox
+			WHERE id = ANY($1::uuid[])`,
+		pq.Array(eventIDs),
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"context"
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+const maxVacancyAlertsPerAccount = 20
+
+var (
+	ErrVacancyAlertNotFound          = errors.New("vacancy alert not found")
+	ErrVacancyAlertsLimitExceeded    = errors.New("vacancy alerts limit exceeded")
+	ErrInvalidVacancyAlert           = errors.New("invalid vacancy alert struct")
+	ErrInvalidVacancyAlertSalary     = errors.New("invalid vacancy alert salary range")
+	ErrInvalidVacancyAlertType       = errors.New("invalid vacancy alert vacancy type")
+	ErrInvalidVacancyAlertKeywords   = errors.New("invalid vacancy alert keywords")
+	ErrInvalidVacancyAlertCategories = errors.New("invalid vacancy alert categories")
+	ErrInvalidVacancyAlertCities     = errors.New("invalid vacancy alert cities")
+	ErrInvalidVacancyAlertCurrency   = errors.New("invalid vacancy alert currency")
+)
+
+type VacancyAlertID string
+
+// VacancyFilter narrows vacancies down, empty fields don't restrict anything. The salary range is a monthly
+// one in Currency, which is UAH when empty, the vacancy salaries are converted by the exchange rates.
+type VacancyFilter struct {
+	CategoriesIDs []string
+	CityIDs       []string
+	MinSalary     *int32
+	MaxSalary     *int32
+	Currency      Currency
+	Type          *VacancyType
+	Keywords      string `valid:"stringlength(0|255)"`
+}
+
+type VacancyAlert struct {
+	ID string
+	VacancyFilter
+}
+
+func (f *VacancyFilter) isEmpty() bool {
+	return len(f.CategoriesIDs) == 0 && len(f.CityIDs) == 0 && f.MinSalary == nil && f.MaxSalary == nil &&
+		f.Type == nil && f.Keywords == ""
+}
+
+func (f *VacancyFilter) validate() error {
+	if f == nil || f.isEmpty() {
+		return errors.WithStack(ErrInvalidVacancyAlert)
+	}
+
+	if valid, err := govalidator.ValidateStruct(f); !valid {
+		if govalidator.ErrorByField(err, "Keywords") != "" {
+			return errors.WithStack(ErrInvalidVacancyAlertKeywords)
+		}
+
+		return errors.New("vacancy filter struct is filled with some invalid data")
+	}
+
+	for _, id := range f.CategoriesIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return errors.WithStack(ErrInvalidVacancyAlertCategories)
+		}
+	}
+
+	for _, id := range f.CityIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return errors.WithStack(ErrInvalidVacancyAlertCities)
+		}
+	}
+
+	if (f.MinSalary != nil && *f.MinSalary < 0) || (f.MaxSalary != nil && *f.MaxSalary < 0) ||
+		(f.MinSalary != nil && f.MaxSalary != nil && *f.MinSalary > *f.MaxSalary) {
+		return errors.WithStack(ErrInvalidVacancyAlertSalary)
+	}
+
+	if !f.Currency.isValid() {
+		return errors.WithStack(ErrInvalidVacancyAlertCurrency)
+	}
+
+	if f.Type != nil {
+		if _, err := toStorageVacancyType(*f.Type); err != nil {
+			return errors.WithStack(ErrInvalidVacancyAlertType)
+		}
+	}
+
+	return nil
+}
+
+func toStorageVacancyFilter(f *VacancyFilter) (*storage.VacancyFilter, error) {
+	sf := &storage.VacancyFilter{
+		CategoriesIDs: f.CategoriesIDs,
+		CityIDs:       f.CityIDs,
+		MinSalary:     f.MinSalary,
+		MaxSalary:     f.MaxSalary,
+		Currency:      toStorageCurrency(f.Currency),
+		Keywords:      f.Keywords,
+	}
+
+	if sf.CategoriesIDs == nil {
+		sf.CategoriesIDs = []string{}
+	}
+
+	if sf.CityIDs == nil {
+		sf.CityIDs = []string{}
+	}
+
+	if f.Type != nil {
+		vt, err := toStorageVacancyType(*f.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		sf.Type = &vt
+	}
+
+	return sf, nil
+}
+
+func fromStorageVacancyFilter(sf *storage.VacancyFilter) (*VacancyFilter, error) {
+	f := &VacancyFilter{
+		CategoriesIDs: sf.CategoriesIDs,
+		CityIDs:       sf.CityIDs,
+		MinSalary:     sf.MinSalary,
+		MaxSalary:     sf.MaxSalary,
+		Currency:      Currency(sf.Currency),
+		Keywords:      sf.Keywords,
+	}
+
+	if sf.Type != nil {
+		vt, err := fromStorageVacancyType(*sf.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		f.Type = &vt
+	}
+
+	return f, nil
+}
+
+// CreateVacancyAlert saves the search of the account. Vacancies published after that which match the search
+// are reported to the account through the notifier.
+func (c *Controller) CreateVacancyAlert(
+	ctx context.Context,
+	accountID string,
+	filter *VacancyFilter,
+) (VacancyAlertID, error) {
+	if err := filter.validate(); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	sf, err := toStorageVacancyFilter(filter)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	id := VacancyAlertID(uuid.NewV4().String())
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		// The concurrent creations wait for each other not to exceed the limit together
+		if err := c.s.TxLockAccountVacancyAlerts(ctx, tx, accountID); err != nil {
+			return errors.WithStack(err)
+		}
+
+		count, err := c.s.TxCountVacancyAlerts(ctx, tx, accountID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if count >= maxVacancyAlertsPerAccount {
+			return errors.WithStack(ErrVacancyAlertsLimitExceeded)
+		}
+
+		if err := c.txCheckExchangeRate(ctx, tx, filter); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.s.TxPutVacancyAlert(ctx, tx, &storage.VacancyAlert{
+			ID:            string(id),
+			AccountID:     accountID,
+			VacancyFilter: *sf,
+			CreatedAt:     time.Now(),
+		}))
+	}); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return id, nil
+}
+
+func (c *Controller) ListVacancyAlerts(ctx context.Context, accountID string) ([]*VacancyAlert, error) {
+	sas, err := c.s.TxGetVacancyAlerts(ctx, c.s.NoTx(), accountID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	alerts := make([]*VacancyAlert, len(sas))
+
+	for idx, sa := range sas {
+		f, err := fromStorageVacancyFilter(&sa.VacancyFilter)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		alerts[idx] = &VacancyAlert{
+			ID:            sa.ID,
+			VacancyFilter: *f,
+		}
+	}
+
+	return alerts, nil
+}
+
+func (c *Controller) DeleteVacancyAlert(ctx context.Context, accountID string, alertID string) error {
+	if _, err := uuid.FromString(alertID); err != nil {
+		return errors.WithStack(ErrVacancyAlertNotFound)
+	}
+
+	switch err := c.s.TxDeleteVacancyAlert(ctx, c.s.NoTx(), accountID, alertID); errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrVacancyAlertNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *Controller) txNotifyVacancyAlerts(ctx context.Context, tx pkgtx.Tx, vid VacancyID, title string) error {
+	matches, err := c.s.TxMatchVacancyAlerts(ctx, tx, string(vid), time.Now())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if c.notifier == nil {
+		return nil
+	}
+
+	for _, m := range matches {
+		if err := c.notifier.TxNotifyVacancyAlert(ctx, tx, m.AccountID, m.AlertID, string(vid), title); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
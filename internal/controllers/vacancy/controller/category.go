@@ -0,0 +1,315 @@
+package controller
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var (
+	ErrVacancyCategoryParentNotFound = errors.New("vacancy category parent not found")
+	ErrInvalidVacancyCategoryParent  = errors.New("vacancy category can't be moved into its own subtree")
+	ErrVacancyCategoryHasChildren    = errors.New("vacancy category has children")
+	ErrInvalidVacancyCategoryLocale  = errors.New("invalid vacancy category locale")
+)
+
+// localeRegexp matches a lowercased BCP 47 language tag, 'uk' or 'en-us'
+var localeRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{1,8})*$`)
+
+// MoveVacancyCategory moves the category with its subtree under the parent, to the roots for the nil parent,
+// and puts it at the position among the children of the parent. A position out of range puts the category
+// last. The positions of the categories left and joined are renumbered.
+func (c *Controller) MoveVacancyCategory(
+	ctx context.Context,
+	categoryID string,
+	parentID *string,
+	position int32,
+) error {
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vc, err := c.s.TxGetVacancyCategory(ctx, tx, categoryID)
+
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrVacancyCategoryNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		path, err := c.txVacancyCategoryPath(ctx, tx, categoryID, parentID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// A category can't be moved below itself
+		if strings.HasPrefix(path, vc.Path) && path != vc.Path {
+			return errors.WithStack(ErrInvalidVacancyCategoryParent)
+		}
+
+		if err := c.s.TxMoveVacancyCategory(ctx, tx, categoryID, parentID, path, time.Now()); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if !sameVacancyCategoryParent(vc.ParentID, parentID) {
+			if err := c.txRenumberVacancyCategories(ctx, tx, vc.ParentID, "", 0); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		return errors.WithStack(c.txRenumberVacancyCategories(ctx, tx, parentID, categoryID, position))
+	}))
+}
+
+// txVacancyCategoryPath returns the path of the category put under the parent.
+func (c *Controller) txVacancyCategoryPath(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	categoryID string,
+	parentID *string,
+) (string, error) {
+	if parentID == nil {
+		return "/" + categoryID + "/", nil
+	}
+
+	parent, err := c.s.TxGetVacancyCategory(ctx, tx, *parentID)
+
+	switch errors.Cause(err) {
+	case nil:
+		return parent.Path + categoryID + "/", nil
+	case storage.ErrNotFound:
+		return "", errors.WithStack(ErrVacancyCategoryParentNotFound)
+	default:
+		return "", errors.WithStack(err)
+	}
+}
+
+// txRenumberVacancyCategories numbers the children of the parent in their order with the category put
+// at the position, the category is skipped when empty.
+func (c *Controller) txRenumberVacancyCategories(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	parentID *string,
+	categoryID string,
+	position int32,
+) error {
+	children, err := c.s.TxGetVacancyCategoryChildrenIDs(ctx, tx, parentID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ids := make([]string, 0, len(children))
+	for _, id := range children {
+		if id != categoryID {
+			ids = append(ids, id)
+		}
+	}
+
+	if categoryID != "" {
+		if position < 0 || int(position) > len(ids) {
+			position = int32(len(ids))
+		}
+
+		ids = append(ids[:position], append([]string{categoryID}, ids[position:]...)...)
+	}
+
+	return errors.WithStack(c.s.TxPutVacancyCategoriesPositions(ctx, tx, ids))
+}
+
+// txGetVacancyCategoriesTitles returns the localized titles of the categories by the category id.
+func (c *Controller) txGetVacancyCategoriesTitles(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	categoriesIDs []string,
+) (map[string]map[string]string, error) {
+	vcts, err := c.s.TxGetVacancyCategoriesTitles(ctx, tx, categoriesIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	titles := make(map[string]map[string]string, len(categoriesIDs))
+	for _, vct := range vcts {
+		if titles[vct.CategoryID] == nil {
+			titles[vct.CategoryID] = make(map[string]string)
+		}
+
+		titles[vct.CategoryID][vct.Locale] = vct.Title
+	}
+
+	return titles, nil
+}
+
+func fromStorageVacancyCategory(
+	vc *storage.VacancyCategory,
+	titles map[string]string,
+	locales []string,
+) *VacancyCategory {
+	if titles == nil {
+		titles = make(map[string]string)
+	}
+
+	return &VacancyCategory{
+		ID:       vc.ID,
+		ParentID: vc.ParentID,
+		Path:     vc.Path,
+		Position: vc.Position,
+		Title:    localizedTitle(vc.Title, titles, locales),
+		Titles:   titles,
+		IconURL:  vc.IconURL,
+		Rating:   vc.Rating,
+	}
+}
+
+// toStorageVacancyCategoryTitles validates the localized titles and lowercases their locales.
+func toStorageVacancyCategoryTitles(
+	categoryID string,
+	titles map[string]string,
+) ([]*storage.VacancyCategoryTitle, error) {
+	vcts := make([]*storage.VacancyCategoryTitle, 0, len(titles))
+
+	for locale, title := range titles {
+		locale = normalizeLocale(locale)
+		if !localeRegexp.MatchString(locale) {
+			return nil, errors.WithStack(ErrInvalidVacancyCategoryLocale)
+		}
+
+		if n := utf8.RuneCountInString(title); n < 2 || n > 50 {
+			return nil, errors.WithStack(ErrInvalidVacancyCategoryTitle)
+		}
+
+		vcts = append(vcts, &storage.VacancyCategoryTitle{CategoryID: categoryID, Locale: locale, Title: title})
+	}
+
+	return vcts, nil
+}
+
+// sortVacancyCategoriesTree orders the categories depth-first keeping the order of the children of a parent.
+// A category with the parent out of the list is taken as a root.
+func sortVacancyCategoriesTree(vcs []*storage.VacancyCategory) []*storage.VacancyCategory {
+	listed := make(map[string]bool, len(vcs))
+	for _, vc := range vcs {
+		listed[vc.ID] = true
+	}
+
+	// The roots are the children of the empty id
+	children := make(map[string][]*storage.VacancyCategory, len(vcs))
+	for _, vc := range vcs {
+		parentID := ""
+		if vc.ParentID != nil && listed[*vc.ParentID] {
+			parentID = *vc.ParentID
+		}
+
+		children[parentID] = append(children[parentID], vc)
+	}
+
+	sorted := make([]*storage.VacancyCategory, 0, len(vcs))
+
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		for _, vc := range children[parentID] {
+			sorted = append(sorted, vc)
+			walk(vc.ID)
+		}
+	}
+
+	walk("")
+
+	return sorted
+}
+
+func sameVacancyCategoryParent(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
+
+// localizedTitle returns the title in the first of the locales it's translated to, the default title
+// when there is none.
+func localizedTitle(title string, titles map[string]string, locales []string) string {
+	for _, locale := range locales {
+		if t, ok := titles[locale]; ok {
+			return t
+		}
+	}
+
+	return title
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(locale), "_", "-", -1))
+}
+
+// ParseAcceptLanguage returns the locales of an Accept-Language header, the most preferred first.
+// Every locale is followed by its less specific ones, 'uk-ua' by 'uk', so a regional preference falls back
+// to the language before the next preferred one. The locales are lowercased, the wildcard and the locales
+// with zero weight are skipped.
+func ParseAcceptLanguage(header string) []string {
+	type weightedLocale struct {
+		locale string
+		q      float64
+	}
+
+	wls := make([]weightedLocale, 0)
+
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+
+		locale := normalizeLocale(params[0])
+		if !localeRegexp.MatchString(locale) {
+			continue
+		}
+
+		q := 1.0
+
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+
+			var err error
+			if q, err = strconv.ParseFloat(param[len("q="):], 64); err != nil {
+				q = 0
+			}
+		}
+
+		if q > 0 {
+			wls = append(wls, weightedLocale{locale: locale, q: q})
+		}
+	}
+
+	sort.SliceStable(wls, func(i, j int) bool {
+		return wls[i].q > wls[j].q
+	})
+
+	locales := make([]string, 0, len(wls))
+	seen := make(map[string]bool, len(wls))
+
+	for _, wl := range wls {
+		for locale := wl.locale; locale != ""; {
+			if !seen[locale] {
+				seen[locale] = true
+				locales = append(locales, locale)
+			}
+
+			idx := strings.LastIndex(locale, "-")
+			if idx < 0 {
+				break
+			}
+
+			locale = locale[:idx]
+		}
+	}
+
+	return locales
+}
@@ -3,14 +3,64 @@ package controller
 import (
 	"context"
 	"personaapp/internal/controllers/vacancy/storage"
+	apievent "personaapp/pkg/grpcapi/event"
+	"personaapp/pkg/nats/event"
 	pkgtx "personaapp/pkg/tx"
 	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/cockroachdb/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/spf13/pflag"
 )
 
+type Config struct {
+	VacancyLifetime time.Duration
+	ExpiryInterval  time.Duration
+	ExpiryBatchSize int
+	// StatsRollupInterval is how often the vacancy events are counted into the hourly stats
+	StatsRollupInterval time.Duration
+	// ModerationEnabled makes the vacancies edited since the last approval go on review before being published
+	ModerationEnabled bool
+	// ModerationAutoApprove publishes the vacancies on review which pass the automatic checks
+	ModerationAutoApprove bool
+	// ModerationBannedWords are the words a vacancy title or description is flagged for, case-insensitive
+	ModerationBannedWords []string
+	// DuplicatePolicy tells what happens to a vacancy similar to another one of the company, see DuplicatePolicy
+	DuplicatePolicy DuplicatePolicy
+	// DuplicateSimilarity is the trigram similarity from 0 to 1 at which vacancies are duplicates
+	DuplicateSimilarity float64
+	// ScheduledPublishInterval is how often the vacancies scheduled to be published are polled
+	ScheduledPublishInterval  time.Duration
+	ScheduledPublishBatchSize int
+	// MaxBumps is how many times a vacancy can be bumped, BumpInterval is the least time between the bumps
+	MaxBumps     int
+	BumpInterval time.Duration
+}
+
+func (c *Config) Flags(name string) *pflag.FlagSet {
+	f := pflag.NewFlagSet(name, pflag.PanicOnError)
+
+	f.DurationVar(&c.VacancyLifetime, "vacancy_lifetime", 30*24*time.Hour, "Longest time a vacancy is published for")
+	f.DurationVar(&c.ExpiryInterval, "vacancy_expiry_interval", time.Minute, "Expired vacancies polling interval")
+	f.IntVar(&c.ExpiryBatchSize, "vacancy_expiry_batch_size", 100, "Expired vacancies closed per poll")
+	f.DurationVar(&c.StatsRollupInterval, "vacancy_stats_rollup_interval", time.Minute, "Vacancy stats rollup interval")
+	f.BoolVar(&c.ModerationEnabled, "vacancy_moderation_enabled", true, "Review vacancies before publishing")
+	f.BoolVar(&c.ModerationAutoApprove, "vacancy_moderation_auto_approve", false, "Publish vacancies passing checks")
+	f.StringSliceVar(&c.ModerationBannedWords, "vacancy_moderation_banned_words", nil, "Words flagged on review")
+	f.StringVar((*string)(&c.DuplicatePolicy), "vacancy_duplicate_policy", string(DuplicatePolicyLink),
+		"Duplicate vacancies policy: off, block or link")
+	f.Float64Var(&c.DuplicateSimilarity, "vacancy_duplicate_similarity", 0.8, "Duplicate vacancies similarity")
+	f.DurationVar(&c.ScheduledPublishInterval, "vacancy_scheduled_publish_interval", time.Minute,
+		"Scheduled vacancies polling interval")
+	f.IntVar(&c.ScheduledPublishBatchSize, "vacancy_scheduled_publish_batch_size", 100,
+		"Scheduled vacancies published per poll")
+	f.IntVar(&c.MaxBumps, "vacancy_max_bumps", 3, "Times a vacancy can be bumped")
+	f.DurationVar(&c.BumpInterval, "vacancy_bump_interval", 24*time.Hour, "Least time between the vacancy bumps")
+
+	return f
+}
+
 type VacancyType string
 
 const (
@@ -27,6 +77,7 @@ func init() {
 
 var (
 	ErrVacancyNotFound                    = errors.New("vacancy not found")
+	ErrVacancyCompanyChanged              = errors.New("vacancy company can't be changed")
 	ErrVacancyCategoryNotFound            = errors.New("vacancy category not found")
 	ErrVacancyImagesNotFound              = errors.New("vacancy image not found")
 	ErrInvalidCursor                      = errors.New("invalid cursor")
@@ -50,8 +101,34 @@ var (
 type Storage interface {
 	TxGetVacancyCategory(ctx context.Context, tx pkgtx.Tx, categoryID string) (*storage.VacancyCategory, error)
 	TxPutVacancyCategory(ctx context.Context, tx pkgtx.Tx, category *storage.VacancyCategory) error
-	TxGetVacanciesCategoriesList(ctx context.Context, tx pkgtx.Tx, rating int32) ([]*storage.VacancyCategory, error)
+	TxGetVacanciesCategoriesList(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		rating int32,
+		parentID *string,
+	) ([]*storage.VacancyCategory, error)
 	TxDeleteVacancyCategory(ctx context.Context, tx pkgtx.Tx, categoryID string) error
+	TxGetVacancyCategoryChildrenIDs(ctx context.Context, tx pkgtx.Tx, parentID *string) ([]string, error)
+	TxPutVacancyCategoriesPositions(ctx context.Context, tx pkgtx.Tx, categoriesIDs []string) error
+	TxMoveVacancyCategory(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		categoryID string,
+		parentID *string,
+		path string,
+		now time.Time,
+	) error
+	TxGetVacancyCategoriesTitles(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		categoriesIDs []string,
+	) ([]*storage.VacancyCategoryTitle, error)
+	TxPutVacancyCategoryTitles(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		categoryID string,
+		titles []*storage.VacancyCategoryTitle,
+	) error
 
 	TxGetVacanciesCategories(
 		ctx context.Context,
@@ -70,10 +147,53 @@ type Storage interface {
 	TxGetVacanciesList(
 		ctx context.Context,
 		tx pkgtx.Tx,
-		categoriesIDs []string,
+		filter *storage.VacancyFilter,
+		sortOrder storage.VacancySortOrder,
 		limit int,
 		cursor *storage.Cursor,
 	) ([]*storage.Vacancy, *storage.Cursor, error)
+	TxSearchVacancies(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		query string,
+		filter *storage.VacancyFilter,
+		limit int,
+		cursor *storage.Cursor,
+	) ([]*storage.VacancySearchResult, *storage.Cursor, error)
+	TxGetVacancyMarkers(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		bbox *storage.BoundingBox,
+		filter *storage.VacancyFilter,
+		limit int,
+	) ([]*storage.VacancyMarker, error)
+	TxGetVacancyClusters(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		bbox *storage.BoundingBox,
+		filter *storage.VacancyFilter,
+		cellSize float64,
+	) ([]*storage.VacancyCluster, error)
+	TxGetVacancyFacets(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		filter *storage.VacancyFilter,
+		salaryBounds []int32,
+	) (*storage.VacancyFacets, error)
+	TxPutVacancyStatus(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		status storage.VacancyStatus,
+		publishedAt *time.Time,
+		expiresAt *time.Time,
+	) error
+	TxCloseExpiredVacancies(ctx context.Context, tx pkgtx.Tx, now time.Time, limit int) ([]string, error)
+	TxGetVacanciesCounters(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyIDs []string,
+	) (map[string]*storage.VacancyCounters, error)
 	TxDeleteVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) error
 
 	TxGetVacancyCities(
@@ -89,24 +209,205 @@ type Storage interface {
 	) error
 	TxDeleteVacancyCities(ctx context.Context, tx pkgtx.Tx, vacancyID string) error
 
+	TxPutVacancyAlert(ctx context.Context, tx pkgtx.Tx, alert *storage.VacancyAlert) error
+	TxGetVacancyAlerts(ctx context.Context, tx pkgtx.Tx, accountID string) ([]*storage.VacancyAlert, error)
+	TxLockAccountVacancyAlerts(ctx context.Context, tx pkgtx.Tx, accountID string) error
+	TxCountVacancyAlerts(ctx context.Context, tx pkgtx.Tx, accountID string) (int, error)
+	TxDeleteVacancyAlert(ctx context.Context, tx pkgtx.Tx, accountID string, alertID string) error
+	TxMatchVacancyAlerts(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		matchedAt time.Time,
+	) ([]*storage.VacancyAlertMatch, error)
+
+	TxPutSavedVacancy(ctx context.Context, tx pkgtx.Tx, accountID string, vacancyID string, savedAt time.Time) error
+	TxDeleteSavedVacancy(ctx context.Context, tx pkgtx.Tx, accountID string, vacancyID string) error
+	TxCountSavedVacancies(ctx context.Context, tx pkgtx.Tx, accountID string) (int, error)
+	TxGetSavedVacancies(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		accountID string,
+		limit int,
+		cursor *storage.Cursor,
+	) ([]*storage.Vacancy, *storage.Cursor, error)
+	TxGetSavedVacanciesIDs(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		accountID string,
+		vacancyIDs []string,
+	) (map[string]bool, error)
+
+	TxPutVacancyEvents(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		eventType storage.VacancyEventType,
+		viewerKey string,
+		vacancyIDs []string,
+		at time.Time,
+	) error
+	TxLockVacancyStatsRollup(ctx context.Context, tx pkgtx.Tx) (time.Time, error)
+	TxPutVacancyStatsRollup(ctx context.Context, tx pkgtx.Tx, rolledUpTill time.Time) error
+	TxRollupVacancyStats(ctx context.Context, tx pkgtx.Tx, since time.Time) (int, error)
+	TxGetVacancyStats(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		from time.Time,
+		till time.Time,
+		step time.Duration,
+	) ([]*storage.VacancyStatsPoint, error)
+	TxHasExchangeRate(ctx context.Context, tx pkgtx.Tx, currency string) (bool, error)
+
+	TxPutVacancyNeedsReview(ctx context.Context, tx pkgtx.Tx, vacancyID string, needsReview bool) error
+	TxPutVacancyModerationDecision(ctx context.Context, tx pkgtx.Tx, d *storage.VacancyModerationDecision) error
+	TxGetVacancyModerationDecisions(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+	) ([]*storage.VacancyModerationDecision, error)
+	TxGetModerationQueue(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		limit int,
+		cursor *storage.Cursor,
+	) ([]*storage.ModerationQueueItem, *storage.Cursor, error)
+	TxHasVacancyDuplicate(ctx context.Context, tx pkgtx.Tx, vd *storage.VacancyDetails) (bool, error)
+
+	TxFindVacancyOriginal(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vd *storage.VacancyDetails,
+		cityIDs []string,
+		minSimilarity float64,
+	) (*string, error)
+	TxGetVacancyDuplicateClusters(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		limit int,
+		cursor *storage.Cursor,
+	) ([]*storage.DuplicateCluster, *storage.Cursor, error)
+
+	TxGetVacancyIDByExternalID(ctx context.Context, tx pkgtx.Tx, companyID string, externalID string) (string, error)
+	TxPutVacancyExternalID(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		companyID string,
+		externalID string,
+		vacancyID string,
+	) error
+	TxGetVacancyCategoriesIDsByTitles(ctx context.Context, tx pkgtx.Tx, titles []string) (map[string]string, error)
+	TxGetCitiesIDsByNames(ctx context.Context, tx pkgtx.Tx, names []string) (map[string]string, error)
+
+	TxGetLiveVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*storage.LiveVacancy, error)
+	TxGetLiveVacancies(ctx context.Context, tx pkgtx.Tx, offset int, limit int) ([]*storage.LiveVacancy, error)
+	TxCountLiveVacancies(ctx context.Context, tx pkgtx.Tx) (int, error)
+	TxGetFeedCompanies(ctx context.Context, tx pkgtx.Tx, companyIDs []string) ([]*storage.FeedCompany, error)
+
+	TxPutVacancyRevision(ctx context.Context, tx pkgtx.Tx, r *storage.VacancyRevision) error
+	TxGetVacancyRevision(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		revision *int32,
+	) (*storage.VacancyRevision, error)
+	TxGetVacancyRevisions(ctx context.Context, tx pkgtx.Tx, vacancyID string) ([]*storage.VacancyRevision, error)
+
+	TxPutVacancySchedule(ctx context.Context, tx pkgtx.Tx, vacancyID string, publishAt *time.Time) error
+	TxGetDueScheduledVacancies(ctx context.Context, tx pkgtx.Tx, now time.Time, limit int) ([]string, error)
+	TxBumpVacancy(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		now time.Time,
+		maxBumps int,
+		bumpedBefore time.Time,
+	) error
+
+	TxGetVacancySimilarity(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*storage.SimilarVacancy, error)
+	TxGetSimilarVacancyCandidates(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		vacancyID string,
+		limit int,
+	) ([]*storage.SimilarVacancy, error)
+	TxGetLiveVacanciesByIDs(ctx context.Context, tx pkgtx.Tx, vacancyIDs []string) ([]*storage.Vacancy, error)
+
 	BeginTx(ctx context.Context) (pkgtx.Tx, error)
 	NoTx() pkgtx.Tx
 }
 
+// Notifier enqueues the notifications about the vacancy matching a saved alert of the account
+// and about the moderation decisions on the vacancies of the company.
+type Notifier interface {
+	TxNotifyVacancyAlert(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		accountID string,
+		alertID string,
+		vacancyID string,
+		vacancyTitle string,
+	) error
+	TxNotifyVacancyModeration(
+		ctx context.Context,
+		tx pkgtx.Tx,
+		accountID string,
+		vacancyID string,
+		vacancyTitle string,
+		decision string,
+		reasons []string,
+		comment string,
+	) error
+}
+
+// FacetsCache keeps the computed vacancy facets for a while, see redis.DefaultStorage.
+type FacetsCache interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+// EventEmitter stores a domain event in the transactional outbox.
+type EventEmitter interface {
+	TxEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error
+}
+
 type Controller struct {
-	s Storage
+	cfg      *Config
+	s        Storage
+	notifier Notifier
+	emitter  EventEmitter
+	cache    FacetsCache
 }
 
-func New(s Storage) *Controller {
-	return &Controller{s: s}
+// New creates the controller. Vacancy alerts are still matched and moderated when notifier is nil,
+// but nobody is notified.
+// Domain events are dropped when emitter is nil. Vacancy facets are computed on every call when cache is nil.
+func New(cfg *Config, s Storage, notifier Notifier, emitter EventEmitter, cache FacetsCache) *Controller {
+	return &Controller{cfg: cfg, s: s, notifier: notifier, emitter: emitter, cache: cache}
+}
+
+func (c *Controller) txEmit(ctx context.Context, tx pkgtx.Tx, e *event.Event) error {
+	if c.emitter == nil {
+		return nil
+	}
+
+	return errors.WithStack(c.emitter.TxEmit(ctx, tx, e))
 }
 
 type VacancyCategoryID string
 
 type VacancyCategory struct {
-	ID      string
-	Title   string `valid:"stringlength(2|50)"`
-	IconURL string `valid:"stringlength(10|255),media_link"`
+	ID string
+	// ParentID is nil for a root category. ParentID, Path and Position are set by PutVacancyCategory
+	// on creation only, see MoveVacancyCategory.
+	ParentID *string
+	Path     string
+	Position int32
+	// Title is the default title, the one of the preferred locale when the category is read.
+	// Titles are the localized titles by their lowercased BCP 47 locales.
+	Title   string            `valid:"stringlength(2|50)"`
+	Titles  map[string]string `valid:"-"`
+	IconURL string            `valid:"stringlength(10|255),media_link"`
 	Rating  int32
 }
 
@@ -121,6 +422,17 @@ type Vacancy struct {
 	MaxSalary int32    `valid:"range(0|1000000000),required"`
 	ImageURLs []string `valid:"stringlength(0|255),media_link"`
 	CompanyID string   `valid:"required"`
+	// Currency of the salary range is UAH and SalaryPeriod is a month when empty. SalaryGross tells whether
+	// the salary is before taxes, nil when not specified.
+	Currency     Currency
+	SalaryPeriod SalaryPeriod
+	SalaryGross  *bool
+	// Distance in meters, set when the vacancies are looked up around a point
+	Distance *float64
+	// Status, PublishedAt and ExpiresAt are ignored by PutVacancy, see PublishVacancy.
+	Status      VacancyStatus
+	PublishedAt *time.Time
+	ExpiresAt   *time.Time
 }
 
 type VacancyDetails struct {
@@ -133,6 +445,13 @@ type VacancyDetails struct {
 	Type                 VacancyType
 	Address              string
 	CountryCode          int32
+	// DuplicateOf is the vacancy this one reposts, see Config.DuplicatePolicy
+	DuplicateOf *string
+	// ScheduledPublishAt, BumpedAt and BumpCount are ignored by PutVacancy, see ScheduleVacancyPublish
+	// and BumpVacancy.
+	ScheduledPublishAt *time.Time
+	BumpedAt           *time.Time
+	BumpCount          int32
 }
 
 type VacancyCategoryShort struct {
@@ -170,7 +489,7 @@ func (vc *VacancyCategory) validate() error {
 
 	if valid, err := govalidator.ValidateStruct(vc); !valid {
 		for _, fe := range fieldErrors {
-			if msg := govalidator.ErrorByField(err, fe.Field); msg != "" {
+			if govalidator.ErrorByField(err, fe.Field) != "" {
 				return errors.WithStack(fe.DefaultError)
 			}
 		}
@@ -183,7 +502,7 @@ func (vc *VacancyCategory) validate() error {
 
 func (vd *VacancyDetails) validate() error {
 	if vd == nil {
-		return ErrInvalidVacancy
+		return errors.WithStack(ErrInvalidVacancy)
 	}
 
 	var fieldErrors = []struct {
@@ -205,14 +524,22 @@ func (vd *VacancyDetails) validate() error {
 
 	if valid, err := govalidator.ValidateStruct(vd); !valid {
 		for _, fe := range fieldErrors {
-			if msg := govalidator.ErrorByField(err, fe.Field); msg != "" {
-				return errors.Wrap(fe.DefaultError, msg)
+			if govalidator.ErrorByField(err, fe.Field) != "" {
+				return errors.WithStack(fe.DefaultError)
 			}
 		}
 
 		return errors.New("vacancy details struct is filled with some invalid data")
 	}
 
+	if !vd.Currency.isValid() {
+		return errors.WithStack(ErrInvalidVacancyCurrency)
+	}
+
+	if _, err := toStorageSalaryPeriod(vd.SalaryPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -238,6 +565,8 @@ func fromStorageVacancyType(vt storage.VacancyType) (VacancyType, error) {
 	}
 }
 
+// PutVacancyCategory creates the category under its parent, the last of its children, or updates
+// the titles, the icon and the rating of the category. The localized titles are replaced.
 func (c *Controller) PutVacancyCategory(
 	ctx context.Context,
 	categoryID *string,
@@ -250,6 +579,16 @@ func (c *Controller) PutVacancyCategory(
 	}
 
 	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		now := time.Now()
+
+		svc := storage.VacancyCategory{
+			Title:     category.Title,
+			IconURL:   category.IconURL,
+			Rating:    category.Rating,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
 		if categoryID != nil {
 			switch _, err := c.s.TxGetVacancyCategory(ctx, tx, *categoryID); errors.Cause(err) {
 			case nil:
@@ -261,20 +600,34 @@ func (c *Controller) PutVacancyCategory(
 			}
 		} else {
 			ID = VacancyCategoryID(uuid.NewV4().String())
+
+			path, err := c.txVacancyCategoryPath(ctx, tx, string(ID), category.ParentID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			children, err := c.s.TxGetVacancyCategoryChildrenIDs(ctx, tx, category.ParentID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			svc.ParentID = category.ParentID
+			svc.Path = path
+			svc.Position = int32(len(children))
 		}
 
-		now := time.Now()
+		svc.ID = string(ID)
 
-		svc := storage.VacancyCategory{
-			ID:        string(ID),
-			Title:     category.Title,
-			IconURL:   category.IconURL,
-			Rating:    category.Rating,
-			CreatedAt: now,
-			UpdatedAt: now,
+		titles, err := toStorageVacancyCategoryTitles(svc.ID, category.Titles)
+		if err != nil {
+			return errors.WithStack(err)
 		}
 
-		return errors.WithStack(c.s.TxPutVacancyCategory(ctx, tx, &svc))
+		if err := c.s.TxPutVacancyCategory(ctx, tx, &svc); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.s.TxPutVacancyCategoryTitles(ctx, tx, svc.ID, titles))
 	}); err != nil {
 		return ID, errors.WithStack(err)
 	}
@@ -282,7 +635,12 @@ func (c *Controller) PutVacancyCategory(
 	return ID, nil
 }
 
-func (c *Controller) GetVacancyCategory(ctx context.Context, categoryID string) (*VacancyCategory, error) {
+// GetVacancyCategory returns the category titled in the first of the locales it's translated to.
+func (c *Controller) GetVacancyCategory(
+	ctx context.Context,
+	categoryID string,
+	locales []string,
+) (*VacancyCategory, error) {
 	vc, err := c.s.TxGetVacancyCategory(ctx, c.s.NoTx(), categoryID)
 
 	switch errors.Cause(err) {
@@ -291,33 +649,49 @@ func (c *Controller) GetVacancyCategory(ctx context.Context, categoryID string)
 		return nil, errors.WithStack(err)
 	}
 
-	return &VacancyCategory{
-		ID:      vc.ID,
-		Title:   vc.Title,
-		IconURL: vc.IconURL,
-		Rating:  vc.Rating,
-	}, nil
+	titles, err := c.txGetVacancyCategoriesTitles(ctx, c.s.NoTx(), []string{vc.ID})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return fromStorageVacancyCategory(vc, titles[vc.ID], locales), nil
 }
 
-func (c *Controller) GetVacanciesCategoriesList(ctx context.Context, rating *int32) ([]*VacancyCategory, error) {
+// GetVacanciesCategoriesList returns the categories rated not less than the rating, the descendants
+// of the parent only when it's given. The categories are in the depth-first order of the tree, a parent
+// comes before its subtree and the children of a parent are ordered by their position. The categories
+// are titled as GetVacancyCategory does.
+func (c *Controller) GetVacanciesCategoriesList(
+	ctx context.Context,
+	rating *int32,
+	parentID *string,
+	locales []string,
+) ([]*VacancyCategory, error) {
 	var r int32 = 0
 	if rating != nil {
 		r = *rating
 	}
 
-	vcs, err := c.s.TxGetVacanciesCategoriesList(ctx, c.s.NoTx(), r)
+	vcs, err := c.s.TxGetVacanciesCategoriesList(ctx, c.s.NoTx(), r, parentID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vcs = sortVacancyCategoriesTree(vcs)
+
+	ids := make([]string, len(vcs))
+	for idx, vc := range vcs {
+		ids[idx] = vc.ID
+	}
+
+	titles, err := c.txGetVacancyCategoriesTitles(ctx, c.s.NoTx(), ids)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	cvcs := make([]*VacancyCategory, len(vcs))
 	for idx, vc := range vcs {
-		cvcs[idx] = &VacancyCategory{
-			ID:      vc.ID,
-			Title:   vc.Title,
-			IconURL: vc.IconURL,
-			Rating:  vc.Rating,
-		}
+		cvcs[idx] = fromStorageVacancyCategory(vc, titles[vc.ID], locales)
 	}
 
 	return cvcs, nil
@@ -328,6 +702,15 @@ func (c *Controller) DeleteVacancyCategory(
 	categoryID string,
 ) error {
 	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		children, err := c.s.TxGetVacancyCategoryChildrenIDs(ctx, tx, &categoryID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if len(children) > 0 {
+			return errors.WithStack(ErrVacancyCategoryHasChildren)
+		}
+
 		switch err := c.s.TxDeleteVacancyCategory(ctx, tx, categoryID); errors.Cause(err) {
 		case nil:
 		case storage.ErrNotFound:
@@ -346,6 +729,7 @@ func (c *Controller) DeleteVacancyCategory(
 
 func (c *Controller) PutVacancy(
 	ctx context.Context,
+	authorID string,
 	vacancyID *string,
 	vacancy *VacancyDetails,
 	categoryIDs []string,
@@ -358,53 +742,125 @@ func (c *Controller) PutVacancy(
 	}
 
 	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
-		// Look for vacancy id
-		if vacancyID != nil {
-			switch _, err := c.s.TxGetVacancyDetails(ctx, tx, *vacancyID); errors.Cause(err) {
-			case nil:
-				vid = VacancyID(*vacancyID)
-			case storage.ErrNotFound:
-				return errors.WithStack(ErrVacancyNotFound)
-			default:
-				return errors.WithStack(err)
+		var err error
+		vid, err = c.txPutVacancy(ctx, tx, &authorID, vacancyID, vacancy, categoryIDs, cityIDs)
+
+		return errors.WithStack(err)
+	}); err != nil {
+		return vid, errors.WithStack(err)
+	}
+
+	return vid, nil
+}
+
+// txPutVacancy puts the validated vacancy with its categories, cities and images and keeps its revision.
+func (c *Controller) txPutVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	authorID *string,
+	vacancyID *string,
+	vacancy *VacancyDetails,
+	categoryIDs []string,
+	cityIDs []string,
+) (VacancyID, error) {
+	var vid VacancyID
+
+	var existing *storage.VacancyDetails
+
+	// Look for vacancy id
+	if vacancyID != nil {
+		switch vd, err := c.s.TxGetVacancyDetails(ctx, tx, *vacancyID); errors.Cause(err) {
+		case nil:
+			if vd.CompanyID != vacancy.CompanyID {
+				return vid, errors.WithStack(ErrVacancyCompanyChanged)
 			}
-		} else {
-			vid = VacancyID(uuid.NewV4().String())
-		}
 
-		vacancyType, err := toStorageVacancyType(vacancy.Type)
-		if err != nil {
-			return errors.WithStack(err)
+			existing = vd
+			vid = VacancyID(*vacancyID)
+		case storage.ErrNotFound:
+			return vid, errors.WithStack(ErrVacancyNotFound)
+		default:
+			return vid, errors.WithStack(err)
 		}
+	} else {
+		vid = VacancyID(uuid.NewV4().String())
+	}
+
+	vacancyType, err := toStorageVacancyType(vacancy.Type)
+	if err != nil {
+		return vid, errors.WithStack(err)
+	}
 
-		v := toStorageVacancyDetails(string(vid), vacancyType, vacancy)
+	salaryPeriod, err := toStorageSalaryPeriod(vacancy.SalaryPeriod)
+	if err != nil {
+		return vid, errors.WithStack(err)
+	}
 
-		// Update vacancy
-		if err := c.s.TxPutVacancy(ctx, tx, v); err != nil {
-			return errors.WithStack(err)
-		}
+	v := toStorageVacancyDetails(string(vid), vacancyType, salaryPeriod, vacancy)
+	v.NeedsReview = c.cfg.ModerationEnabled
 
-		// Update vacancy categories
-		if err := updateVacancyCategories(ctx, tx, c, vid, categoryIDs); err != nil {
-			return errors.WithStack(err)
-		}
+	if err := c.txLinkVacancyDuplicate(ctx, tx, v, cityIDs); err != nil {
+		return vid, errors.WithStack(err)
+	}
 
-		// Update vacancy cities
-		if err := updateVacancyCities(ctx, tx, c, vid, cityIDs); err != nil {
-			return errors.WithStack(err)
-		}
+	// Update vacancy
+	if err := c.s.TxPutVacancy(ctx, tx, v); err != nil {
+		return vid, errors.WithStack(err)
+	}
 
-		// Update vacancy images
-		if err := updateVacancyImages(ctx, c, tx, vid, vacancy); err != nil {
-			return errors.WithStack(err)
+	// Update vacancy categories
+	if err := updateVacancyCategories(ctx, tx, c, vid, categoryIDs); err != nil {
+		return vid, errors.WithStack(err)
+	}
+
+	// Update vacancy cities
+	if err := updateVacancyCities(ctx, tx, c, vid, cityIDs); err != nil {
+		return vid, errors.WithStack(err)
+	}
+
+	// Update vacancy images
+	if err := updateVacancyImages(ctx, c, tx, vid, vacancy); err != nil {
+		return vid, errors.WithStack(err)
+	}
+
+	if err := c.txPutVacancyRevision(ctx, tx, authorID, v, categoryIDs, cityIDs, vacancy.ImageURLs); err != nil {
+		return vid, errors.WithStack(err)
+	}
+
+	// Put the edited live or pending vacancy on review again
+	if c.needsReview(v) && existing != nil &&
+		(existing.Status == storage.VacancyStatusPublished || existing.Status == storage.VacancyStatusPendingReview) {
+		v.Status = existing.Status
+		v.PublishedAt = existing.PublishedAt
+		v.ExpiresAt = existing.ExpiresAt
+
+		if err := c.txSubmitVacancy(ctx, tx, v, existing.ExpiresAt); err != nil {
+			return vid, errors.WithStack(err)
 		}
+	}
 
-		return nil
-	}); err != nil {
+	// Notify saved searches which match the vacancy
+	if err := c.txNotifyVacancyAlerts(ctx, tx, vid, vacancy.Title); err != nil {
 		return vid, errors.WithStack(err)
 	}
 
-	return vid, nil
+	ev := &apievent.Vacancy{
+		Id:            string(vid),
+		CompanyId:     vacancy.CompanyID,
+		Title:         vacancy.Title,
+		MinSalary:     vacancy.MinSalary,
+		MaxSalary:     vacancy.MaxSalary,
+		CategoriesIds: categoryIDs,
+		CitiesIds:     cityIDs,
+		Currency:      v.Currency,
+		SalaryPeriod:  string(vacancy.SalaryPeriod.orDefault()),
+	}
+
+	if vacancyID == nil {
+		return vid, errors.WithStack(c.txEmit(ctx, tx, event.VacancyCreated(&apievent.VacancyCreatedV1{Vacancy: ev})))
+	}
+
+	return vid, errors.WithStack(c.txEmit(ctx, tx, event.VacancyUpdated(&apievent.VacancyUpdatedV1{Vacancy: ev})))
 }
 
 func updateVacancyCategories(
@@ -495,20 +951,40 @@ func (c *Controller) GetVacancyDetails(ctx context.Context, vacancyID string) (*
 		return nil, errors.WithStack(err)
 	}
 
+	return fromStorageVacancyDetails(vd, vi[vacancyID])
+}
+
+func fromStorageVacancyDetails(vd *storage.VacancyDetails, imageURLs []string) (*VacancyDetails, error) {
 	vacancyType, err := fromStorageVacancyType(vd.Type)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
+	status, err := fromStorageVacancyStatus(vd.Status)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	salaryPeriod, err := fromStorageSalaryPeriod(vd.SalaryPeriod)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	return &VacancyDetails{
 		Vacancy: Vacancy{
-			ID:        vd.ID,
-			Title:     vd.Title,
-			Phone:     vd.Phone,
-			MinSalary: vd.MinSalary,
-			MaxSalary: vd.MaxSalary,
-			ImageURLs: vi[vacancyID],
-			CompanyID: vd.CompanyID,
+			ID:           vd.ID,
+			Title:        vd.Title,
+			Phone:        vd.Phone,
+			MinSalary:    vd.MinSalary,
+			MaxSalary:    vd.MaxSalary,
+			Currency:     Currency(vd.Currency),
+			SalaryPeriod: salaryPeriod,
+			SalaryGross:  vd.SalaryGross,
+			ImageURLs:    imageURLs,
+			CompanyID:    vd.CompanyID,
+			Status:       status,
+			PublishedAt:  vd.PublishedAt,
+			ExpiresAt:    vd.ExpiresAt,
 		},
 		Description:          vd.Description,
 		WorkMonthsExperience: vd.WorkMonthsExperience,
@@ -518,17 +994,43 @@ func (c *Controller) GetVacancyDetails(ctx context.Context, vacancyID string) (*
 		Type:                 vacancyType,
 		Address:              vd.Address,
 		CountryCode:          vd.CountryCode,
+		DuplicateOf:          vd.DuplicateOf,
+		ScheduledPublishAt:   vd.ScheduledPublishAt,
+		BumpedAt:             vd.BumpedAt,
+		BumpCount:            vd.BumpCount,
 	}, nil
 }
 
+// GetVacanciesList returns the filtered vacancies in the sort order. When it's empty, the vacancies
+// are ordered by distance if the filter has near set, or the newest first otherwise.
 func (c *Controller) GetVacanciesList(
 	ctx context.Context,
-	categoriesIDs []string,
+	filter *VacancyListFilter,
+	sortOrder VacancySortOrder,
 	cursor *Cursor,
 	limit int,
 ) ([]*Vacancy, *Cursor, error) {
+	if filter == nil {
+		filter = &VacancyListFilter{}
+	}
+
+	if err := filter.validate(); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	if sortOrder == "" {
+		sortOrder = filter.defaultSortOrder()
+	}
+
+	if err := filter.validateSortOrder(sortOrder); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	filter = filter.normalize()
+
 	cursorData, err := toCursorData(cursor)
-	if err != nil || (cursorData != nil && !equal(cursorData.CategoriesIDs, categoriesIDs)) {
+	if err != nil || (cursorData != nil && (cursorData.Query != "" ||
+		cursorData.SortOrder != sortOrder || !equalListFilters(cursorData.Filter, filter))) {
 		return nil, nil, errors.WithStack(ErrInvalidCursor)
 	}
 
@@ -537,10 +1039,25 @@ func (c *Controller) GetVacanciesList(
 		limit = maxLimit
 	}
 
+	if err := c.txCheckExchangeRate(ctx, c.s.NoTx(), &filter.VacancyFilter); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	storageFilter, err := toStorageVacancyListFilter(filter)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	storageSortOrder, err := toStorageVacancySortOrder(sortOrder)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
 	vcs, storageCursor, err := c.s.TxGetVacanciesList(
 		ctx,
 		c.s.NoTx(),
-		categoriesIDs,
+		storageFilter,
+		storageSortOrder,
 		limit,
 		toStorageCursor(cursorData),
 	)
@@ -551,37 +1068,64 @@ func (c *Controller) GetVacanciesList(
 		return nil, nil, errors.WithStack(err)
 	}
 
-	controllerCursor, err := toCursor(storageCursor, categoriesIDs)
+	controllerCursor, err := toCursor(storageCursor, filter, sortOrder)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	controllerVacancies, err := c.fromStorageVacancies(ctx, vcs)
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
 	}
 
+	return controllerVacancies, controllerCursor, nil
+}
+
+// fromStorageVacancies converts the vacancies adding their images.
+func (c *Controller) fromStorageVacancies(ctx context.Context, vcs []*storage.Vacancy) ([]*Vacancy, error) {
 	vacancyIDs := extractVacancyIDs(vcs)
 	vacanciesImagesMap, err := c.s.TxGetVacanciesImages(ctx, c.s.NoTx(), vacancyIDs)
 
 	switch errors.Cause(err) {
 	case nil:
 	case storage.ErrNotFound:
-		return nil, nil, errors.WithStack(ErrVacancyImagesNotFound)
+		return nil, errors.WithStack(ErrVacancyImagesNotFound)
 	default:
-		return nil, nil, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	controllerVacancies := make([]*Vacancy, len(vcs))
 
 	for idx, v := range vcs {
+		status, err := fromStorageVacancyStatus(v.Status)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		salaryPeriod, err := fromStorageSalaryPeriod(v.SalaryPeriod)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
 		controllerVacancies[idx] = &Vacancy{
-			ID:        v.ID,
-			Title:     v.Title,
-			Phone:     v.Phone,
-			MinSalary: v.MinSalary,
-			MaxSalary: v.MaxSalary,
-			ImageURLs: vacanciesImagesMap[v.ID],
-			CompanyID: v.CompanyID,
+			ID:           v.ID,
+			Title:        v.Title,
+			Phone:        v.Phone,
+			MinSalary:    v.MinSalary,
+			MaxSalary:    v.MaxSalary,
+			Currency:     Currency(v.Currency),
+			SalaryPeriod: salaryPeriod,
+			SalaryGross:  v.SalaryGross,
+			ImageURLs:    vacanciesImagesMap[v.ID],
+			CompanyID:    v.CompanyID,
+			Distance:     v.Distance,
+			Status:       status,
+			PublishedAt:  v.PublishedAt,
+			ExpiresAt:    v.ExpiresAt,
 		}
 	}
 
-	return controllerVacancies, controllerCursor, nil
+	return controllerVacancies, nil
 }
 
 func extractVacancyIDs(vcs []*storage.Vacancy) []string {
@@ -658,7 +1202,7 @@ func (c *Controller) DeleteVacancy(
 			return errors.WithStack(err)
 		}
 
-		return nil
+		return errors.WithStack(c.txEmit(ctx, tx, event.VacancyDeleted(&apievent.VacancyDeletedV1{VacancyId: vacancyID})))
 	}); err != nil {
 		return errors.WithStack(err)
 	}
@@ -668,19 +1212,27 @@ func (c *Controller) DeleteVacancy(
 
 // Mappings
 
-func toStorageVacancyDetails(vid string, vacancyType storage.VacancyType, vd *VacancyDetails) *storage.VacancyDetails {
+func toStorageVacancyDetails(
+	vid string,
+	vacancyType storage.VacancyType,
+	salaryPeriod storage.SalaryPeriod,
+	vd *VacancyDetails,
+) *storage.VacancyDetails {
 	now := time.Now()
 
 	return &storage.VacancyDetails{
 		Vacancy: storage.Vacancy{
-			ID:        vid,
-			Title:     vd.Title,
-			Phone:     vd.Phone,
-			MinSalary: vd.MinSalary,
-			MaxSalary: vd.MaxSalary,
-			CompanyID: vd.CompanyID,
-			CreatedAt: now,
-			UpdatedAt: now,
+			ID:           vid,
+			Title:        vd.Title,
+			Phone:        vd.Phone,
+			MinSalary:    vd.MinSalary,
+			MaxSalary:    vd.MaxSalary,
+			Currency:     toStorageCurrency(vd.Currency),
+			SalaryPeriod: salaryPeriod,
+			SalaryGross:  vd.SalaryGross,
+			CompanyID:    vd.CompanyID,
+			CreatedAt:    now,
+			UpdatedAt:    now,
 		},
 		Description:          vd.Description,
 		WorkMonthsExperience: vd.WorkMonthsExperience,
@@ -12,9 +12,15 @@ import (
 	cityStorage "personaapp/internal/controllers/city/storage"
 	companyController "personaapp/internal/controllers/company/controller"
 	companyStorage "personaapp/internal/controllers/company/storage"
+	currencyController "personaapp/internal/controllers/currency/controller"
+	currencyStorage "personaapp/internal/controllers/currency/storage"
 	"personaapp/internal/controllers/vacancy/controller"
 	"personaapp/internal/controllers/vacancy/storage"
 	"personaapp/internal/testutils"
+	pkgtx "personaapp/pkg/tx"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -25,6 +31,12 @@ var authCfg = &authController.Config{
 	TokenValidityGap:  15 * time.Second,
 }
 
+var vacancyCfg = &controller.Config{
+	VacancyLifetime: 24 * time.Hour,
+	ExpiryInterval:  time.Minute,
+	ExpiryBatchSize: 10,
+}
+
 func initStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
 	pg := testutils.EnsurePostgres(t)
 	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
@@ -39,6 +51,13 @@ func initCompanyStorage(t *testing.T) (_ *companyStorage.Storage, closer func()
 	return companyStorage.New(pg), pg.Close
 }
 
+func initCurrencyStorage(t *testing.T) (_ *currencyStorage.Storage, closer func() error) {
+	pg := testutils.EnsurePostgres(t)
+	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
+
+	return currencyStorage.New(pg), pg.Close
+}
+
 func initCityStorage(t *testing.T) (_ *cityStorage.Storage, closer func() error) {
 	pg := testutils.EnsurePostgres(t)
 	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Up))
@@ -46,11 +65,195 @@ func initCityStorage(t *testing.T) (_ *cityStorage.Storage, closer func() error)
 	return cityStorage.New(pg), pg.Close
 }
 
+type alertNotification struct {
+	AccountID string
+	AlertID   string
+	VacancyID string
+}
+
+type moderationNotification struct {
+	AccountID string
+	VacancyID string
+	Decision  string
+	Reasons   []string
+}
+
+type alertRecorder struct {
+	notifications []alertNotification
+	moderations   []moderationNotification
+}
+
+func (r *alertRecorder) TxNotifyVacancyAlert(
+	_ context.Context,
+	_ pkgtx.Tx,
+	accountID string,
+	alertID string,
+	vacancyID string,
+	_ string,
+) error {
+	r.notifications = append(r.notifications, alertNotification{
+		AccountID: accountID,
+		AlertID:   alertID,
+		VacancyID: vacancyID,
+	})
+
+	return nil
+}
+
+func (r *alertRecorder) TxNotifyVacancyModeration(
+	_ context.Context,
+	_ pkgtx.Tx,
+	accountID string,
+	vacancyID string,
+	_ string,
+	decision string,
+	reasons []string,
+	_ string,
+) error {
+	r.moderations = append(r.moderations, moderationNotification{
+		AccountID: accountID,
+		VacancyID: vacancyID,
+		Decision:  decision,
+		Reasons:   reasons,
+	})
+
+	return nil
+}
+
+func categoriesFilter(categoriesIDs ...string) *controller.VacancyListFilter {
+	return &controller.VacancyListFilter{VacancyFilter: controller.VacancyFilter{CategoriesIDs: categoriesIDs}}
+}
+
 func cleanup(t *testing.T) {
 	pg := testutils.EnsurePostgres(t)
 	require.NoError(t, testutils.Migrate(pg.DB, sqlMigrate.Down))
 }
 
+// fixture holds the vacancy storage with the controllers putting the accounts and the cities the vacancies refer to.
+type fixture struct {
+	s  *storage.Storage
+	ac *authController.Controller
+	cc *companyController.Controller
+	cy *cityController.Controller
+}
+
+func initFixture(t *testing.T) (_ *fixture, closer func()) {
+	s, storageCloser := initStorage(t)
+	as, authCloser := testutils.InitAuthStorage(t)
+	cs, companyCloser := initCompanyStorage(t)
+	cys, cityCloser := initCityStorage(t)
+
+	f := &fixture{
+		s:  s,
+		ac: authController.New(authCfg, as, nil),
+		cc: companyController.New(cs, nil),
+		cy: cityController.New(cys),
+	}
+
+	return f, func() {
+		for _, closer := range []func() error{storageCloser, authCloser, companyCloser, cityCloser} {
+			if err := closer(); err != nil {
+				t.Error(err)
+			}
+		}
+		cleanup(t)
+	}
+}
+
+// register returns the id of the new account, a company is given a title.
+func (f *fixture) register(t *testing.T, email string, phone string, account authController.AccountType) string {
+	token, err := f.ac.Register(context.TODO(), &authController.RegisterData{
+		Email:    email,
+		Phone:    phone,
+		Account:  account,
+		Password: "Password1488",
+	})
+	require.NoError(t, err)
+
+	claims, err := f.ac.GetAuthClaims(context.TODO(), token.Token)
+	require.NoError(t, err)
+
+	if account == authController.AccountTypeCompany {
+		companyTitle := "Title"
+		require.NoError(t, f.cc.Update(context.TODO(), &companyController.CompanyData{
+			ID:    claims.AccountID,
+			Title: &companyTitle,
+		}))
+	}
+
+	return claims.AccountID
+}
+
+func (f *fixture) registerCompany(t *testing.T, email string, phone string) string {
+	return f.register(t, email, phone, authController.AccountTypeCompany)
+}
+
+func (f *fixture) putCity(t *testing.T, name string) string {
+	cityID, err := f.cy.PutCity(context.TODO(), nil, &cityController.City{Name: name})
+	require.NoError(t, err)
+
+	return string(cityID)
+}
+
+func putCategory(t *testing.T, c *controller.Controller, title string) string {
+	categoryID, err := c.PutVacancyCategory(context.TODO(), nil, &controller.VacancyCategory{Title: title})
+	require.NoError(t, err)
+
+	return string(categoryID)
+}
+
+// newVacancy returns a valid vacancy of the company, the tests change the fields they check.
+func newVacancy(companyID string, title string) *controller.VacancyDetails {
+	return &controller.VacancyDetails{
+		Vacancy: controller.Vacancy{
+			Title:     title,
+			Phone:     "+380503000002",
+			MinSalary: 10000,
+			MaxSalary: 20000,
+			CompanyID: companyID,
+		},
+		Description:          "Description",
+		WorkMonthsExperience: 12,
+		Type:                 controller.VacancyTypeNormal,
+	}
+}
+
+// putVacancy creates the draft of the vacancy on behalf of its company.
+func putVacancy(
+	t *testing.T,
+	c *controller.Controller,
+	vacancy *controller.VacancyDetails,
+	categoriesIDs []string,
+	cityIDs []string,
+) string {
+	vacancyID, err := c.PutVacancy(context.TODO(), vacancy.CompanyID, nil, vacancy, categoriesIDs, cityIDs)
+	require.NoError(t, err)
+
+	return string(vacancyID)
+}
+
+func publishVacancy(
+	t *testing.T,
+	c *controller.Controller,
+	vacancy *controller.VacancyDetails,
+	categoriesIDs []string,
+	cityIDs []string,
+) string {
+	vacancyID := putVacancy(t, c, vacancy, categoriesIDs, cityIDs)
+	require.NoError(t, c.PublishVacancy(context.TODO(), vacancyID, nil))
+
+	return vacancyID
+}
+
+func idsOf(vacancies []*controller.Vacancy) []string {
+	ids := make([]string, len(vacancies))
+	for idx, v := range vacancies {
+		ids[idx] = v.ID
+	}
+
+	return ids
+}
+
 func TestController_PutVacancyCategory(t *testing.T) {
 	s, closer := initStorage(t)
 	defer func() {
@@ -60,7 +263,7 @@ func TestController_PutVacancyCategory(t *testing.T) {
 		cleanup(t)
 	}()
 
-	c := controller.New(s)
+	c := controller.New(vacancyCfg, s, nil, nil, nil)
 
 	t.Run("create new vacancy category", func(t *testing.T) {
 		categoryToCreate := controller.VacancyCategory{
@@ -72,7 +275,7 @@ func TestController_PutVacancyCategory(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, ID)
 
-		createdCategory, err := c.GetVacancyCategory(context.TODO(), string(ID))
+		createdCategory, err := c.GetVacancyCategory(context.TODO(), string(ID), nil)
 		require.NoError(t, err)
 		require.NotNil(t, createdCategory)
 		require.Equal(t, categoryToCreate.Title, createdCategory.Title)
@@ -101,7 +304,7 @@ func TestController_PutVacancyCategory(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, ID, updatedID)
 
-		updatedCategory, err := c.GetVacancyCategory(context.TODO(), stringID)
+		updatedCategory, err := c.GetVacancyCategory(context.TODO(), stringID, nil)
 		require.NoError(t, err)
 		require.NotNil(t, updatedCategory)
 		require.Equal(t, string(ID), updatedCategory.ID)
@@ -164,9 +367,9 @@ func TestController_PutVacancy(t *testing.T) {
 		cleanup(t)
 	}()
 
-	c := controller.New(s)
-	ac := authController.New(authCfg, as)
-	cc := companyController.New(cs)
+	c := controller.New(vacancyCfg, s, nil, nil, nil)
+	ac := authController.New(authCfg, as, nil)
+	cc := companyController.New(cs, nil)
 	cy := cityController.New(cys)
 
 	t.Run("create new vacancy", func(t *testing.T) {
@@ -228,7 +431,10 @@ func TestController_PutVacancy(t *testing.T) {
 			Address:              "Trafalgar sq",
 			CountryCode:          0,
 		}
-		vacancyID, err := c.PutVacancy(context.TODO(), nil, &vacancy, []string{string(categoryID)}, []string{string(cityID)})
+		vacancyID, err := c.PutVacancy(
+			context.TODO(), claims.AccountID, nil, &vacancy,
+			[]string{string(categoryID)}, []string{string(cityID)},
+		)
 		require.NoError(t, err)
 		require.NotNil(t, vacancyID)
 
@@ -259,7 +465,7 @@ func TestController_GetVacanciesCategoriesList(t *testing.T) {
 		cleanup(t)
 	}()
 
-	c := controller.New(s)
+	c := controller.New(vacancyCfg, s, nil, nil, nil)
 
 	t.Run("get vacancies categories list", func(t *testing.T) {
 		count := 5
@@ -277,7 +483,7 @@ func TestController_GetVacanciesCategoriesList(t *testing.T) {
 			categoriesMap[string(ID)] = category
 		}
 
-		categories, err := c.GetVacanciesCategoriesList(context.TODO(), nil)
+		categories, err := c.GetVacanciesCategoriesList(context.TODO(), nil, nil, nil)
 		require.NoError(t, err)
 		require.Equal(t, count, len(categories))
 
@@ -291,7 +497,7 @@ func TestController_GetVacanciesCategoriesList(t *testing.T) {
 		popularCategoriesCount := 2
 		rating := new(int32)
 		*rating = 1
-		categories, err = c.GetVacanciesCategoriesList(context.TODO(), rating)
+		categories, err = c.GetVacanciesCategoriesList(context.TODO(), rating, nil, nil)
 		require.NoError(t, err)
 		require.Equal(t, popularCategoriesCount, len(categories))
 	})
@@ -319,9 +525,9 @@ func TestController_GetVacanciesList(t *testing.T) {
 		cleanup(t)
 	}()
 
-	c := controller.New(s)
-	ac := authController.New(authCfg, as)
-	cc := companyController.New(cs)
+	c := controller.New(vacancyCfg, s, nil, nil, nil)
+	ac := authController.New(authCfg, as, nil)
+	cc := companyController.New(cs, nil)
 	cy := cityController.New(cys)
 
 	t.Run("get vacancies list", func(t *testing.T) {
@@ -406,16 +612,20 @@ func TestController_GetVacanciesList(t *testing.T) {
 				CountryCode:          0,
 			}
 
-			ID, err := c.PutVacancy(context.TODO(), nil, vacancy, []string{categoriesIDs[i], categoriesIDs[i+1]}, []string{citiesIDs[i]})
+			ID, err := c.PutVacancy(
+				context.TODO(), claims.AccountID, nil, vacancy,
+				[]string{categoriesIDs[i], categoriesIDs[i+1]}, []string{citiesIDs[i]},
+			)
 			require.NoError(t, err)
 			require.NotNil(t, ID)
+			require.NoError(t, c.PublishVacancy(context.TODO(), string(ID), nil))
 
 			vacanciesMap[string(ID)] = vacancy
 			vacanciesIDs[i] = string(ID)
 		}
 
 		t.Run("get all without filters", func(t *testing.T) {
-			vacancies, _, err := c.GetVacanciesList(context.TODO(), []string{}, nil, 100)
+			vacancies, _, err := c.GetVacanciesList(context.TODO(), categoriesFilter(), "", nil, 100)
 			require.NoError(t, err)
 			// Check vacancies
 			require.Equal(t, 3, len(vacancies))
@@ -451,37 +661,37 @@ func TestController_GetVacanciesList(t *testing.T) {
 		})
 
 		t.Run("get all with invalid filter", func(t *testing.T) {
-			vacancies, _, err := c.GetVacanciesList(context.TODO(), []string{uuid.NewV4().String()}, nil, 100)
+			vacancies, _, err := c.GetVacanciesList(context.TODO(), categoriesFilter(uuid.NewV4().String()), "", nil, 100)
 			require.NoError(t, err)
 			require.Equal(t, 0, len(vacancies))
 		})
 
 		t.Run("get all with invalid cursor", func(t *testing.T) {
 			cursor := controller.Cursor("invalid cursor")
-			_, _, err := c.GetVacanciesList(context.TODO(), []string{}, &cursor, 100)
+			_, _, err := c.GetVacanciesList(context.TODO(), categoriesFilter(), "", &cursor, 100)
 			require.Error(t, err)
 			require.EqualError(t, controller.ErrInvalidCursor, err.Error())
 		})
 
 		t.Run("get one with changed categories for cursor", func(t *testing.T) {
-			_, cursor, err := c.GetVacanciesList(context.TODO(), []string{}, nil, 1)
+			_, cursor, err := c.GetVacanciesList(context.TODO(), categoriesFilter(), "", nil, 1)
 			require.NoError(t, err)
 			require.NotNil(t, cursor)
 
-			_, _, err = c.GetVacanciesList(context.TODO(), []string{categoriesIDs[1]}, cursor, 1)
+			_, _, err = c.GetVacanciesList(context.TODO(), categoriesFilter(categoriesIDs[1]), "", cursor, 1)
 			require.Error(t, err)
 			require.EqualError(t, controller.ErrInvalidCursor, err.Error())
 		})
 
 		t.Run("get all by one category filter", func(t *testing.T) {
-			vacancies, _, err := c.GetVacanciesList(context.TODO(), []string{categoriesIDs[0]}, nil, 100)
+			vacancies, _, err := c.GetVacanciesList(context.TODO(), categoriesFilter(categoriesIDs[0]), "", nil, 100)
 			require.NoError(t, err)
 			require.Equal(t, 1, len(vacancies))
 			require.Equal(t, vacanciesIDs[0], vacancies[0].ID)
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 1), vacancies[0].ImageURLs[0])
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 2), vacancies[0].ImageURLs[1])
 
-			vacancies, _, err = c.GetVacanciesList(context.TODO(), []string{categoriesIDs[1]}, nil, 100)
+			vacancies, _, err = c.GetVacanciesList(context.TODO(), categoriesFilter(categoriesIDs[1]), "", nil, 100)
 			require.NoError(t, err)
 			require.Equal(t, 2, len(vacancies))
 			require.Equal(t, vacanciesIDs[1], vacancies[0].ID)
@@ -490,7 +700,7 @@ func TestController_GetVacanciesList(t *testing.T) {
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 1), vacancies[1].ImageURLs[0])
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 2), vacancies[1].ImageURLs[1])
 
-			vacancies, _, err = c.GetVacanciesList(context.TODO(), []string{categoriesIDs[2]}, nil, 100)
+			vacancies, _, err = c.GetVacanciesList(context.TODO(), categoriesFilter(categoriesIDs[2]), "", nil, 100)
 			require.NoError(t, err)
 			require.Equal(t, 2, len(vacancies))
 			require.Equal(t, vacanciesIDs[2], vacancies[0].ID)
@@ -499,7 +709,7 @@ func TestController_GetVacanciesList(t *testing.T) {
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 2), vacancies[1].ImageURLs[0])
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 3), vacancies[1].ImageURLs[1])
 
-			vacancies, _, err = c.GetVacanciesList(context.TODO(), []string{categoriesIDs[3]}, nil, 100)
+			vacancies, _, err = c.GetVacanciesList(context.TODO(), categoriesFilter(categoriesIDs[3]), "", nil, 100)
 			require.NoError(t, err)
 			require.Equal(t, 1, len(vacancies))
 			require.Equal(t, vacanciesIDs[2], vacancies[0].ID)
@@ -508,7 +718,7 @@ func TestController_GetVacanciesList(t *testing.T) {
 		})
 
 		t.Run("get 1 vacancy without filter and test pagination", func(t *testing.T) {
-			vacancies, cursor, err := c.GetVacanciesList(context.TODO(), []string{}, nil, 1)
+			vacancies, cursor, err := c.GetVacanciesList(context.TODO(), categoriesFilter(), "", nil, 1)
 			require.NoError(t, err)
 			require.NotNil(t, cursor)
 			require.Equal(t, 1, len(vacancies))
@@ -516,7 +726,7 @@ func TestController_GetVacanciesList(t *testing.T) {
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 3), vacancies[0].ImageURLs[0])
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 4), vacancies[0].ImageURLs[1])
 
-			vacancies, cursor, err = c.GetVacanciesList(context.TODO(), []string{}, cursor, 1)
+			vacancies, cursor, err = c.GetVacanciesList(context.TODO(), categoriesFilter(), "", cursor, 1)
 			require.NoError(t, err)
 			require.NotNil(t, cursor)
 			require.Equal(t, 1, len(vacancies))
@@ -524,7 +734,7 @@ func TestController_GetVacanciesList(t *testing.T) {
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 2), vacancies[0].ImageURLs[0])
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 3), vacancies[0].ImageURLs[1])
 
-			vacancies, cursor, err = c.GetVacanciesList(context.TODO(), []string{}, cursor, 1)
+			vacancies, cursor, err = c.GetVacanciesList(context.TODO(), categoriesFilter(), "", cursor, 1)
 			require.NoError(t, err)
 			require.NotNil(t, cursor)
 			require.Equal(t, 1, len(vacancies))
@@ -532,10 +742,2096 @@ func TestController_GetVacanciesList(t *testing.T) {
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 1), vacancies[0].ImageURLs[0])
 			require.Equal(t, fmt.Sprintf(imagePlaceholder, 2), vacancies[0].ImageURLs[1])
 
-			vacancies, cursor, err = c.GetVacanciesList(context.TODO(), []string{}, cursor, 1)
+			vacancies, cursor, err = c.GetVacanciesList(context.TODO(), categoriesFilter(), "", cursor, 1)
 			require.NoError(t, err)
 			require.Nil(t, cursor)
 			require.Equal(t, 0, len(vacancies))
 		})
 	})
 }
+
+// nolint:funlen // integration scenario
+func TestController_VacancyAlerts(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	notifier := &alertRecorder{}
+	c := controller.New(vacancyCfg, f.s, notifier, nil, nil)
+
+	companyID := f.registerCompany(t, "alerts.company@gmail.com", "+380503000010")
+	personaID := f.register(t, "alerts.persona@gmail.com", "+380503000011", authController.AccountTypePersona)
+
+	categoryID := putCategory(t, c, "Alerts category")
+	cityID := f.putCity(t, "Kyiv")
+
+	alertVacancy := func(title string, minSalary int32, maxSalary int32) *controller.VacancyDetails {
+		vacancy := newVacancy(companyID, title)
+		vacancy.MinSalary = minSalary
+		vacancy.MaxSalary = maxSalary
+		vacancy.Description = "Backend development in Golang"
+		vacancy.Type = controller.VacancyTypeRemote
+
+		return vacancy
+	}
+
+	minSalary := int32(15000)
+	remote := controller.VacancyTypeRemote
+	filter := &controller.VacancyFilter{
+		CategoriesIDs: []string{categoryID},
+		CityIDs:       []string{cityID},
+		MinSalary:     &minSalary,
+		Type:          &remote,
+		Keywords:      "golang",
+	}
+
+	var alertID controller.VacancyAlertID
+
+	t.Run("create alert", func(t *testing.T) {
+		var err error
+
+		alertID, err = c.CreateVacancyAlert(context.TODO(), personaID, filter)
+		require.NoError(t, err)
+		require.NotEmpty(t, alertID)
+
+		alerts, err := c.ListVacancyAlerts(context.TODO(), personaID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		require.Equal(t, string(alertID), alerts[0].ID)
+		require.Equal(t, filter.CategoriesIDs, alerts[0].CategoriesIDs)
+		require.Equal(t, filter.CityIDs, alerts[0].CityIDs)
+		require.Equal(t, minSalary, *alerts[0].MinSalary)
+		require.Nil(t, alerts[0].MaxSalary)
+		require.Equal(t, remote, *alerts[0].Type)
+		require.Equal(t, filter.Keywords, alerts[0].Keywords)
+	})
+
+	t.Run("create invalid alerts", func(t *testing.T) {
+		_, err := c.CreateVacancyAlert(context.TODO(), personaID, &controller.VacancyFilter{})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidVacancyAlert.Error())
+
+		maxSalary := int32(1000)
+		_, err = c.CreateVacancyAlert(context.TODO(), personaID, &controller.VacancyFilter{
+			MinSalary: &minSalary,
+			MaxSalary: &maxSalary,
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidVacancyAlertSalary.Error())
+
+		unknown := controller.VacancyType("unknown")
+		_, err = c.CreateVacancyAlert(context.TODO(), personaID, &controller.VacancyFilter{Type: &unknown})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidVacancyAlertType.Error())
+
+		_, err = c.CreateVacancyAlert(context.TODO(), personaID, &controller.VacancyFilter{
+			CategoriesIDs: []string{"not uuid"},
+		})
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidVacancyAlertCategories.Error())
+	})
+
+	t.Run("notify matching vacancy once", func(t *testing.T) {
+		vacancy := alertVacancy("Golang developer", 10000, 20000)
+		vacancyID := putVacancy(t, c, vacancy, []string{categoryID}, []string{cityID})
+		require.Empty(t, notifier.notifications)
+
+		require.NoError(t, c.PublishVacancy(context.TODO(), vacancyID, nil))
+		require.Len(t, notifier.notifications, 1)
+		require.Equal(t, alertNotification{
+			AccountID: personaID,
+			AlertID:   string(alertID),
+			VacancyID: vacancyID,
+		}, notifier.notifications[0])
+
+		_, err := c.PutVacancy(context.TODO(), companyID, &vacancyID, vacancy, []string{categoryID}, []string{cityID})
+		require.NoError(t, err)
+		require.Len(t, notifier.notifications, 1)
+	})
+
+	t.Run("skip vacancies which don't match", func(t *testing.T) {
+		notifier.notifications = nil
+
+		publishVacancy(t, c, alertVacancy("Golang intern", 5000, 10000), []string{categoryID}, []string{cityID})
+		publishVacancy(t, c, alertVacancy("Golang developer", 10000, 20000), []string{categoryID}, nil)
+
+		require.Empty(t, notifier.notifications)
+	})
+
+	t.Run("delete alert", func(t *testing.T) {
+		err := c.DeleteVacancyAlert(context.TODO(), companyID, string(alertID))
+		require.EqualError(t, errors.Cause(err), controller.ErrVacancyAlertNotFound.Error())
+
+		require.NoError(t, c.DeleteVacancyAlert(context.TODO(), personaID, string(alertID)))
+
+		err = c.DeleteVacancyAlert(context.TODO(), personaID, string(alertID))
+		require.EqualError(t, errors.Cause(err), controller.ErrVacancyAlertNotFound.Error())
+
+		alerts, err := c.ListVacancyAlerts(context.TODO(), personaID)
+		require.NoError(t, err)
+		require.Empty(t, alerts)
+	})
+
+	t.Run("limit holds for concurrent alerts", func(t *testing.T) {
+		errs := make([]error, 25)
+
+		var wg sync.WaitGroup
+
+		for idx := range errs {
+			wg.Add(1)
+
+			go func(idx int) {
+				defer wg.Done()
+
+				_, errs[idx] = c.CreateVacancyAlert(context.TODO(), personaID, &controller.VacancyFilter{
+					Keywords: fmt.Sprintf("golang %d", idx),
+				})
+			}(idx)
+		}
+
+		wg.Wait()
+
+		exceeded := 0
+
+		for _, err := range errs {
+			if err != nil {
+				require.EqualError(t, errors.Cause(err), controller.ErrVacancyAlertsLimitExceeded.Error())
+				exceeded++
+			}
+		}
+
+		require.Equal(t, 5, exceeded)
+
+		alerts, err := c.ListVacancyAlerts(context.TODO(), personaID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 20)
+	})
+}
+
+func TestController_SearchVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "search.company@gmail.com", "+380503000020")
+
+	companyTitle := "Persona Soft"
+	require.NoError(t, f.cc.Update(context.TODO(), &companyController.CompanyData{
+		ID:    companyID,
+		Title: &companyTitle,
+	}))
+
+	publish := func(title string, description string) string {
+		vacancy := newVacancy(companyID, title)
+		vacancy.Description = description
+
+		return publishVacancy(t, c, vacancy, nil, nil)
+	}
+
+	golangID := publish("Golang developer", "Разработка сервисов на Go")
+	backendID := publish("Backend engineer", "Ищем разработчиков со знанием Golang")
+	designerID := publish("Дизайнер интерфейсов", "Мобильные приложения")
+
+	t.Run("rank title matches first", func(t *testing.T) {
+		rs, cursor, err := c.SearchVacancies(context.TODO(), "golang", nil, nil, 10)
+		require.NoError(t, err)
+		require.Nil(t, cursor)
+		require.Len(t, rs, 2)
+		require.Equal(t, golangID, rs[0].ID)
+		require.Equal(t, backendID, rs[1].ID)
+		require.Equal(t, "<b>Golang</b> developer", rs[0].TitleHighlight)
+		require.Contains(t, rs[1].DescriptionSnippet, "<b>Golang</b>")
+	})
+
+	t.Run("match word forms and prefixes", func(t *testing.T) {
+		rs, _, err := c.SearchVacancies(context.TODO(), "разработчик", nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		require.Equal(t, backendID, rs[0].ID)
+
+		rs, _, err = c.SearchVacancies(context.TODO(), "дизайн", nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		require.Equal(t, designerID, rs[0].ID)
+	})
+
+	t.Run("match company title", func(t *testing.T) {
+		rs, _, err := c.SearchVacancies(context.TODO(), "persona", nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, rs, 3)
+	})
+
+	t.Run("paginate with cursor", func(t *testing.T) {
+		rs, cursor, err := c.SearchVacancies(context.TODO(), "golang", nil, nil, 1)
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		require.Equal(t, golangID, rs[0].ID)
+		require.NotNil(t, cursor)
+
+		_, _, err = c.SearchVacancies(context.TODO(), "designer", nil, cursor, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidCursor.Error())
+
+		_, _, err = c.GetVacanciesList(context.TODO(), nil, "", cursor, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidCursor.Error())
+
+		rs, _, err = c.SearchVacancies(context.TODO(), "golang", nil, cursor, 1)
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+		require.Equal(t, backendID, rs[0].ID)
+	})
+
+	t.Run("search with invalid query", func(t *testing.T) {
+		_, _, err := c.SearchVacancies(context.TODO(), " ?! ", nil, nil, 10)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidSearchQuery.Error())
+	})
+}
+
+func TestController_GeoVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "geo.company@gmail.com", "+380503000030")
+
+	publish := func(title string, lat float32, lon float32) string {
+		vacancy := newVacancy(companyID, title)
+		vacancy.LocationLatitude = lat
+		vacancy.LocationLongitude = lon
+
+		return publishVacancy(t, c, vacancy, nil, nil)
+	}
+
+	kyivID := publish("Vacancy in Kyiv", 50.4501, 30.5234)
+	brovaryID := publish("Vacancy in Brovary", 50.5110, 30.7909)
+	lvivID := publish("Vacancy in Lviv", 49.8397, 24.0297)
+
+	near := &controller.GeoRadius{Latitude: 50.4501, Longitude: 30.5234, RadiusKm: 50}
+	nearFilter := &controller.VacancyListFilter{Near: near}
+
+	t.Run("get nearest vacancies first", func(t *testing.T) {
+		vacancies, cursor, err := c.GetVacanciesList(context.TODO(), nearFilter, "", nil, 100)
+		require.NoError(t, err)
+		require.Nil(t, cursor)
+		require.Len(t, vacancies, 2)
+		require.Equal(t, kyivID, vacancies[0].ID)
+		require.Equal(t, brovaryID, vacancies[1].ID)
+		require.NotNil(t, vacancies[0].Distance)
+		require.Less(t, *vacancies[0].Distance, float64(100))
+		require.NotNil(t, vacancies[1].Distance)
+		require.InDelta(t, 20000, *vacancies[1].Distance, 2000)
+
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), nil, "", nil, 100)
+		require.NoError(t, err)
+		require.Len(t, vacancies, 3)
+		require.Nil(t, vacancies[0].Distance)
+	})
+
+	t.Run("paginate nearest vacancies", func(t *testing.T) {
+		vacancies, cursor, err := c.GetVacanciesList(context.TODO(), nearFilter, "", nil, 1)
+		require.NoError(t, err)
+		require.Len(t, vacancies, 1)
+		require.Equal(t, kyivID, vacancies[0].ID)
+
+		_, _, err = c.GetVacanciesList(context.TODO(), nil, "", cursor, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidCursor.Error())
+
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), nearFilter, "", cursor, 1)
+		require.NoError(t, err)
+		require.Len(t, vacancies, 1)
+		require.Equal(t, brovaryID, vacancies[0].ID)
+	})
+
+	t.Run("get vacancies with invalid radius", func(t *testing.T) {
+		_, _, err := c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			Near: &controller.GeoRadius{Latitude: 91, RadiusKm: 1},
+		}, "", nil, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidGeoRadius.Error())
+
+		_, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			Near: &controller.GeoRadius{RadiusKm: 0},
+		}, "", nil, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidGeoRadius.Error())
+	})
+
+	ukraine := &controller.BoundingBox{MinLatitude: 44, MinLongitude: 22, MaxLatitude: 53, MaxLongitude: 41}
+
+	t.Run("cluster vacancies at low zoom", func(t *testing.T) {
+		m, err := c.GetVacanciesMap(context.TODO(), ukraine, 5, nil)
+		require.NoError(t, err)
+		require.Empty(t, m.Markers)
+		require.Len(t, m.Clusters, 2)
+
+		counts := []int{m.Clusters[0].Count, m.Clusters[1].Count}
+		require.ElementsMatch(t, []int{1, 2}, counts)
+	})
+
+	t.Run("get markers at high zoom", func(t *testing.T) {
+		m, err := c.GetVacanciesMap(context.TODO(), ukraine, 15, nil)
+		require.NoError(t, err)
+		require.Empty(t, m.Clusters)
+		require.Len(t, m.Markers, 3)
+
+		ids := []string{m.Markers[0].ID, m.Markers[1].ID, m.Markers[2].ID}
+		require.ElementsMatch(t, []string{kyivID, brovaryID, lvivID}, ids)
+	})
+
+	t.Run("get map with invalid bounds", func(t *testing.T) {
+		_, err := c.GetVacanciesMap(context.TODO(), &controller.BoundingBox{MinLatitude: 53, MaxLatitude: 44}, 5, nil)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidBoundingBox.Error())
+
+		_, err = c.GetVacanciesMap(context.TODO(), ukraine, 21, nil)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidMapZoom.Error())
+	})
+}
+
+func TestController_GetVacanciesListFilters(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	firstCompanyID := f.registerCompany(t, "filters.first@gmail.com", "+380503000040")
+	secondCompanyID := f.registerCompany(t, "filters.second@gmail.com", "+380503000041")
+
+	publish := func(
+		companyID string,
+		title string,
+		maxSalary int32,
+		experience int32,
+		vt controller.VacancyType,
+		countryCode int32,
+	) string {
+		vacancy := newVacancy(companyID, title)
+		vacancy.MinSalary = maxSalary / 2
+		vacancy.MaxSalary = maxSalary
+		vacancy.WorkMonthsExperience = experience
+		vacancy.Type = vt
+		vacancy.CountryCode = countryCode
+
+		return publishVacancy(t, c, vacancy, nil, nil)
+	}
+
+	juniorID := publish(firstCompanyID, "Junior golang developer", 20000, 0, controller.VacancyTypeNormal, 380)
+	seniorID := publish(firstCompanyID, "Senior golang developer golang", 80000, 60, controller.VacancyTypeRemote, 380)
+	designerID := publish(secondCompanyID, "Designer", 40000, 12, controller.VacancyTypeNormal, 48)
+
+	t.Run("filter by fields", func(t *testing.T) {
+		maxExperience := int32(12)
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			MaxExperienceMonths: &maxExperience,
+		}, "", nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{designerID, juniorID}, idsOf(vacancies))
+
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			CompanyID: secondCompanyID,
+		}, "", nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{designerID}, idsOf(vacancies))
+
+		countryCode := int32(380)
+		remote := controller.VacancyTypeRemote
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{Type: &remote},
+			CountryCode:   &countryCode,
+		}, "", nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{seniorID}, idsOf(vacancies))
+
+		minSalary := int32(30000)
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{MinSalary: &minSalary, Currency: controller.CurrencyUAH},
+		}, "", nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{designerID, seniorID}, idsOf(vacancies))
+	})
+
+	t.Run("sort by salary with cursor", func(t *testing.T) {
+		vacancies, cursor, err := c.GetVacanciesList(context.TODO(), nil, controller.VacancySortOrderSalary, nil, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{seniorID, designerID}, idsOf(vacancies))
+		require.NotNil(t, cursor)
+
+		_, _, err = c.GetVacanciesList(context.TODO(), nil, controller.VacancySortOrderNewest, cursor, 2)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidCursor.Error())
+
+		vacancies, cursor, err = c.GetVacanciesList(context.TODO(), nil, controller.VacancySortOrderSalary, cursor, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{juniorID}, idsOf(vacancies))
+		require.Nil(t, cursor)
+	})
+
+	t.Run("sort by relevance", func(t *testing.T) {
+		filter := &controller.VacancyListFilter{VacancyFilter: controller.VacancyFilter{Keywords: "golang"}}
+
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), filter, controller.VacancySortOrderRelevance, nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{seniorID, juniorID}, idsOf(vacancies))
+
+		_, _, err = c.GetVacanciesList(context.TODO(), nil, controller.VacancySortOrderRelevance, nil, 100)
+		require.EqualError(t, errors.Cause(err), controller.ErrRelevanceSortWithoutKeywords.Error())
+	})
+
+	t.Run("bind cursor to the filter", func(t *testing.T) {
+		filter := &controller.VacancyListFilter{CountryCode: new(int32)}
+		*filter.CountryCode = 380
+
+		_, cursor, err := c.GetVacanciesList(context.TODO(), filter, "", nil, 1)
+		require.NoError(t, err)
+		require.NotNil(t, cursor)
+
+		_, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{}, "", cursor, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidCursor.Error())
+
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), filter, "", cursor, 1)
+		require.NoError(t, err)
+		require.Equal(t, []string{juniorID}, idsOf(vacancies))
+	})
+
+	t.Run("get list with invalid filter", func(t *testing.T) {
+		_, _, err := c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{Currency: controller.Currency("usd")},
+		}, "", nil, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidFilterCurrency.Error())
+
+		_, _, err = c.GetVacanciesList(context.TODO(), nil, controller.VacancySortOrderDistance, nil, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrDistanceSortWithoutNear.Error())
+
+		_, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{CompanyID: "not uuid"}, "", nil, 1)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidFilterCompanyID.Error())
+	})
+}
+
+type mapCache map[string][]byte
+
+func (m mapCache) Get(key string) ([]byte, bool, error) {
+	value, ok := m[key]
+	return value, ok, nil
+}
+
+func (m mapCache) Set(key string, value []byte, _ time.Duration) error {
+	m[key] = value
+	return nil
+}
+
+func TestController_GetVacancyFacets(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "facets.company@gmail.com", "+380503000050")
+
+	backendID, designID := putCategory(t, c, "Backend"), putCategory(t, c, "Design")
+	kyivID, lvivID := f.putCity(t, "Kyiv"), f.putCity(t, "Lviv")
+
+	publish := func(maxSalary int32, vt controller.VacancyType, categoryID string, cityID string) {
+		vacancy := newVacancy(companyID, "Vacancy")
+		vacancy.MinSalary = maxSalary / 2
+		vacancy.MaxSalary = maxSalary
+		vacancy.Type = vt
+
+		publishVacancy(t, c, vacancy, []string{categoryID}, []string{cityID})
+	}
+
+	publish(20000, controller.VacancyTypeNormal, backendID, kyivID)
+	publish(80000, controller.VacancyTypeRemote, backendID, lvivID)
+	publish(40000, controller.VacancyTypeNormal, designID, kyivID)
+
+	salaryCounts := func(f *controller.VacancyFacets) []int {
+		counts := make([]int, len(f.SalaryBuckets))
+		for idx, b := range f.SalaryBuckets {
+			counts[idx] = b.Count
+		}
+
+		return counts
+	}
+
+	t.Run("without filter", func(t *testing.T) {
+		f, err := c.GetVacancyFacets(context.TODO(), nil)
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{backendID: 2, designID: 1}, f.Categories)
+		require.Equal(t, map[string]int{kyivID: 2, lvivID: 1}, f.Cities)
+		require.Equal(t, map[controller.VacancyType]int{
+			controller.VacancyTypeNormal: 2,
+			controller.VacancyTypeRemote: 1,
+		}, f.Types)
+		require.Equal(t, []int{0, 0, 1, 0, 1, 1}, salaryCounts(f))
+		require.Equal(t, int32(0), f.SalaryBuckets[0].From)
+		require.Equal(t, int32(60000), f.SalaryBuckets[5].From)
+		require.Nil(t, f.SalaryBuckets[5].To)
+	})
+
+	t.Run("facet ignores own filter", func(t *testing.T) {
+		remote := controller.VacancyTypeRemote
+		f, err := c.GetVacancyFacets(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{
+				CategoriesIDs: []string{backendID},
+				Type:          &remote,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{backendID: 1}, f.Categories)
+		require.Equal(t, map[string]int{lvivID: 1}, f.Cities)
+		require.Equal(t, map[controller.VacancyType]int{
+			controller.VacancyTypeNormal: 1,
+			controller.VacancyTypeRemote: 1,
+		}, f.Types)
+		require.Equal(t, []int{0, 0, 0, 0, 0, 1}, salaryCounts(f))
+
+		f, err = c.GetVacancyFacets(context.TODO(), categoriesFilter(backendID))
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{backendID: 2, designID: 1}, f.Categories)
+		require.Equal(t, map[string]int{kyivID: 1, lvivID: 1}, f.Cities)
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		cache := mapCache{}
+		withCache := controller.New(vacancyCfg, f.s, nil, nil, cache)
+
+		f, err := withCache.GetVacancyFacets(context.TODO(), categoriesFilter(designID, backendID))
+		require.NoError(t, err)
+		require.Len(t, cache, 1)
+
+		publish(20000, controller.VacancyTypeNormal, designID, lvivID)
+
+		cached, err := withCache.GetVacancyFacets(context.TODO(), categoriesFilter(backendID, designID))
+		require.NoError(t, err)
+		require.Equal(t, f, cached)
+
+		f, err = c.GetVacancyFacets(context.TODO(), categoriesFilter(backendID, designID))
+		require.NoError(t, err)
+		require.Equal(t, map[string]int{backendID: 2, designID: 2}, f.Categories)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := c.GetVacancyFacets(context.TODO(), categoriesFilter("invalid"))
+		require.Equal(t, controller.ErrInvalidFilterCategories, errors.Cause(err))
+	})
+
+	t.Run("currency without exchange rate", func(t *testing.T) {
+		_, err := c.GetVacancyFacets(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{Currency: "USD"},
+		})
+		require.Equal(t, controller.ErrNoExchangeRate, errors.Cause(err))
+	})
+}
+
+func TestController_VacancyLifecycle(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "lifecycle.company@gmail.com", "+380503000060")
+
+	listed := func(filter *controller.VacancyListFilter) []string {
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), filter, "", nil, 100)
+		require.NoError(t, err)
+
+		return idsOf(vacancies)
+	}
+
+	getDetails := func(vacancyID string) *controller.VacancyDetails {
+		vd, err := c.GetVacancyDetails(context.TODO(), vacancyID)
+		require.NoError(t, err)
+
+		return vd
+	}
+
+	vacancyID := putVacancy(t, c, newVacancy(companyID, "Golang developer"), nil, nil)
+	ownFilter := &controller.VacancyListFilter{ViewerCompanyID: companyID}
+
+	t.Run("draft is shown to its company only", func(t *testing.T) {
+		vd := getDetails(vacancyID)
+		require.Equal(t, controller.VacancyStatusDraft, vd.Status)
+		require.Nil(t, vd.PublishedAt)
+		require.False(t, vd.IsLive(time.Now()))
+
+		require.Empty(t, listed(nil))
+		require.Equal(t, []string{vacancyID}, listed(ownFilter))
+
+		rs, _, err := c.SearchVacancies(context.TODO(), "golang", nil, nil, 10)
+		require.NoError(t, err)
+		require.Empty(t, rs)
+	})
+
+	t.Run("invalid transitions", func(t *testing.T) {
+		err := c.PauseVacancy(context.TODO(), vacancyID)
+		require.Equal(t, controller.ErrInvalidVacancyStatusTransition, errors.Cause(err))
+
+		past := time.Now().Add(-time.Minute)
+		err = c.PublishVacancy(context.TODO(), vacancyID, &past)
+		require.Equal(t, controller.ErrInvalidVacancyExpiry, errors.Cause(err))
+
+		tooLate := time.Now().Add(2 * vacancyCfg.VacancyLifetime)
+		err = c.PublishVacancy(context.TODO(), vacancyID, &tooLate)
+		require.Equal(t, controller.ErrInvalidVacancyExpiry, errors.Cause(err))
+
+		err = c.PublishVacancy(context.TODO(), uuid.NewV4().String(), nil)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+
+	t.Run("publish, pause and close", func(t *testing.T) {
+		require.NoError(t, c.PublishVacancy(context.TODO(), vacancyID, nil))
+
+		vd := getDetails(vacancyID)
+		require.Equal(t, controller.VacancyStatusPublished, vd.Status)
+		require.NotNil(t, vd.PublishedAt)
+		require.NotNil(t, vd.ExpiresAt)
+		require.True(t, vd.IsLive(time.Now()))
+		require.Equal(t, []string{vacancyID}, listed(nil))
+
+		rs, _, err := c.SearchVacancies(context.TODO(), "golang", nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, rs, 1)
+
+		require.NoError(t, c.PauseVacancy(context.TODO(), vacancyID))
+		require.Equal(t, controller.VacancyStatusPaused, getDetails(vacancyID).Status)
+		require.Empty(t, listed(nil))
+
+		require.NoError(t, c.PublishVacancy(context.TODO(), vacancyID, nil))
+		require.Equal(t, vd.PublishedAt.Unix(), getDetails(vacancyID).PublishedAt.Unix())
+		require.Equal(t, []string{vacancyID}, listed(nil))
+
+		require.NoError(t, c.CloseVacancy(context.TODO(), vacancyID))
+		require.Equal(t, controller.VacancyStatusClosed, getDetails(vacancyID).Status)
+		require.Empty(t, listed(nil))
+		require.Equal(t, []string{vacancyID}, listed(ownFilter))
+
+		err = c.PublishVacancy(context.TODO(), vacancyID, nil)
+		require.Equal(t, controller.ErrInvalidVacancyStatusTransition, errors.Cause(err))
+	})
+
+	t.Run("close expired vacancies", func(t *testing.T) {
+		expiredID := putVacancy(t, c, newVacancy(companyID, "Expired vacancy"), nil, nil)
+		past := time.Now().Add(-time.Minute)
+		require.NoError(t, f.s.TxPutVacancyStatus(
+			context.TODO(), f.s.NoTx(), expiredID, storage.VacancyStatusPublished, &past, &past,
+		))
+		require.Empty(t, listed(nil))
+
+		closed, err := c.CloseExpiredVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, closed)
+		require.Equal(t, controller.VacancyStatusClosed, getDetails(expiredID).Status)
+
+		closed, err = c.CloseExpiredVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 0, closed)
+	})
+}
+
+func TestController_ListMyVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "my.company@gmail.com", "+380503000070")
+	otherCompanyID := f.registerCompany(t, "my.other@gmail.com", "+380503000071")
+	personaID := f.register(t, "my.persona@gmail.com", "+380503000072", authController.AccountTypePersona)
+
+	_, err := c.CreateVacancyAlert(context.TODO(), personaID, &controller.VacancyFilter{Keywords: "golang"})
+	require.NoError(t, err)
+
+	draftID := putVacancy(t, c, newVacancy(companyID, "Designer"), nil, nil)
+	publishedID := publishVacancy(t, c, newVacancy(companyID, "Golang developer"), nil, nil)
+	require.NoError(t, c.SaveVacancy(context.TODO(), personaID, publishedID))
+	require.NoError(t, c.RecordVacancyEvents(context.TODO(), controller.VacancyEventTypeView, personaID,
+		[]string{publishedID}))
+	require.NoError(t, c.RecordVacancyEvents(context.TODO(), controller.VacancyEventTypeView, "anonymous",
+		[]string{publishedID}))
+	closedID := putVacancy(t, c, newVacancy(companyID, "Manager"), nil, nil)
+	require.NoError(t, c.CloseVacancy(context.TODO(), closedID))
+	putVacancy(t, c, newVacancy(otherCompanyID, "Other vacancy"), nil, nil)
+
+	ids := func(vacancies []*controller.MyVacancy) []string {
+		result := make([]string, len(vacancies))
+		for idx, v := range vacancies {
+			result[idx] = v.ID
+		}
+
+		return result
+	}
+
+	t.Run("list all statuses", func(t *testing.T) {
+		vacancies, cursor, err := c.ListMyVacancies(context.TODO(), companyID, nil, nil, 2)
+		require.NoError(t, err)
+		require.NotNil(t, cursor)
+		require.Equal(t, []string{closedID, publishedID}, ids(vacancies))
+		require.Equal(t, controller.VacancyStatusClosed, vacancies[0].Status)
+		require.Equal(t, controller.VacancyStatusPublished, vacancies[1].Status)
+		require.Equal(t, controller.VacancyCounters{}, vacancies[0].Counters)
+		require.Equal(t, controller.VacancyCounters{AlertMatches: 1, Views: 2, Saves: 1}, vacancies[1].Counters)
+
+		vacancies, _, err = c.ListMyVacancies(context.TODO(), companyID, nil, cursor, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{draftID}, ids(vacancies))
+		require.Equal(t, controller.VacancyStatusDraft, vacancies[0].Status)
+
+		_, _, err = c.ListMyVacancies(context.TODO(), otherCompanyID, nil, cursor, 2)
+		require.Equal(t, controller.ErrInvalidCursor, errors.Cause(err))
+	})
+
+	t.Run("filter by statuses", func(t *testing.T) {
+		vacancies, _, err := c.ListMyVacancies(context.TODO(), companyID, []controller.VacancyStatus{
+			controller.VacancyStatusDraft,
+			controller.VacancyStatusClosed,
+		}, nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{closedID, draftID}, ids(vacancies))
+
+		_, _, err = c.ListMyVacancies(context.TODO(), companyID, []controller.VacancyStatus{"unknown"}, nil, 10)
+		require.Equal(t, controller.ErrInvalidFilterStatus, errors.Cause(err))
+
+		_, _, err = c.ListMyVacancies(context.TODO(), "invalid", nil, nil, 10)
+		require.Equal(t, controller.ErrInvalidFilterCompanyID, errors.Cause(err))
+	})
+
+	t.Run("vacancy company can't be changed", func(t *testing.T) {
+		_, err := c.PutVacancy(
+			context.TODO(), otherCompanyID, &draftID,
+			newVacancy(otherCompanyID, "Designer"), []string{}, []string{},
+		)
+		require.Equal(t, controller.ErrVacancyCompanyChanged, errors.Cause(err))
+	})
+}
+
+func TestController_SavedVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "saved.company@gmail.com", "+380503000100")
+	personaID := f.register(t, "saved.persona@gmail.com", "+380503000101", authController.AccountTypePersona)
+	otherPersonaID := f.register(t, "saved.other@gmail.com", "+380503000102", authController.AccountTypePersona)
+
+	firstID := publishVacancy(t, c, newVacancy(companyID, "Golang developer"), nil, nil)
+	secondID := publishVacancy(t, c, newVacancy(companyID, "Python developer"), nil, nil)
+	draftID := putVacancy(t, c, newVacancy(companyID, "Designer"), nil, nil)
+
+	t.Run("save", func(t *testing.T) {
+		require.NoError(t, c.SaveVacancy(context.TODO(), personaID, firstID))
+		require.NoError(t, c.SaveVacancy(context.TODO(), personaID, secondID))
+		require.NoError(t, c.SaveVacancy(context.TODO(), personaID, firstID))
+
+		err := c.SaveVacancy(context.TODO(), personaID, draftID)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+
+		err = c.SaveVacancy(context.TODO(), personaID, "not uuid")
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+
+	t.Run("list saved", func(t *testing.T) {
+		vacancies, cursor, err := c.ListSavedVacancies(context.TODO(), personaID, nil, 1)
+		require.NoError(t, err)
+		require.NotNil(t, cursor)
+		require.Equal(t, []string{secondID}, idsOf(vacancies))
+
+		vacancies, cursor, err = c.ListSavedVacancies(context.TODO(), personaID, cursor, 1)
+		require.NoError(t, err)
+		require.Equal(t, []string{firstID}, idsOf(vacancies))
+
+		_, _, err = c.ListSavedVacancies(context.TODO(), otherPersonaID, cursor, 1)
+		require.Equal(t, controller.ErrInvalidCursor, errors.Cause(err))
+
+		vacancies, _, err = c.ListSavedVacancies(context.TODO(), otherPersonaID, nil, 10)
+		require.NoError(t, err)
+		require.Empty(t, vacancies)
+	})
+
+	t.Run("saved ids", func(t *testing.T) {
+		saved, err := c.GetSavedVacanciesIDs(context.TODO(), personaID, []string{firstID, draftID})
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{firstID: true}, saved)
+
+		saved, err = c.GetSavedVacanciesIDs(context.TODO(), otherPersonaID, []string{firstID, secondID})
+		require.NoError(t, err)
+		require.Empty(t, saved)
+	})
+
+	t.Run("closed vacancy is left out", func(t *testing.T) {
+		require.NoError(t, c.CloseVacancy(context.TODO(), secondID))
+
+		vacancies, _, err := c.ListSavedVacancies(context.TODO(), personaID, nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{firstID}, idsOf(vacancies))
+	})
+
+	t.Run("unsave", func(t *testing.T) {
+		require.NoError(t, c.UnsaveVacancy(context.TODO(), personaID, firstID))
+
+		err := c.UnsaveVacancy(context.TODO(), personaID, firstID)
+		require.Equal(t, controller.ErrSavedVacancyNotFound, errors.Cause(err))
+
+		vacancies, _, err := c.ListSavedVacancies(context.TODO(), personaID, nil, 10)
+		require.NoError(t, err)
+		require.Empty(t, vacancies)
+	})
+}
+
+func TestController_VacancyStats(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "stats.company@gmail.com", "+380503000104")
+	personaID := f.register(t, "stats.persona@gmail.com", "+380503000105", authController.AccountTypePersona)
+	otherPersonaID := f.register(t, "stats.other@gmail.com", "+380503000106", authController.AccountTypePersona)
+
+	vacancy := newVacancy(companyID, "Golang developer")
+	vacancyID := publishVacancy(t, c, vacancy, nil, nil)
+	otherVacancyID := publishVacancy(t, c, newVacancy(companyID, "Python developer"), nil, nil)
+	draft := newVacancy(companyID, "Designer")
+	draftID := putVacancy(t, c, draft, nil, nil)
+
+	t.Run("record", func(t *testing.T) {
+		for _, viewerKey := range []string{personaID, personaID, otherPersonaID, companyID, "anon:key", ""} {
+			require.NoError(t, c.RecordVacancyEvents(
+				context.TODO(),
+				controller.VacancyEventTypeView,
+				viewerKey,
+				[]string{vacancyID},
+			))
+		}
+
+		require.NoError(t, c.RecordVacancyEvents(
+			context.TODO(),
+			controller.VacancyEventTypeImpression,
+			personaID,
+			[]string{otherVacancyID, vacancyID, "not uuid"},
+		))
+
+		err := c.RecordVacancyEvents(context.TODO(), "click", personaID, []string{vacancyID})
+		require.Equal(t, controller.ErrInvalidVacancyEventType, errors.Cause(err))
+
+		require.NoError(t, c.SaveVacancy(context.TODO(), personaID, vacancyID))
+	})
+
+	t.Run("reveal phone", func(t *testing.T) {
+		phone, err := c.RevealVacancyPhone(context.TODO(), otherPersonaID, vacancyID)
+		require.NoError(t, err)
+		require.Equal(t, vacancy.Phone, phone)
+
+		// The server shows the vacancies which aren't live to their company only
+		phone, err = c.RevealVacancyPhone(context.TODO(), companyID, draftID)
+		require.NoError(t, err)
+		require.Equal(t, draft.Phone, phone)
+
+		_, err = c.RevealVacancyPhone(context.TODO(), otherPersonaID, "not uuid")
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		points, err := c.GetVacancyStats(context.TODO(), vacancyID, nil, nil, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, points)
+		require.Equal(t, controller.VacancyStatsPoint{Time: points[len(points)-1].Time}, *points[len(points)-1])
+
+		count, err := c.RollupVacancyStats(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 6, count)
+
+		// Rolling up again recounts the same hours
+		count, err = c.RollupVacancyStats(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 6, count)
+
+		points, err = c.GetVacancyStats(context.TODO(), vacancyID, nil, nil, controller.VacancyStatsGranularityDay)
+		require.NoError(t, err)
+		require.True(t, len(points) >= 30)
+
+		last := points[len(points)-1]
+		require.Equal(t, 0, last.Time.UTC().Hour())
+		require.Equal(t, 3, last.Views)
+		require.Equal(t, 1, last.Impressions)
+		require.Equal(t, 1, last.Saves)
+		require.Equal(t, 1, last.PhoneReveals)
+
+		for _, p := range points[:len(points)-1] {
+			require.Equal(t, controller.VacancyStatsPoint{Time: p.Time}, *p)
+		}
+
+		from := time.Now().Add(-2 * time.Hour)
+		points, err = c.GetVacancyStats(context.TODO(), otherVacancyID, &from, nil, controller.VacancyStatsGranularityHour)
+		require.NoError(t, err)
+		require.Len(t, points, 3)
+		require.Equal(t, 1, points[2].Impressions)
+
+		till := from.Add(-time.Hour)
+		_, err = c.GetVacancyStats(context.TODO(), vacancyID, &from, &till, controller.VacancyStatsGranularityHour)
+		require.Equal(t, controller.ErrInvalidVacancyStatsRange, errors.Cause(err))
+
+		from = time.Now().Add(-365 * 24 * time.Hour)
+		_, err = c.GetVacancyStats(context.TODO(), vacancyID, &from, nil, controller.VacancyStatsGranularityHour)
+		require.Equal(t, controller.ErrInvalidVacancyStatsRange, errors.Cause(err))
+
+		_, err = c.GetVacancyStats(context.TODO(), vacancyID, nil, nil, "week")
+		require.Equal(t, controller.ErrInvalidVacancyStatsGranularity, errors.Cause(err))
+	})
+}
+
+func TestController_VacancySalaryCurrencies(t *testing.T) {
+	f, closer := initFixture(t)
+	rs, currencyCloser := initCurrencyStorage(t)
+
+	defer func() {
+		if err := currencyCloser(); err != nil {
+			t.Error(err)
+		}
+		closer()
+	}()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+	rc := currencyController.New(rs)
+
+	companyID := f.registerCompany(t, "currency.company@gmail.com", "+380503000108")
+
+	require.NoError(t, rc.PutExchangeRates(context.TODO(), "UAH", []*currencyController.ExchangeRate{
+		{Currency: "USD", Rate: 40},
+		{Currency: "EUR", Rate: 44},
+	}))
+
+	publish := func(
+		minSalary int32,
+		maxSalary int32,
+		currency controller.Currency,
+		salaryPeriod controller.SalaryPeriod,
+		salaryGross *bool,
+	) (string, error) {
+		vacancy := newVacancy(companyID, "Golang developer")
+		vacancy.MinSalary = minSalary
+		vacancy.MaxSalary = maxSalary
+		vacancy.Currency = currency
+		vacancy.SalaryPeriod = salaryPeriod
+		vacancy.SalaryGross = salaryGross
+
+		vacancyID, err := c.PutVacancy(context.TODO(), companyID, nil, vacancy, nil, nil)
+		if err != nil {
+			return "", err
+		}
+
+		return string(vacancyID), c.PublishVacancy(context.TODO(), string(vacancyID), nil)
+	}
+
+	gross := true
+
+	uahID, err := publish(50000, 60000, "", "", nil)
+	require.NoError(t, err)
+	usdID, err := publish(1500, 2000, "USD", controller.SalaryPeriodMonth, nil)
+	require.NoError(t, err)
+	eurID, err := publish(100, 150, "EUR", controller.SalaryPeriodHour, &gross)
+	require.NoError(t, err)
+	// There is no exchange rate of the zloty, so the vacancy doesn't match any salary range
+	plnID, err := publish(10000, 12000, "PLN", controller.SalaryPeriodYear, nil)
+	require.NoError(t, err)
+
+	t.Run("put with invalid salary", func(t *testing.T) {
+		_, err := publish(1500, 2000, "usd", "", nil)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidVacancyCurrency.Error())
+
+		_, err = publish(1500, 2000, "USD", "week", nil)
+		require.EqualError(t, errors.Cause(err), controller.ErrInvalidVacancySalaryPeriod.Error())
+	})
+
+	t.Run("get details", func(t *testing.T) {
+		vd, err := c.GetVacancyDetails(context.TODO(), uahID)
+		require.NoError(t, err)
+		require.Equal(t, controller.CurrencyUAH, vd.Currency)
+		require.Equal(t, controller.SalaryPeriodMonth, vd.SalaryPeriod)
+		require.Nil(t, vd.SalaryGross)
+
+		vd, err = c.GetVacancyDetails(context.TODO(), eurID)
+		require.NoError(t, err)
+		require.Equal(t, controller.Currency("EUR"), vd.Currency)
+		require.Equal(t, controller.SalaryPeriodHour, vd.SalaryPeriod)
+		require.Equal(t, &gross, vd.SalaryGross)
+	})
+
+	t.Run("filter by salary in currency", func(t *testing.T) {
+		minSalary := int32(1600)
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{MinSalary: &minSalary, Currency: "USD"},
+			CompanyID:     companyID,
+		}, controller.VacancySortOrderSalary, nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{eurID, usdID}, idsOf(vacancies))
+
+		maxSalary := int32(1200)
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{MaxSalary: &maxSalary, Currency: "EUR"},
+			CompanyID:     companyID,
+		}, "", nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{uahID}, idsOf(vacancies))
+
+		_, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{MinSalary: &minSalary, Currency: "GBP"},
+		}, "", nil, 100)
+		require.Equal(t, controller.ErrNoExchangeRate, errors.Cause(err))
+
+		// Without a salary range the currency doesn't matter
+		vacancies, _, err = c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			VacancyFilter: controller.VacancyFilter{Currency: "GBP"},
+			CompanyID:     companyID,
+		}, controller.VacancySortOrderSalary, nil, 100)
+		require.NoError(t, err)
+		require.Equal(t, []string{eurID, usdID, uahID, plnID}, idsOf(vacancies))
+	})
+}
+
+func TestController_VacancyModeration(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	cfg := *vacancyCfg
+	cfg.ModerationEnabled = true
+	cfg.ModerationBannedWords = []string{"Casino"}
+
+	notifier := &alertRecorder{}
+	c := controller.New(&cfg, f.s, notifier, nil, nil)
+
+	companyID := f.registerCompany(t, "moderation.company@gmail.com", "+380503000109")
+	moderatorID := uuid.NewV4().String()
+
+	put := func(vacancyID *string, title string, description string, maxSalary int32) string {
+		vacancy := newVacancy(companyID, title)
+		vacancy.Description = description
+		vacancy.MaxSalary = maxSalary
+
+		id, err := c.PutVacancy(context.TODO(), companyID, vacancyID, vacancy, nil, nil)
+		require.NoError(t, err)
+
+		return string(id)
+	}
+
+	getStatus := func(vacancyID string) controller.VacancyStatus {
+		vd, err := c.GetVacancyDetails(context.TODO(), vacancyID)
+		require.NoError(t, err)
+
+		return vd.Status
+	}
+
+	queue := func() map[string][]controller.ModerationReason {
+		items, _, err := c.ListModerationQueue(context.TODO(), nil, 100)
+		require.NoError(t, err)
+
+		reasons := make(map[string][]controller.ModerationReason, len(items))
+		for _, item := range items {
+			reasons[item.ID] = item.Reasons
+		}
+
+		return reasons
+	}
+
+	cleanID := put(nil, "Golang developer", "Writing services", 20000)
+	flaggedID := put(nil, "Casino dealer", "Call us at 050 123 45 67", 5000)
+
+	t.Run("publishing puts on review", func(t *testing.T) {
+		require.NoError(t, c.PublishVacancy(context.TODO(), cleanID, nil))
+		require.NoError(t, c.PublishVacancy(context.TODO(), flaggedID, nil))
+
+		require.Equal(t, controller.VacancyStatusPendingReview, getStatus(cleanID))
+		require.Equal(t, controller.VacancyStatusPendingReview, getStatus(flaggedID))
+
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), nil, "", nil, 100)
+		require.NoError(t, err)
+		require.Empty(t, vacancies)
+
+		require.Equal(t, map[string][]controller.ModerationReason{
+			cleanID: {},
+			flaggedID: {
+				controller.ModerationReasonBannedWords,
+				controller.ModerationReasonInvalidPhone,
+				controller.ModerationReasonMissingSalary,
+			},
+		}, queue())
+
+		err = c.PauseVacancy(context.TODO(), cleanID)
+		require.Equal(t, controller.ErrInvalidVacancyStatusTransition, errors.Cause(err))
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		err := c.RejectVacancy(context.TODO(), moderatorID, flaggedID, nil, "")
+		require.Equal(t, controller.ErrInvalidModerationReasons, errors.Cause(err))
+
+		err = c.RejectVacancy(context.TODO(), moderatorID, flaggedID, []controller.ModerationReason{"wrong"}, "")
+		require.Equal(t, controller.ErrInvalidModerationReasons, errors.Cause(err))
+
+		reasons := []controller.ModerationReason{controller.ModerationReasonBannedWords}
+		err = c.RejectVacancy(context.TODO(), moderatorID, flaggedID, reasons, strings.Repeat("a", 1001))
+		require.Equal(t, controller.ErrInvalidModerationComment, errors.Cause(err))
+
+		require.NoError(t, c.RejectVacancy(context.TODO(), moderatorID, flaggedID, reasons, "No gambling"))
+		require.Equal(t, controller.VacancyStatusRejected, getStatus(flaggedID))
+		require.NotContains(t, queue(), flaggedID)
+
+		require.Equal(t, []moderationNotification{{
+			AccountID: companyID,
+			VacancyID: flaggedID,
+			Decision:  string(controller.ModerationDecisionRejected),
+			Reasons:   []string{string(controller.ModerationReasonBannedWords)},
+		}}, notifier.moderations)
+
+		err = c.RejectVacancy(context.TODO(), moderatorID, flaggedID, reasons, "")
+		require.Equal(t, controller.ErrVacancyNotPendingReview, errors.Cause(err))
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		notifier.moderations = nil
+
+		require.NoError(t, c.ApproveVacancy(context.TODO(), moderatorID, cleanID))
+		require.Equal(t, controller.VacancyStatusPublished, getStatus(cleanID))
+
+		err := c.ApproveVacancy(context.TODO(), moderatorID, cleanID)
+		require.Equal(t, controller.ErrVacancyNotPendingReview, errors.Cause(err))
+
+		require.Equal(t, []moderationNotification{{
+			AccountID: companyID,
+			VacancyID: cleanID,
+			Decision:  string(controller.ModerationDecisionApproved),
+			Reasons:   []string{},
+		}}, notifier.moderations)
+
+		// Pausing and publishing again doesn't need another review
+		require.NoError(t, c.PauseVacancy(context.TODO(), cleanID))
+		require.NoError(t, c.PublishVacancy(context.TODO(), cleanID, nil))
+		require.Equal(t, controller.VacancyStatusPublished, getStatus(cleanID))
+	})
+
+	t.Run("editing a live vacancy puts it on review again", func(t *testing.T) {
+		put(&cleanID, "Golang developer", "Writing services in Go", 20000)
+		require.Equal(t, controller.VacancyStatusPendingReview, getStatus(cleanID))
+
+		require.NoError(t, c.ApproveVacancy(context.TODO(), moderatorID, cleanID))
+		require.Equal(t, controller.VacancyStatusPublished, getStatus(cleanID))
+	})
+
+	t.Run("resubmit the rejected vacancy", func(t *testing.T) {
+		put(&flaggedID, "Croupier", "Dealing cards", 30000)
+		require.Equal(t, controller.VacancyStatusRejected, getStatus(flaggedID))
+
+		require.NoError(t, c.PublishVacancy(context.TODO(), flaggedID, nil))
+		require.Equal(t, map[string][]controller.ModerationReason{flaggedID: {}}, queue())
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		duplicateID := put(nil, "golang  Developer", " Writing services in Go", 20000)
+		require.NoError(t, c.PublishVacancy(context.TODO(), duplicateID, nil))
+
+		require.Equal(t, []controller.ModerationReason{controller.ModerationReasonDuplicate}, queue()[duplicateID])
+	})
+
+	t.Run("auto approve", func(t *testing.T) {
+		autoCfg := cfg
+		autoCfg.ModerationAutoApprove = true
+		ac := controller.New(&autoCfg, f.s, nil, nil, nil)
+
+		vacancyID := put(nil, "Rust developer", "Writing services in Rust", 20000)
+		require.NoError(t, ac.PublishVacancy(context.TODO(), vacancyID, nil))
+		require.Equal(t, controller.VacancyStatusPublished, getStatus(vacancyID))
+
+		flaggedID := put(nil, "Casino manager", "Managing", 20000)
+		require.NoError(t, ac.PublishVacancy(context.TODO(), flaggedID, nil))
+		require.Equal(t, controller.VacancyStatusPendingReview, getStatus(flaggedID))
+	})
+
+	t.Run("history", func(t *testing.T) {
+		decisions, err := c.GetVacancyModerationDecisions(context.TODO(), flaggedID)
+		require.NoError(t, err)
+		require.Len(t, decisions, 3)
+
+		require.Equal(t, controller.ModerationDecisionSubmitted, decisions[0].Decision)
+		require.Nil(t, decisions[0].ModeratorID)
+		require.Len(t, decisions[0].Reasons, 3)
+
+		require.Equal(t, controller.ModerationDecisionRejected, decisions[1].Decision)
+		require.Equal(t, &moderatorID, decisions[1].ModeratorID)
+		require.Equal(t, "No gambling", decisions[1].Comment)
+
+		require.Equal(t, controller.ModerationDecisionSubmitted, decisions[2].Decision)
+		require.Empty(t, decisions[2].Reasons)
+	})
+}
+
+func TestController_VacancyDuplicates(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	cfg := *vacancyCfg
+	cfg.DuplicatePolicy = controller.DuplicatePolicyLink
+	cfg.DuplicateSimilarity = 0.8
+
+	c := controller.New(&cfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "duplicates.company@gmail.com", "+380503000111")
+	kyivID, lvivID := f.putCity(t, "Kyiv"), f.putCity(t, "Lviv")
+
+	const description = "We build payment services in Go. Remote friendly team, flexible hours."
+	const otherDescription = "Writing embedded firmware in Rust for the home appliances."
+
+	put := func(
+		c *controller.Controller,
+		vacancyID *string,
+		title string,
+		description string,
+		cityID string,
+	) (string, error) {
+		vacancy := newVacancy(companyID, title)
+		vacancy.Description = description
+
+		id, err := c.PutVacancy(context.TODO(), companyID, vacancyID, vacancy, nil, []string{cityID})
+
+		return string(id), err
+	}
+
+	publish := func(title string, cityID string) string {
+		vacancyID, err := put(c, nil, title, description, cityID)
+		require.NoError(t, err)
+		require.NoError(t, c.PublishVacancy(context.TODO(), vacancyID, nil))
+
+		return vacancyID
+	}
+
+	duplicateOf := func(vacancyID string) *string {
+		vd, err := c.GetVacancyDetails(context.TODO(), vacancyID)
+		require.NoError(t, err)
+
+		return vd.DuplicateOf
+	}
+
+	listed := func() []string {
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), nil, "", nil, 100)
+		require.NoError(t, err)
+
+		ids := make([]string, len(vacancies))
+		for idx, v := range vacancies {
+			ids[idx] = v.ID
+		}
+
+		sort.Strings(ids)
+
+		return ids
+	}
+
+	sorted := func(ids ...string) []string {
+		sort.Strings(ids)
+		return ids
+	}
+
+	originalID := publish("Senior Golang developer", kyivID)
+	repostID := publish("Senior Golang Developer!", kyivID)
+	otherCityID := publish("Senior Golang developer", lvivID)
+
+	t.Run("repost is linked", func(t *testing.T) {
+		require.Nil(t, duplicateOf(originalID))
+		require.Equal(t, &originalID, duplicateOf(repostID))
+		require.Nil(t, duplicateOf(otherCityID))
+
+		require.Equal(t, sorted(originalID, otherCityID), listed())
+
+		own, _, err := c.GetVacanciesList(
+			context.TODO(),
+			&controller.VacancyListFilter{ViewerCompanyID: companyID},
+			"",
+			nil,
+			100,
+		)
+		require.NoError(t, err)
+		require.Len(t, own, 3)
+	})
+
+	t.Run("duplicate clusters", func(t *testing.T) {
+		clusters, cursor, err := c.ListDuplicateClusters(context.TODO(), nil, 10)
+		require.NoError(t, err)
+		require.Nil(t, cursor)
+		require.Len(t, clusters, 1)
+		require.Equal(t, originalID, clusters[0].ID)
+		require.Equal(t, []string{repostID}, clusters[0].DuplicatesIDs)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		blockCfg := cfg
+		blockCfg.DuplicatePolicy = controller.DuplicatePolicyBlock
+		bc := controller.New(&blockCfg, f.s, nil, nil, nil)
+
+		_, err := put(bc, nil, "Senior golang developer", description, kyivID)
+		require.Equal(t, controller.ErrVacancyDuplicate, errors.Cause(err))
+
+		_, err = put(bc, &originalID, "Senior Golang developer", description, kyivID)
+		require.NoError(t, err)
+	})
+
+	t.Run("repost is listed once the original is closed", func(t *testing.T) {
+		require.NoError(t, c.CloseVacancy(context.TODO(), originalID))
+		require.Equal(t, sorted(repostID, otherCityID), listed())
+	})
+
+	t.Run("edited repost is unlinked", func(t *testing.T) {
+		_, err := put(c, &repostID, "Junior Rust developer", otherDescription, kyivID)
+		require.NoError(t, err)
+		require.Nil(t, duplicateOf(repostID))
+	})
+}
+
+func TestParseImportCSV(t *testing.T) {
+	const header = "external_id,title,description,phone,min_salary,max_salary,work_months_experience," +
+		"currency,type,categories,cities\n"
+	const feed = header + `A-1,Golang developer,Payments in Go,+380501234567,10000,20000,12,usd,remote,IT | Finance,Kyiv
+A-2,Rust developer,Firmware in Rust,+380501234567,ten,20000,12,,,IT,
+A-3,Golang developer,Payments in Go,+380501234567,10000,20000,12,,office,,
+`
+
+	rows, err := controller.ParseImportCSV(strings.NewReader(feed))
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	require.Equal(t, &controller.ImportRow{
+		Line:                 2,
+		ExternalID:           "A-1",
+		Title:                "Golang developer",
+		Description:          "Payments in Go",
+		Phone:                "+380501234567",
+		MinSalary:            10000,
+		MaxSalary:            20000,
+		Currency:             "USD",
+		Type:                 controller.VacancyTypeRemote,
+		WorkMonthsExperience: 12,
+		Categories:           []string{"IT", "Finance"},
+		Cities:               []string{"Kyiv"},
+	}, rows[0])
+	require.Equal(t, 3, rows[1].Line)
+	require.Equal(t, 4, rows[2].Line)
+
+	_, err = controller.ParseImportCSV(strings.NewReader("external_id,title\nA-1,Golang developer\n"))
+	require.Equal(t, controller.ErrInvalidImportFeed, errors.Cause(err))
+
+	_, err = controller.ParseImportCSV(strings.NewReader(
+		"external_id,title,description,phone,min_salary,max_salary,work_months_experience,salary\nA-1,,,,,,,\n",
+	))
+	require.Equal(t, controller.ErrInvalidImportFeed, errors.Cause(err))
+}
+
+func TestParseImportXML(t *testing.T) {
+	const feed = `<?xml version="1.0" encoding="UTF-8"?>
+<source>
+	<job>
+		<referencenumber>A-1</referencenumber>
+		<title>Golang developer</title>
+		<description><![CDATA[Payments in <b>Go</b>]]></description>
+		<phone>+380501234567</phone>
+		<category>IT</category>
+		<category>Finance</category>
+		<city>Kyiv</city>
+		<salary min="10000" max="20000" currency="usd" period="month"/>
+		<jobtype>Remote</jobtype>
+		<experience>12</experience>
+	</job>
+	<job>
+		<referencenumber>A-2</referencenumber>
+		<title>Rust developer</title>
+		<jobtype>full-time</jobtype>
+	</job>
+</source>`
+
+	rows, err := controller.ParseImportXML(strings.NewReader(feed))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	require.Equal(t, &controller.ImportRow{
+		Line:                 1,
+		ExternalID:           "A-1",
+		Title:                "Golang developer",
+		Description:          "Payments in <b>Go</b>",
+		Phone:                "+380501234567",
+		MinSalary:            10000,
+		MaxSalary:            20000,
+		Currency:             "USD",
+		SalaryPeriod:         controller.SalaryPeriodMonth,
+		Type:                 controller.VacancyTypeRemote,
+		WorkMonthsExperience: 12,
+		Categories:           []string{"IT", "Finance"},
+		Cities:               []string{"Kyiv"},
+	}, rows[0])
+	require.Equal(t, 2, rows[1].Line)
+	require.Equal(t, controller.VacancyTypeNormal, rows[1].Type)
+
+	_, err = controller.ParseImportXML(strings.NewReader("not xml"))
+	require.Equal(t, controller.ErrInvalidImportFeed, errors.Cause(err))
+}
+
+func TestController_ImportVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "import.company@gmail.com", "+380503000113")
+	categoryID := putCategory(t, c, "IT")
+	kyivID := f.putCity(t, "Kyiv")
+
+	const feed = `external_id,title,description,phone,min_salary,max_salary,work_months_experience,categories,cities
+A-1,Golang developer,Payments in Go,+380503000114,10000,20000,12,it,KYIV
+A-2,Rust developer,Firmware in Rust,+380503000114,10000,20000,12,IT,
+A-3,Java developer,Banking in Java,+380503000114,10000,20000,12,Accounting,
+A-4,Python developer,Data in Python,+380503000114,ten,20000,12,,
+,Ruby developer,Shops in Ruby,+380503000114,10000,20000,12,,
+A-6,C developer,Drivers in C,+380503000114,10000,20000,0,,
+`
+
+	rows, err := controller.ParseImportCSV(strings.NewReader(feed))
+	require.NoError(t, err)
+
+	var firstID string
+
+	t.Run("import", func(t *testing.T) {
+		report, err := c.ImportVacancies(context.TODO(), &companyID, companyID, rows)
+		require.NoError(t, err)
+		require.Equal(t, 2, report.Created)
+		require.Equal(t, 0, report.Updated)
+		require.Len(t, report.Errors, 4)
+
+		require.Equal(t, 4, report.Errors[0].Line)
+		require.Equal(t, "A-3", report.Errors[0].ExternalID)
+		require.Equal(t, "categories", report.Errors[0].Field)
+		require.Equal(t, controller.ErrImportCategoryNotFound, errors.Cause(report.Errors[0].Err))
+
+		require.Equal(t, "min_salary", report.Errors[1].Field)
+		require.Equal(t, controller.ErrInvalidImportRow, errors.Cause(report.Errors[1].Err))
+
+		require.Equal(t, "external_id", report.Errors[2].Field)
+		require.Equal(t, controller.ErrInvalidImportExternalID, errors.Cause(report.Errors[2].Err))
+
+		require.Equal(t, "A-6", report.Errors[3].ExternalID)
+		require.Error(t, report.Errors[3].Err)
+
+		vacancies, _, err := c.ListMyVacancies(context.TODO(), companyID, nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, vacancies, 2)
+
+		for _, v := range vacancies {
+			require.Equal(t, controller.VacancyStatusDraft, v.Status)
+
+			if v.Title == "Golang developer" {
+				firstID = v.ID
+			}
+		}
+
+		require.NotEmpty(t, firstID)
+
+		categories, err := c.GetVacanciesCategories(context.TODO(), []string{firstID})
+		require.NoError(t, err)
+		require.Len(t, categories, 1)
+		require.Equal(t, categoryID, categories[0].ID)
+
+		cities, err := c.GetVacancyCities(context.TODO(), []string{firstID})
+		require.NoError(t, err)
+		require.Len(t, cities, 1)
+		require.Equal(t, kyivID, cities[0].ID)
+	})
+
+	t.Run("reimport updates", func(t *testing.T) {
+		// The location and images are put by the company, the feed doesn't carry them
+		vd, err := c.GetVacancyDetails(context.TODO(), firstID)
+		require.NoError(t, err)
+
+		vd.LocationLatitude = 50.45
+		vd.LocationLongitude = 30.52
+		vd.CountryCode = 380
+		vd.ImageURLs = []string{"https://s3.bucket.org/import.jpg"}
+
+		_, err = c.PutVacancy(context.TODO(), companyID, &firstID, vd, []string{categoryID}, []string{kyivID})
+		require.NoError(t, err)
+
+		rows, err := controller.ParseImportXML(strings.NewReader(`<source>
+	<job>
+		<referencenumber>A-1</referencenumber>
+		<title>Senior Golang developer</title>
+		<description>Payments in Go</description>
+		<phone>+380503000114</phone>
+		<salary min="15000" max="25000"/>
+		<experience>24</experience>
+	</job>
+</source>`))
+		require.NoError(t, err)
+
+		report, err := c.ImportVacancies(context.TODO(), &companyID, companyID, rows)
+		require.NoError(t, err)
+		require.Equal(t, 0, report.Created)
+		require.Equal(t, 1, report.Updated)
+		require.Empty(t, report.Errors)
+
+		vd, err = c.GetVacancyDetails(context.TODO(), firstID)
+		require.NoError(t, err)
+		require.Equal(t, "Senior Golang developer", vd.Title)
+		require.Equal(t, int32(15000), vd.MinSalary)
+		require.InDelta(t, 50.45, vd.LocationLatitude, 1e-4)
+		require.InDelta(t, 30.52, vd.LocationLongitude, 1e-4)
+		require.Equal(t, int32(380), vd.CountryCode)
+		require.Equal(t, []string{"https://s3.bucket.org/import.jpg"}, vd.ImageURLs)
+
+		vacancies, _, err := c.ListMyVacancies(context.TODO(), companyID, nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, vacancies, 2)
+	})
+
+	t.Run("too many rows", func(t *testing.T) {
+		_, err := c.ImportVacancies(context.TODO(), &companyID, companyID, make([]*controller.ImportRow, 5001))
+		require.Equal(t, controller.ErrTooManyImportRows, errors.Cause(err))
+	})
+}
+
+func TestController_FeedVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "feed.company@gmail.com", "+380503000115")
+
+	companyTitle := "Feed company"
+	logoURL := "https://cdn.personaapp.com/logo.png"
+	require.NoError(t, f.cc.Update(context.TODO(), &companyController.CompanyData{
+		ID:      companyID,
+		Title:   &companyTitle,
+		LogoURL: &logoURL,
+	}))
+
+	categoriesIDs := []string{putCategory(t, c, "IT")}
+	cityIDs := []string{f.putCity(t, "Kyiv")}
+
+	feedVacancy := func(title string) *controller.VacancyDetails {
+		vacancy := newVacancy(companyID, title)
+		vacancy.Address = "Khreshchatyk 1"
+
+		return vacancy
+	}
+
+	firstID := publishVacancy(t, c, feedVacancy("Golang developer"), categoriesIDs, cityIDs)
+	secondID := publishVacancy(t, c, feedVacancy("Rust developer"), categoriesIDs, cityIDs)
+	draftID := putVacancy(t, c, feedVacancy("Java developer"), categoriesIDs, cityIDs)
+
+	t.Run("vacancy", func(t *testing.T) {
+		v, err := c.GetFeedVacancy(context.TODO(), firstID)
+		require.NoError(t, err)
+		require.Equal(t, "Golang developer", v.Title)
+		require.Equal(t, "Khreshchatyk 1", v.Address)
+		require.Equal(t, &controller.FeedCompany{
+			ID:      companyID,
+			Title:   companyTitle,
+			LogoURL: logoURL,
+		}, v.Company)
+		require.Len(t, v.Categories, 1)
+		require.Equal(t, "IT", v.Categories[0].Title)
+		require.Len(t, v.Cities, 1)
+		require.Equal(t, "Kyiv", v.Cities[0].Name)
+		require.NotNil(t, v.PublishedAt)
+		require.False(t, v.UpdatedAt.Before(*v.PublishedAt))
+
+		_, err = c.GetFeedVacancy(context.TODO(), draftID)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+
+		_, err = c.GetFeedVacancy(context.TODO(), "not-an-id")
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		count, err := c.CountFeedVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		first, err := c.ListFeedVacancies(context.TODO(), 1, 1)
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+		require.Equal(t, firstID, first[0].ID)
+
+		second, err := c.ListSitemapVacancies(context.TODO(), 2, 1)
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+		require.Equal(t, secondID, second[0].ID)
+
+		past, err := c.ListFeedVacancies(context.TODO(), 3, 1)
+		require.NoError(t, err)
+		require.Empty(t, past)
+
+		_, err = c.ListFeedVacancies(context.TODO(), 0, 1)
+		require.Equal(t, controller.ErrInvalidFeedPage, errors.Cause(err))
+	})
+
+	t.Run("closed vacancy is dropped", func(t *testing.T) {
+		require.NoError(t, c.CloseVacancy(context.TODO(), secondID))
+
+		count, err := c.CountFeedVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		_, err = c.GetFeedVacancy(context.TODO(), secondID)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+}
+
+func TestController_VacancyRevisions(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "revisions.company@gmail.com", "+380503000117")
+	itID, designID := putCategory(t, c, "IT"), putCategory(t, c, "Design")
+	adminID := uuid.NewV4().String()
+
+	var vacancyID string
+
+	edit := func(authorID string, title string, maxSalary int32, categoryID string) {
+		var id *string
+		if vacancyID != "" {
+			id = &vacancyID
+		}
+
+		vacancy := newVacancy(companyID, title)
+		vacancy.MaxSalary = maxSalary
+
+		vid, err := c.PutVacancy(context.TODO(), authorID, id, vacancy, []string{categoryID}, nil)
+		require.NoError(t, err)
+
+		vacancyID = string(vid)
+	}
+
+	t.Run("revision is kept on every change of the content", func(t *testing.T) {
+		edit(companyID, "Golang developer", 20000, itID)
+		edit(companyID, "Golang developer", 20000, itID)
+		require.NoError(t, c.PublishVacancy(context.TODO(), vacancyID, nil))
+		edit(adminID, "Senior Golang developer", 30000, designID)
+
+		revisions, err := c.ListVacancyRevisions(context.TODO(), vacancyID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+
+		require.Equal(t, int32(2), revisions[0].Revision)
+		require.Equal(t, adminID, *revisions[0].AuthorID)
+		require.Equal(t, "Senior Golang developer", revisions[0].Vacancy.Title)
+		require.Equal(t, []string{"title", "max_salary", "categories_ids"}, revisions[0].ChangedFields)
+
+		require.Equal(t, int32(1), revisions[1].Revision)
+		require.Equal(t, companyID, *revisions[1].AuthorID)
+		require.Contains(t, revisions[1].ChangedFields, "title")
+	})
+
+	t.Run("get and diff revisions", func(t *testing.T) {
+		r, err := c.GetVacancyRevision(context.TODO(), vacancyID, 1)
+		require.NoError(t, err)
+		require.Equal(t, "Golang developer", r.Vacancy.Title)
+		require.Equal(t, int32(20000), r.Vacancy.MaxSalary)
+		require.Equal(t, companyID, r.Vacancy.CompanyID)
+		require.Equal(t, []string{itID}, r.CategoriesIDs)
+		require.Empty(t, r.CityIDs)
+
+		changes, err := c.DiffVacancyRevisions(context.TODO(), vacancyID, 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, []*controller.VacancyFieldChange{
+			{Field: "title", From: "Golang developer", To: "Senior Golang developer"},
+			{Field: "max_salary", From: "20000", To: "30000"},
+			{Field: "categories_ids", From: itID, To: designID},
+		}, changes)
+
+		changes, err = c.DiffVacancyRevisions(context.TODO(), vacancyID, 2, 2)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+
+		_, err = c.GetVacancyRevision(context.TODO(), vacancyID, 10)
+		require.Equal(t, controller.ErrVacancyRevisionNotFound, errors.Cause(err))
+
+		_, err = c.ListVacancyRevisions(context.TODO(), uuid.NewV4().String())
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+
+	t.Run("restore revision", func(t *testing.T) {
+		require.NoError(t, c.RestoreVacancyRevision(context.TODO(), companyID, vacancyID, 1))
+
+		vd, err := c.GetVacancyDetails(context.TODO(), vacancyID)
+		require.NoError(t, err)
+		require.Equal(t, "Golang developer", vd.Title)
+		require.Equal(t, int32(20000), vd.MaxSalary)
+
+		categories, err := c.GetVacanciesCategories(context.TODO(), []string{vacancyID})
+		require.NoError(t, err)
+		require.Len(t, categories, 1)
+		require.Equal(t, itID, categories[0].ID)
+
+		revisions, err := c.ListVacancyRevisions(context.TODO(), vacancyID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		require.Equal(t, int32(3), revisions[0].Revision)
+		require.Equal(t, companyID, *revisions[0].AuthorID)
+
+		changes, err := c.DiffVacancyRevisions(context.TODO(), vacancyID, 1, 3)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+
+		err = c.RestoreVacancyRevision(context.TODO(), companyID, vacancyID, 10)
+		require.Equal(t, controller.ErrVacancyRevisionNotFound, errors.Cause(err))
+	})
+}
+
+func TestController_VacancySchedule(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(&controller.Config{
+		VacancyLifetime:           24 * time.Hour,
+		ScheduledPublishBatchSize: 10,
+		MaxBumps:                  1,
+		BumpInterval:              time.Hour,
+	}, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "schedule.company@gmail.com", "+380503000119")
+
+	listNewest := func() []string {
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), &controller.VacancyListFilter{
+			CompanyID: companyID,
+		}, controller.VacancySortOrderNewest, nil, 10)
+		require.NoError(t, err)
+
+		return idsOf(vacancies)
+	}
+
+	firstID := putVacancy(t, c, newVacancy(companyID, "Golang developer"), nil, nil)
+	secondID := putVacancy(t, c, newVacancy(companyID, "Rust developer"), nil, nil)
+
+	t.Run("scheduled vacancy is published when it's due", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		err := c.ScheduleVacancyPublish(context.TODO(), firstID, &past)
+		require.Equal(t, controller.ErrInvalidVacancySchedule, errors.Cause(err))
+
+		publishAt := time.Now().Add(time.Hour)
+		require.NoError(t, c.ScheduleVacancyPublish(context.TODO(), firstID, &publishAt))
+		require.NoError(t, c.ScheduleVacancyPublish(context.TODO(), secondID, &publishAt))
+
+		published, err := c.PublishScheduledVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Zero(t, published)
+
+		// The schedule of the first vacancy comes due, the second one is cancelled
+		require.NoError(t, f.s.TxPutVacancySchedule(context.TODO(), f.s.NoTx(), firstID, &past))
+		require.NoError(t, c.ScheduleVacancyPublish(context.TODO(), secondID, nil))
+
+		published, err = c.PublishScheduledVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, 1, published)
+
+		first, err := c.GetVacancyDetails(context.TODO(), firstID)
+		require.NoError(t, err)
+		require.Equal(t, controller.VacancyStatusPublished, first.Status)
+		require.Nil(t, first.ScheduledPublishAt)
+
+		second, err := c.GetVacancyDetails(context.TODO(), secondID)
+		require.NoError(t, err)
+		require.Equal(t, controller.VacancyStatusDraft, second.Status)
+		require.Nil(t, second.ScheduledPublishAt)
+
+		err = c.ScheduleVacancyPublish(context.TODO(), firstID, &publishAt)
+		require.Equal(t, controller.ErrInvalidVacancyStatusTransition, errors.Cause(err))
+	})
+
+	t.Run("bumped vacancy is listed as the newest one", func(t *testing.T) {
+		err := c.BumpVacancy(context.TODO(), secondID)
+		require.Equal(t, controller.ErrVacancyNotLive, errors.Cause(err))
+
+		require.NoError(t, c.PublishVacancy(context.TODO(), secondID, nil))
+		require.Equal(t, []string{secondID, firstID}, listNewest())
+
+		before, err := f.s.TxGetVacancyDetails(context.TODO(), f.s.NoTx(), firstID)
+		require.NoError(t, err)
+
+		require.NoError(t, c.BumpVacancy(context.TODO(), firstID))
+		require.Equal(t, []string{firstID, secondID}, listNewest())
+
+		after, err := f.s.TxGetVacancyDetails(context.TODO(), f.s.NoTx(), firstID)
+		require.NoError(t, err)
+		require.True(t, before.CreatedAt.Equal(after.CreatedAt))
+		require.Equal(t, int32(1), after.BumpCount)
+		require.NotNil(t, after.BumpedAt)
+
+		err = c.BumpVacancy(context.TODO(), firstID)
+		require.Equal(t, controller.ErrVacancyBumpsExceeded, errors.Cause(err))
+
+		// The limits hold for a bump racing with the one above
+		err = f.s.TxBumpVacancy(context.TODO(), f.s.NoTx(), firstID, time.Now(), 1, time.Now())
+		require.Equal(t, storage.ErrNotFound, errors.Cause(err))
+	})
+
+	t.Run("published vacancy isn't republished by its schedule", func(t *testing.T) {
+		thirdID := putVacancy(t, c, newVacancy(companyID, "Python developer"), nil, nil)
+
+		publishAt := time.Now().Add(time.Hour)
+		require.NoError(t, c.ScheduleVacancyPublish(context.TODO(), thirdID, &publishAt))
+
+		past := time.Now().Add(-time.Minute)
+		require.NoError(t, f.s.TxPutVacancySchedule(context.TODO(), f.s.NoTx(), thirdID, &past))
+
+		require.NoError(t, c.PublishVacancy(context.TODO(), thirdID, nil))
+		require.NoError(t, c.PauseVacancy(context.TODO(), thirdID))
+
+		published, err := c.PublishScheduledVacancies(context.TODO())
+		require.NoError(t, err)
+		require.Zero(t, published)
+
+		third, err := c.GetVacancyDetails(context.TODO(), thirdID)
+		require.NoError(t, err)
+		require.Equal(t, controller.VacancyStatusPaused, third.Status)
+		require.Nil(t, third.ScheduledPublishAt)
+	})
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	t.Run("locales are ordered by weight with the less specific ones after", func(t *testing.T) {
+		require.Equal(t,
+			[]string{"uk-ua", "uk", "en-us", "en", "pl"},
+			controller.ParseAcceptLanguage("en-US;q=0.8, uk-UA, pl;q=0.5, en;q=0.7"),
+		)
+	})
+
+	t.Run("wildcard, zero weight and invalid locales are skipped", func(t *testing.T) {
+		require.Equal(t,
+			[]string{"de"},
+			controller.ParseAcceptLanguage("*, fr;q=0, de;q=0.3, not a locale, en;q=x"),
+		)
+	})
+
+	t.Run("empty header", func(t *testing.T) {
+		require.Empty(t, controller.ParseAcceptLanguage(""))
+	})
+}
+
+func TestController_VacancyCategoriesTree(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "categories.company@gmail.com", "+380503000121")
+
+	putTreeCategory := func(parentID *string, title string, titles map[string]string) string {
+		id, err := c.PutVacancyCategory(context.TODO(), nil, &controller.VacancyCategory{
+			ParentID: parentID,
+			Title:    title,
+			Titles:   titles,
+		})
+		require.NoError(t, err)
+
+		return string(id)
+	}
+
+	itID := putTreeCategory(nil, "IT", map[string]string{"uk": "Інформаційні технології"})
+	backendID := putTreeCategory(&itID, "Backend", nil)
+	golangID := putTreeCategory(&backendID, "Golang", nil)
+	frontendID := putTreeCategory(&itID, "Frontend", map[string]string{"uk": "Фронтенд", "en-GB": "Front end"})
+	designID := putTreeCategory(nil, "Design", nil)
+
+	publishVacancy(t, c, newVacancy(companyID, "Golang developer"), []string{golangID}, nil)
+
+	listIDs := func(parentID *string) []string {
+		categories, err := c.GetVacanciesCategoriesList(context.TODO(), nil, parentID, nil)
+		require.NoError(t, err)
+
+		ids := make([]string, len(categories))
+		for idx, category := range categories {
+			ids[idx] = category.ID
+		}
+
+		return ids
+	}
+
+	listVacancies := func(categoryID string) int {
+		vacancies, _, err := c.GetVacanciesList(context.TODO(), categoriesFilter(categoryID), "", nil, 100)
+		require.NoError(t, err)
+
+		return len(vacancies)
+	}
+
+	t.Run("categories are listed depth-first in their positions", func(t *testing.T) {
+		require.Equal(t, []string{itID, backendID, golangID, frontendID, designID}, listIDs(nil))
+		require.Equal(t, []string{backendID, golangID, frontendID}, listIDs(&itID))
+		require.Empty(t, listIDs(&golangID))
+
+		golang, err := c.GetVacancyCategory(context.TODO(), golangID, nil)
+		require.NoError(t, err)
+		require.Equal(t, backendID, *golang.ParentID)
+		require.Equal(t, "/"+itID+"/"+backendID+"/"+golangID+"/", golang.Path)
+	})
+
+	t.Run("title of the preferred locale is chosen", func(t *testing.T) {
+		frontend, err := c.GetVacancyCategory(context.TODO(), frontendID, []string{"en-gb", "en", "uk"})
+		require.NoError(t, err)
+		require.Equal(t, "Front end", frontend.Title)
+		require.Equal(t, map[string]string{"uk": "Фронтенд", "en-gb": "Front end"}, frontend.Titles)
+
+		categories, err := c.GetVacanciesCategoriesList(context.TODO(), nil, nil, []string{"uk-ua", "uk"})
+		require.NoError(t, err)
+		require.Equal(t, "Інформаційні технології", categories[0].Title)
+		require.Equal(t, "Design", categories[len(categories)-1].Title)
+
+		_, err = c.PutVacancyCategory(context.TODO(), nil, &controller.VacancyCategory{
+			Title:  "QA",
+			Titles: map[string]string{"not a locale": "QA"},
+		})
+		require.Equal(t, controller.ErrInvalidVacancyCategoryLocale, errors.Cause(err))
+	})
+
+	t.Run("filtering by a category includes its subtree", func(t *testing.T) {
+		require.Equal(t, 1, listVacancies(itID))
+		require.Equal(t, 1, listVacancies(backendID))
+		require.Equal(t, 0, listVacancies(frontendID))
+		require.Equal(t, 0, listVacancies(designID))
+	})
+
+	t.Run("category is moved with its subtree", func(t *testing.T) {
+		require.NoError(t, c.MoveVacancyCategory(context.TODO(), backendID, &designID, 0))
+
+		require.Equal(t, []string{itID, frontendID, designID, backendID, golangID}, listIDs(nil))
+		require.Equal(t, []string{backendID, golangID}, listIDs(&designID))
+
+		golang, err := c.GetVacancyCategory(context.TODO(), golangID, nil)
+		require.NoError(t, err)
+		require.Equal(t, "/"+designID+"/"+backendID+"/"+golangID+"/", golang.Path)
+
+		frontend, err := c.GetVacancyCategory(context.TODO(), frontendID, nil)
+		require.NoError(t, err)
+		require.Equal(t, int32(0), frontend.Position)
+
+		require.Equal(t, 0, listVacancies(itID))
+		require.Equal(t, 1, listVacancies(designID))
+	})
+
+	t.Run("categories are reordered", func(t *testing.T) {
+		require.NoError(t, c.MoveVacancyCategory(context.TODO(), designID, nil, 0))
+		require.Equal(t, []string{designID, backendID, golangID, itID, frontendID}, listIDs(nil))
+
+		require.NoError(t, c.MoveVacancyCategory(context.TODO(), designID, nil, 100))
+		require.Equal(t, []string{itID, frontendID, designID, backendID, golangID}, listIDs(nil))
+	})
+
+	t.Run("category can't be moved into its own subtree", func(t *testing.T) {
+		err := c.MoveVacancyCategory(context.TODO(), designID, &golangID, 0)
+		require.Equal(t, controller.ErrInvalidVacancyCategoryParent, errors.Cause(err))
+
+		err = c.MoveVacancyCategory(context.TODO(), designID, &designID, 0)
+		require.Equal(t, controller.ErrInvalidVacancyCategoryParent, errors.Cause(err))
+
+		missingID := uuid.NewV4().String()
+		err = c.MoveVacancyCategory(context.TODO(), designID, &missingID, 0)
+		require.Equal(t, controller.ErrVacancyCategoryParentNotFound, errors.Cause(err))
+	})
+
+	t.Run("category with children can't be deleted", func(t *testing.T) {
+		err := c.DeleteVacancyCategory(context.TODO(), backendID)
+		require.Equal(t, controller.ErrVacancyCategoryHasChildren, errors.Cause(err))
+	})
+}
+
+func TestVacancySimilarity(t *testing.T) {
+	salary := func(s float64) *float64 {
+		return &s
+	}
+
+	origin := &controller.VacancySimilarityFeatures{
+		CategoriesIDs: []string{"it", "backend"},
+		CityIDs:       []string{"kyiv"},
+		MinSalary:     salary(40000),
+		MaxSalary:     salary(60000),
+		Title:         "Senior Golang developer",
+	}
+
+	t.Run("same vacancy nearby scores 1", func(t *testing.T) {
+		same := *origin
+		same.Distance = salary(0)
+
+		require.InDelta(t, 1, controller.VacancySimilarity(origin, &same), 1e-9)
+	})
+
+	t.Run("nothing in common scores 0", func(t *testing.T) {
+		require.Zero(t, controller.VacancySimilarity(origin, &controller.VacancySimilarityFeatures{
+			CategoriesIDs: []string{"design"},
+			CityIDs:       []string{"lviv"},
+			Title:         "UI designer",
+		}))
+	})
+
+	t.Run("features are weighted", func(t *testing.T) {
+		score := controller.VacancySimilarity(origin, &controller.VacancySimilarityFeatures{
+			// 1 of 3 categories, the same city
+			CategoriesIDs: []string{"it", "frontend"},
+			CityIDs:       []string{"kyiv"},
+			// The middle is 40000 against 50000
+			MinSalary: salary(30000),
+			MaxSalary: salary(50000),
+			// 2 of 4 words
+			Title: "Golang developer (middle)",
+			// The distance similarity halves at 10 km
+			Distance: salary(10000),
+		})
+
+		require.InDelta(t, 0.35/3+0.15+0.15*0.8+0.25*0.5+0.1*0.5, score, 1e-9)
+	})
+
+	t.Run("unknown salary and location don't add to the score", func(t *testing.T) {
+		score := controller.VacancySimilarity(origin, &controller.VacancySimilarityFeatures{
+			CategoriesIDs: []string{"it", "backend"},
+			MinSalary:     salary(0),
+			MaxSalary:     salary(0),
+			Title:         "Senior Golang developer",
+		})
+
+		require.InDelta(t, 0.35+0.25, score, 1e-9)
+	})
+
+	t.Run("closer vacancy scores higher", func(t *testing.T) {
+		near, far := *origin, *origin
+		near.Distance, far.Distance = salary(1000), salary(50000)
+
+		require.Greater(t, controller.VacancySimilarity(origin, &near), controller.VacancySimilarity(origin, &far))
+	})
+}
+
+func TestController_GetSimilarVacancies(t *testing.T) {
+	f, closer := initFixture(t)
+	defer closer()
+
+	c := controller.New(vacancyCfg, f.s, nil, nil, nil)
+
+	companyID := f.registerCompany(t, "similar.company@gmail.com", "+380503000123")
+	itIDs, designIDs := []string{putCategory(t, c, "IT")}, []string{putCategory(t, c, "Design")}
+
+	similarVacancy := func(title string, maxSalary int32) *controller.VacancyDetails {
+		vacancy := newVacancy(companyID, title)
+		vacancy.MaxSalary = maxSalary
+
+		return vacancy
+	}
+
+	originID := publishVacancy(t, c, similarVacancy("Golang developer", 20000), itIDs, nil)
+	closestID := publishVacancy(t, c, similarVacancy("Senior Golang developer", 22000), itIDs, nil)
+	sameCategoryID := publishVacancy(t, c, similarVacancy("Java engineer", 50000), itIDs, nil)
+	putVacancy(t, c, similarVacancy("Golang developer at night", 20000), itIDs, nil)
+	publishVacancy(t, c, similarVacancy("Product designer", 20000), designIDs, nil)
+
+	t.Run("similar vacancies are the most similar first", func(t *testing.T) {
+		vacancies, err := c.GetSimilarVacancies(context.TODO(), originID, 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{closestID, sameCategoryID}, idsOf(vacancies))
+	})
+
+	t.Run("similar vacancies are limited", func(t *testing.T) {
+		vacancies, err := c.GetSimilarVacancies(context.TODO(), originID, 1)
+		require.NoError(t, err)
+		require.Len(t, vacancies, 1)
+	})
+
+	t.Run("similar vacancies of an unknown vacancy", func(t *testing.T) {
+		_, err := c.GetSimilarVacancies(context.TODO(), uuid.NewV4().String(), 0)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+
+		_, err = c.GetSimilarVacancies(context.TODO(), "not an id", 0)
+		require.Equal(t, controller.ErrVacancyNotFound, errors.Cause(err))
+	})
+}
@@ -26,7 +26,8 @@ func toCursorData(cursor *Cursor) (*cursorData, error) {
 	return &cursorData, nil
 }
 
-func toCursor(cursor *storage.Cursor, categoriesIDs []string) (*Cursor, error) {
+// toCursor binds the cursor to the filter and the sort order, so the next page can't be requested for others.
+func toCursor(cursor *storage.Cursor, filter *VacancyListFilter, sortOrder VacancySortOrder) (*Cursor, error) {
 	if cursor == nil {
 		return nil, nil
 	}
@@ -34,9 +35,48 @@ func toCursor(cursor *storage.Cursor, categoriesIDs []string) (*Cursor, error) {
 	cursorData := cursorData{
 		PrevCreatedAt: cursor.PrevCreatedAt,
 		PrevPosition:  cursor.PrevPosition,
+		PrevKey:       cursor.PrevKey,
+		Filter:        filter,
+		SortOrder:     sortOrder,
+	}
+
+	return encodeCursor(&cursorData)
+}
+
+// toSearchCursor binds the cursor to the search query besides the categories,
+// so the next page can't be requested for another query.
+func toSearchCursor(cursor *storage.Cursor, query string, categoriesIDs []string) (*Cursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	cursorData := cursorData{
+		PrevCreatedAt: cursor.PrevCreatedAt,
+		PrevPosition:  cursor.PrevPosition,
+		PrevKey:       cursor.PrevKey,
 		CategoriesIDs: categoriesIDs,
+		Query:         query,
+	}
+
+	return encodeCursor(&cursorData)
+}
+
+// toSavedCursor binds the cursor to the account, so the saved vacancies of others can't be paged through.
+func toSavedCursor(cursor *storage.Cursor, accountID string) (*Cursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	cursorData := cursorData{
+		PrevCreatedAt: cursor.PrevCreatedAt,
+		PrevPosition:  cursor.PrevPosition,
+		AccountID:     accountID,
 	}
 
+	return encodeCursor(&cursorData)
+}
+
+func encodeCursor(cursorData *cursorData) (*Cursor, error) {
 	data, err := json.Marshal(cursorData)
 	if err != nil {
 		return nil, err
@@ -55,27 +95,43 @@ func toStorageCursor(cursorData *cursorData) *storage.Cursor {
 	return &storage.Cursor{
 		PrevCreatedAt: cursorData.PrevCreatedAt,
 		PrevPosition:  cursorData.PrevPosition,
+		PrevKey:       cursorData.PrevKey,
 	}
 }
 
 // cursor data
 type cursorData struct {
-	PrevCreatedAt time.Time `json:"created_at,string"` //nolint:staticcheck // will rework
-	PrevPosition  int       `json:"position"`
-	CategoriesIDs []string  `json:"categories"`
+	PrevCreatedAt time.Time          `json:"created_at,string"` //nolint:staticcheck // will rework
+	PrevPosition  int                `json:"position"`
+	PrevKey       float64            `json:"key"`
+	CategoriesIDs []string           `json:"categories"`
+	Query         string             `json:"query"`
+	Filter        *VacancyListFilter `json:"filter"`
+	SortOrder     VacancySortOrder   `json:"sort"`
+	AccountID     string             `json:"account"`
 }
 
 type basicCursorData struct {
-	PrevCreatedAt string   `json:"created_at"`
-	PrevPosition  int      `json:"int,string"`
-	CategoriesIDs []string `json:"categories"`
+	PrevCreatedAt string             `json:"created_at"`
+	PrevPosition  int                `json:"int,string"`
+	PrevKey       float64            `json:"key,omitempty"`
+	CategoriesIDs []string           `json:"categories"`
+	Query         string             `json:"query,omitempty"`
+	Filter        *VacancyListFilter `json:"filter,omitempty"`
+	SortOrder     VacancySortOrder   `json:"sort,omitempty"`
+	AccountID     string             `json:"account,omitempty"`
 }
 
 func (cd cursorData) MarshalJSON() ([]byte, error) {
 	return json.Marshal(basicCursorData{
 		PrevCreatedAt: cd.PrevCreatedAt.Format(time.RFC3339Nano),
 		PrevPosition:  cd.PrevPosition,
+		PrevKey:       cd.PrevKey,
 		CategoriesIDs: cd.CategoriesIDs,
+		Query:         cd.Query,
+		Filter:        cd.Filter,
+		SortOrder:     cd.SortOrder,
+		AccountID:     cd.AccountID,
 	})
 }
 
@@ -92,7 +148,12 @@ func (cd *cursorData) UnmarshalJSON(j []byte) error {
 
 	cd.PrevPosition = data.PrevPosition
 	cd.PrevCreatedAt = createdAt
+	cd.PrevKey = data.PrevKey
 	cd.CategoriesIDs = data.CategoriesIDs
+	cd.Query = data.Query
+	cd.Filter = data.Filter
+	cd.SortOrder = data.SortOrder
+	cd.AccountID = data.AccountID
 
 	return nil
 }
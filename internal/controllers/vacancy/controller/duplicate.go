@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+// DuplicatePolicy tells what happens when a company puts a vacancy similar to another one of its vacancies
+// which isn't closed, in the same city.
+type DuplicatePolicy string
+
+const (
+	// DuplicatePolicyOff puts the duplicates as any other vacancy
+	DuplicatePolicyOff DuplicatePolicy = "off"
+	// DuplicatePolicyBlock rejects the duplicates with ErrVacancyDuplicate
+	DuplicatePolicyBlock DuplicatePolicy = "block"
+	// DuplicatePolicyLink links the duplicate to the original vacancy, the duplicate isn't listed
+	// to anybody but its company while the original is live
+	DuplicatePolicyLink DuplicatePolicy = "link"
+)
+
+var ErrVacancyDuplicate = errors.New("vacancy duplicates another one")
+
+// duplicateClustersSortOrder binds the cursor to the duplicates report, it's never requested by the clients.
+const duplicateClustersSortOrder VacancySortOrder = "duplicate_clusters"
+
+// DuplicateCluster is an original vacancy with its reposts, the oldest repost first.
+type DuplicateCluster struct {
+	Vacancy
+	DuplicatesIDs []string
+	RepostedAt    time.Time
+}
+
+// txLinkVacancyDuplicate looks for the vacancy the given one reposts and applies the duplicate policy.
+// A vacancy edited to be different from the original is unlinked.
+func (c *Controller) txLinkVacancyDuplicate(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vd *storage.VacancyDetails,
+	cityIDs []string,
+) error {
+	if c.cfg.DuplicatePolicy != DuplicatePolicyBlock && c.cfg.DuplicatePolicy != DuplicatePolicyLink {
+		return nil
+	}
+
+	originalID, err := c.s.TxFindVacancyOriginal(ctx, tx, vd, cityIDs, c.cfg.DuplicateSimilarity)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if originalID != nil && c.cfg.DuplicatePolicy == DuplicatePolicyBlock {
+		return errors.WithStack(ErrVacancyDuplicate)
+	}
+
+	vd.DuplicateOf = originalID
+
+	return nil
+}
+
+// ListDuplicateClusters lists the vacancies which were reposted with their reposts, the most recently
+// reposted first.
+func (c *Controller) ListDuplicateClusters(
+	ctx context.Context,
+	cursor *Cursor,
+	limit int,
+) ([]*DuplicateCluster, *Cursor, error) {
+	cursorData, err := toCursorData(cursor)
+	if err != nil || (cursorData != nil && cursorData.SortOrder != duplicateClustersSortOrder) {
+		return nil, nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	maxLimit := 100
+	if limit > maxLimit || limit <= 0 {
+		limit = maxLimit
+	}
+
+	scs, storageCursor, err := c.s.TxGetVacancyDuplicateClusters(ctx, c.s.NoTx(), limit, toStorageCursor(cursorData))
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	controllerCursor, err := toCursor(storageCursor, nil, duplicateClustersSortOrder)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	svs := make([]*storage.Vacancy, len(scs))
+	for idx, sc := range scs {
+		svs[idx] = &sc.Vacancy
+	}
+
+	vacancies, err := c.fromStorageVacancies(ctx, svs)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	clusters := make([]*DuplicateCluster, len(scs))
+	for idx, sc := range scs {
+		clusters[idx] = &DuplicateCluster{
+			Vacancy:       *vacancies[idx],
+			DuplicatesIDs: sc.DuplicatesIDs,
+			RepostedAt:    sc.RepostedAt,
+		}
+	}
+
+	return clusters, controllerCursor, nil
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const facetsCacheTTL = time.Minute
+
+// salaryBucketBounds split the monthly upper salaries in the filter currency into the buckets of the salary facet.
+var salaryBucketBounds = []int32{10000, 20000, 30000, 40000, 60000}
+
+// SalaryBucket counts the vacancies whose upper salary is in [From, To), To is nil for the last bucket.
+type SalaryBucket struct {
+	From  int32  `json:"from"`
+	To    *int32 `json:"to"`
+	Count int    `json:"count"`
+}
+
+// VacancyFacets holds the vacancy counts per filter value. A facet ignores its own part of the filter,
+// so the counts of the other values stay available when some of them are selected.
+type VacancyFacets struct {
+	Categories    map[string]int      `json:"categories"`
+	Cities        map[string]int      `json:"cities"`
+	Types         map[VacancyType]int `json:"types"`
+	SalaryBuckets []*SalaryBucket     `json:"salary_buckets"`
+}
+
+// GetVacancyFacets counts the vacancies matching the filter per category, city, type and salary bucket.
+// The counts are cached for a minute when the controller has a cache.
+func (c *Controller) GetVacancyFacets(ctx context.Context, filter *VacancyListFilter) (*VacancyFacets, error) {
+	if filter == nil {
+		filter = &VacancyListFilter{}
+	}
+
+	if err := filter.validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	filter = filter.normalize()
+
+	key, err := facetsCacheKey(filter)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if f := c.getCachedFacets(key); f != nil {
+		return f, nil
+	}
+
+	// The salary buckets are in the filter currency even when the filter has no salary range
+	if err := c.txCheckCurrencyExchangeRate(ctx, c.s.NoTx(), filter.Currency); err != nil {
+		return nil, err
+	}
+
+	sf, err := toStorageVacancyListFilter(filter)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sfs, err := c.s.TxGetVacancyFacets(ctx, c.s.NoTx(), sf, salaryBucketBounds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	f := &VacancyFacets{
+		Categories:    sfs.Categories,
+		Cities:        sfs.Cities,
+		Types:         make(map[VacancyType]int, len(sfs.Types)),
+		SalaryBuckets: toSalaryBuckets(sfs.SalaryBuckets),
+	}
+
+	for svt, count := range sfs.Types {
+		vt, err := fromStorageVacancyType(svt)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		f.Types[vt] = count
+	}
+
+	c.setCachedFacets(key, f)
+
+	return f, nil
+}
+
+// toSalaryBuckets lists all the buckets including the empty ones, counts are keyed by the bucket index.
+func toSalaryBuckets(counts map[int]int) []*SalaryBucket {
+	buckets := make([]*SalaryBucket, len(salaryBucketBounds)+1)
+
+	for idx := range buckets {
+		b := &SalaryBucket{Count: counts[idx]}
+
+		if idx > 0 {
+			b.From = salaryBucketBounds[idx-1]
+		}
+
+		if idx < len(salaryBucketBounds) {
+			to := salaryBucketBounds[idx]
+			b.To = &to
+		}
+
+		buckets[idx] = b
+	}
+
+	return buckets
+}
+
+func facetsCacheKey(filter *VacancyListFilter) (string, error) {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return "vacancy_facets:" + hex.EncodeToString(sum[:]), nil
+}
+
+// getCachedFacets returns nil on a cache miss. The cache is an optimization only,
+// so its failures make the facets computed again rather than fail the call.
+func (c *Controller) getCachedFacets(key string) *VacancyFacets {
+	if c.cache == nil {
+		return nil
+	}
+
+	data, ok, err := c.cache.Get(key)
+	if err != nil || !ok {
+		return nil
+	}
+
+	var f VacancyFacets
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil
+	}
+
+	return &f
+}
+
+func (c *Controller) setCachedFacets(key string, f *VacancyFacets) {
+	if c.cache == nil {
+		return
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		return
+	}
+
+	_ = c.cache.Set(key, data, facetsCacheTTL)
+}
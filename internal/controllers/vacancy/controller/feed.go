@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var ErrInvalidFeedPage = errors.New("invalid feed page")
+
+type FeedCompany struct {
+	ID      string
+	Title   string
+	LogoURL string
+}
+
+// FeedVacancy is a live vacancy with the data the job aggregators index. UpdatedAt is the latest of
+// the vacancy update and publishing.
+type FeedVacancy struct {
+	VacancyDetails
+	Company    *FeedCompany
+	Categories []*VacancyCategoryShort
+	Cities     []*VacancyCity
+	UpdatedAt  time.Time
+}
+
+// SitemapVacancy is a live vacancy of the sitemap.
+type SitemapVacancy struct {
+	ID        string
+	UpdatedAt time.Time
+}
+
+// GetFeedVacancy returns the live vacancy, ErrVacancyNotFound for a vacancy not listed to anybody.
+func (c *Controller) GetFeedVacancy(ctx context.Context, vacancyID string) (*FeedVacancy, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	}
+
+	lv, err := c.s.TxGetLiveVacancy(ctx, c.s.NoTx(), vacancyID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	vacancies, err := c.txGetFeedVacancies(ctx, c.s.NoTx(), []*storage.LiveVacancy{lv})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return vacancies[0], nil
+}
+
+// CountFeedVacancies returns the number of the live vacancies to page the feeds.
+func (c *Controller) CountFeedVacancies(ctx context.Context) (int, error) {
+	count, err := c.s.TxCountLiveVacancies(ctx, c.s.NoTx())
+	return count, errors.WithStack(err)
+}
+
+// ListFeedVacancies returns a page of the live vacancies, the earliest published first. Pages start from 1.
+func (c *Controller) ListFeedVacancies(ctx context.Context, page int, limit int) ([]*FeedVacancy, error) {
+	lvs, err := c.getLiveVacancies(ctx, page, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vacancies, err := c.txGetFeedVacancies(ctx, c.s.NoTx(), lvs)
+
+	return vacancies, errors.WithStack(err)
+}
+
+// ListSitemapVacancies returns a page of the live vacancies as ListFeedVacancies does, without the details.
+func (c *Controller) ListSitemapVacancies(ctx context.Context, page int, limit int) ([]*SitemapVacancy, error) {
+	lvs, err := c.getLiveVacancies(ctx, page, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vacancies := make([]*SitemapVacancy, len(lvs))
+	for idx, lv := range lvs {
+		vacancies[idx] = &SitemapVacancy{ID: lv.ID, UpdatedAt: lv.UpdatedAt}
+	}
+
+	return vacancies, nil
+}
+
+func (c *Controller) getLiveVacancies(ctx context.Context, page int, limit int) ([]*storage.LiveVacancy, error) {
+	if page < 1 || limit < 1 {
+		return nil, errors.WithStack(ErrInvalidFeedPage)
+	}
+
+	lvs, err := c.s.TxGetLiveVacancies(ctx, c.s.NoTx(), (page-1)*limit, limit)
+
+	return lvs, errors.WithStack(err)
+}
+
+// txGetFeedVacancies loads the details, the companies, the categories and the cities of the live vacancies.
+func (c *Controller) txGetFeedVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	lvs []*storage.LiveVacancy,
+) ([]*FeedVacancy, error) {
+	vacancies := make([]*FeedVacancy, len(lvs))
+	vacancyIDs := make([]string, len(lvs))
+	companyIDs := make([]string, 0, len(lvs))
+	byID := make(map[string]*FeedVacancy, len(lvs))
+
+	for idx, lv := range lvs {
+		vd, err := c.s.TxGetVacancyDetails(ctx, tx, lv.ID)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		details, err := fromStorageVacancyDetails(vd, nil)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		vacancies[idx] = &FeedVacancy{
+			VacancyDetails: *details,
+			Company:        &FeedCompany{ID: vd.CompanyID},
+			Categories:     make([]*VacancyCategoryShort, 0),
+			Cities:         make([]*VacancyCity, 0),
+			UpdatedAt:      lv.UpdatedAt,
+		}
+		vacancyIDs[idx] = lv.ID
+		companyIDs = append(companyIDs, vd.CompanyID)
+		byID[lv.ID] = vacancies[idx]
+	}
+
+	companies, err := c.s.TxGetFeedCompanies(ctx, tx, companyIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	byCompanyID := make(map[string]*FeedCompany, len(companies))
+	for _, fc := range companies {
+		byCompanyID[fc.ID] = &FeedCompany{ID: fc.ID, Title: fc.Title, LogoURL: fc.LogoURL}
+	}
+
+	for _, v := range vacancies {
+		if fc, ok := byCompanyID[v.CompanyID]; ok {
+			v.Company = fc
+		}
+	}
+
+	vscs, err := c.s.TxGetVacanciesCategories(ctx, tx, vacancyIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, category := range vscs {
+		v := byID[category.VacancyID]
+		v.Categories = append(v.Categories, &VacancyCategoryShort{
+			VacancyID: category.VacancyID,
+			ID:        category.ID,
+			Title:     category.Title,
+		})
+	}
+
+	vcs, err := c.s.TxGetVacancyCities(ctx, tx, vacancyIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, city := range vcs {
+		v := byID[city.VacancyID]
+		v.Cities = append(v.Cities, &VacancyCity{
+			VacancyID:   city.VacancyID,
+			ID:          city.ID,
+			Name:        city.Name,
+			CountryCode: city.CountryCode,
+			Rating:      city.Rating,
+		})
+	}
+
+	return vacancies, nil
+}
@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"context"
+	"math"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+)
+
+const (
+	maxGeoRadiusKm = 500
+	maxMapZoom     = 20
+	// Vacancies are clustered below this zoom level, at higher ones they are returned one by one
+	minMarkersZoom = 14
+	// Number of grid cells along a side of a 256px map tile, i.e. a cell is 32px wide
+	clusterCellsPerTile = 8
+	maxMapMarkers       = 500
+)
+
+var (
+	ErrInvalidGeoRadius   = errors.New("invalid geo radius")
+	ErrInvalidBoundingBox = errors.New("invalid bounding box")
+	ErrInvalidMapZoom     = errors.New("invalid map zoom")
+)
+
+// GeoRadius limits vacancies to the ones located within RadiusKm kilometers from the point.
+type GeoRadius struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lon"`
+	RadiusKm  float64 `json:"radius_km"`
+}
+
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+type VacancyMarker struct {
+	ID        string
+	Title     string
+	CompanyID string
+	Latitude  float64
+	Longitude float64
+}
+
+type VacancyCluster struct {
+	Latitude  float64
+	Longitude float64
+	Count     int
+}
+
+// VacanciesMap holds either markers of single vacancies or clusters depending on the zoom level.
+type VacanciesMap struct {
+	Markers  []*VacancyMarker
+	Clusters []*VacancyCluster
+}
+
+func isValidLatitude(lat float64) bool {
+	return lat >= -90 && lat <= 90
+}
+
+func isValidLongitude(lon float64) bool {
+	return lon >= -180 && lon <= 180
+}
+
+func (r *GeoRadius) validate() error {
+	if !isValidLatitude(r.Latitude) || !isValidLongitude(r.Longitude) || r.RadiusKm <= 0 || r.RadiusKm > maxGeoRadiusKm {
+		return ErrInvalidGeoRadius
+	}
+
+	return nil
+}
+
+func (b *BoundingBox) validate() error {
+	if !isValidLatitude(b.MinLatitude) || !isValidLatitude(b.MaxLatitude) ||
+		!isValidLongitude(b.MinLongitude) || !isValidLongitude(b.MaxLongitude) ||
+		b.MinLatitude >= b.MaxLatitude || b.MinLongitude >= b.MaxLongitude {
+		return ErrInvalidBoundingBox
+	}
+
+	return nil
+}
+
+func toStorageGeoRadius(r *GeoRadius) *storage.GeoRadius {
+	if r == nil {
+		return nil
+	}
+
+	return &storage.GeoRadius{
+		GeoPoint: storage.GeoPoint{Latitude: r.Latitude, Longitude: r.Longitude},
+		Meters:   r.RadiusKm * 1000,
+	}
+}
+
+// clusterCellSize returns the side of a cluster grid cell in degrees for the zoom level of a web map,
+// where the whole world fits into a single tile at zoom 0 and each next level doubles the tiles.
+func clusterCellSize(zoom int) float64 {
+	return 360 / math.Pow(2, float64(zoom)) / clusterCellsPerTile
+}
+
+// GetVacanciesMap returns the vacancies located inside the bounding box for the map view.
+// Below minMarkersZoom they are grouped into grid cells with counts.
+func (c *Controller) GetVacanciesMap(
+	ctx context.Context,
+	bbox *BoundingBox,
+	zoom int,
+	categoriesIDs []string,
+) (*VacanciesMap, error) {
+	if bbox == nil {
+		return nil, errors.WithStack(ErrInvalidBoundingBox)
+	}
+
+	if err := bbox.validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if zoom < 0 || zoom > maxMapZoom {
+		return nil, errors.WithStack(ErrInvalidMapZoom)
+	}
+
+	sbbox := &storage.BoundingBox{
+		MinLatitude:  bbox.MinLatitude,
+		MinLongitude: bbox.MinLongitude,
+		MaxLatitude:  bbox.MaxLatitude,
+		MaxLongitude: bbox.MaxLongitude,
+	}
+	filter := &storage.VacancyFilter{CategoriesIDs: categoriesIDs}
+
+	if zoom < minMarkersZoom {
+		cs, err := c.s.TxGetVacancyClusters(ctx, c.s.NoTx(), sbbox, filter, clusterCellSize(zoom))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		clusters := make([]*VacancyCluster, len(cs))
+		for idx, vc := range cs {
+			clusters[idx] = &VacancyCluster{Latitude: vc.Latitude, Longitude: vc.Longitude, Count: vc.Count}
+		}
+
+		return &VacanciesMap{Markers: []*VacancyMarker{}, Clusters: clusters}, nil
+	}
+
+	ms, err := c.s.TxGetVacancyMarkers(ctx, c.s.NoTx(), sbbox, filter, maxMapMarkers)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	markers := make([]*VacancyMarker, len(ms))
+	for idx, m := range ms {
+		markers[idx] = &VacancyMarker{
+			ID:        m.ID,
+			Title:     m.Title,
+			CompanyID: m.CompanyID,
+			Latitude:  m.Latitude,
+			Longitude: m.Longitude,
+		}
+	}
+
+	return &VacanciesMap{Markers: markers, Clusters: []*VacancyCluster{}}, nil
+}
@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const (
+	maxImportRows             = 5000
+	maxImportExternalIDLength = 255
+)
+
+var (
+	ErrInvalidImportFeed       = errors.New("invalid vacancy import feed")
+	ErrTooManyImportRows       = errors.New("too many vacancy import rows")
+	ErrInvalidImportRow        = errors.New("invalid vacancy import row")
+	ErrInvalidImportExternalID = errors.New("invalid vacancy import external id")
+	ErrImportCategoryNotFound  = errors.New("vacancy import category not found")
+	ErrImportCityNotFound      = errors.New("vacancy import city not found")
+)
+
+// ImportRow is a vacancy of a job feed. The vacancy is identified by the external id within the company,
+// categories and cities are given by their names.
+type ImportRow struct {
+	// Line is the line of a CSV row or the position of an XML job, starting from 1
+	Line                 int
+	ExternalID           string
+	Title                string
+	Description          string
+	Phone                string
+	MinSalary            int32
+	MaxSalary            int32
+	Currency             Currency
+	SalaryPeriod         SalaryPeriod
+	Type                 VacancyType
+	WorkMonthsExperience int32
+	WorkSchedule         string
+	Address              string
+	Categories           []string
+	Cities               []string
+
+	// field and err are set by the parsers for a row which can't be read
+	field string
+	err   error
+}
+
+// ImportRowError tells why a row wasn't imported. Field is the feed column at fault when it's known.
+type ImportRowError struct {
+	Line       int
+	ExternalID string
+	Field      string
+	Err        error
+}
+
+type ImportReport struct {
+	Created int
+	Updated int
+	Errors  []*ImportRowError
+}
+
+// ImportVacancies puts the vacancies of the job feed for the company. A vacancy imported before with the same
+// external id is updated, a new one is created as a draft. The rows which can't be imported are reported
+// and don't stop the import. The author is nil for the imports made by the system.
+func (c *Controller) ImportVacancies(
+	ctx context.Context,
+	authorID *string,
+	companyID string,
+	rows []*ImportRow,
+) (*ImportReport, error) {
+	if len(rows) > maxImportRows {
+		return nil, errors.WithStack(ErrTooManyImportRows)
+	}
+
+	var categoriesNames, citiesNames []string
+	for _, row := range rows {
+		categoriesNames = append(categoriesNames, row.Categories...)
+		citiesNames = append(citiesNames, row.Cities...)
+	}
+
+	categoriesIDs, err := c.s.TxGetVacancyCategoriesIDsByTitles(ctx, c.s.NoTx(), categoriesNames)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	citiesIDs, err := c.s.TxGetCitiesIDsByNames(ctx, c.s.NoTx(), citiesNames)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	report := &ImportReport{Errors: make([]*ImportRowError, 0)}
+
+	for _, row := range rows {
+		rowErr := &ImportRowError{Line: row.Line, ExternalID: row.ExternalID}
+
+		vacancy, categoryIDs, cityIDs, err := toImportVacancy(companyID, row, categoriesIDs, citiesIDs, rowErr)
+		if err != nil {
+			rowErr.Err = err
+			report.Errors = append(report.Errors, rowErr)
+
+			continue
+		}
+
+		created, err := c.importVacancy(ctx, authorID, companyID, row.ExternalID, vacancy, categoryIDs, cityIDs)
+
+		switch errors.Cause(err) {
+		case nil:
+		case ErrVacancyDuplicate:
+			rowErr.Err = err
+			report.Errors = append(report.Errors, rowErr)
+
+			continue
+		default:
+			return nil, errors.WithStack(err)
+		}
+
+		if created {
+			report.Created++
+		} else {
+			report.Updated++
+		}
+	}
+
+	return report, nil
+}
+
+func (c *Controller) importVacancy(
+	ctx context.Context,
+	authorID *string,
+	companyID string,
+	externalID string,
+	vacancy *VacancyDetails,
+	categoryIDs []string,
+	cityIDs []string,
+) (created bool, _ error) {
+	err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		var vacancyID *string
+
+		merged := *vacancy
+
+		switch id, err := c.s.TxGetVacancyIDByExternalID(ctx, tx, companyID, externalID); errors.Cause(err) {
+		case nil:
+			vacancyID = &id
+
+			if err := c.txMergeImportedVacancy(ctx, tx, id, &merged); err != nil {
+				return errors.WithStack(err)
+			}
+		case storage.ErrNotFound:
+			created = true
+		default:
+			return errors.WithStack(err)
+		}
+
+		vid, err := c.txPutVacancy(ctx, tx, authorID, vacancyID, &merged, categoryIDs, cityIDs)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.s.TxPutVacancyExternalID(ctx, tx, companyID, externalID, string(vid)))
+	})
+
+	return created, errors.WithStack(err)
+}
+
+// txMergeImportedVacancy keeps the location, images and salary gross of the vacancy imported before, since
+// a feed doesn't carry them.
+func (c *Controller) txMergeImportedVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	vacancy *VacancyDetails,
+) error {
+	existing, err := c.s.TxGetVacancyDetails(ctx, tx, vacancyID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	images, err := c.s.TxGetVacanciesImages(ctx, tx, []string{vacancyID})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	vacancy.LocationLatitude = existing.LocationLatitude
+	vacancy.LocationLongitude = existing.LocationLongitude
+	vacancy.CountryCode = existing.CountryCode
+	vacancy.SalaryGross = existing.SalaryGross
+	vacancy.ImageURLs = images[vacancyID]
+
+	return nil
+}
+
+// toImportVacancy builds the vacancy of the row and resolves its categories and cities. The field at fault
+// is set to rowErr.
+func toImportVacancy(
+	companyID string,
+	row *ImportRow,
+	categoriesIDs map[string]string,
+	citiesIDs map[string]string,
+	rowErr *ImportRowError,
+) (*VacancyDetails, []string, []string, error) {
+	if row.err != nil {
+		rowErr.Field = row.field
+		return nil, nil, nil, errors.WithStack(row.err)
+	}
+
+	if row.ExternalID == "" || utf8.RuneCountInString(row.ExternalID) > maxImportExternalIDLength {
+		rowErr.Field = "external_id"
+		return nil, nil, nil, errors.WithStack(ErrInvalidImportExternalID)
+	}
+
+	categoryIDs, ok := resolveImportNames(row.Categories, categoriesIDs)
+	if !ok {
+		rowErr.Field = "categories"
+		return nil, nil, nil, errors.WithStack(ErrImportCategoryNotFound)
+	}
+
+	cityIDs, ok := resolveImportNames(row.Cities, citiesIDs)
+	if !ok {
+		rowErr.Field = "cities"
+		return nil, nil, nil, errors.WithStack(ErrImportCityNotFound)
+	}
+
+	vacancyType := row.Type
+	if vacancyType == "" {
+		vacancyType = VacancyTypeNormal
+	}
+
+	vacancy := &VacancyDetails{
+		Vacancy: Vacancy{
+			Title:        row.Title,
+			Phone:        row.Phone,
+			MinSalary:    row.MinSalary,
+			MaxSalary:    row.MaxSalary,
+			Currency:     row.Currency,
+			SalaryPeriod: row.SalaryPeriod,
+			CompanyID:    companyID,
+		},
+		Description:          row.Description,
+		WorkMonthsExperience: row.WorkMonthsExperience,
+		WorkSchedule:         row.WorkSchedule,
+		Type:                 vacancyType,
+		Address:              row.Address,
+	}
+
+	if err := vacancy.validate(); err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+
+	return vacancy, categoryIDs, cityIDs, nil
+}
+
+func resolveImportNames(names []string, ids map[string]string) ([]string, bool) {
+	resolved := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		id, ok := ids[strings.ToLower(name)]
+		if !ok {
+			return nil, false
+		}
+
+		if !seen[id] {
+			seen[id] = true
+			resolved = append(resolved, id)
+		}
+	}
+
+	return resolved, true
+}
@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// importListSeparator separates the categories and the cities of a CSV row
+const importListSeparator = "|"
+
+var importCSVColumns = map[string]bool{
+	"external_id":            true,
+	"title":                  true,
+	"description":            true,
+	"phone":                  true,
+	"min_salary":             true,
+	"max_salary":             true,
+	"currency":               false,
+	"salary_period":          false,
+	"type":                   false,
+	"work_months_experience": true,
+	"work_schedule":          false,
+	"address":                false,
+	"categories":             false,
+	"cities":                 false,
+}
+
+// ParseImportCSV reads the vacancies of a CSV job feed. The header line names the columns in any order,
+// see importCSVColumns for the required ones. Categories and cities are separated with "|".
+func ParseImportCSV(r io.Reader) ([]*ImportRow, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidImportFeed)
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for idx, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := importCSVColumns[name]; !ok {
+			return nil, errors.WithStack(ErrInvalidImportFeed)
+		}
+
+		columns[name] = idx
+	}
+
+	for name, required := range importCSVColumns {
+		if _, ok := columns[name]; required && !ok {
+			return nil, errors.WithStack(ErrInvalidImportFeed)
+		}
+	}
+
+	rows := make([]*ImportRow, 0)
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(ErrInvalidImportFeed)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		value := func(name string) string {
+			if idx, ok := columns[name]; ok {
+				return strings.TrimSpace(record[idx])
+			}
+
+			return ""
+		}
+
+		row := &ImportRow{
+			Line:         line,
+			ExternalID:   value("external_id"),
+			Title:        value("title"),
+			Description:  value("description"),
+			Phone:        value("phone"),
+			Currency:     Currency(strings.ToUpper(value("currency"))),
+			SalaryPeriod: SalaryPeriod(strings.ToLower(value("salary_period"))),
+			WorkSchedule: value("work_schedule"),
+			Address:      value("address"),
+			Categories:   splitImportList(value("categories")),
+			Cities:       splitImportList(value("cities")),
+		}
+
+		row.MinSalary = row.parseInt32("min_salary", value("min_salary"))
+		row.MaxSalary = row.parseInt32("max_salary", value("max_salary"))
+		row.WorkMonthsExperience = row.parseInt32("work_months_experience", value("work_months_experience"))
+
+		switch t := VacancyType(strings.ToLower(value("type"))); t {
+		case "", VacancyTypeNormal, VacancyTypeRemote:
+			row.Type = t
+		default:
+			row.setErr("type")
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// xmlJobFeed is the common XML job feed format of the job boards:
+//
+//	<source>
+//	  <job>
+//	    <referencenumber>A-1</referencenumber>
+//	    <title>Golang developer</title>
+//	    <description>...</description>
+//	    <phone>+380501234567</phone>
+//	    <category>IT</category>
+//	    <city>Kyiv</city>
+//	    <salary min="10000" max="20000" currency="UAH" period="month"/>
+//	    <jobtype>remote</jobtype>
+//	    <experience>12</experience>
+//	    <schedule>...</schedule>
+//	    <address>...</address>
+//	  </job>
+//	</source>
+//
+// Categories and cities may be repeated. Any job type but remote is a normal vacancy, the experience is
+// in months.
+type xmlJobFeed struct {
+	XMLName xml.Name `xml:"source"`
+	Jobs    []struct {
+		ReferenceNumber string   `xml:"referencenumber"`
+		Title           string   `xml:"title"`
+		Description     string   `xml:"description"`
+		Phone           string   `xml:"phone"`
+		Categories      []string `xml:"category"`
+		Cities          []string `xml:"city"`
+		Salary          struct {
+			Min      string `xml:"min,attr"`
+			Max      string `xml:"max,attr"`
+			Currency string `xml:"currency,attr"`
+			Period   string `xml:"period,attr"`
+		} `xml:"salary"`
+		JobType    string `xml:"jobtype"`
+		Experience string `xml:"experience"`
+		Schedule   string `xml:"schedule"`
+		Address    string `xml:"address"`
+	} `xml:"job"`
+}
+
+// ParseImportXML reads the vacancies of an XML job feed, see xmlJobFeed.
+func ParseImportXML(r io.Reader) ([]*ImportRow, error) {
+	var feed xmlJobFeed
+	if err := xml.NewDecoder(r).Decode(&feed); err != nil {
+		return nil, errors.WithStack(ErrInvalidImportFeed)
+	}
+
+	rows := make([]*ImportRow, len(feed.Jobs))
+
+	for idx, job := range feed.Jobs {
+		row := &ImportRow{
+			Line:         idx + 1,
+			ExternalID:   strings.TrimSpace(job.ReferenceNumber),
+			Title:        strings.TrimSpace(job.Title),
+			Description:  strings.TrimSpace(job.Description),
+			Phone:        strings.TrimSpace(job.Phone),
+			Currency:     Currency(strings.ToUpper(strings.TrimSpace(job.Salary.Currency))),
+			SalaryPeriod: SalaryPeriod(strings.ToLower(strings.TrimSpace(job.Salary.Period))),
+			Type:         VacancyTypeNormal,
+			WorkSchedule: strings.TrimSpace(job.Schedule),
+			Address:      strings.TrimSpace(job.Address),
+			Categories:   trimImportList(job.Categories),
+			Cities:       trimImportList(job.Cities),
+		}
+
+		if strings.EqualFold(strings.TrimSpace(job.JobType), string(VacancyTypeRemote)) {
+			row.Type = VacancyTypeRemote
+		}
+
+		row.MinSalary = row.parseInt32("salary", job.Salary.Min)
+		row.MaxSalary = row.parseInt32("salary", job.Salary.Max)
+		row.WorkMonthsExperience = row.parseInt32("experience", job.Experience)
+
+		rows[idx] = row
+	}
+
+	return rows, nil
+}
+
+// parseInt32 reads an optional number, the row is marked invalid when it can't be read.
+func (r *ImportRow) parseInt32(field string, s string) int32 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		r.setErr(field)
+		return 0
+	}
+
+	return int32(n)
+}
+
+func (r *ImportRow) setErr(field string) {
+	if r.err == nil {
+		r.field = field
+		r.err = ErrInvalidImportRow
+	}
+}
+
+func splitImportList(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return trimImportList(strings.Split(s, importListSeparator))
+}
+
+func trimImportList(list []string) []string {
+	trimmed := make([]string, 0, len(list))
+
+	for _, s := range list {
+		if s = strings.TrimSpace(s); s != "" {
+			trimmed = append(trimmed, s)
+		}
+	}
+
+	return trimmed
+}
@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/vacancy/storage"
+)
+
+type VacancySortOrder string
+
+const (
+	VacancySortOrderNewest    VacancySortOrder = "newest"
+	VacancySortOrderSalary    VacancySortOrder = "salary"
+	VacancySortOrderRelevance VacancySortOrder = "relevance"
+	VacancySortOrderDistance  VacancySortOrder = "distance"
+)
+
+var (
+	ErrInvalidSortOrder             = errors.New("invalid sort order")
+	ErrInvalidFilterCategories      = errors.New("invalid filter categories")
+	ErrInvalidFilterCities          = errors.New("invalid filter cities")
+	ErrInvalidFilterSalary          = errors.New("invalid filter salary range")
+	ErrInvalidFilterCurrency        = errors.New("invalid filter currency")
+	ErrInvalidFilterType            = errors.New("invalid filter vacancy type")
+	ErrInvalidFilterKeywords        = errors.New("invalid filter keywords")
+	ErrInvalidFilterMaxExperience   = errors.New("invalid filter max experience")
+	ErrInvalidFilterCompanyID       = errors.New("invalid filter company id")
+	ErrInvalidFilterCountryCode     = errors.New("invalid filter country code")
+	ErrInvalidFilterStatus          = errors.New("invalid filter vacancy status")
+	ErrRelevanceSortWithoutKeywords = errors.New("relevance sort order requires keywords")
+	ErrDistanceSortWithoutNear      = errors.New("distance sort order requires near")
+)
+
+// VacancyListFilter narrows the vacancies list down, empty fields don't restrict anything.
+// Only live vacancies are listed, except for the ones of ViewerCompanyID, the company looking at the list.
+type VacancyListFilter struct {
+	VacancyFilter
+	MaxExperienceMonths *int32
+	CompanyID           string
+	CountryCode         *int32
+	Near                *GeoRadius
+	ViewerCompanyID     string
+	Statuses            []VacancyStatus
+}
+
+func (f *VacancyListFilter) validate() error {
+	for _, id := range f.CategoriesIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return ErrInvalidFilterCategories
+		}
+	}
+
+	for _, id := range f.CityIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return ErrInvalidFilterCities
+		}
+	}
+
+	if (f.MinSalary != nil && *f.MinSalary < 0) || (f.MaxSalary != nil && *f.MaxSalary < 0) ||
+		(f.MinSalary != nil && f.MaxSalary != nil && *f.MinSalary > *f.MaxSalary) {
+		return ErrInvalidFilterSalary
+	}
+
+	if !f.Currency.isValid() {
+		return ErrInvalidFilterCurrency
+	}
+
+	if f.Type != nil {
+		if _, err := toStorageVacancyType(*f.Type); err != nil {
+			return ErrInvalidFilterType
+		}
+	}
+
+	if len(f.Keywords) > maxSearchQueryLength {
+		return ErrInvalidFilterKeywords
+	}
+
+	if f.MaxExperienceMonths != nil && *f.MaxExperienceMonths < 0 {
+		return ErrInvalidFilterMaxExperience
+	}
+
+	if f.CompanyID != "" {
+		if _, err := uuid.FromString(f.CompanyID); err != nil {
+			return ErrInvalidFilterCompanyID
+		}
+	}
+
+	if f.CountryCode != nil && *f.CountryCode < 0 {
+		return ErrInvalidFilterCountryCode
+	}
+
+	for _, vs := range f.Statuses {
+		if _, err := toStorageVacancyStatus(vs); err != nil {
+			return ErrInvalidFilterStatus
+		}
+	}
+
+	if f.Near != nil {
+		return f.Near.validate()
+	}
+
+	return nil
+}
+
+// normalize returns a copy of the filter which compares equal to the same filter with the IDs in another order.
+func (f *VacancyListFilter) normalize() *VacancyListFilter {
+	nf := *f
+
+	nf.CategoriesIDs = sortedCopy(f.CategoriesIDs)
+	nf.CityIDs = sortedCopy(f.CityIDs)
+
+	if f.Statuses != nil {
+		nf.Statuses = make([]VacancyStatus, len(f.Statuses))
+		copy(nf.Statuses, f.Statuses)
+		sort.Slice(nf.Statuses, func(i, j int) bool { return nf.Statuses[i] < nf.Statuses[j] })
+	}
+
+	if nf.Currency == "" {
+		nf.Currency = DefaultCurrency
+	}
+
+	return &nf
+}
+
+func sortedCopy(ids []string) []string {
+	c := make([]string, len(ids))
+	copy(c, ids)
+	sort.Strings(c)
+
+	return c
+}
+
+func (f *VacancyListFilter) defaultSortOrder() VacancySortOrder {
+	if f.Near != nil {
+		return VacancySortOrderDistance
+	}
+
+	return VacancySortOrderNewest
+}
+
+func (f *VacancyListFilter) validateSortOrder(so VacancySortOrder) error {
+	switch so {
+	case VacancySortOrderNewest, VacancySortOrderSalary:
+	case VacancySortOrderRelevance:
+		if f.Keywords == "" {
+			return ErrRelevanceSortWithoutKeywords
+		}
+	case VacancySortOrderDistance:
+		if f.Near == nil {
+			return ErrDistanceSortWithoutNear
+		}
+	default:
+		return ErrInvalidSortOrder
+	}
+
+	return nil
+}
+
+func equalListFilters(a, b *VacancyListFilter) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func toStorageVacancyListFilter(f *VacancyListFilter) (*storage.VacancyFilter, error) {
+	sf := &storage.VacancyFilter{
+		CategoriesIDs: f.CategoriesIDs,
+		CityIDs:       f.CityIDs,
+		MinSalary:     f.MinSalary,
+		MaxSalary:     f.MaxSalary,
+		Currency:      toStorageCurrency(f.Currency),
+		Keywords:      f.Keywords,
+		MaxExperience: f.MaxExperienceMonths,
+		CountryCode:   f.CountryCode,
+		Radius:        toStorageGeoRadius(f.Near),
+	}
+
+	if f.Type != nil {
+		vt, err := toStorageVacancyType(*f.Type)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		sf.Type = &vt
+	}
+
+	if f.CompanyID != "" {
+		companyID := f.CompanyID
+		sf.CompanyID = &companyID
+	}
+
+	if f.ViewerCompanyID != "" {
+		viewerCompanyID := f.ViewerCompanyID
+		sf.OwnerID = &viewerCompanyID
+	}
+
+	for _, vs := range f.Statuses {
+		status, err := toStorageVacancyStatus(vs)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		sf.Statuses = append(sf.Statuses, status)
+	}
+
+	return sf, nil
+}
+
+func toStorageVacancySortOrder(so VacancySortOrder) (storage.VacancySortOrder, error) {
+	switch so {
+	case VacancySortOrderNewest:
+		return storage.VacancySortOrderNewest, nil
+	case VacancySortOrderSalary:
+		return storage.VacancySortOrderSalary, nil
+	case VacancySortOrderRelevance:
+		return storage.VacancySortOrderRelevance, nil
+	case VacancySortOrderDistance:
+		return storage.VacancySortOrderDistance, nil
+	default:
+		return "", errors.WithStack(ErrInvalidSortOrder)
+	}
+}
@@ -0,0 +1,565 @@
+package controller
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+// ModerationReason tells what is wrong with a vacancy. The automatic checks find the first four,
+// the rest are up to a moderator.
+type ModerationReason string
+
+const (
+	ModerationReasonBannedWords    ModerationReason = "banned_words"
+	ModerationReasonInvalidPhone   ModerationReason = "invalid_phone"
+	ModerationReasonMissingSalary  ModerationReason = "missing_salary"
+	ModerationReasonDuplicate      ModerationReason = "duplicate"
+	ModerationReasonMisleading     ModerationReason = "misleading"
+	ModerationReasonDiscriminatory ModerationReason = "discriminatory"
+	ModerationReasonOther          ModerationReason = "other"
+)
+
+type ModerationDecision string
+
+const (
+	ModerationDecisionSubmitted ModerationDecision = "submitted"
+	ModerationDecisionApproved  ModerationDecision = "approved"
+	ModerationDecisionRejected  ModerationDecision = "rejected"
+)
+
+const maxModerationCommentLength = 1000
+
+// moderationQueueSortOrder binds the cursor to the moderation queue, it's never requested by the clients.
+const moderationQueueSortOrder VacancySortOrder = "moderation_queue"
+
+var (
+	ErrVacancyNotPendingReview  = errors.New("vacancy isn't pending review")
+	ErrInvalidModerationReasons = errors.New("invalid moderation reasons")
+	ErrInvalidModerationComment = errors.New("invalid moderation comment")
+)
+
+var (
+	// vacancyPhonePattern is the format of the phones vacancies are published with, the same as the accounts have
+	vacancyPhonePattern = regexp.MustCompile(`^\+380\d{9}$`)
+	// textPhonePattern finds the phone numbers written in the text rather than the phone field
+	textPhonePattern = regexp.MustCompile(`\+?\d[\d\s()-]{8,}\d`)
+)
+
+// VacancyModerationDecision is a record of the moderation audit log. ModeratorID is nil for the automatic
+// checks, which either submit the vacancy with the reasons they found or approve it, see Config.
+type VacancyModerationDecision struct {
+	ModeratorID *string
+	Decision    ModerationDecision
+	Reasons     []ModerationReason
+	Comment     string
+	CreatedAt   time.Time
+}
+
+// ModerationQueueItem is a vacancy pending review. Reasons are what the automatic checks found wrong with it.
+type ModerationQueueItem struct {
+	Vacancy
+	SubmittedAt time.Time
+	Reasons     []ModerationReason
+}
+
+// moderationRule is an automatic check of a vacancy submitted for review, it tells whether the reason applies.
+type moderationRule struct {
+	reason ModerationReason
+	check  func(ctx context.Context, tx pkgtx.Tx, vd *storage.VacancyDetails) (bool, error)
+}
+
+func (c *Controller) moderationRules() []moderationRule {
+	return []moderationRule{
+		{reason: ModerationReasonBannedWords, check: c.hasBannedWords},
+		{reason: ModerationReasonInvalidPhone, check: hasInvalidPhone},
+		{reason: ModerationReasonMissingSalary, check: c.hasMissingSalary},
+		{reason: ModerationReasonDuplicate, check: c.isDuplicate},
+	}
+}
+
+func (c *Controller) hasBannedWords(_ context.Context, _ pkgtx.Tx, vd *storage.VacancyDetails) (bool, error) {
+	if len(c.cfg.ModerationBannedWords) == 0 {
+		return false, nil
+	}
+
+	words := make(map[string]bool)
+	for _, w := range strings.FieldsFunc(strings.ToLower(vd.Title+" "+vd.Description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[w] = true
+	}
+
+	for _, banned := range c.cfg.ModerationBannedWords {
+		if words[strings.ToLower(banned)] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isDuplicate tells whether the vacancy is linked to the one it reposts or another vacancy of the company
+// has the same text.
+func (c *Controller) isDuplicate(ctx context.Context, tx pkgtx.Tx, vd *storage.VacancyDetails) (bool, error) {
+	if vd.DuplicateOf != nil {
+		return true, nil
+	}
+
+	ok, err := c.s.TxHasVacancyDuplicate(ctx, tx, vd)
+
+	return ok, errors.WithStack(err)
+}
+
+// hasInvalidPhone tells whether the phone is malformed or the contacts are given in the text.
+func hasInvalidPhone(_ context.Context, _ pkgtx.Tx, vd *storage.VacancyDetails) (bool, error) {
+	return !vacancyPhonePattern.MatchString(vd.Phone) || textPhonePattern.MatchString(vd.Title+"\n"+vd.Description), nil
+}
+
+// hasMissingSalary tells whether the salary range is empty or can't be compared with the others,
+// so the vacancy wouldn't match any salary filter.
+func (c *Controller) hasMissingSalary(ctx context.Context, tx pkgtx.Tx, vd *storage.VacancyDetails) (bool, error) {
+	if vd.MaxSalary <= 0 || vd.MaxSalary < vd.MinSalary {
+		return true, nil
+	}
+
+	ok, err := c.s.TxHasExchangeRate(ctx, tx, vd.Currency)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return !ok, nil
+}
+
+func (c *Controller) txCheckVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vd *storage.VacancyDetails,
+) ([]storage.ModerationReason, error) {
+	reasons := make([]storage.ModerationReason, 0)
+
+	for _, rule := range c.moderationRules() {
+		found, err := rule.check(ctx, tx, vd)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if found {
+			reason, err := toStorageModerationReason(rule.reason)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return reasons, nil
+}
+
+// needsReview tells whether the vacancy has to be approved by a moderator before it goes live.
+func (c *Controller) needsReview(vd *storage.VacancyDetails) bool {
+	return c.cfg.ModerationEnabled && vd.NeedsReview
+}
+
+// txSubmitVacancy puts the vacancy on review with the reasons the automatic checks found. Once approved,
+// it goes live until expiresAt.
+func (c *Controller) txSubmitVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vd *storage.VacancyDetails,
+	expiresAt *time.Time,
+) error {
+	reasons, err := c.txCheckVacancy(ctx, tx, vd)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := c.s.TxPutVacancyStatus(
+		ctx,
+		tx,
+		vd.ID,
+		storage.VacancyStatusPendingReview,
+		vd.PublishedAt,
+		expiresAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := c.txPutModerationDecision(
+		ctx,
+		tx,
+		vd.ID,
+		nil,
+		storage.ModerationDecisionSubmitted,
+		reasons,
+		"",
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if c.cfg.ModerationAutoApprove && len(reasons) == 0 {
+		return errors.WithStack(c.txApproveVacancy(ctx, tx, vd, nil, expiresAt))
+	}
+
+	return nil
+}
+
+// txApproveVacancy makes the vacancy live until expiresAt, or for VacancyLifetime if it has expired while
+// the vacancy was on review.
+func (c *Controller) txApproveVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vd *storage.VacancyDetails,
+	moderatorID *string,
+	expiresAt *time.Time,
+) error {
+	now := time.Now()
+
+	if expiresAt == nil || !expiresAt.After(now) {
+		maxExpiresAt := now.Add(c.cfg.VacancyLifetime)
+		expiresAt = &maxExpiresAt
+	}
+
+	publishedAt := vd.PublishedAt
+	if publishedAt == nil {
+		publishedAt = &now
+	}
+
+	if err := c.s.TxPutVacancyStatus(
+		ctx,
+		tx,
+		vd.ID,
+		storage.VacancyStatusPublished,
+		publishedAt,
+		expiresAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := c.s.TxPutVacancyNeedsReview(ctx, tx, vd.ID, false); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := c.txPutModerationDecision(
+		ctx,
+		tx,
+		vd.ID,
+		moderatorID,
+		storage.ModerationDecisionApproved,
+		[]storage.ModerationReason{},
+		"",
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := c.txNotifyVacancyAlerts(ctx, tx, VacancyID(vd.ID), vd.Title); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(c.txNotifyModeration(ctx, tx, vd, ModerationDecisionApproved, nil, ""))
+}
+
+func (c *Controller) txPutModerationDecision(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	moderatorID *string,
+	decision storage.ModerationDecision,
+	reasons []storage.ModerationReason,
+	comment string,
+) error {
+	return errors.WithStack(c.s.TxPutVacancyModerationDecision(ctx, tx, &storage.VacancyModerationDecision{
+		ID:          uuid.NewV4().String(),
+		VacancyID:   vacancyID,
+		ModeratorID: moderatorID,
+		Decision:    decision,
+		Reasons:     reasons,
+		Comment:     comment,
+		CreatedAt:   time.Now(),
+	}))
+}
+
+func (c *Controller) txNotifyModeration(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vd *storage.VacancyDetails,
+	decision ModerationDecision,
+	reasons []ModerationReason,
+	comment string,
+) error {
+	if c.notifier == nil {
+		return nil
+	}
+
+	rs := make([]string, len(reasons))
+	for idx, r := range reasons {
+		rs[idx] = string(r)
+	}
+
+	return errors.WithStack(c.notifier.TxNotifyVacancyModeration(
+		ctx,
+		tx,
+		vd.CompanyID,
+		vd.ID,
+		vd.Title,
+		string(decision),
+		rs,
+		comment,
+	))
+}
+
+func (c *Controller) txGetVacancyPendingReview(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+) (*storage.VacancyDetails, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	}
+
+	vd, err := c.txGetVacancy(ctx, tx, vacancyID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if vd.Status != storage.VacancyStatusPendingReview {
+		return nil, errors.WithStack(ErrVacancyNotPendingReview)
+	}
+
+	return vd, nil
+}
+
+// ApproveVacancy makes a vacancy pending review live and notifies its company and the matching alerts.
+func (c *Controller) ApproveVacancy(ctx context.Context, moderatorID string, vacancyID string) error {
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.txGetVacancyPendingReview(ctx, tx, vacancyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txApproveVacancy(ctx, tx, vd, &moderatorID, vd.ExpiresAt))
+	}))
+}
+
+// RejectVacancy sends a vacancy pending review back to its company with the reasons and the comment.
+// The company may edit and publish it again.
+func (c *Controller) RejectVacancy(
+	ctx context.Context,
+	moderatorID string,
+	vacancyID string,
+	reasons []ModerationReason,
+	comment string,
+) error {
+	if len(reasons) == 0 {
+		return errors.WithStack(ErrInvalidModerationReasons)
+	}
+
+	srs := make([]storage.ModerationReason, len(reasons))
+	for idx, r := range reasons {
+		sr, err := toStorageModerationReason(r)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		srs[idx] = sr
+	}
+
+	if len([]rune(comment)) > maxModerationCommentLength {
+		return errors.WithStack(ErrInvalidModerationComment)
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.txGetVacancyPendingReview(ctx, tx, vacancyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := c.s.TxPutVacancyStatus(
+			ctx,
+			tx,
+			vd.ID,
+			storage.VacancyStatusRejected,
+			vd.PublishedAt,
+			vd.ExpiresAt,
+		); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := c.txPutModerationDecision(
+			ctx,
+			tx,
+			vd.ID,
+			&moderatorID,
+			storage.ModerationDecisionRejected,
+			srs,
+			comment,
+		); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txNotifyModeration(ctx, tx, vd, ModerationDecisionRejected, reasons, comment))
+	}))
+}
+
+// ListModerationQueue lists the vacancies pending review, the longest waiting first.
+func (c *Controller) ListModerationQueue(
+	ctx context.Context,
+	cursor *Cursor,
+	limit int,
+) ([]*ModerationQueueItem, *Cursor, error) {
+	cursorData, err := toCursorData(cursor)
+	if err != nil || (cursorData != nil && cursorData.SortOrder != moderationQueueSortOrder) {
+		return nil, nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	maxLimit := 100
+	if limit > maxLimit || limit <= 0 {
+		limit = maxLimit
+	}
+
+	items, storageCursor, err := c.s.TxGetModerationQueue(ctx, c.s.NoTx(), limit, toStorageCursor(cursorData))
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	controllerCursor, err := toCursor(storageCursor, nil, moderationQueueSortOrder)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	svs := make([]*storage.Vacancy, len(items))
+	for idx, item := range items {
+		svs[idx] = &item.Vacancy
+	}
+
+	vacancies, err := c.fromStorageVacancies(ctx, svs)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	queue := make([]*ModerationQueueItem, len(items))
+
+	for idx, item := range items {
+		reasons, err := fromStorageModerationReasons(item.Reasons)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		queue[idx] = &ModerationQueueItem{
+			Vacancy:     *vacancies[idx],
+			SubmittedAt: item.SubmittedAt,
+			Reasons:     reasons,
+		}
+	}
+
+	return queue, controllerCursor, nil
+}
+
+// GetVacancyModerationDecisions returns the moderation log of the vacancy, the oldest decision first.
+func (c *Controller) GetVacancyModerationDecisions(
+	ctx context.Context,
+	vacancyID string,
+) ([]*VacancyModerationDecision, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	}
+
+	sds, err := c.s.TxGetVacancyModerationDecisions(ctx, c.s.NoTx(), vacancyID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	decisions := make([]*VacancyModerationDecision, len(sds))
+
+	for idx, sd := range sds {
+		decision, err := fromStorageModerationDecision(sd.Decision)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		reasons, err := fromStorageModerationReasons(sd.Reasons)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		decisions[idx] = &VacancyModerationDecision{
+			ModeratorID: sd.ModeratorID,
+			Decision:    decision,
+			Reasons:     reasons,
+			Comment:     sd.Comment,
+			CreatedAt:   sd.CreatedAt,
+		}
+	}
+
+	return decisions, nil
+}
+
+func toStorageModerationReason(r ModerationReason) (storage.ModerationReason, error) {
+	switch r {
+	case ModerationReasonBannedWords:
+		return storage.ModerationReasonBannedWords, nil
+	case ModerationReasonInvalidPhone:
+		return storage.ModerationReasonInvalidPhone, nil
+	case ModerationReasonMissingSalary:
+		return storage.ModerationReasonMissingSalary, nil
+	case ModerationReasonDuplicate:
+		return storage.ModerationReasonDuplicate, nil
+	case ModerationReasonMisleading:
+		return storage.ModerationReasonMisleading, nil
+	case ModerationReasonDiscriminatory:
+		return storage.ModerationReasonDiscriminatory, nil
+	case ModerationReasonOther:
+		return storage.ModerationReasonOther, nil
+	default:
+		return "", errors.WithStack(ErrInvalidModerationReasons)
+	}
+}
+
+func fromStorageModerationReasons(srs []storage.ModerationReason) ([]ModerationReason, error) {
+	reasons := make([]ModerationReason, len(srs))
+
+	for idx, sr := range srs {
+		switch sr {
+		case storage.ModerationReasonBannedWords:
+			reasons[idx] = ModerationReasonBannedWords
+		case storage.ModerationReasonInvalidPhone:
+			reasons[idx] = ModerationReasonInvalidPhone
+		case storage.ModerationReasonMissingSalary:
+			reasons[idx] = ModerationReasonMissingSalary
+		case storage.ModerationReasonDuplicate:
+			reasons[idx] = ModerationReasonDuplicate
+		case storage.ModerationReasonMisleading:
+			reasons[idx] = ModerationReasonMisleading
+		case storage.ModerationReasonDiscriminatory:
+			reasons[idx] = ModerationReasonDiscriminatory
+		case storage.ModerationReasonOther:
+			reasons[idx] = ModerationReasonOther
+		default:
+			return nil, errors.Newf("unknown moderation reason=%s", sr)
+		}
+	}
+
+	return reasons, nil
+}
+
+func fromStorageModerationDecision(sd storage.ModerationDecision) (ModerationDecision, error) {
+	switch sd {
+	case storage.ModerationDecisionSubmitted:
+		return ModerationDecisionSubmitted, nil
+	case storage.ModerationDecisionApproved:
+		return ModerationDecisionApproved, nil
+	case storage.ModerationDecisionRejected:
+		return ModerationDecisionRejected, nil
+	default:
+		return "", errors.Newf("unknown moderation decision=%s", sd)
+	}
+}
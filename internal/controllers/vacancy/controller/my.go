@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// VacancyCounters are shown to the company owning the vacancy only.
+type VacancyCounters struct {
+	// AlertMatches is the number of saved searches the vacancy was sent to
+	AlertMatches int
+	// Applications is the number of applications to the vacancy which aren't withdrawn
+	Applications int
+	// Views counts every viewer once a day, see VacancyStatsPoint
+	Views int
+	// Saves is the number of accounts which have the vacancy saved
+	Saves int
+}
+
+type MyVacancy struct {
+	Vacancy
+	Counters VacancyCounters
+}
+
+// ListMyVacancies lists the vacancies of the company whatever their status, the newest first.
+// Empty statuses don't restrict anything.
+func (c *Controller) ListMyVacancies(
+	ctx context.Context,
+	companyID string,
+	statuses []VacancyStatus,
+	cursor *Cursor,
+	limit int,
+) ([]*MyVacancy, *Cursor, error) {
+	if _, err := uuid.FromString(companyID); err != nil {
+		return nil, nil, errors.WithStack(ErrInvalidFilterCompanyID)
+	}
+
+	vs, nextCursor, err := c.GetVacanciesList(ctx, &VacancyListFilter{
+		CompanyID:       companyID,
+		ViewerCompanyID: companyID,
+		Statuses:        statuses,
+	}, VacancySortOrderNewest, cursor, limit)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	ids := make([]string, len(vs))
+	for idx, v := range vs {
+		ids[idx] = v.ID
+	}
+
+	counters, err := c.s.TxGetVacanciesCounters(ctx, c.s.NoTx(), ids)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	mvs := make([]*MyVacancy, len(vs))
+
+	for idx, v := range vs {
+		mv := &MyVacancy{Vacancy: *v}
+
+		if vc, ok := counters[v.ID]; ok {
+			mv.Counters = VacancyCounters{
+				AlertMatches: vc.AlertMatches,
+				Applications: vc.Applications,
+				Views:        vc.Views,
+				Saves:        vc.Saves,
+			}
+		}
+
+		mvs[idx] = mv
+	}
+
+	return mvs, nextCursor, nil
+}
@@ -0,0 +1,328 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+var (
+	ErrVacancyRevisionNotFound = errors.New("vacancy revision not found")
+	ErrInvalidVacancyRevision  = errors.New("vacancy revision can't be restored")
+)
+
+// VacancyRevision is an immutable snapshot of the vacancy content with its categories, cities and images.
+// A revision is kept on every change of the content, the status changes aren't revisioned. AuthorID is nil
+// for the changes made by the system.
+type VacancyRevision struct {
+	VacancyID string
+	Revision  int32
+	AuthorID  *string
+	CreatedAt time.Time
+	// Vacancy has the content only, the status of the vacancy isn't a part of a revision
+	Vacancy       *VacancyDetails
+	CategoriesIDs []string
+	CityIDs       []string
+	// ChangedFields are the fields changed since the previous revision, see DiffVacancyRevisions.
+	// It's set for the listed revisions only.
+	ChangedFields []string
+}
+
+// VacancyFieldChange is a field changed between two revisions, the values are formatted as text.
+// The lists are comma separated.
+type VacancyFieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// ListVacancyRevisions returns the revisions of the vacancy with the fields each of them changed,
+// the latest first.
+func (c *Controller) ListVacancyRevisions(ctx context.Context, vacancyID string) ([]*VacancyRevision, error) {
+	if err := c.checkVacancyExists(ctx, c.s.NoTx(), vacancyID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	srs, err := c.s.TxGetVacancyRevisions(ctx, c.s.NoTx(), vacancyID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	revisions := make([]*VacancyRevision, len(srs))
+	for idx, sr := range srs {
+		if revisions[idx], err = fromStorageVacancyRevision(sr); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	// The revisions are the latest first, the earliest one is compared to an empty vacancy
+	for idx, r := range revisions {
+		previous := &VacancyRevision{Vacancy: &VacancyDetails{}}
+		if idx+1 < len(revisions) {
+			previous = revisions[idx+1]
+		}
+
+		changes := diffVacancyRevisions(previous, r)
+
+		r.ChangedFields = make([]string, len(changes))
+		for i, change := range changes {
+			r.ChangedFields[i] = change.Field
+		}
+	}
+
+	return revisions, nil
+}
+
+func (c *Controller) GetVacancyRevision(
+	ctx context.Context,
+	vacancyID string,
+	revision int32,
+) (*VacancyRevision, error) {
+	r, err := c.txGetVacancyRevision(ctx, c.s.NoTx(), vacancyID, revision)
+	return r, errors.WithStack(err)
+}
+
+// DiffVacancyRevisions returns the fields changed from one revision of the vacancy to another one.
+// The revisions may be given in any order.
+func (c *Controller) DiffVacancyRevisions(
+	ctx context.Context,
+	vacancyID string,
+	from int32,
+	to int32,
+) ([]*VacancyFieldChange, error) {
+	fromRevision, err := c.txGetVacancyRevision(ctx, c.s.NoTx(), vacancyID, from)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	toRevision, err := c.txGetVacancyRevision(ctx, c.s.NoTx(), vacancyID, to)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return diffVacancyRevisions(fromRevision, toRevision), nil
+}
+
+// RestoreVacancyRevision puts the content of the revision to the vacancy as the author would put it
+// with PutVacancy. The restored content is kept as a new revision, the earlier ones are left as is.
+func (c *Controller) RestoreVacancyRevision(
+	ctx context.Context,
+	authorID string,
+	vacancyID string,
+	revision int32,
+) error {
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		r, err := c.txGetVacancyRevision(ctx, tx, vacancyID, revision)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		// The rules of a vacancy may have changed since the revision was kept
+		if err := r.Vacancy.validate(); err != nil {
+			return errors.WithStack(ErrInvalidVacancyRevision)
+		}
+
+		_, err = c.txPutVacancy(ctx, tx, &authorID, &vacancyID, r.Vacancy, r.CategoriesIDs, r.CityIDs)
+
+		return errors.WithStack(err)
+	}))
+}
+
+func (c *Controller) checkVacancyExists(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
+	switch _, err := c.s.TxGetVacancyDetails(ctx, tx, vacancyID); errors.Cause(err) {
+	case nil:
+		return nil
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrVacancyNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+}
+
+// txGetVacancyRevision returns the revision with the company of the vacancy set to its content.
+func (c *Controller) txGetVacancyRevision(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	revision int32,
+) (*VacancyRevision, error) {
+	vd, err := c.s.TxGetVacancyDetails(ctx, tx, vacancyID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	sr, err := c.s.TxGetVacancyRevision(ctx, tx, vacancyID, &revision)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, errors.WithStack(ErrVacancyRevisionNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	r, err := fromStorageVacancyRevision(sr)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	r.Vacancy.CompanyID = vd.CompanyID
+
+	return r, nil
+}
+
+// txPutVacancyRevision keeps the put vacancy as a new revision unless its content is the same
+// as the one of the latest revision.
+func (c *Controller) txPutVacancyRevision(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	authorID *string,
+	vd *storage.VacancyDetails,
+	categoryIDs []string,
+	cityIDs []string,
+	imageURLs []string,
+) error {
+	snapshot := &storage.VacancySnapshot{
+		Title:                vd.Title,
+		Description:          vd.Description,
+		Phone:                vd.Phone,
+		MinSalary:            vd.MinSalary,
+		MaxSalary:            vd.MaxSalary,
+		Currency:             vd.Currency,
+		SalaryPeriod:         vd.SalaryPeriod,
+		SalaryGross:          vd.SalaryGross,
+		Type:                 vd.Type,
+		Address:              vd.Address,
+		CountryCode:          vd.CountryCode,
+		WorkMonthsExperience: vd.WorkMonthsExperience,
+		WorkSchedule:         vd.WorkSchedule,
+		LocationLatitude:     vd.LocationLatitude,
+		LocationLongitude:    vd.LocationLongitude,
+		CategoriesIDs:        sortedCopy(categoryIDs),
+		CityIDs:              sortedCopy(cityIDs),
+		ImageURLs:            append(make([]string, 0, len(imageURLs)), imageURLs...),
+	}
+
+	switch latest, err := c.s.TxGetVacancyRevision(ctx, tx, vd.ID, nil); errors.Cause(err) {
+	case nil:
+		if reflect.DeepEqual(latest.Snapshot, snapshot) {
+			return nil
+		}
+	case storage.ErrNotFound:
+	default:
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(c.s.TxPutVacancyRevision(ctx, tx, &storage.VacancyRevision{
+		VacancyID: vd.ID,
+		AuthorID:  authorID,
+		Snapshot:  snapshot,
+		CreatedAt: time.Now(),
+	}))
+}
+
+func fromStorageVacancyRevision(sr *storage.VacancyRevision) (*VacancyRevision, error) {
+	s := sr.Snapshot
+
+	vacancyType, err := fromStorageVacancyType(s.Type)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	salaryPeriod, err := fromStorageSalaryPeriod(s.SalaryPeriod)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &VacancyRevision{
+		VacancyID: sr.VacancyID,
+		Revision:  sr.Revision,
+		AuthorID:  sr.AuthorID,
+		CreatedAt: sr.CreatedAt,
+		Vacancy: &VacancyDetails{
+			Vacancy: Vacancy{
+				ID:           sr.VacancyID,
+				Title:        s.Title,
+				Phone:        s.Phone,
+				MinSalary:    s.MinSalary,
+				MaxSalary:    s.MaxSalary,
+				ImageURLs:    s.ImageURLs,
+				Currency:     Currency(s.Currency),
+				SalaryPeriod: salaryPeriod,
+				SalaryGross:  s.SalaryGross,
+			},
+			Description:          s.Description,
+			WorkMonthsExperience: s.WorkMonthsExperience,
+			WorkSchedule:         s.WorkSchedule,
+			LocationLatitude:     s.LocationLatitude,
+			LocationLongitude:    s.LocationLongitude,
+			Type:                 vacancyType,
+			Address:              s.Address,
+			CountryCode:          s.CountryCode,
+		},
+		CategoriesIDs: s.CategoriesIDs,
+		CityIDs:       s.CityIDs,
+	}, nil
+}
+
+type vacancyRevisionField struct {
+	name  string
+	value string
+}
+
+// vacancyRevisionFields returns the fields of the revision in the order they're diffed.
+func vacancyRevisionFields(r *VacancyRevision) []vacancyRevisionField {
+	v := r.Vacancy
+
+	salaryGross := ""
+	if v.SalaryGross != nil {
+		salaryGross = strconv.FormatBool(*v.SalaryGross)
+	}
+
+	return []vacancyRevisionField{
+		{name: "title", value: v.Title},
+		{name: "description", value: v.Description},
+		{name: "phone", value: v.Phone},
+		{name: "min_salary", value: strconv.Itoa(int(v.MinSalary))},
+		{name: "max_salary", value: strconv.Itoa(int(v.MaxSalary))},
+		{name: "currency", value: string(v.Currency)},
+		{name: "salary_period", value: string(v.SalaryPeriod)},
+		{name: "salary_gross", value: salaryGross},
+		{name: "type", value: string(v.Type)},
+		{name: "address", value: v.Address},
+		{name: "country_code", value: strconv.Itoa(int(v.CountryCode))},
+		{name: "work_months_experience", value: strconv.Itoa(int(v.WorkMonthsExperience))},
+		{name: "work_schedule", value: v.WorkSchedule},
+		{name: "location_latitude", value: strconv.FormatFloat(float64(v.LocationLatitude), 'f', -1, 32)},
+		{name: "location_longitude", value: strconv.FormatFloat(float64(v.LocationLongitude), 'f', -1, 32)},
+		{name: "categories_ids", value: strings.Join(r.CategoriesIDs, ", ")},
+		{name: "cities_ids", value: strings.Join(r.CityIDs, ", ")},
+		{name: "image_urls", value: strings.Join(v.ImageURLs, ", ")},
+	}
+}
+
+func diffVacancyRevisions(from *VacancyRevision, to *VacancyRevision) []*VacancyFieldChange {
+	fromFields, toFields := vacancyRevisionFields(from), vacancyRevisionFields(to)
+
+	changes := make([]*VacancyFieldChange, 0)
+
+	for idx, f := range fromFields {
+		if t := toFields[idx]; f.value != t.value {
+			changes = append(changes, &VacancyFieldChange{Field: f.name, From: f.value, To: t.value})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const (
+	CurrencyUAH Currency = "UAH"
+	// DefaultCurrency is the currency of the salaries and the filters without one
+	DefaultCurrency = CurrencyUAH
+)
+
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHour  SalaryPeriod = "hour"
+	SalaryPeriodMonth SalaryPeriod = "month"
+	SalaryPeriodYear  SalaryPeriod = "year"
+)
+
+var (
+	ErrInvalidVacancyCurrency     = errors.New("invalid vacancy currency")
+	ErrInvalidVacancySalaryPeriod = errors.New("invalid vacancy salary period")
+	ErrNoExchangeRate             = errors.New("no exchange rate for the currency")
+)
+
+func (c Currency) isValid() bool {
+	return c == "" || govalidator.IsISO4217(string(c))
+}
+
+func (p SalaryPeriod) orDefault() SalaryPeriod {
+	if p == "" {
+		return SalaryPeriodMonth
+	}
+
+	return p
+}
+
+func toStorageCurrency(c Currency) string {
+	if c == "" {
+		return string(DefaultCurrency)
+	}
+
+	return string(c)
+}
+
+func toStorageSalaryPeriod(p SalaryPeriod) (storage.SalaryPeriod, error) {
+	switch p {
+	case SalaryPeriodHour:
+		return storage.SalaryPeriodHour, nil
+	case SalaryPeriodMonth, "":
+		return storage.SalaryPeriodMonth, nil
+	case SalaryPeriodYear:
+		return storage.SalaryPeriodYear, nil
+	default:
+		return "", errors.WithStack(ErrInvalidVacancySalaryPeriod)
+	}
+}
+
+func fromStorageSalaryPeriod(p storage.SalaryPeriod) (SalaryPeriod, error) {
+	switch p {
+	case storage.SalaryPeriodHour:
+		return SalaryPeriodHour, nil
+	case storage.SalaryPeriodMonth:
+		return SalaryPeriodMonth, nil
+	case storage.SalaryPeriodYear:
+		return SalaryPeriodYear, nil
+	default:
+		return "", errors.Newf("unknown salary period=%s", p)
+	}
+}
+
+// txCheckExchangeRate makes sure the salary range of the filter can be compared with the vacancy salaries,
+// otherwise no vacancy would match it.
+func (c *Controller) txCheckExchangeRate(ctx context.Context, tx pkgtx.Tx, f *VacancyFilter) error {
+	if f.MinSalary == nil && f.MaxSalary == nil {
+		return nil
+	}
+
+	return c.txCheckCurrencyExchangeRate(ctx, tx, f.Currency)
+}
+
+// txCheckCurrencyExchangeRate makes sure the vacancy salaries can be converted to the currency.
+func (c *Controller) txCheckCurrencyExchangeRate(ctx context.Context, tx pkgtx.Tx, currency Currency) error {
+	ok, err := c.s.TxHasExchangeRate(ctx, tx, toStorageCurrency(currency))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !ok {
+		return errors.WithStack(ErrNoExchangeRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const maxSavedVacanciesPerAccount = 500
+
+var (
+	ErrSavedVacancyNotFound        = errors.New("saved vacancy not found")
+	ErrSavedVacanciesLimitExceeded = errors.New("saved vacancies limit exceeded")
+)
+
+// SaveVacancy bookmarks a live vacancy for the account, saving it again does nothing.
+func (c *Controller) SaveVacancy(ctx context.Context, accountID string, vacancyID string) error {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return errors.WithStack(ErrVacancyNotFound)
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.s.TxGetVacancyDetails(ctx, tx, vacancyID)
+
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrVacancyNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		now := time.Now()
+
+		if !isLiveVacancy(&vd.Vacancy, now) {
+			return errors.WithStack(ErrVacancyNotFound)
+		}
+
+		count, err := c.s.TxCountSavedVacancies(ctx, tx, accountID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if count >= maxSavedVacanciesPerAccount {
+			return errors.WithStack(ErrSavedVacanciesLimitExceeded)
+		}
+
+		if err := c.s.TxPutSavedVacancy(ctx, tx, accountID, vacancyID, now); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txRecordVacancyEvents(ctx, tx, VacancyEventTypeSave, accountID, []string{vacancyID}))
+	}))
+}
+
+func (c *Controller) UnsaveVacancy(ctx context.Context, accountID string, vacancyID string) error {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return errors.WithStack(ErrSavedVacancyNotFound)
+	}
+
+	switch err := c.s.TxDeleteSavedVacancy(ctx, c.s.NoTx(), accountID, vacancyID); errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return errors.WithStack(ErrSavedVacancyNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// ListSavedVacancies lists the vacancies saved by the account, the most recently saved first.
+// Vacancies which aren't live any more are left out, they are listed again once republished.
+func (c *Controller) ListSavedVacancies(
+	ctx context.Context,
+	accountID string,
+	cursor *Cursor,
+	limit int,
+) ([]*Vacancy, *Cursor, error) {
+	cursorData, err := toCursorData(cursor)
+	if err != nil || (cursorData != nil && cursorData.AccountID != accountID) {
+		return nil, nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	maxLimit := 100
+	if limit > maxLimit || limit <= 0 {
+		limit = maxLimit
+	}
+
+	vcs, storageCursor, err := c.s.TxGetSavedVacancies(ctx, c.s.NoTx(), accountID, limit, toStorageCursor(cursorData))
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	controllerCursor, err := toSavedCursor(storageCursor, accountID)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	vacancies, err := c.fromStorageVacancies(ctx, vcs)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return vacancies, controllerCursor, nil
+}
+
+// GetSavedVacanciesIDs returns the ones of vacancyIDs saved by the account.
+func (c *Controller) GetSavedVacanciesIDs(
+	ctx context.Context,
+	accountID string,
+	vacancyIDs []string,
+) (map[string]bool, error) {
+	if len(vacancyIDs) == 0 {
+		return map[string]bool{}, nil
+	}
+
+	saved, err := c.s.TxGetSavedVacanciesIDs(ctx, c.s.NoTx(), accountID, vacancyIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+// maxScheduleAhead is how far ahead a vacancy can be scheduled to be published
+const maxScheduleAhead = 90 * 24 * time.Hour
+
+var (
+	ErrInvalidVacancySchedule = errors.New("invalid vacancy schedule")
+	ErrVacancyNotLive         = errors.New("vacancy isn't live")
+	ErrVacancyBumpsExceeded   = errors.New("vacancy bumps limit exceeded")
+	ErrVacancyBumpedRecently  = errors.New("vacancy was bumped recently")
+)
+
+// ScheduleVacancyPublish makes the scheduler publish a draft or paused vacancy at publishAt as PublishVacancy
+// does, the vacancy expires in VacancyLifetime from then. A nil publishAt cancels the scheduled publishing.
+func (c *Controller) ScheduleVacancyPublish(ctx context.Context, vacancyID string, publishAt *time.Time) error {
+	now := time.Now()
+
+	if publishAt != nil && (!publishAt.After(now) || publishAt.After(now.Add(maxScheduleAhead))) {
+		return errors.WithStack(ErrInvalidVacancySchedule)
+	}
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.txGetVacancy(ctx, tx, vacancyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		status, err := fromStorageVacancyStatus(vd.Status)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if publishAt != nil && status != VacancyStatusDraft && status != VacancyStatusPaused {
+			return errors.WithStack(ErrInvalidVacancyStatusTransition)
+		}
+
+		return errors.WithStack(c.s.TxPutVacancySchedule(ctx, tx, vacancyID, publishAt))
+	}))
+}
+
+// PublishScheduledVacancies publishes a batch of the vacancies scheduled to be published by now and returns
+// the number of vacancies published or put on review. A vacancy which can't be published anymore has its
+// schedule cancelled.
+func (c *Controller) PublishScheduledVacancies(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	ids, err := c.s.TxGetDueScheduledVacancies(ctx, c.s.NoTx(), now, c.cfg.ScheduledPublishBatchSize)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	published := 0
+
+	for _, id := range ids {
+		err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+			return errors.WithStack(c.txPublishVacancy(ctx, tx, id, nil, now))
+		})
+
+		switch errors.Cause(err) {
+		case nil:
+			published++
+		case ErrInvalidVacancyStatusTransition, ErrVacancyNotFound:
+			if err := c.s.TxPutVacancySchedule(ctx, c.s.NoTx(), id, nil); err != nil {
+				return published, errors.WithStack(err)
+			}
+		default:
+			return published, errors.WithStack(err)
+		}
+	}
+
+	return published, nil
+}
+
+// BumpVacancy lists a live vacancy as the newest one again, the time it was created at is kept. A vacancy
+// is bumped up to MaxBumps times, not more often than once in BumpInterval.
+func (c *Controller) BumpVacancy(ctx context.Context, vacancyID string) error {
+	now := time.Now()
+
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.txGetVacancy(ctx, tx, vacancyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if !isLiveVacancy(&vd.Vacancy, now) {
+			return errors.WithStack(ErrVacancyNotLive)
+		}
+
+		if int(vd.BumpCount) >= c.cfg.MaxBumps {
+			return errors.WithStack(ErrVacancyBumpsExceeded)
+		}
+
+		if vd.BumpedAt != nil && now.Before(vd.BumpedAt.Add(c.cfg.BumpInterval)) {
+			return errors.WithStack(ErrVacancyBumpedRecently)
+		}
+
+		err = c.s.TxBumpVacancy(ctx, tx, vacancyID, now, c.cfg.MaxBumps, now.Add(-c.cfg.BumpInterval))
+
+		switch errors.Cause(err) {
+		case nil:
+			return nil
+		case storage.ErrNotFound:
+			// The vacancy read above was bumped concurrently
+			return errors.WithStack(ErrVacancyBumpedRecently)
+		default:
+			return errors.WithStack(err)
+		}
+	}))
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+)
+
+const (
+	maxSearchQueryLength = 255
+	maxSearchQueryTerms  = 10
+)
+
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+// VacancySearchResult is a found vacancy with the matched words wrapped in <b></b> tags.
+type VacancySearchResult struct {
+	Vacancy
+	TitleHighlight     string
+	DescriptionSnippet string
+}
+
+// toSearchQuery turns the user input into a text search query which matches vacancies containing all
+// the words, the last one may be typed partially. Everything but letters and digits is dropped,
+// so the input can't break the query syntax.
+func toSearchQuery(query string) (string, error) {
+	if len(query) > maxSearchQueryLength {
+		return "", errors.WithStack(ErrInvalidSearchQuery)
+	}
+
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(terms) == 0 {
+		return "", errors.WithStack(ErrInvalidSearchQuery)
+	}
+
+	if len(terms) > maxSearchQueryTerms {
+		terms = terms[:maxSearchQueryTerms]
+	}
+
+	terms[len(terms)-1] += ":*"
+
+	return strings.Join(terms, " & "), nil
+}
+
+// SearchVacancies returns the vacancies matching the query ordered by relevance. Titles weigh more than
+// company titles and those more than descriptions.
+func (c *Controller) SearchVacancies(
+	ctx context.Context,
+	query string,
+	categoriesIDs []string,
+	cursor *Cursor,
+	limit int,
+) ([]*VacancySearchResult, *Cursor, error) {
+	tsQuery, err := toSearchQuery(query)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	cursorData, err := toCursorData(cursor)
+	if err != nil || (cursorData != nil &&
+		(cursorData.Query != tsQuery || !equal(cursorData.CategoriesIDs, categoriesIDs))) {
+		return nil, nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	maxLimit := 100
+	if limit > maxLimit || limit <= 0 {
+		limit = maxLimit
+	}
+
+	rs, storageCursor, err := c.s.TxSearchVacancies(
+		ctx,
+		c.s.NoTx(),
+		tsQuery,
+		&storage.VacancyFilter{CategoriesIDs: categoriesIDs},
+		limit,
+		toStorageCursor(cursorData),
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	controllerCursor, err := toSearchCursor(storageCursor, tsQuery, categoriesIDs)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	vacancyIDs := make([]string, len(rs))
+	for idx := range rs {
+		vacancyIDs[idx] = rs[idx].ID
+	}
+
+	vacanciesImagesMap, err := c.s.TxGetVacanciesImages(ctx, c.s.NoTx(), vacancyIDs)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, nil, errors.WithStack(ErrVacancyImagesNotFound)
+	default:
+		return nil, nil, errors.WithStack(err)
+	}
+
+	results := make([]*VacancySearchResult, len(rs))
+
+	for idx, r := range rs {
+		salaryPeriod, err := fromStorageSalaryPeriod(r.SalaryPeriod)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		results[idx] = &VacancySearchResult{
+			Vacancy: Vacancy{
+				ID:           r.ID,
+				Title:        r.Title,
+				Phone:        r.Phone,
+				MinSalary:    r.MinSalary,
+				MaxSalary:    r.MaxSalary,
+				Currency:     Currency(r.Currency),
+				SalaryPeriod: salaryPeriod,
+				SalaryGross:  r.SalaryGross,
+				ImageURLs:    vacanciesImagesMap[r.ID],
+				CompanyID:    r.CompanyID,
+			},
+			TitleHighlight:     r.TitleHighlight,
+			DescriptionSnippet: r.DescriptionSnippet,
+		}
+	}
+
+	return results, controllerCursor, nil
+}
@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/vacancy/storage"
+)
+
+const (
+	maxSimilarVacancies = 20
+	// The newest candidates are scored, the older ones are unlikely to be shown anyway
+	similarVacancyCandidates = 200
+
+	similarityCategoriesWeight = 0.35
+	similarityCitiesWeight     = 0.15
+	similaritySalaryWeight     = 0.15
+	similarityTitleWeight      = 0.25
+	similarityDistanceWeight   = 0.1
+	// The distance similarity halves at this distance
+	similarityDistanceScaleKm = 10
+)
+
+// VacancySimilarityFeatures are the features the vacancies are compared by. The salaries are monthly
+// in the base currency, nil when unknown. Distance is in meters from the vacancy compared to, nil when
+// either of them isn't located.
+type VacancySimilarityFeatures struct {
+	CategoriesIDs []string
+	CityIDs       []string
+	MinSalary     *float64
+	MaxSalary     *float64
+	Title         string
+	Distance      *float64
+}
+
+// GetSimilarVacancies returns up to limit live vacancies similar to the vacancy, the most similar first,
+// see VacancySimilarity. The distance of a vacancy is the one from the vacancy compared to.
+func (c *Controller) GetSimilarVacancies(ctx context.Context, vacancyID string, limit int) ([]*Vacancy, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	}
+
+	if limit > maxSimilarVacancies || limit <= 0 {
+		limit = maxSimilarVacancies
+	}
+
+	origin, err := c.s.TxGetVacancySimilarity(ctx, c.s.NoTx(), vacancyID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	candidates, err := c.s.TxGetSimilarVacancyCandidates(ctx, c.s.NoTx(), vacancyID, similarVacancyCandidates)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	originFeatures := toVacancySimilarityFeatures(origin)
+
+	scores := make(map[string]float64, len(candidates))
+	for _, candidate := range candidates {
+		scores[candidate.ID] = VacancySimilarity(originFeatures, toVacancySimilarityFeatures(candidate))
+	}
+
+	// The candidates are the newest first, so are the equally similar ones
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].ID] > scores[candidates[j].ID]
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	vcs := make([]*storage.Vacancy, len(candidates))
+	for idx, candidate := range candidates {
+		vcs[idx] = &candidate.Vacancy
+	}
+
+	vacancies, err := c.fromStorageVacancies(ctx, vcs)
+
+	return vacancies, errors.WithStack(err)
+}
+
+// VacancySimilarity scores the similarity of the candidate to the origin from 0 to 1. It's the weighted sum
+// of the shares of the common categories and cities, the salary proximity, the share of the common words
+// of the titles and the proximity of the locations. An unknown feature doesn't add to the score.
+func VacancySimilarity(origin *VacancySimilarityFeatures, candidate *VacancySimilarityFeatures) float64 {
+	score := similarityCategoriesWeight*jaccardSimilarity(origin.CategoriesIDs, candidate.CategoriesIDs) +
+		similarityCitiesWeight*jaccardSimilarity(origin.CityIDs, candidate.CityIDs) +
+		similaritySalaryWeight*salarySimilarity(origin, candidate) +
+		similarityTitleWeight*jaccardSimilarity(titleWords(origin.Title), titleWords(candidate.Title))
+
+	if candidate.Distance != nil {
+		score += similarityDistanceWeight / (1 + *candidate.Distance/1000/similarityDistanceScaleKm)
+	}
+
+	return score
+}
+
+func toVacancySimilarityFeatures(sv *storage.SimilarVacancy) *VacancySimilarityFeatures {
+	return &VacancySimilarityFeatures{
+		CategoriesIDs: sv.CategoriesIDs,
+		CityIDs:       sv.CityIDs,
+		MinSalary:     sv.NormalizedMinSalary,
+		MaxSalary:     sv.NormalizedMaxSalary,
+		Title:         sv.Title,
+		Distance:      sv.Distance,
+	}
+}
+
+// jaccardSimilarity returns the share of the common elements of the sets, 0 when both are empty.
+func jaccardSimilarity(a []string, b []string) float64 {
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+
+	union := len(set)
+	common := 0
+	seen := make(map[string]bool, len(b))
+
+	for _, s := range b {
+		if seen[s] {
+			continue
+		}
+
+		seen[s] = true
+
+		if set[s] {
+			common++
+		} else {
+			union++
+		}
+	}
+
+	if union == 0 {
+		return 0
+	}
+
+	return float64(common) / float64(union)
+}
+
+// salarySimilarity compares the middles of the salary ranges, 1 for the equal ones and 0 when either
+// is unknown.
+func salarySimilarity(a *VacancySimilarityFeatures, b *VacancySimilarityFeatures) float64 {
+	aMiddle, ok := salaryMiddle(a)
+	if !ok {
+		return 0
+	}
+
+	bMiddle, ok := salaryMiddle(b)
+	if !ok {
+		return 0
+	}
+
+	return 1 - math.Abs(aMiddle-bMiddle)/math.Max(aMiddle, bMiddle)
+}
+
+func salaryMiddle(f *VacancySimilarityFeatures) (float64, bool) {
+	if f.MinSalary == nil || f.MaxSalary == nil || *f.MaxSalary <= 0 {
+		return 0, false
+	}
+
+	return (*f.MinSalary + *f.MaxSalary) / 2, true
+}
+
+// titleWords returns the lowercased words of the title, the one letter ones are skipped.
+func titleWords(title string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if utf8.RuneCountInString(f) > 1 {
+			words = append(words, f)
+		}
+	}
+
+	return words
+}
+
+// GetLiveVacanciesByIDs returns the live ones of the vacancies in the order of the ids.
+func (c *Controller) GetLiveVacanciesByIDs(ctx context.Context, vacancyIDs []string) ([]*Vacancy, error) {
+	ids := make([]string, 0, len(vacancyIDs))
+	for _, id := range vacancyIDs {
+		if _, err := uuid.FromString(id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	vcs, err := c.s.TxGetLiveVacanciesByIDs(ctx, c.s.NoTx(), ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	order := make(map[string]int, len(ids))
+	for idx, id := range ids {
+		order[id] = idx
+	}
+
+	sort.Slice(vcs, func(i, j int) bool {
+		return order[vcs[i].ID] < order[vcs[j].ID]
+	})
+
+	vacancies, err := c.fromStorageVacancies(ctx, vcs)
+
+	return vacancies, errors.WithStack(err)
+}
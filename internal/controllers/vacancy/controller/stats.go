@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+const (
+	maxVacancyStatsPoints     = 1000
+	defaultVacancyStatsPeriod = 30 * 24 * time.Hour
+	// vacancyStatsRollupLag makes a rollup recount the hour before the last one rolled up, so the events
+	// committed a bit later than they were created are counted too
+	vacancyStatsRollupLag = time.Hour
+)
+
+type VacancyEventType string
+
+const (
+	VacancyEventTypeView        VacancyEventType = "view"
+	VacancyEventTypeImpression  VacancyEventType = "impression"
+	VacancyEventTypeSave        VacancyEventType = "save"
+	VacancyEventTypePhoneReveal VacancyEventType = "phone_reveal"
+)
+
+type VacancyStatsGranularity string
+
+const (
+	VacancyStatsGranularityHour VacancyStatsGranularity = "hour"
+	VacancyStatsGranularityDay  VacancyStatsGranularity = "day"
+)
+
+var (
+	ErrInvalidVacancyEventType        = errors.New("invalid vacancy event type")
+	ErrInvalidVacancyStatsRange       = errors.New("invalid vacancy stats range")
+	ErrInvalidVacancyStatsGranularity = errors.New("invalid vacancy stats granularity")
+)
+
+// VacancyStatsPoint sums up the events of a vacancy from Time till the next point. Every viewer is counted
+// once a day for each event type.
+type VacancyStatsPoint struct {
+	Time         time.Time
+	Views        int
+	Impressions  int
+	Saves        int
+	PhoneReveals int
+}
+
+// RecordVacancyEvents records the event of the viewer for the vacancies. The viewer is an account or
+// an anonymous visitor, nothing is recorded when viewerKey is empty.
+func (c *Controller) RecordVacancyEvents(
+	ctx context.Context,
+	eventType VacancyEventType,
+	viewerKey string,
+	vacancyIDs []string,
+) error {
+	return errors.WithStack(c.txRecordVacancyEvents(ctx, c.s.NoTx(), eventType, viewerKey, vacancyIDs))
+}
+
+func (c *Controller) txRecordVacancyEvents(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	eventType VacancyEventType,
+	viewerKey string,
+	vacancyIDs []string,
+) error {
+	storageEventType, err := toStorageVacancyEventType(eventType)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ids := make([]string, 0, len(vacancyIDs))
+
+	for _, id := range vacancyIDs {
+		if _, err := uuid.FromString(id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	if viewerKey == "" || len(ids) == 0 {
+		return nil
+	}
+
+	// concurrent recordings lock the same events in the same order
+	sort.Strings(ids)
+
+	return errors.WithStack(c.s.TxPutVacancyEvents(ctx, tx, storageEventType, viewerKey, ids, time.Now()))
+}
+
+// RevealVacancyPhone returns the phone of the vacancy recording that the viewer asked for it. The caller checks
+// whether the viewer may see the vacancy.
+func (c *Controller) RevealVacancyPhone(ctx context.Context, viewerKey string, vacancyID string) (string, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return "", errors.WithStack(ErrVacancyNotFound)
+	}
+
+	var phone string
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.s.TxGetVacancyDetails(ctx, tx, vacancyID)
+
+		switch errors.Cause(err) {
+		case nil:
+		case storage.ErrNotFound:
+			return errors.WithStack(ErrVacancyNotFound)
+		default:
+			return errors.WithStack(err)
+		}
+
+		phone = vd.Phone
+
+		return errors.WithStack(c.txRecordVacancyEvents(
+			ctx,
+			tx,
+			VacancyEventTypePhoneReveal,
+			viewerKey,
+			[]string{vacancyID},
+		))
+	}); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return phone, nil
+}
+
+// RollupVacancyStats counts the recorded events into the hourly stats and returns the number of the stats
+// rows written. Rollups of several servers run one after another.
+func (c *Controller) RollupVacancyStats(ctx context.Context) (int, error) {
+	var count int
+
+	if err := pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		rolledUpTill, err := c.s.TxLockVacancyStatsRollup(ctx, tx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		now := time.Now()
+		since := rolledUpTill.Add(-vacancyStatsRollupLag).UTC().Truncate(time.Hour)
+
+		if count, err = c.s.TxRollupVacancyStats(ctx, tx, since); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.s.TxPutVacancyStatsRollup(ctx, tx, now))
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// GetVacancyStats returns the time series of the vacancy events by granularity, which is a day when empty.
+// The range is the last 30 days when from or till are nil, it is widened to whole hours or days in UTC.
+// Stats lag behind the events till they are rolled up, see RollupVacancyStats.
+func (c *Controller) GetVacancyStats(
+	ctx context.Context,
+	vacancyID string,
+	from *time.Time,
+	till *time.Time,
+	granularity VacancyStatsGranularity,
+) ([]*VacancyStatsPoint, error) {
+	if _, err := uuid.FromString(vacancyID); err != nil {
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	}
+
+	var step time.Duration
+
+	switch granularity {
+	case VacancyStatsGranularityHour:
+		step = time.Hour
+	case VacancyStatsGranularityDay, "":
+		step = 24 * time.Hour
+	default:
+		return nil, errors.WithStack(ErrInvalidVacancyStatsGranularity)
+	}
+
+	end := time.Now()
+	if till != nil {
+		end = *till
+	}
+
+	start := end.Add(-defaultVacancyStatsPeriod)
+	if from != nil {
+		start = *from
+	}
+
+	start = start.UTC().Truncate(step)
+
+	if aligned := end.UTC().Truncate(step); aligned.Before(end) {
+		end = aligned.Add(step)
+	} else {
+		end = aligned
+	}
+
+	if !start.Before(end) || end.Sub(start)/step > maxVacancyStatsPoints {
+		return nil, errors.WithStack(ErrInvalidVacancyStatsRange)
+	}
+
+	sps, err := c.s.TxGetVacancyStats(ctx, c.s.NoTx(), vacancyID, start, end, step)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	points := make([]*VacancyStatsPoint, len(sps))
+
+	for idx, sp := range sps {
+		points[idx] = &VacancyStatsPoint{
+			Time:         sp.Time,
+			Views:        sp.Views,
+			Impressions:  sp.Impressions,
+			Saves:        sp.Saves,
+			PhoneReveals: sp.PhoneReveals,
+		}
+	}
+
+	return points, nil
+}
+
+func toStorageVacancyEventType(t VacancyEventType) (storage.VacancyEventType, error) {
+	switch t {
+	case VacancyEventTypeView:
+		return storage.VacancyEventTypeView, nil
+	case VacancyEventTypeImpression:
+		return storage.VacancyEventTypeImpression, nil
+	case VacancyEventTypeSave:
+		return storage.VacancyEventTypeSave, nil
+	case VacancyEventTypePhoneReveal:
+		return storage.VacancyEventTypePhoneReveal, nil
+	default:
+		return "", errors.WithStack(ErrInvalidVacancyEventType)
+	}
+}
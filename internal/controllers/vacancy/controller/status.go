@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"personaapp/internal/controllers/vacancy/storage"
+	pkgtx "personaapp/pkg/tx"
+)
+
+type VacancyStatus string
+
+const (
+	VacancyStatusDraft         VacancyStatus = "draft"
+	VacancyStatusPublished     VacancyStatus = "published"
+	VacancyStatusPaused        VacancyStatus = "paused"
+	VacancyStatusClosed        VacancyStatus = "closed"
+	VacancyStatusPendingReview VacancyStatus = "pending_review"
+	VacancyStatusRejected      VacancyStatus = "rejected"
+)
+
+var (
+	ErrInvalidVacancyStatusTransition = errors.New("invalid vacancy status transition")
+	ErrInvalidVacancyExpiry           = errors.New("invalid vacancy expiry")
+)
+
+// vacancyStatusTransitions lists the statuses a vacancy may move to from each status. A closed vacancy
+// stays closed. A vacancy goes on review instead of being published while moderation is on and it has been
+// edited since the last approval, the moderator either publishes or rejects it.
+var vacancyStatusTransitions = map[VacancyStatus][]VacancyStatus{
+	VacancyStatusDraft:         {VacancyStatusPublished, VacancyStatusPendingReview, VacancyStatusClosed},
+	VacancyStatusPublished:     {VacancyStatusPaused, VacancyStatusPendingReview, VacancyStatusClosed},
+	VacancyStatusPaused:        {VacancyStatusPublished, VacancyStatusPendingReview, VacancyStatusClosed},
+	VacancyStatusPendingReview: {VacancyStatusPublished, VacancyStatusRejected, VacancyStatusClosed},
+	VacancyStatusRejected:      {VacancyStatusPublished, VacancyStatusPendingReview, VacancyStatusClosed},
+}
+
+func canTransit(from VacancyStatus, to VacancyStatus) bool {
+	for _, s := range vacancyStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsLive tells whether the vacancy is shown to everybody rather than to its company only.
+func (v *Vacancy) IsLive(now time.Time) bool {
+	return v.Status == VacancyStatusPublished && v.ExpiresAt != nil && v.ExpiresAt.After(now)
+}
+
+func isLiveVacancy(v *storage.Vacancy, now time.Time) bool {
+	return v.Status == storage.VacancyStatusPublished && v.ExpiresAt != nil && v.ExpiresAt.After(now)
+}
+
+// PublishVacancy makes a draft or paused vacancy live until expiresAt, which is in VacancyLifetime
+// when nil and can't be later than that. Saved alerts matching the vacancy are notified.
+// While moderation is on, a vacancy edited since the last approval goes on review instead.
+// The scheduled publishing of the vacancy is cancelled.
+func (c *Controller) PublishVacancy(ctx context.Context, vacancyID string, expiresAt *time.Time) error {
+	return errors.WithStack(pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		return errors.WithStack(c.txPublishVacancy(ctx, tx, vacancyID, expiresAt, time.Now()))
+	}))
+}
+
+func (c *Controller) txPublishVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	expiresAt *time.Time,
+	now time.Time,
+) error {
+	maxExpiresAt := now.Add(c.cfg.VacancyLifetime)
+
+	if expiresAt == nil {
+		expiresAt = &maxExpiresAt
+	}
+
+	if !expiresAt.After(now) || expiresAt.After(maxExpiresAt) {
+		return errors.WithStack(ErrInvalidVacancyExpiry)
+	}
+
+	vd, err := c.txGetVacancy(ctx, tx, vacancyID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if c.needsReview(vd) {
+		if err := checkTransition(vd, VacancyStatusPendingReview); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.txSubmitVacancy(ctx, tx, vd, expiresAt))
+	}
+
+	if err := checkTransition(vd, VacancyStatusPublished); err != nil {
+		return errors.WithStack(err)
+	}
+
+	publishedAt := vd.PublishedAt
+	if publishedAt == nil {
+		publishedAt = &now
+	}
+
+	if err := c.s.TxPutVacancyStatus(
+		ctx,
+		tx,
+		vacancyID,
+		storage.VacancyStatusPublished,
+		publishedAt,
+		expiresAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(c.txNotifyVacancyAlerts(ctx, tx, VacancyID(vacancyID), vd.Title))
+}
+
+// PauseVacancy hides a published vacancy until it's published again.
+func (c *Controller) PauseVacancy(ctx context.Context, vacancyID string) error {
+	return errors.WithStack(c.transit(ctx, vacancyID, VacancyStatusPaused))
+}
+
+// CloseVacancy hides the vacancy for good, its data is kept unlike with DeleteVacancy.
+func (c *Controller) CloseVacancy(ctx context.Context, vacancyID string) error {
+	return errors.WithStack(c.transit(ctx, vacancyID, VacancyStatusClosed))
+}
+
+// CloseExpiredVacancies closes a batch of the published vacancies which have expired and returns
+// the number of vacancies closed.
+func (c *Controller) CloseExpiredVacancies(ctx context.Context) (int, error) {
+	ids, err := c.s.TxCloseExpiredVacancies(ctx, c.s.NoTx(), time.Now(), c.cfg.ExpiryBatchSize)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return len(ids), nil
+}
+
+func (c *Controller) transit(ctx context.Context, vacancyID string, to VacancyStatus) error {
+	return pkgtx.RunInTx(ctx, c.s, func(ctx context.Context, tx pkgtx.Tx) error {
+		vd, err := c.txGetVacancy(ctx, tx, vacancyID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := checkTransition(vd, to); err != nil {
+			return errors.WithStack(err)
+		}
+
+		status, err := toStorageVacancyStatus(to)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(c.s.TxPutVacancyStatus(ctx, tx, vacancyID, status, vd.PublishedAt, vd.ExpiresAt))
+	})
+}
+
+func (c *Controller) txGetVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+) (*storage.VacancyDetails, error) {
+	vd, err := c.s.TxGetVacancyDetails(ctx, tx, vacancyID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case storage.ErrNotFound:
+		return nil, errors.WithStack(ErrVacancyNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return vd, nil
+}
+
+func checkTransition(vd *storage.VacancyDetails, to VacancyStatus) error {
+	from, err := fromStorageVacancyStatus(vd.Status)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !canTransit(from, to) {
+		return errors.WithStack(ErrInvalidVacancyStatusTransition)
+	}
+
+	return nil
+}
+
+func toStorageVacancyStatus(vs VacancyStatus) (storage.VacancyStatus, error) {
+	switch vs {
+	case VacancyStatusDraft:
+		return storage.VacancyStatusDraft, nil
+	case VacancyStatusPublished:
+		return storage.VacancyStatusPublished, nil
+	case VacancyStatusPaused:
+		return storage.VacancyStatusPaused, nil
+	case VacancyStatusClosed:
+		return storage.VacancyStatusClosed, nil
+	case VacancyStatusPendingReview:
+		return storage.VacancyStatusPendingReview, nil
+	case VacancyStatusRejected:
+		return storage.VacancyStatusRejected, nil
+	default:
+		return "", errors.New("wrong vacancy status")
+	}
+}
+
+func fromStorageVacancyStatus(vs storage.VacancyStatus) (VacancyStatus, error) {
+	switch vs {
+	case storage.VacancyStatusDraft:
+		return VacancyStatusDraft, nil
+	case storage.VacancyStatusPublished:
+		return VacancyStatusPublished, nil
+	case storage.VacancyStatusPaused:
+		return VacancyStatusPaused, nil
+	case storage.VacancyStatusClosed:
+		return VacancyStatusClosed, nil
+	case storage.VacancyStatusPendingReview:
+		return VacancyStatusPendingReview, nil
+	case storage.VacancyStatusRejected:
+		return VacancyStatusRejected, nil
+	default:
+		return "", errors.New("wrong vacancy status")
+	}
+}
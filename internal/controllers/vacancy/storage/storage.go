@@ -3,11 +3,13 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/cockroachdb/errors"
 	"github.com/lib/pq"
 	"personaapp/pkg/postgresql"
 	pkgtx "personaapp/pkg/tx"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -29,8 +31,45 @@ const (
 	VacancyTypeNormal VacancyType = "vacancy_type_normal"
 )
 
+type VacancyStatus string
+
+const (
+	VacancyStatusDraft     VacancyStatus = "vacancy_status_draft"
+	VacancyStatusPublished VacancyStatus = "vacancy_status_published"
+	VacancyStatusPaused    VacancyStatus = "vacancy_status_paused"
+	VacancyStatusClosed    VacancyStatus = "vacancy_status_closed"
+	// A vacancy waits for a moderator in the pending review status, see TxGetModerationQueue.
+	VacancyStatusPendingReview VacancyStatus = "vacancy_status_pending_review"
+	VacancyStatusRejected      VacancyStatus = "vacancy_status_rejected"
+)
+
+type ModerationDecision string
+
+const (
+	ModerationDecisionSubmitted ModerationDecision = "moderation_decision_submitted"
+	ModerationDecisionApproved  ModerationDecision = "moderation_decision_approved"
+	ModerationDecisionRejected  ModerationDecision = "moderation_decision_rejected"
+)
+
+type ModerationReason string
+
+const (
+	ModerationReasonBannedWords    ModerationReason = "moderation_reason_banned_words"
+	ModerationReasonInvalidPhone   ModerationReason = "moderation_reason_invalid_phone"
+	ModerationReasonMissingSalary  ModerationReason = "moderation_reason_missing_salary"
+	ModerationReasonDuplicate      ModerationReason = "moderation_reason_duplicate"
+	ModerationReasonMisleading     ModerationReason = "moderation_reason_misleading"
+	ModerationReasonDiscriminatory ModerationReason = "moderation_reason_discriminatory"
+	ModerationReasonOther          ModerationReason = "moderation_reason_other"
+)
+
+// VacancyCategory is a node of the categories tree. Path is the materialized path of the ids from the root,
+// '/root/child/', and Position orders the categories of the same parent.
 type VacancyCategory struct {
 	ID        string
+	ParentID  *string
+	Path      string
+	Position  int32
 	Title     string
 	IconURL   string
 	Rating    int32
@@ -38,6 +77,13 @@ type VacancyCategory struct {
 	UpdatedAt time.Time
 }
 
+// VacancyCategoryTitle is the title of the category in a lowercased BCP 47 locale, 'uk' or 'en-us'.
+type VacancyCategoryTitle struct {
+	CategoryID string
+	Locale     string
+	Title      string
+}
+
 type VacancyCategoryShort struct {
 	VacancyID string
 	ID        string
@@ -62,9 +108,20 @@ type Vacancy struct {
 	Phone     string
 	MinSalary int32
 	MaxSalary int32
-	CompanyID string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	// Currency is the ISO 4217 code of the salary range.
+	Currency     string
+	SalaryPeriod SalaryPeriod
+	// SalaryGross tells whether the salary is before taxes, nil when not specified.
+	SalaryGross *bool
+	CompanyID   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// Distance is set in meters when the vacancies are looked up around a point.
+	Distance *float64
+	// Status, PublishedAt and ExpiresAt are read only, see TxPutVacancyStatus.
+	Status      VacancyStatus
+	PublishedAt *time.Time
+	ExpiresAt   *time.Time
 }
 
 type VacancyDetails struct {
@@ -77,27 +134,286 @@ type VacancyDetails struct {
 	Type                 VacancyType
 	Address              string
 	CountryCode          int32
+	// NeedsReview tells whether the vacancy was changed since a moderator approved it.
+	NeedsReview bool
+	// DuplicateOf is the vacancy this one reposts, only its company gets it listed while the original is live.
+	DuplicateOf *string
+	// ScheduledPublishAt, BumpedAt and BumpCount are read only, see TxPutVacancySchedule and TxBumpVacancy.
+	ScheduledPublishAt *time.Time
+	BumpedAt           *time.Time
+	BumpCount          int32
+}
+
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHour  SalaryPeriod = "salary_period_hour"
+	SalaryPeriodMonth SalaryPeriod = "salary_period_month"
+	SalaryPeriodYear  SalaryPeriod = "salary_period_year"
+)
+
+type VacancySortOrder string
+
+const (
+	VacancySortOrderNewest    VacancySortOrder = "newest"
+	VacancySortOrderSalary    VacancySortOrder = "salary"
+	VacancySortOrderRelevance VacancySortOrder = "relevance"
+	VacancySortOrderDistance  VacancySortOrder = "distance"
+)
+
+type VacancyEventType string
+
+const (
+	VacancyEventTypeView        VacancyEventType = "vacancy_event_type_view"
+	VacancyEventTypeImpression  VacancyEventType = "vacancy_event_type_impression"
+	VacancyEventTypeSave        VacancyEventType = "vacancy_event_type_save"
+	VacancyEventTypePhoneReveal VacancyEventType = "vacancy_event_type_phone_reveal"
+)
+
+// VacancyStatsPoint sums up the events of a vacancy from Time till the next point.
+type VacancyStatsPoint struct {
+	Time         time.Time
+	Views        int
+	Impressions  int
+	Saves        int
+	PhoneReveals int
 }
 
+// Cursor points after the last returned vacancy. PrevKey is its sort key for the orders other than newest,
+// PrevCreatedAt is the time it was listed at for the newest one.
 type Cursor struct {
 	PrevCreatedAt time.Time
 	PrevPosition  int
+	PrevKey       float64
+}
+
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type GeoRadius struct {
+	GeoPoint
+	Meters float64
+}
+
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+type VacancyMarker struct {
+	ID        string
+	Title     string
+	CompanyID string
+	GeoPoint
+}
+
+type VacancyCluster struct {
+	GeoPoint
+	Count int
+}
+
+// VacancyFilter narrows vacancies down. The salary range is a monthly one in Currency, it is compared
+// with the vacancy salaries converted by the exchange rates, see normalized_salary.
+type VacancyFilter struct {
+	CategoriesIDs []string
+	CityIDs       []string
+	MinSalary     *int32
+	MaxSalary     *int32
+	Currency      string
+	Type          *VacancyType
+	Keywords      string
+	// The fields below are not a part of saved alerts, the vacancies list only.
+	MaxExperience *int32
+	CompanyID     *string
+	CountryCode   *int32
+	Radius        *GeoRadius
+	// Only published unexpired vacancies match, except for the ones of the OwnerID company.
+	OwnerID  *string
+	Statuses []VacancyStatus
+}
+
+// VacancyCounters sums up what happened to a vacancy since it was created.
+type VacancyCounters struct {
+	AlertMatches int
+	Applications int
+	Views        int
+	Saves        int
+}
+
+// VacancyFacets holds the vacancy counts per facet value. SalaryBuckets is keyed by the bucket index,
+// see TxGetVacancyFacets.
+type VacancyFacets struct {
+	Categories    map[string]int
+	Cities        map[string]int
+	Types         map[VacancyType]int
+	SalaryBuckets map[int]int
+}
+
+type VacancySearchResult struct {
+	Vacancy
+	TitleHighlight     string
+	DescriptionSnippet string
+}
+
+type VacancyAlert struct {
+	ID        string
+	AccountID string
+	VacancyFilter
+	CreatedAt time.Time
+}
+
+type VacancyAlertMatch struct {
+	AlertID   string
+	AccountID string
+}
+
+// vacancyFilterExprs holds the SQL expressions the vacancy filter is compared with,
+// either query placeholders or columns of a saved alert.
+type vacancyFilterExprs struct {
+	CategoriesIDs string
+	CityIDs       string
+	MinSalary     string
+	MaxSalary     string
+	Currency      string
+	Type          string
+	Keywords      string
+	MaxExperience string
+	CompanyID     string
+	CountryCode   string
+	OwnerID       string
+	Statuses      string
+}
+
+// queryArgs collects the arguments of a query built on the fly.
+type queryArgs []interface{}
+
+// add appends the argument and returns its placeholder.
+func (a *queryArgs) add(v interface{}) string {
+	*a = append(*a, v)
+	return fmt.Sprintf("$%d", len(*a))
 }
 
+// envelope adds the bounding box arguments and returns the geography of the box.
+func (a *queryArgs) envelope(b *BoundingBox) string {
+	return fmt.Sprintf(
+		`ST_MakeEnvelope(%s::float8, %s::float8, %s::float8, %s::float8, 4326)::geography`,
+		a.add(b.MinLongitude),
+		a.add(b.MinLatitude),
+		a.add(b.MaxLongitude),
+		a.add(b.MaxLatitude),
+	)
+}
+
+// point adds the point arguments and returns its geography.
+func (a *queryArgs) point(p GeoPoint) string {
+	return fmt.Sprintf(
+		`ST_SetSRID(ST_MakePoint(%s::float8, %s::float8), 4326)::geography`,
+		a.add(p.Longitude),
+		a.add(p.Latitude),
+	)
+}
+
+// radius adds the radius arguments and returns the condition keeping the vacancy aliased as v within it.
+// The condition is empty without a radius.
+func (a *queryArgs) radius(r *GeoRadius) string {
+	if r == nil {
+		return ""
+	}
+
+	return ` AND ST_DWithin(v.location, ` + a.point(r.GeoPoint) + `, ` + a.add(r.Meters) + `::float8)`
+}
+
+// filter adds the filter arguments and returns the expressions to build the filter condition with.
+func (a *queryArgs) filter(f *VacancyFilter) vacancyFilterExprs {
+	return vacancyFilterExprs{
+		CategoriesIDs: a.add(pq.Array(f.CategoriesIDs)) + "::uuid[]",
+		CityIDs:       a.add(pq.Array(f.CityIDs)) + "::uuid[]",
+		MinSalary:     a.add(f.MinSalary) + "::integer",
+		MaxSalary:     a.add(f.MaxSalary) + "::integer",
+		Currency:      a.add(f.Currency) + "::char(3)",
+		Type:          a.add(f.Type) + "::e_vacancy_type",
+		Keywords:      a.add(f.Keywords) + "::text",
+		MaxExperience: a.add(f.MaxExperience) + "::integer",
+		CompanyID:     a.add(f.CompanyID) + "::uuid",
+		CountryCode:   a.add(f.CountryCode) + "::integer",
+		OwnerID:       a.add(f.OwnerID) + "::uuid",
+		Statuses:      a.add(pq.Array(f.Statuses)) + "::e_vacancy_status[]",
+	}
+}
+
+// liveVacancyCondition matches the vacancies listed to anybody, a repost is hidden while its original is live.
+const liveVacancyCondition = `v.status = 'vacancy_status_published' AND v.expires_at > now()
+	AND (v.duplicate_of IS NULL OR NOT EXISTS (
+		SELECT 1 FROM vacancy AS o
+		WHERE o.id = v.duplicate_of AND o.status = 'vacancy_status_published' AND o.expires_at > now()
+	))`
+
+// vacancyFilterCondition builds the predicate over the vacancy aliased as v. The vacancies list and
+// the alerts matcher share it, so a vacancy triggers an alert only if the same search would return it.
+// A category matches the vacancies of its whole subtree.
+func vacancyFilterCondition(e vacancyFilterExprs) string {
+	return fmt.Sprintf(
+		`((`+liveVacancyCondition+`) OR v.company_id = %[10]s)
+		AND (coalesce(cardinality(%[1]s), 0) = 0 OR v.id IN (
+			SELECT vscs.vacancy_id FROM vacancies_categories AS vscs
+			INNER JOIN vacancy_category AS child ON child.id = vscs.category_id
+			INNER JOIN vacancy_category AS parent ON child.path LIKE parent.path || '%%'
+			WHERE parent.id = ANY(%[1]s)
+		))
+		AND (coalesce(cardinality(%[2]s), 0) = 0 OR v.id IN (
+			SELECT vacancy_id FROM vacancy_cities WHERE city_id = ANY(%[2]s)
+		))
+		AND (%[3]s IS NULL OR normalized_salary(v.max_salary, v.currency, v.salary_period) >=
+			normalized_salary(%[3]s, %[12]s, 'salary_period_month'))
+		AND (%[4]s IS NULL OR normalized_salary(v.min_salary, v.currency, v.salary_period) <=
+			normalized_salary(%[4]s, %[12]s, 'salary_period_month'))
+		AND (%[5]s IS NULL OR v.type = %[5]s)
+		AND (%[6]s = '' OR v.search_vector @@ plainto_tsquery('vacancy_search', %[6]s))
+		AND (%[7]s IS NULL OR v.work_months_experience <= %[7]s)
+		AND (%[8]s IS NULL OR v.company_id = %[8]s)
+		AND (%[9]s IS NULL OR v.country_code = %[9]s)
+		AND (coalesce(cardinality(%[11]s), 0) = 0 OR v.status = ANY(%[11]s))`,
+		e.CategoriesIDs,
+		e.CityIDs,
+		e.MinSalary,
+		e.MaxSalary,
+		e.Type,
+		e.Keywords,
+		e.MaxExperience,
+		e.CompanyID,
+		e.CountryCode,
+		e.OwnerID,
+		e.Statuses,
+		e.Currency,
+	)
+}
+
+// TxGetVacanciesCategoriesList returns the categories rated not less than the given rating, the subtree below
+// the parent only when it's given. The categories are ordered by their position among the children
+// of their parents.
 func (s *Storage) TxGetVacanciesCategoriesList(
 	ctx context.Context,
 	tx pkgtx.Tx,
 	rating int32,
+	parentID *string,
 ) (_ []*VacancyCategory, rerr error) {
 	c := postgresql.FromTx(tx)
 
 	rows, err := c.QueryContext(
 		ctx,
-		`SELECT id, title, icon_url, rating 
-				FROM vacancy_category
-				WHERE rating >= $1
-				ORDER BY title ASC`,
+		`SELECT vc.id, vc.parent_id, vc.path, vc.position, vc.title, vc.icon_url, vc.rating
+				FROM vacancy_category AS vc
+				WHERE vc.rating >= $1
+					AND ($2::uuid IS NULL OR vc.id <> $2 AND vc.path LIKE (
+						SELECT p.path || '%' FROM vacancy_category AS p WHERE p.id = $2
+					))
+				ORDER BY vc.position, vc.title`,
 		rating,
+		parentID,
 	)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -117,7 +433,15 @@ func (s *Storage) TxGetVacanciesCategoriesList(
 
 	for rows.Next() {
 		var vc VacancyCategory
-		if err := rows.Scan(&vc.ID, &vc.Title, &vc.IconURL, &vc.Rating); err != nil {
+		if err := rows.Scan(
+			&vc.ID,
+			&vc.ParentID,
+			&vc.Path,
+			&vc.Position,
+			&vc.Title,
+			&vc.IconURL,
+			&vc.Rating,
+		); err != nil {
 			return nil, errors.WithStack(err)
 		}
 
@@ -130,6 +454,8 @@ func (s *Storage) TxGetVacanciesCategoriesList(
 	return vcs, nil
 }
 
+// TxPutVacancyCategory creates the category or updates its title, icon and rating. The place of the category
+// in the tree is set on creation only, see TxMoveVacancyCategory.
 func (s *Storage) TxPutVacancyCategory(ctx context.Context, tx pkgtx.Tx, category *VacancyCategory) error {
 	c := postgresql.FromTx(tx)
 
@@ -144,8 +470,8 @@ func (s *Storage) TxPutVacancyCategory(ctx context.Context, tx pkgtx.Tx, categor
 				WHERE id = $1
 				RETURNING id, title, icon_url
 			)
-			INSERT INTO vacancy_category (id, title, icon_url, rating, created_at, updated_at)
-			SELECT $1, $2, $3, $4, $5, $6
+			INSERT INTO vacancy_category (id, title, icon_url, rating, created_at, updated_at, parent_id, path, position)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9
 			WHERE NOT EXISTS (SELECT * FROM upsert)`,
 		category.ID,
 		category.Title,
@@ -153,6 +479,9 @@ func (s *Storage) TxPutVacancyCategory(ctx context.Context, tx pkgtx.Tx, categor
 		category.Rating,
 		category.CreatedAt,
 		category.UpdatedAt,
+		category.ParentID,
+		category.Path,
+		category.Position,
 	); err != nil {
 		return errors.WithStack(err)
 	}
@@ -166,11 +495,11 @@ func (s *Storage) TxGetVacancyCategory(ctx context.Context, tx pkgtx.Tx, categor
 	var vc VacancyCategory
 	err := c.QueryRowContext(
 		ctx,
-		`SELECT id, title, icon_url, rating
+		`SELECT id, parent_id, path, position, title, icon_url, rating
 				FROM vacancy_category
 				WHERE id = $1`,
 		categoryID,
-	).Scan(&vc.ID, &vc.Title, &vc.IconURL, &vc.Rating)
+	).Scan(&vc.ID, &vc.ParentID, &vc.Path, &vc.Position, &vc.Title, &vc.IconURL, &vc.Rating)
 
 	switch err {
 	case nil:
@@ -198,22 +527,208 @@ func (s *Storage) TxDeleteVacancyCategory(ctx context.Context, tx pkgtx.Tx, cate
 	return nil
 }
 
+/**
+Categories tree part start
+*/
+
+// TxGetVacancyCategoryChildrenIDs returns the ids of the children of the parent ordered by their position,
+// the ids of the roots for the nil parent.
+func (s *Storage) TxGetVacancyCategoryChildrenIDs(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	parentID *string,
+) (_ []string, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id
+			FROM vacancy_category
+			WHERE parent_id IS NOT DISTINCT FROM $1::uuid
+			ORDER BY position, title`,
+		parentID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	ids := make([]string, 0)
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		ids = append(ids, id)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return ids, nil
+}
+
+// TxPutVacancyCategoriesPositions numbers the categories from 0 in the given order.
+func (s *Storage) TxPutVacancyCategoriesPositions(ctx context.Context, tx pkgtx.Tx, categoriesIDs []string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE vacancy_category AS vc SET position = o.position - 1
+			FROM unnest($1::uuid[]) WITH ORDINALITY AS o(id, position)
+			WHERE vc.id = o.id`,
+		pq.Array(categoriesIDs),
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxMoveVacancyCategory sets the parent of the category and moves its subtree to the new path of the category.
+func (s *Storage) TxMoveVacancyCategory(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	categoryID string,
+	parentID *string,
+	path string,
+	now time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`WITH moved AS (SELECT path FROM vacancy_category WHERE id = $1)
+			UPDATE vacancy_category AS vc SET
+				parent_id = CASE WHEN vc.id = $1 THEN $2::uuid ELSE vc.parent_id END,
+				path = $3 || substr(vc.path, length(moved.path) + 1),
+				updated_at = $4
+			FROM moved
+			WHERE vc.path LIKE moved.path || '%'`,
+		categoryID,
+		parentID,
+		path,
+		now,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxGetVacancyCategoriesTitles returns the localized titles of the categories.
+func (s *Storage) TxGetVacancyCategoriesTitles(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	categoriesIDs []string,
+) (_ []*VacancyCategoryTitle, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT category_id, locale, title
+			FROM vacancy_category_title
+			WHERE category_id = ANY($1::uuid[])`,
+		pq.Array(categoriesIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	titles := make([]*VacancyCategoryTitle, 0)
+
+	for rows.Next() {
+		var t VacancyCategoryTitle
+		if err := rows.Scan(&t.CategoryID, &t.Locale, &t.Title); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		titles = append(titles, &t)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return titles, nil
+}
+
+// TxPutVacancyCategoryTitles replaces the localized titles of the category.
+func (s *Storage) TxPutVacancyCategoryTitles(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	categoryID string,
+	titles []*VacancyCategoryTitle,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM vacancy_category_title WHERE category_id = $1`,
+		categoryID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, t := range titles {
+		if _, err := c.ExecContext(
+			ctx,
+			`INSERT INTO vacancy_category_title (category_id, locale, title) VALUES ($1, $2, $3)`,
+			categoryID,
+			t.Locale,
+			t.Title,
+		); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+/**
+Categories tree part end
+*/
+
 func (s *Storage) TxGetVacancyDetails(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*VacancyDetails, error) {
 	c := postgresql.FromTx(tx)
 
 	var vd VacancyDetails
 	err := c.QueryRowContext(
 		ctx,
-		`SELECT id, title, description, phone, min_salary, max_salary, company_id, 
-					type, address, country_code, work_months_experience, 
-					work_schedule, ST_X(location::geometry), ST_Y(location::geometry), created_at, updated_at
+		`SELECT id, title, description, phone, min_salary, max_salary, currency, salary_period, salary_gross,
+					company_id, type, address, country_code, work_months_experience, 
+					work_schedule, ST_X(location::geometry), ST_Y(location::geometry), created_at, updated_at,
+					status, published_at, expires_at, needs_review, duplicate_of, scheduled_publish_at, bumped_at,
+					bump_count
 				FROM vacancy
 				WHERE id = $1`,
 		vacancyID,
-	).Scan(&vd.ID, &vd.Title, &vd.Description, &vd.Phone, &vd.MinSalary, &vd.MaxSalary, &vd.CompanyID,
+	).Scan(&vd.ID, &vd.Title, &vd.Description, &vd.Phone, &vd.MinSalary, &vd.MaxSalary,
+		&vd.Currency, &vd.SalaryPeriod, &vd.SalaryGross, &vd.CompanyID,
 		&vd.Type, &vd.Address, &vd.CountryCode,
 		&vd.WorkMonthsExperience, &vd.WorkSchedule, &vd.LocationLongitude, &vd.LocationLatitude,
-		&vd.CreatedAt, &vd.UpdatedAt)
+		&vd.CreatedAt, &vd.UpdatedAt, &vd.Status, &vd.PublishedAt, &vd.ExpiresAt, &vd.NeedsReview,
+		&vd.DuplicateOf, &vd.ScheduledPublishAt, &vd.BumpedAt, &vd.BumpCount)
 
 	switch err {
 	case nil:
@@ -245,14 +760,23 @@ func (s *Storage) TxPutVacancy(ctx context.Context, tx pkgtx.Tx, vacancy *Vacanc
 					type = $12,
 					address = $13,
 					country_code = $14,
-					updated_at = $16
+					updated_at = $16,
+					currency = $17,
+					salary_period = $18,
+					salary_gross = $19,
+					needs_review = $20,
+					duplicate_of = $21,
+					fingerprint = vacancy_fingerprint($2, $3)
 				WHERE id = $1
 				RETURNING id, title, description, phone, min_salary, max_salary, company_id, work_months_experience, 
-					work_schedule, location, type, address, country_code, created_at, updated_at
+					work_schedule, location, type, address, country_code, created_at, updated_at, currency,
+					salary_period, salary_gross, needs_review, duplicate_of, fingerprint
 			)
 			INSERT INTO vacancy (id, title, description, phone, min_salary, max_salary, company_id, work_months_experience, 
-					work_schedule, location, type, address, country_code, created_at, updated_at)
-			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, ST_SetSRID(ST_MakePoint($10, $11), 4326), $12, $13, $14, $15, $16
+					work_schedule, location, type, address, country_code, created_at, updated_at, currency,
+					salary_period, salary_gross, needs_review, duplicate_of, fingerprint, listed_at)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, ST_SetSRID(ST_MakePoint($10, $11), 4326), $12, $13, $14, $15, $16,
+				$17, $18, $19, $20, $21, vacancy_fingerprint($2, $3), $15
 			WHERE NOT EXISTS (SELECT * FROM upsert)`,
 		vacancy.ID,
 		vacancy.Title,
@@ -270,6 +794,11 @@ func (s *Storage) TxPutVacancy(ctx context.Context, tx pkgtx.Tx, vacancy *Vacanc
 		vacancy.CountryCode,
 		vacancy.CreatedAt,
 		vacancy.UpdatedAt,
+		vacancy.Currency,
+		vacancy.SalaryPeriod,
+		vacancy.SalaryGross,
+		vacancy.NeedsReview,
+		vacancy.DuplicateOf,
 	); err != nil {
 		return errors.WithStack(err)
 	}
@@ -277,43 +806,70 @@ func (s *Storage) TxPutVacancy(ctx context.Context, tx pkgtx.Tx, vacancy *Vacanc
 	return nil
 }
 
-// nolint:funlen // will rework
-func (s *Storage) TxGetVacanciesList(
+// TxPutVacancyStatus changes the status of the vacancy, the timestamps are stored as they are. The scheduled
+// publishing is cancelled unless the vacancy is a draft or paused.
+func (s *Storage) TxPutVacancyStatus(
 	ctx context.Context,
 	tx pkgtx.Tx,
-	categoriesIDs []string,
-	limit int,
-	cursor *Cursor,
-) (_ []*Vacancy, _ *Cursor, rerr error) {
-	cursorCreatedAt := time.Now()
-	cursorPosition := -1
+	vacancyID string,
+	status VacancyStatus,
+	publishedAt *time.Time,
+	expiresAt *time.Time,
+) error {
+	c := postgresql.FromTx(tx)
 
-	if cursor != nil {
-		cursorCreatedAt = cursor.PrevCreatedAt
-		cursorPosition = cursor.PrevPosition
+	res, err := c.ExecContext(
+		ctx,
+		`UPDATE vacancy SET
+				status = $2,
+				published_at = $3,
+				expires_at = $4,
+				scheduled_publish_at = CASE
+					WHEN $2 IN ('vacancy_status_draft', 'vacancy_status_paused') THEN scheduled_publish_at
+				END
+			WHERE id = $1`,
+		vacancyID,
+		status,
+		publishedAt,
+		expiresAt,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
 	}
 
+	return nil
+}
+
+// TxGetVacanciesCounters returns the counters of the vacancies by id.
+func (s *Storage) TxGetVacanciesCounters(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyIDs []string,
+) (_ map[string]*VacancyCounters, rerr error) {
 	c := postgresql.FromTx(tx)
+
 	rows, err := c.QueryContext(
 		ctx,
-		`WITH filtered_categories AS (
-			SELECT vacancy_id
-			FROM vacancies_categories
-			WHERE category_id = ANY($1::uuid[])
-		)
-		SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.company_id, v.position, v.created_at
-		FROM vacancy AS v
-		WHERE ($1 = '{}' OR v.id IN (SELECT vacancy_id FROM filtered_categories))
-		AND ($3 < 0 OR (v.created_at, v.position) < ($4, $3))
-		ORDER BY v.created_at DESC, v.position DESC
-		LIMIT $2`,
-		pq.Array(categoriesIDs),
-		limit,
-		cursorPosition,
-		cursorCreatedAt,
+		`SELECT v.id,
+				(SELECT count(*) FROM vacancy_alert_match WHERE vacancy_id = v.id),
+				(SELECT count(*) FROM application WHERE vacancy_id = v.id AND withdrawn_at IS NULL),
+				(SELECT count(*) FROM vacancy_event WHERE vacancy_id = v.id AND type = 'vacancy_event_type_view'),
+				(SELECT count(*) FROM saved_vacancy WHERE vacancy_id = v.id)
+			FROM vacancy AS v
+			WHERE v.id = ANY($1)`,
+		pq.Array(vacancyIDs),
 	)
 	if err != nil {
-		return nil, nil, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	defer func() {
@@ -327,29 +883,224 @@ func (s *Storage) TxGetVacanciesList(
 		}
 	}()
 
-	vs := make([]*Vacancy, 0)
-
-	var lastCreatedAt time.Time
-	var lastPosition int
+	counters := make(map[string]*VacancyCounters, len(vacancyIDs))
 
 	for rows.Next() {
-		var v Vacancy
-
-		err := rows.Scan(
-			&v.ID,
-			&v.Title,
-			&v.Phone,
-			&v.MinSalary,
-			&v.MaxSalary,
-			&v.CompanyID,
-			&lastPosition,
-			&lastCreatedAt,
+		var (
+			id string
+			vc VacancyCounters
 		)
-		if err != nil {
-			return nil, nil, errors.WithStack(err)
+
+		if err := rows.Scan(&id, &vc.AlertMatches, &vc.Applications, &vc.Views, &vc.Saves); err != nil {
+			return nil, errors.WithStack(err)
 		}
 
-		vs = append(vs, &v)
+		counters[id] = &vc
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return counters, nil
+}
+
+// TxCloseExpiredVacancies closes up to limit published vacancies which have expired by now
+// and returns their ids.
+func (s *Storage) TxCloseExpiredVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	now time.Time,
+	limit int,
+) (_ []string, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`UPDATE vacancy SET status = 'vacancy_status_closed'
+			WHERE id IN (
+				SELECT id FROM vacancy
+				WHERE status = 'vacancy_status_published' AND expires_at <= $1
+				ORDER BY expires_at
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id`,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	ids := make([]string, 0)
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
+
+// TxGetVacanciesList returns the vacancies in the sort order. The salary order compares the upper bounds
+// normalized to monthly ones in the base currency, the ones without the exchange rate go last. The relevance
+// one ranks the filter keywords and the distance one requires the filter radius. Sort keys are rounded so that
+// they survive the round trip through the cursor.
+func (s *Storage) TxGetVacanciesList(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	filter *VacancyFilter,
+	sortOrder VacancySortOrder,
+	limit int,
+	cursor *Cursor,
+) (_ []*Vacancy, _ *Cursor, rerr error) {
+	if filter == nil {
+		filter = &VacancyFilter{}
+	}
+
+	var args queryArgs
+
+	where := vacancyFilterCondition(args.filter(filter))
+	distance := `NULL::numeric`
+
+	if filter.Radius != nil {
+		distance = `round(ST_Distance(v.location, ` + args.point(filter.Radius.GeoPoint) + `)::numeric, 2)`
+		where += args.radius(filter.Radius)
+	}
+
+	var key, orderBy string
+
+	switch sortOrder {
+	case VacancySortOrderNewest:
+		key = `NULL::numeric`
+		orderBy = `v.listed_at DESC, v.position DESC`
+	case VacancySortOrderSalary:
+		key = `round(coalesce(normalized_salary(v.max_salary, v.currency, v.salary_period), 0), 2)`
+		orderBy = `k.key DESC, v.position DESC`
+	case VacancySortOrderRelevance:
+		key = `round(ts_rank_cd(v.search_vector, plainto_tsquery('vacancy_search', ` +
+			args.add(filter.Keywords) + `::text))::numeric, 6)`
+		orderBy = `k.key DESC, v.position DESC`
+	case VacancySortOrderDistance:
+		if filter.Radius == nil {
+			return nil, nil, errors.New("distance sort order requires radius")
+		}
+
+		key = `d.distance`
+		orderBy = `k.key ASC, v.position DESC`
+	default:
+		return nil, nil, errors.Newf("unknown sort order=%s", sortOrder)
+	}
+
+	if cursor != nil {
+		position := args.add(cursor.PrevPosition)
+
+		switch sortOrder {
+		case VacancySortOrderNewest:
+			where += fmt.Sprintf(` AND (v.listed_at, v.position) < (%s, %s)`, args.add(cursor.PrevCreatedAt), position)
+		case VacancySortOrderDistance:
+			prevKey := args.add(cursor.PrevKey)
+			where += fmt.Sprintf(
+				` AND (k.key > %[1]s::numeric OR (k.key = %[1]s::numeric AND v.position < %[2]s))`,
+				prevKey,
+				position,
+			)
+		default:
+			where += fmt.Sprintf(` AND (k.key, v.position) < (%s::numeric, %s)`, args.add(cursor.PrevKey), position)
+		}
+	}
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.position, v.listed_at, v.status, v.published_at, v.expires_at, d.distance, k.key
+		FROM
+			vacancy AS v,
+			LATERAL (SELECT `+distance+` AS distance) AS d,
+			LATERAL (SELECT `+key+` AS key) AS k
+		WHERE `+where+`
+		ORDER BY `+orderBy+`
+		LIMIT `+args.add(limit),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	vs := make([]*Vacancy, 0)
+
+	var (
+		lastCreatedAt time.Time
+		lastPosition  int
+		lastKey       sql.NullFloat64
+	)
+
+	for rows.Next() {
+		var (
+			v        Vacancy
+			distance sql.NullFloat64
+		)
+
+		err := rows.Scan(
+			&v.ID,
+			&v.Title,
+			&v.Phone,
+			&v.MinSalary,
+			&v.MaxSalary,
+			&v.Currency,
+			&v.SalaryPeriod,
+			&v.SalaryGross,
+			&v.CompanyID,
+			&lastPosition,
+			&lastCreatedAt,
+			&v.Status,
+			&v.PublishedAt,
+			&v.ExpiresAt,
+			&distance,
+			&lastKey,
+		)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		if distance.Valid {
+			v.Distance = &distance.Float64
+		}
+
+		vs = append(vs, &v)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -363,298 +1114,2573 @@ func (s *Storage) TxGetVacanciesList(
 	return vs, &Cursor{
 		PrevCreatedAt: lastCreatedAt,
 		PrevPosition:  lastPosition,
+		PrevKey:       lastKey.Float64,
 	}, nil
 }
 
-func (s *Storage) TxGetVacanciesCategories(
+// TxGetVacancyMarkers returns up to limit newest vacancies located inside the bounding box.
+func (s *Storage) TxGetVacancyMarkers(
 	ctx context.Context,
 	tx pkgtx.Tx,
-	vacancyIDs []string,
-) ([]*VacancyCategoryShort, error) {
-	c := postgresql.FromTx(tx)
+	bbox *BoundingBox,
+	filter *VacancyFilter,
+	limit int,
+) (_ []*VacancyMarker, rerr error) {
+	if filter == nil {
+		filter = &VacancyFilter{}
+	}
 
+	var args queryArgs
+
+	c := postgresql.FromTx(tx)
 	rows, err := c.QueryContext(
 		ctx,
-		`SELECT vscs.vacancy_id, vc.id, vc.title
-			FROM vacancies_categories AS vscs
-			INNER JOIN vacancy_category AS vc
-			ON vscs.category_id = vc.id
-			WHERE vacancy_id = ANY($1::uuid[])`,
-		pq.Array(vacancyIDs),
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.company_id, ST_Y(v.location::geometry), ST_X(v.location::geometry)
+		FROM vacancy AS v
+		WHERE v.location && `+args.envelope(bbox)+`
+		AND `+vacancyFilterCondition(args.filter(filter))+`
+		ORDER BY v.listed_at DESC, v.position DESC
+		LIMIT `+args.add(limit),
+		args...,
 	)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	vcs := make([]*VacancyCategoryShort, 0)
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	ms := make([]*VacancyMarker, 0)
 
 	for rows.Next() {
-		var vc VacancyCategoryShort
-		if err := rows.Scan(&vc.VacancyID, &vc.ID, &vc.Title); err != nil {
-			_ = rows.Close()
+		var m VacancyMarker
+		if err := rows.Scan(&m.ID, &m.Title, &m.CompanyID, &m.Latitude, &m.Longitude); err != nil {
 			return nil, errors.WithStack(err)
 		}
 
-		vcs = append(vcs, &vc)
+		ms = append(ms, &m)
 	}
 
-	return vcs, nil
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ms, nil
 }
 
-func (s *Storage) TxPutVacancyCategories(
+// TxGetVacancyClusters groups the vacancies located inside the bounding box into square grid cells
+// with the side of cellSize degrees. A cluster is placed at the centroid of its vacancies.
+func (s *Storage) TxGetVacancyClusters(
 	ctx context.Context,
 	tx pkgtx.Tx,
-	vacancyID string,
-	categoriesIDs []string,
-) error {
+	bbox *BoundingBox,
+	filter *VacancyFilter,
+	cellSize float64,
+) (_ []*VacancyCluster, rerr error) {
+	if filter == nil {
+		filter = &VacancyFilter{}
+	}
+
+	var args queryArgs
+
 	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT ST_Y(ST_Centroid(ST_Collect(g.point))), ST_X(ST_Centroid(ST_Collect(g.point))), count(*)
+		FROM vacancy AS v, LATERAL (SELECT v.location::geometry AS point) AS g
+		WHERE v.location && `+args.envelope(bbox)+`
+		AND `+vacancyFilterCondition(args.filter(filter))+`
+		GROUP BY ST_SnapToGrid(g.point, `+args.add(cellSize)+`::float8)`,
+		args...,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 
-	queryFormat := `INSERT 
-		INTO vacancies_categories (vacancy_id, category_id)
-		VALUES %s`
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
 
-	columns := 2
-	valueStrings := make([]string, len(categoriesIDs))
-	valueArgs := make([]interface{}, len(categoriesIDs)*columns)
+			rerr = errors.WithStack(err)
+		}
+	}()
 
-	for i := 0; i < len(categoriesIDs); i++ {
-		offset := i * columns
-		valueStrings[i] = fmt.Sprintf("($%d, $%d)", offset+1, offset+2)
-		valueArgs[offset] = vacancyID
-		valueArgs[offset+1] = categoriesIDs[i]
+	cs := make([]*VacancyCluster, 0)
+
+	for rows.Next() {
+		var vc VacancyCluster
+		if err := rows.Scan(&vc.Latitude, &vc.Longitude, &vc.Count); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		cs = append(cs, &vc)
 	}
 
-	if _, err := c.ExecContext(
-		ctx,
-		fmt.Sprintf(queryFormat, strings.TrimSuffix(strings.Join(valueStrings, ","), ",")),
-		valueArgs...,
-	); err != nil {
-		return errors.WithStack(err)
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	return nil
+	return cs, nil
 }
 
-func (s *Storage) TxDeleteVacancyCategories(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
-	c := postgresql.FromTx(tx)
+const (
+	facetCategory = "category"
+	facetCity     = "city"
+	facetType     = "type"
+	facetSalary   = "salary"
+)
 
-	if _, err := c.ExecContext(
+// TxGetVacancyFacets counts the vacancies matching the filter per category, city, type and salary bucket
+// in a single query. Each facet ignores its own part of the filter, so that the counts of the values
+// not selected yet tell how many vacancies selecting them would add. The salary bucket of a vacancy
+// is the number of the ascending bounds not greater than its upper salary, which is converted to a monthly one
+// in the filter currency. The vacancies whose salary can't be converted aren't counted in any salary bucket.
+func (s *Storage) TxGetVacancyFacets(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	filter *VacancyFilter,
+	salaryBounds []int32,
+) (_ *VacancyFacets, rerr error) {
+	if filter == nil {
+		filter = &VacancyFilter{}
+	}
+
+	var args queryArgs
+
+	condition := func(f VacancyFilter) string {
+		return vacancyFilterCondition(args.filter(&f)) + args.radius(f.Radius)
+	}
+
+	withoutCategories, withoutCities, withoutType, withoutSalary := *filter, *filter, *filter, *filter
+	withoutCategories.CategoriesIDs = nil
+	withoutCities.CityIDs = nil
+	withoutType.Type = nil
+	withoutSalary.MinSalary, withoutSalary.MaxSalary = nil, nil
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
 		ctx,
-		`DELETE FROM vacancies_categories 
-			WHERE vacancy_id = $1`,
-		vacancyID,
-	); err != nil {
-		return errors.WithStack(err)
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT '`+facetCategory+`', vc.category_id::text, count(*)
+			FROM vacancy AS v
+			INNER JOIN vacancies_categories AS vc ON vc.vacancy_id = v.id
+			WHERE `+condition(withoutCategories)+`
+			GROUP BY vc.category_id
+		UNION ALL
+		SELECT '`+facetCity+`', vcs.city_id::text, count(*)
+			FROM vacancy AS v
+			INNER JOIN vacancy_cities AS vcs ON vcs.vacancy_id = v.id
+			WHERE `+condition(withoutCities)+`
+			GROUP BY vcs.city_id
+		UNION ALL
+		SELECT '`+facetType+`', v.type::text, count(*)
+			FROM vacancy AS v
+			WHERE `+condition(withoutType)+`
+			GROUP BY v.type
+		UNION ALL
+		SELECT '`+facetSalary+`', b.bucket::text, count(*)
+			FROM
+				vacancy AS v,
+				LATERAL (
+					SELECT width_bucket(
+						normalized_salary(v.max_salary, v.currency, v.salary_period) /
+							normalized_salary(1, `+args.add(filter.Currency)+`::char(3), 'salary_period_month'),
+						`+args.add(pq.Array(salaryBounds))+`::numeric[]
+					) AS bucket
+				) AS b
+			WHERE `+condition(withoutSalary)+` AND b.bucket IS NOT NULL
+			GROUP BY b.bucket`,
+		args...,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	f := VacancyFacets{
+		Categories:    make(map[string]int),
+		Cities:        make(map[string]int),
+		Types:         make(map[VacancyType]int),
+		SalaryBuckets: make(map[int]int),
+	}
+
+	for rows.Next() {
+		var (
+			facet, value string
+			count        int
+		)
+
+		if err := rows.Scan(&facet, &value, &count); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		switch facet {
+		case facetCategory:
+			f.Categories[value] = count
+		case facetCity:
+			f.Cities[value] = count
+		case facetType:
+			f.Types[VacancyType(value)] = count
+		case facetSalary:
+			bucket, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			f.SalaryBuckets[bucket] = count
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &f, nil
+}
+
+// TxSearchVacancies returns the vacancies matching the text search query ordered by relevance,
+// the rank is rounded so that it survives the round trip through the cursor. Matched words of the title
+// and of the description snippet are wrapped in <b></b> tags.
+func (s *Storage) TxSearchVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	query string,
+	filter *VacancyFilter,
+	limit int,
+	cursor *Cursor,
+) (_ []*VacancySearchResult, _ *Cursor, rerr error) {
+	if filter == nil {
+		filter = &VacancyFilter{}
+	}
+
+	var args queryArgs
+
+	tsQuery := args.add(query)
+	where := vacancyFilterCondition(args.filter(filter))
+
+	if cursor != nil {
+		where += fmt.Sprintf(
+			` AND (r.rank, v.position) < (%s::numeric, %s)`,
+			args.add(cursor.PrevKey),
+			args.add(cursor.PrevPosition),
+		)
+	}
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT
+			v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.position, r.rank,
+			ts_headline('vacancy_search', v.title, q.query, 'HighlightAll=true, StartSel=<b>, StopSel=</b>'),
+			ts_headline(
+				'vacancy_search',
+				v.description,
+				q.query,
+				'MaxFragments=2, MaxWords=20, MinWords=5, StartSel=<b>, StopSel=</b>'
+			)
+		FROM
+			vacancy AS v,
+			to_tsquery('vacancy_search', `+tsQuery+`::text) AS q(query),
+			LATERAL (SELECT round(ts_rank_cd(v.search_vector, q.query)::numeric, 6) AS rank) AS r
+		WHERE v.search_vector @@ q.query
+		AND `+where+`
+		ORDER BY r.rank DESC, v.position DESC
+		LIMIT `+args.add(limit),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	rs := make([]*VacancySearchResult, 0)
+
+	var (
+		lastPosition int
+		lastRank     float64
+	)
+
+	for rows.Next() {
+		var r VacancySearchResult
+
+		err := rows.Scan(
+			&r.ID,
+			&r.Title,
+			&r.Phone,
+			&r.MinSalary,
+			&r.MaxSalary,
+			&r.Currency,
+			&r.SalaryPeriod,
+			&r.SalaryGross,
+			&r.CompanyID,
+			&lastPosition,
+			&lastRank,
+			&r.TitleHighlight,
+			&r.DescriptionSnippet,
+		)
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		rs = append(rs, &r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	if len(rs) == 0 || len(rs) < limit {
+		return rs, nil, nil
+	}
+
+	return rs, &Cursor{
+		PrevPosition: lastPosition,
+		PrevKey:      lastRank,
+	}, nil
+}
+
+func (s *Storage) TxGetVacanciesCategories(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyIDs []string,
+) ([]*VacancyCategoryShort, error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT vscs.vacancy_id, vc.id, vc.title
+			FROM vacancies_categories AS vscs
+			INNER JOIN vacancy_category AS vc
+			ON vscs.category_id = vc.id
+			WHERE vacancy_id = ANY($1::uuid[])`,
+		pq.Array(vacancyIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vcs := make([]*VacancyCategoryShort, 0)
+
+	for rows.Next() {
+		var vc VacancyCategoryShort
+		if err := rows.Scan(&vc.VacancyID, &vc.ID, &vc.Title); err != nil {
+			_ = rows.Close()
+			return nil, errors.WithStack(err)
+		}
+
+		vcs = append(vcs, &vc)
+	}
+
+	return vcs, nil
+}
+
+func (s *Storage) TxPutVacancyCategories(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	categoriesIDs []string,
+) error {
+	c := postgresql.FromTx(tx)
+
+	queryFormat := `INSERT 
+		INTO vacancies_categories (vacancy_id, category_id)
+		VALUES %s`
+
+	columns := 2
+	valueStrings := make([]string, len(categoriesIDs))
+	valueArgs := make([]interface{}, len(categoriesIDs)*columns)
+
+	for i := 0; i < len(categoriesIDs); i++ {
+		offset := i * columns
+		valueStrings[i] = fmt.Sprintf("($%d, $%d)", offset+1, offset+2)
+		valueArgs[offset] = vacancyID
+		valueArgs[offset+1] = categoriesIDs[i]
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		fmt.Sprintf(queryFormat, strings.TrimSuffix(strings.Join(valueStrings, ","), ",")),
+		valueArgs...,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteVacancyCategories(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM vacancies_categories 
+			WHERE vacancy_id = $1`,
+		vacancyID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`DELETE FROM vacancy 
+			WHERE id = $1`,
+		vacancyID,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
+	return nil
+}
+
+/**
+Vacancy images part start
+*/
+
+func (s *Storage) TxGetVacanciesImages(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyIDs []string,
+) (map[string][]string, error) {
+	c := postgresql.FromTx(tx)
+
+	vacancyImageMap := make(map[string][]string, len(vacancyIDs))
+
+	if len(vacancyIDs) == 0 {
+		return vacancyImageMap, nil
+	}
+
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // will rework
+		`SELECT vacancy_id, image_url
+			FROM vacancies_images
+			WHERE vacancy_id = ANY($1::uuid[])
+			ORDER BY vacancy_id ASC, position ASC`,
+		pq.Array(vacancyIDs),
+	)
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for rows.Next() {
+		var (
+			vacancyID string
+			imageURL  string
+		)
+
+		err := rows.Scan(&vacancyID, &imageURL)
+		if err != nil {
+			_ = rows.Close()
+			return nil, errors.WithStack(err)
+		}
+
+		vacancyImageMap[vacancyID] = append(vacancyImageMap[vacancyID], imageURL)
+	}
+
+	return vacancyImageMap, nil
+}
+
+func (s *Storage) TxPutVacancyImages(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	imageUrls []string,
+) error {
+	c := postgresql.FromTx(tx)
+
+	queryFormat := `INSERT 
+		INTO vacancies_images (vacancy_id, position, image_url)
+		VALUES %s`
+
+	columns := 3
+	valueStrings := make([]string, len(imageUrls))
+	valueArgs := make([]interface{}, len(imageUrls)*columns)
+
+	for i := 0; i < len(imageUrls); i++ {
+		offset := i * columns
+		valueStrings[i] = fmt.Sprintf("($%d, $%d, $%d)", offset+1, offset+2, offset+3)
+		valueArgs[offset] = vacancyID
+		valueArgs[offset+1] = i
+		valueArgs[offset+2] = imageUrls[i]
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		fmt.Sprintf(queryFormat, strings.TrimSuffix(strings.Join(valueStrings, ","), ",")),
+		valueArgs...,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteVacancyImages(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM vacancies_images 
+			WHERE vacancy_id = $1`,
+		vacancyID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+/**
+Vacancy images part end
+*/
+
+/**
+Vacancy cities part start
+*/
+func (s *Storage) TxGetVacancyCities(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyIDs []string,
+) ([]*VacancyCity, error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT vscs.vacancy_id, c.id, c.name, country_code, rating
+			FROM vacancy_cities AS vscs
+			INNER JOIN city AS c
+			ON vscs.city_id = c.id
+			WHERE vacancy_id = ANY($1::uuid[])`,
+		pq.Array(vacancyIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vcs := make([]*VacancyCity, 0)
+
+	for rows.Next() {
+		var vc VacancyCity
+		if err := rows.Scan(&vc.VacancyID, &vc.ID, &vc.Name, &vc.CountryCode, &vc.Rating); err != nil {
+			_ = rows.Close()
+			return nil, errors.WithStack(err)
+		}
+
+		vcs = append(vcs, &vc)
+	}
+
+	return vcs, nil
+}
+
+func (s *Storage) TxPutVacancyCities(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	cityIDs []string,
+) error {
+	c := postgresql.FromTx(tx)
+
+	queryFormat := `INSERT 
+		INTO vacancy_cities (vacancy_id, city_id)
+		VALUES %s`
+
+	columns := 2
+	valueStrings := make([]string, len(cityIDs))
+	valueArgs := make([]interface{}, len(cityIDs)*columns)
+
+	for i := 0; i < len(cityIDs); i++ {
+		offset := i * columns
+		valueStrings[i] = fmt.Sprintf("($%d, $%d)", offset+1, offset+2)
+		valueArgs[offset] = vacancyID
+		valueArgs[offset+1] = cityIDs[i]
+	}
+
+	if _, err := c.ExecContext(
+		ctx,
+		fmt.Sprintf(queryFormat, strings.TrimSuffix(strings.Join(valueStrings, ","), ",")),
+		valueArgs...,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteVacancyCities(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`DELETE FROM vacancy_cities 
+			WHERE vacancy_id = $1`,
+		vacancyID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+/**
+Vacancy cities part end
+*/
+
+/**
+Vacancy alerts part start
+*/
+
+func (s *Storage) TxPutVacancyAlert(ctx context.Context, tx pkgtx.Tx, alert *VacancyAlert) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO vacancy_alert (id, account_id, category_ids, city_ids, min_salary, max_salary, type, 
+				keywords, created_at, currency)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		alert.ID,
+		alert.AccountID,
+		pq.Array(alert.CategoriesIDs),
+		pq.Array(alert.CityIDs),
+		alert.MinSalary,
+		alert.MaxSalary,
+		alert.Type,
+		alert.Keywords,
+		alert.CreatedAt,
+		alert.Currency,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxGetVacancyAlerts(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+) (_ []*VacancyAlert, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id, account_id, category_ids, city_ids, min_salary, max_salary, currency, type, keywords, created_at
+			FROM vacancy_alert
+			WHERE account_id = $1
+			ORDER BY created_at ASC`,
+		accountID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	alerts := make([]*VacancyAlert, 0)
+
+	for rows.Next() {
+		var (
+			a         VacancyAlert
+			minSalary sql.NullInt32
+			maxSalary sql.NullInt32
+			vt        sql.NullString
+		)
+
+		if err := rows.Scan(
+			&a.ID,
+			&a.AccountID,
+			pq.Array(&a.CategoriesIDs),
+			pq.Array(&a.CityIDs),
+			&minSalary,
+			&maxSalary,
+			&a.Currency,
+			&vt,
+			&a.Keywords,
+			&a.CreatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if minSalary.Valid {
+			a.MinSalary = &minSalary.Int32
+		}
+
+		if maxSalary.Valid {
+			a.MaxSalary = &maxSalary.Int32
+		}
+
+		if vt.Valid {
+			t := VacancyType(vt.String)
+			a.Type = &t
+		}
+
+		alerts = append(alerts, &a)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return alerts, nil
+}
+
+// TxLockAccountVacancyAlerts locks the account until the end of the transaction, so its alerts are counted
+// and put one transaction at a time.
+func (s *Storage) TxLockAccountVacancyAlerts(ctx context.Context, tx pkgtx.Tx, accountID string) error {
+	c := postgresql.FromTx(tx)
+
+	var id string
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT account_id
+			FROM auth
+			WHERE account_id = $1
+			FOR UPDATE`,
+		accountID,
+	).Scan(&id)
+
+	switch err {
+	case nil:
+		return nil
+	case sql.ErrNoRows:
+		return errors.WithStack(ErrNotFound)
+	default:
+		return errors.WithStack(err)
+	}
+}
+
+func (s *Storage) TxCountVacancyAlerts(ctx context.Context, tx pkgtx.Tx, accountID string) (int, error) {
+	c := postgresql.FromTx(tx)
+
+	var count int
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT count(*)
+			FROM vacancy_alert
+			WHERE account_id = $1`,
+		accountID,
+	).Scan(&count); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+func (s *Storage) TxDeleteVacancyAlert(ctx context.Context, tx pkgtx.Tx, accountID string, alertID string) error {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`DELETE FROM vacancy_alert 
+			WHERE id = $1 AND account_id = $2`,
+		alertID,
+		accountID,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
+	return nil
+}
+
+// TxMatchVacancyAlerts records the alerts matching the vacancy and returns the ones which have not matched
+// it before, so a vacancy is reported to every alert at most once however many times it is updated.
+func (s *Storage) TxMatchVacancyAlerts(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	matchedAt time.Time,
+) (_ []*VacancyAlertMatch, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the condition is built from constant expressions
+		`WITH matched AS (
+			INSERT INTO vacancy_alert_match (alert_id, vacancy_id, created_at)
+			SELECT a.id, v.id, $2
+			FROM vacancy_alert AS a
+			INNER JOIN vacancy AS v
+			ON v.id = $1 AND `+vacancyFilterCondition(vacancyFilterExprs{
+			CategoriesIDs: "a.category_ids",
+			CityIDs:       "a.city_ids",
+			MinSalary:     "a.min_salary",
+			MaxSalary:     "a.max_salary",
+			Currency:      "a.currency",
+			Type:          "a.type",
+			Keywords:      "a.keywords",
+			MaxExperience: "NULL::integer",
+			CompanyID:     "NULL::uuid",
+			CountryCode:   "NULL::integer",
+			OwnerID:       "NULL::uuid",
+			Statuses:      "NULL::e_vacancy_status[]",
+		})+`
+			ON CONFLICT DO NOTHING
+			RETURNING alert_id
+		)
+		SELECT a.id, a.account_id
+		FROM vacancy_alert AS a
+		INNER JOIN matched AS m
+		ON m.alert_id = a.id`,
+		vacancyID,
+		matchedAt,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	matches := make([]*VacancyAlertMatch, 0)
+
+	for rows.Next() {
+		var m VacancyAlertMatch
+		if err := rows.Scan(&m.AlertID, &m.AccountID); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		matches = append(matches, &m)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return matches, nil
+}
+
+/**
+Vacancy alerts part end
+*/
+
+/**
+Saved vacancies part start
+*/
+
+// TxPutSavedVacancy saves the vacancy for the account, saving it again keeps the first time it was saved.
+func (s *Storage) TxPutSavedVacancy(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	vacancyID string,
+	savedAt time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO saved_vacancy (account_id, vacancy_id, created_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING`,
+		accountID,
+		vacancyID,
+		savedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxDeleteSavedVacancy(ctx context.Context, tx pkgtx.Tx, accountID string, vacancyID string) error {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`DELETE FROM saved_vacancy
+			WHERE account_id = $1 AND vacancy_id = $2`,
+		accountID,
+		vacancyID,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxCountSavedVacancies(ctx context.Context, tx pkgtx.Tx, accountID string) (int, error) {
+	c := postgresql.FromTx(tx)
+
+	var count int
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT count(*)
+			FROM saved_vacancy
+			WHERE account_id = $1`,
+		accountID,
+	).Scan(&count); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// TxGetSavedVacancies returns the live vacancies saved by the account, the most recently saved first.
+// The cursor PrevCreatedAt is the time the last returned vacancy was saved at.
+func (s *Storage) TxGetSavedVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	limit int,
+	cursor *Cursor,
+) (_ []*Vacancy, _ *Cursor, rerr error) {
+	var args queryArgs
+
+	where := `sv.account_id = ` + args.add(accountID) +
+		` AND v.status = 'vacancy_status_published' AND v.expires_at > now()`
+
+	if cursor != nil {
+		where += fmt.Sprintf(
+			` AND (sv.created_at, v.position) < (%s, %s)`,
+			args.add(cursor.PrevCreatedAt),
+			args.add(cursor.PrevPosition),
+		)
+	}
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.status, v.published_at, v.expires_at, v.position, sv.created_at
+		FROM saved_vacancy AS sv
+		INNER JOIN vacancy AS v
+		ON v.id = sv.vacancy_id
+		WHERE `+where+`
+		ORDER BY sv.created_at DESC, v.position DESC
+		LIMIT `+args.add(limit),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	vs := make([]*Vacancy, 0)
+
+	var (
+		lastSavedAt  time.Time
+		lastPosition int
+	)
+
+	for rows.Next() {
+		var v Vacancy
+
+		if err := rows.Scan(
+			&v.ID,
+			&v.Title,
+			&v.Phone,
+			&v.MinSalary,
+			&v.MaxSalary,
+			&v.Currency,
+			&v.SalaryPeriod,
+			&v.SalaryGross,
+			&v.CompanyID,
+			&v.Status,
+			&v.PublishedAt,
+			&v.ExpiresAt,
+			&lastPosition,
+			&lastSavedAt,
+		); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		vs = append(vs, &v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	if len(vs) == 0 || len(vs) < limit {
+		return vs, nil, nil
+	}
+
+	return vs, &Cursor{
+		PrevCreatedAt: lastSavedAt,
+		PrevPosition:  lastPosition,
+	}, nil
+}
+
+// TxGetSavedVacanciesIDs returns the ones of vacancyIDs saved by the account.
+func (s *Storage) TxGetSavedVacanciesIDs(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	accountID string,
+	vacancyIDs []string,
+) (_ map[string]bool, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT vacancy_id
+			FROM saved_vacancy
+			WHERE account_id = $1 AND vacancy_id = ANY($2::uuid[])`,
+		accountID,
+		pq.Array(vacancyIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	saved := make(map[string]bool)
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		saved[id] = true
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return saved, nil
+}
+
+/**
+Saved vacancies part end
+*/
+
+/**
+Vacancy stats part start
+*/
+
+// TxPutVacancyEvents appends the event for every vacancy once a day per viewer. The events of the company
+// owning a vacancy are not recorded.
+func (s *Storage) TxPutVacancyEvents(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	eventType VacancyEventType,
+	viewerKey string,
+	vacancyIDs []string,
+	at time.Time,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO vacancy_event (vacancy_id, type, viewer_key, day, created_at)
+			SELECT v.id, $1::e_vacancy_event_type, $2::text, ($4::timestamptz AT TIME ZONE 'UTC')::date, $4
+			FROM vacancy AS v
+			WHERE v.id = ANY($3::uuid[]) AND coalesce(v.company_id::text, '') <> $2::text
+			ORDER BY v.id
+			ON CONFLICT DO NOTHING`,
+		eventType,
+		viewerKey,
+		pq.Array(vacancyIDs),
+		at,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxLockVacancyStatsRollup locks the rollup state and returns the time the events were rolled up till.
+func (s *Storage) TxLockVacancyStatsRollup(ctx context.Context, tx pkgtx.Tx) (time.Time, error) {
+	c := postgresql.FromTx(tx)
+
+	var rolledUpTill time.Time
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT rolled_up_till
+			FROM vacancy_stats_rollup
+			FOR UPDATE`,
+	).Scan(&rolledUpTill); err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+
+	return rolledUpTill, nil
+}
+
+func (s *Storage) TxPutVacancyStatsRollup(ctx context.Context, tx pkgtx.Tx, rolledUpTill time.Time) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE vacancy_stats_rollup
+			SET rolled_up_till = $1`,
+		rolledUpTill,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxRollupVacancyStats recounts the hourly stats of the hours starting from since and returns the number of
+// the stats rows written. Recounting replaces the counts, so an hour may be rolled up any number of times.
+func (s *Storage) TxRollupVacancyStats(ctx context.Context, tx pkgtx.Tx, since time.Time) (int, error) {
+	c := postgresql.FromTx(tx)
+
+	res, err := c.ExecContext(
+		ctx,
+		`INSERT INTO vacancy_stats_hourly (vacancy_id, type, hour, count)
+			SELECT vacancy_id, type, date_trunc('hour', created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC', count(*)
+			FROM vacancy_event
+			WHERE created_at >= $1
+			GROUP BY 1, 2, 3
+			ORDER BY 1, 2, 3
+			ON CONFLICT (vacancy_id, type, hour) DO UPDATE SET count = EXCLUDED.count`,
+		since,
+	)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return int(affected), nil
+}
+
+// TxGetVacancyStats returns the rolled up stats of the vacancy from from till till by step, every step is
+// listed even when nothing happened then.
+func (s *Storage) TxGetVacancyStats(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	from time.Time,
+	till time.Time,
+	step time.Duration,
+) (_ []*VacancyStatsPoint, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT p.time,
+				coalesce(sum(h.count) FILTER (WHERE h.type = 'vacancy_event_type_view'), 0),
+				coalesce(sum(h.count) FILTER (WHERE h.type = 'vacancy_event_type_impression'), 0),
+				coalesce(sum(h.count) FILTER (WHERE h.type = 'vacancy_event_type_save'), 0),
+				coalesce(sum(h.count) FILTER (WHERE h.type = 'vacancy_event_type_phone_reveal'), 0)
+			FROM generate_series($2::timestamptz, $3::timestamptz - make_interval(secs => $4), make_interval(secs => $4))
+				AS p(time)
+			LEFT JOIN vacancy_stats_hourly AS h
+			ON h.vacancy_id = $1 AND h.hour >= p.time AND h.hour < p.time + make_interval(secs => $4)
+			GROUP BY p.time
+			ORDER BY p.time`,
+		vacancyID,
+		from,
+		till,
+		step.Seconds(),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	points := make([]*VacancyStatsPoint, 0)
+
+	for rows.Next() {
+		var p VacancyStatsPoint
+		if err := rows.Scan(&p.Time, &p.Views, &p.Impressions, &p.Saves, &p.PhoneReveals); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		points = append(points, &p)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
+	}
+
+	return points, nil
+}
+
+/**
+Vacancy stats part end
+*/
+
+/**
+Exchange rates part start
+*/
+
+// TxHasExchangeRate tells whether the salaries in the currency can be normalized, see normalized_salary.
+func (s *Storage) TxHasExchangeRate(ctx context.Context, tx pkgtx.Tx, currency string) (bool, error) {
+	c := postgresql.FromTx(tx)
+
+	var exists bool
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM exchange_rate WHERE currency = $1)`,
+		currency,
+	).Scan(&exists); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exists, nil
+}
+
+/**
+Exchange rates part end
+*/
+
+/**
+Moderation part start
+*/
+
+// VacancyModerationDecision is a record of the moderation audit log. ModeratorID is nil for the automatic
+// checks, which submit the vacancy with the reasons they found.
+type VacancyModerationDecision struct {
+	ID          string
+	VacancyID   string
+	ModeratorID *string
+	Decision    ModerationDecision
+	Reasons     []ModerationReason
+	Comment     string
+	CreatedAt   time.Time
+}
+
+// ModerationQueueItem is a vacancy pending review along with its latest submission.
+type ModerationQueueItem struct {
+	Vacancy
+	SubmittedAt time.Time
+	Reasons     []ModerationReason
+}
+
+// TxPutVacancyNeedsReview marks whether the vacancy has to be approved by a moderator before it goes live.
+func (s *Storage) TxPutVacancyNeedsReview(ctx context.Context, tx pkgtx.Tx, vacancyID string, needsReview bool) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`UPDATE vacancy SET needs_review = $2 WHERE id = $1`,
+		vacancyID,
+		needsReview,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (s *Storage) TxPutVacancyModerationDecision(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	decision *VacancyModerationDecision,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO vacancy_moderation_decision (id, vacancy_id, moderator_id, decision, reasons, comment, created_at)
+			VALUES ($1, $2, $3, $4, $5::e_moderation_reason[], $6, $7)`,
+		decision.ID,
+		decision.VacancyID,
+		decision.ModeratorID,
+		decision.Decision,
+		pq.Array(decision.Reasons),
+		decision.Comment,
+		decision.CreatedAt,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxGetVacancyModerationDecisions returns the moderation log of the vacancy, the oldest decision first.
+func (s *Storage) TxGetVacancyModerationDecisions(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+) (_ []*VacancyModerationDecision, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT id, vacancy_id, moderator_id, decision, reasons::text[], comment, created_at
+			FROM vacancy_moderation_decision
+			WHERE vacancy_id = $1
+			ORDER BY created_at, id`,
+		vacancyID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	decisions := make([]*VacancyModerationDecision, 0)
+
+	for rows.Next() {
+		var (
+			d       VacancyModerationDecision
+			reasons pq.StringArray
+		)
+
+		if err := rows.Scan(
+			&d.ID,
+			&d.VacancyID,
+			&d.ModeratorID,
+			&d.Decision,
+			&reasons,
+			&d.Comment,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		d.Reasons = toModerationReasons(reasons)
+		decisions = append(decisions, &d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return decisions, nil
+}
+
+// TxGetModerationQueue returns the vacancies pending review, the longest waiting first.
+func (s *Storage) TxGetModerationQueue(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	limit int,
+	cursor *Cursor,
+) (_ []*ModerationQueueItem, _ *Cursor, rerr error) {
+	var args queryArgs
+
+	where := `v.status = 'vacancy_status_pending_review'`
+
+	if cursor != nil {
+		where += fmt.Sprintf(
+			` AND (d.created_at, v.position) > (%s, %s)`,
+			args.add(cursor.PrevCreatedAt),
+			args.add(cursor.PrevPosition),
+		)
+	}
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.status, v.published_at, v.expires_at, v.position, d.created_at, d.reasons::text[]
+		FROM vacancy AS v
+		INNER JOIN LATERAL (
+			SELECT created_at, reasons
+			FROM vacancy_moderation_decision
+			WHERE vacancy_id = v.id AND decision = 'moderation_decision_submitted'
+			ORDER BY created_at DESC
+			LIMIT 1
+		) AS d ON TRUE
+		WHERE `+where+`
+		ORDER BY d.created_at, v.position
+		LIMIT `+args.add(limit),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	items := make([]*ModerationQueueItem, 0)
+
+	var lastPosition int
+
+	for rows.Next() {
+		var (
+			item    ModerationQueueItem
+			reasons pq.StringArray
+		)
+
+		if err := rows.Scan(
+			&item.ID,
+			&item.Title,
+			&item.Phone,
+			&item.MinSalary,
+			&item.MaxSalary,
+			&item.Currency,
+			&item.SalaryPeriod,
+			&item.SalaryGross,
+			&item.CompanyID,
+			&item.Status,
+			&item.PublishedAt,
+			&item.ExpiresAt,
+			&lastPosition,
+			&item.SubmittedAt,
+			&reasons,
+		); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		item.Reasons = toModerationReasons(reasons)
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	if len(items) == 0 || len(items) < limit {
+		return items, nil, nil
+	}
+
+	return items, &Cursor{
+		PrevCreatedAt: items[len(items)-1].SubmittedAt,
+		PrevPosition:  lastPosition,
+	}, nil
+}
+
+// TxHasVacancyDuplicate tells whether another vacancy of the company which isn't closed has the same title
+// and description, ignoring the case and the whitespace.
+func (s *Storage) TxHasVacancyDuplicate(ctx context.Context, tx pkgtx.Tx, vacancy *VacancyDetails) (bool, error) {
+	c := postgresql.FromTx(tx)
+
+	var exists bool
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (
+			SELECT 1 FROM vacancy
+			WHERE company_id = $2 AND id <> $1 AND status <> 'vacancy_status_closed'
+				AND lower(regexp_replace(trim(title), '\s+', ' ', 'g')) =
+					lower(regexp_replace(trim($3::text), '\s+', ' ', 'g'))
+				AND lower(regexp_replace(trim(description), '\s+', ' ', 'g')) =
+					lower(regexp_replace(trim($4::text), '\s+', ' ', 'g'))
+		)`,
+		vacancy.ID,
+		vacancy.CompanyID,
+		vacancy.Title,
+		vacancy.Description,
+	).Scan(&exists); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exists, nil
+}
+
+func toModerationReasons(reasons []string) []ModerationReason {
+	mrs := make([]ModerationReason, len(reasons))
+	for idx, r := range reasons {
+		mrs[idx] = ModerationReason(r)
+	}
+
+	return mrs
+}
+
+/**
+Moderation part end
+*/
+
+/**
+Duplicates part start
+*/
+
+type DuplicateCluster struct {
+	Vacancy
+	DuplicatesIDs []string
+	RepostedAt    time.Time
+}
+
+// TxFindVacancyOriginal returns the vacancy the given one reposts, if any. That's the most similar vacancy
+// of the company which isn't closed and shares a city with it, or has no cities either. The similarity is
+// the trigram similarity of the vacancy fingerprints, see vacancy_fingerprint.
+func (s *Storage) TxFindVacancyOriginal(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancy *VacancyDetails,
+	cityIDs []string,
+	minSimilarity float64,
+) (*string, error) {
+	c := postgresql.FromTx(tx)
+
+	var originalID string
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT coalesce(v.duplicate_of, v.id)
+		FROM vacancy AS v
+		WHERE v.company_id = $2 AND v.id <> $1 AND coalesce(v.duplicate_of, v.id) <> $1
+			AND v.status <> 'vacancy_status_closed'
+			AND similarity(v.fingerprint, vacancy_fingerprint($3, $4)) >= $5
+			AND (
+				(coalesce(cardinality($6::uuid[]), 0) = 0 AND NOT EXISTS (
+					SELECT 1 FROM vacancy_cities WHERE vacancy_id = v.id
+				))
+				OR EXISTS (
+					SELECT 1 FROM vacancy_cities WHERE vacancy_id = v.id AND city_id = ANY($6::uuid[])
+				)
+			)
+		ORDER BY similarity(v.fingerprint, vacancy_fingerprint($3, $4)) DESC, v.created_at
+		LIMIT 1`,
+		vacancy.ID,
+		vacancy.CompanyID,
+		vacancy.Title,
+		vacancy.Description,
+		minSimilarity,
+		pq.Array(cityIDs),
+	).Scan(&originalID)
+
+	switch err {
+	case nil:
+		return &originalID, nil
+	case sql.ErrNoRows:
+		return nil, nil
+	default:
+		return nil, errors.WithStack(err)
+	}
+}
+
+// TxGetVacancyDuplicateClusters returns the vacancies which were reposted, the most recently reposted first.
+// The cursor PrevCreatedAt is the time the last returned vacancy was reposted at.
+func (s *Storage) TxGetVacancyDuplicateClusters(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	limit int,
+	cursor *Cursor,
+) (_ []*DuplicateCluster, _ *Cursor, rerr error) {
+	var args queryArgs
+
+	where := `d.ids IS NOT NULL`
+
+	if cursor != nil {
+		where += fmt.Sprintf(
+			` AND (d.reposted_at, v.position) < (%s, %s)`,
+			args.add(cursor.PrevCreatedAt),
+			args.add(cursor.PrevPosition),
+		)
+	}
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(
+		ctx,
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.status, v.published_at, v.expires_at, v.position, d.ids, d.reposted_at
+		FROM vacancy AS v
+		INNER JOIN LATERAL (
+			SELECT array_agg(id::text ORDER BY created_at) AS ids, max(created_at) AS reposted_at
+			FROM vacancy
+			WHERE duplicate_of = v.id
+		) AS d ON TRUE
+		WHERE `+where+`
+		ORDER BY d.reposted_at DESC, v.position DESC
+		LIMIT `+args.add(limit),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	clusters := make([]*DuplicateCluster, 0)
+
+	var lastPosition int
+
+	for rows.Next() {
+		var (
+			cluster DuplicateCluster
+			ids     pq.StringArray
+		)
+
+		if err := rows.Scan(
+			&cluster.ID,
+			&cluster.Title,
+			&cluster.Phone,
+			&cluster.MinSalary,
+			&cluster.MaxSalary,
+			&cluster.Currency,
+			&cluster.SalaryPeriod,
+			&cluster.SalaryGross,
+			&cluster.CompanyID,
+			&cluster.Status,
+			&cluster.PublishedAt,
+			&cluster.ExpiresAt,
+			&lastPosition,
+			&ids,
+			&cluster.RepostedAt,
+		); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		cluster.DuplicatesIDs = ids
+		clusters = append(clusters, &cluster)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	if len(clusters) == 0 || len(clusters) < limit {
+		return clusters, nil, nil
+	}
+
+	return clusters, &Cursor{
+		PrevCreatedAt: clusters[len(clusters)-1].RepostedAt,
+		PrevPosition:  lastPosition,
+	}, nil
+}
+
+/**
+Duplicates part end
+*/
+
+/**
+Import part start
+*/
+
+// TxGetVacancyIDByExternalID returns the vacancy imported with the external id by the company.
+func (s *Storage) TxGetVacancyIDByExternalID(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+	externalID string,
+) (string, error) {
+	c := postgresql.FromTx(tx)
+
+	var vacancyID string
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT vacancy_id FROM vacancy_external_id WHERE company_id = $1 AND external_id = $2`,
+		companyID,
+		externalID,
+	).Scan(&vacancyID)
+
+	switch err {
+	case nil:
+		return vacancyID, nil
+	case sql.ErrNoRows:
+		return "", errors.WithStack(ErrNotFound)
+	default:
+		return "", errors.WithStack(err)
+	}
+}
+
+func (s *Storage) TxPutVacancyExternalID(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyID string,
+	externalID string,
+	vacancyID string,
+) error {
+	c := postgresql.FromTx(tx)
+
+	if _, err := c.ExecContext(
+		ctx,
+		`INSERT INTO vacancy_external_id (company_id, external_id, vacancy_id) VALUES ($1, $2, $3)
+		ON CONFLICT (company_id, external_id) DO UPDATE SET vacancy_id = excluded.vacancy_id`,
+		companyID,
+		externalID,
+		vacancyID,
+	); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// TxGetVacancyCategoriesIDsByTitles returns the ids of the categories by their lowercased titles.
+func (s *Storage) TxGetVacancyCategoriesIDsByTitles(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	titles []string,
+) (map[string]string, error) {
+	return s.txGetIDsByNames(
+		ctx,
+		tx,
+		`SELECT DISTINCT ON (lower(title)) lower(title), id
+		FROM vacancy_category
+		WHERE lower(title) = ANY($1)
+		ORDER BY lower(title), rating DESC`,
+		titles,
+	)
+}
+
+// TxGetCitiesIDsByNames returns the ids of the cities by their lowercased names. The highest rated city
+// is taken of the cities with the same name.
+func (s *Storage) TxGetCitiesIDsByNames(ctx context.Context, tx pkgtx.Tx, names []string) (map[string]string, error) {
+	return s.txGetIDsByNames(
+		ctx,
+		tx,
+		`SELECT DISTINCT ON (lower(name)) lower(name), id
+		FROM city
+		WHERE lower(name) = ANY($1)
+		ORDER BY lower(name), rating DESC`,
+		names,
+	)
+}
+
+func (s *Storage) txGetIDsByNames(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	query string,
+	names []string,
+) (_ map[string]string, rerr error) {
+	lowered := make([]string, len(names))
+	for idx, n := range names {
+		lowered[idx] = strings.ToLower(n)
+	}
+
+	c := postgresql.FromTx(tx)
+	rows, err := c.QueryContext(ctx, query, pq.Array(lowered))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	ids := make(map[string]string)
+
+	for rows.Next() {
+		var name, id string
+
+		if err := rows.Scan(&name, &id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		ids[name] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
+
+/**
+Import part end
+*/
+
+/**
+Feed part start
+*/
+
+// LiveVacancy is a vacancy listed to anybody. UpdatedAt is the latest of its update and publishing.
+type LiveVacancy struct {
+	ID        string
+	UpdatedAt time.Time
+}
+
+type FeedCompany struct {
+	ID      string
+	Title   string
+	LogoURL string
+}
+
+func (s *Storage) TxGetLiveVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*LiveVacancy, error) {
+	c := postgresql.FromTx(tx)
+
+	var lv LiveVacancy
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT v.id, GREATEST(v.updated_at, v.published_at)
+		FROM vacancy AS v
+		WHERE v.id = $1 AND `+liveVacancyCondition,
+		vacancyID,
+	).Scan(&lv.ID, &lv.UpdatedAt)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errors.WithStack(ErrNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return &lv, nil
+}
+
+// TxGetLiveVacancies returns a page of the live vacancies, the earliest published first.
+func (s *Storage) TxGetLiveVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	offset int,
+	limit int,
+) (_ []*LiveVacancy, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT v.id, GREATEST(v.updated_at, v.published_at)
+		FROM vacancy AS v
+		WHERE `+liveVacancyCondition+`
+		ORDER BY v.published_at, v.id
+		OFFSET $1 LIMIT $2`,
+		offset,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	vacancies := make([]*LiveVacancy, 0)
+
+	for rows.Next() {
+		var lv LiveVacancy
+
+		if err := rows.Scan(&lv.ID, &lv.UpdatedAt); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		vacancies = append(vacancies, &lv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return vacancies, nil
+}
+
+func (s *Storage) TxCountLiveVacancies(ctx context.Context, tx pkgtx.Tx) (int, error) {
+	c := postgresql.FromTx(tx)
+
+	var count int
+	if err := c.QueryRowContext(
+		ctx,
+		`SELECT count(*) FROM vacancy AS v WHERE `+liveVacancyCondition,
+	).Scan(&count); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+func (s *Storage) TxGetFeedCompanies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	companyIDs []string,
+) (_ []*FeedCompany, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT auth_id, coalesce(title, ''), coalesce(logo_url, '')
+		FROM company
+		WHERE auth_id = ANY($1::uuid[])`,
+		pq.Array(companyIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	companies := make([]*FeedCompany, 0)
+
+	for rows.Next() {
+		var fc FeedCompany
+
+		if err := rows.Scan(&fc.ID, &fc.Title, &fc.LogoURL); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		companies = append(companies, &fc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return companies, nil
+}
+
+/**
+Feed part end
+*/
+
+/**
+Revisions part start
+*/
+
+// VacancySnapshot is the content of a vacancy kept with its revision. The status isn't a part of it.
+type VacancySnapshot struct {
+	Title                string       `json:"title"`
+	Description          string       `json:"description"`
+	Phone                string       `json:"phone"`
+	MinSalary            int32        `json:"min_salary"`
+	MaxSalary            int32        `json:"max_salary"`
+	Currency             string       `json:"currency"`
+	SalaryPeriod         SalaryPeriod `json:"salary_period"`
+	SalaryGross          *bool        `json:"salary_gross"`
+	Type                 VacancyType  `json:"type"`
+	Address              string       `json:"address"`
+	CountryCode          int32        `json:"country_code"`
+	WorkMonthsExperience int32        `json:"work_months_experience"`
+	WorkSchedule         string       `json:"work_schedule"`
+	LocationLatitude     float32      `json:"location_latitude"`
+	LocationLongitude    float32      `json:"location_longitude"`
+	// CategoriesIDs and CityIDs are sorted, ImageURLs are in the order of the images
+	CategoriesIDs []string `json:"categories_ids"`
+	CityIDs       []string `json:"city_ids"`
+	ImageURLs     []string `json:"image_urls"`
+}
+
+// VacancyRevision is an immutable snapshot of a vacancy, the revisions of a vacancy are numbered from 1.
+// AuthorID is nil for the changes made by the system.
+type VacancyRevision struct {
+	VacancyID string
+	Revision  int32
+	AuthorID  *string
+	Snapshot  *VacancySnapshot
+	CreatedAt time.Time
+}
+
+// TxPutVacancyRevision adds the next revision of the vacancy, the revision number of r is set.
+func (s *Storage) TxPutVacancyRevision(ctx context.Context, tx pkgtx.Tx, r *VacancyRevision) error {
+	c := postgresql.FromTx(tx)
+
+	snapshot, err := json.Marshal(r.Snapshot)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(c.QueryRowContext(
+		ctx,
+		`INSERT INTO vacancy_revision (vacancy_id, revision, author_id, snapshot, created_at)
+		SELECT $1::uuid, coalesce(max(revision), 0) + 1, $2::uuid, $3::JSONB, $4::TIMESTAMPTZ
+		FROM vacancy_revision
+		WHERE vacancy_id = $1::uuid
+		RETURNING revision`,
+		r.VacancyID,
+		r.AuthorID,
+		snapshot,
+		r.CreatedAt,
+	).Scan(&r.Revision))
+}
+
+// TxGetVacancyRevision returns the revision of the vacancy, the latest one when revision is nil.
+func (s *Storage) TxGetVacancyRevision(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	revision *int32,
+) (*VacancyRevision, error) {
+	c := postgresql.FromTx(tx)
+
+	var (
+		r        VacancyRevision
+		snapshot []byte
+	)
+
+	err := c.QueryRowContext(
+		ctx,
+		`SELECT vacancy_id, revision, author_id, snapshot, created_at
+		FROM vacancy_revision
+		WHERE vacancy_id = $1 AND ($2::INTEGER IS NULL OR revision = $2)
+		ORDER BY revision DESC
+		LIMIT 1`,
+		vacancyID,
+		revision,
+	).Scan(&r.VacancyID, &r.Revision, &r.AuthorID, &snapshot, &r.CreatedAt)
+
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil, errors.WithStack(ErrNotFound)
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	if err := json.Unmarshal(snapshot, &r.Snapshot); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &r, nil
+}
+
+// TxGetVacancyRevisions returns the revisions of the vacancy, the latest first.
+func (s *Storage) TxGetVacancyRevisions(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+) (_ []*VacancyRevision, rerr error) {
+	c := postgresql.FromTx(tx)
+
+	rows, err := c.QueryContext(
+		ctx,
+		`SELECT vacancy_id, revision, author_id, snapshot, created_at
+		FROM vacancy_revision
+		WHERE vacancy_id = $1
+		ORDER BY revision DESC`,
+		vacancyID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
+
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	revisions := make([]*VacancyRevision, 0)
+
+	for rows.Next() {
+		var (
+			r        VacancyRevision
+			snapshot []byte
+		)
+
+		if err := rows.Scan(&r.VacancyID, &r.Revision, &r.AuthorID, &snapshot, &r.CreatedAt); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if err := json.Unmarshal(snapshot, &r.Snapshot); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		revisions = append(revisions, &r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
 	}
 
-	return nil
+	return revisions, nil
 }
 
-func (s *Storage) TxDeleteVacancy(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
+/**
+Revisions part end
+*/
+
+/**
+Scheduling part start
+*/
+
+// TxPutVacancySchedule sets the time the vacancy is published at by the scheduler, nil cancels the publishing.
+func (s *Storage) TxPutVacancySchedule(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	publishAt *time.Time,
+) error {
 	c := postgresql.FromTx(tx)
 
-	if _, err := c.ExecContext(
+	res, err := c.ExecContext(
 		ctx,
-		`DELETE FROM vacancy 
+		`UPDATE vacancy SET scheduled_publish_at = $2
 			WHERE id = $1`,
 		vacancyID,
-	); err != nil {
+		publishAt,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
 		return errors.WithStack(err)
 	}
 
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
 	return nil
 }
 
-/**
-Vacancy images part start
-*/
-
-func (s *Storage) TxGetVacanciesImages(
+// TxGetDueScheduledVacancies returns the ids of up to limit draft or paused vacancies scheduled to be published
+// by now, the earliest scheduled first.
+func (s *Storage) TxGetDueScheduledVacancies(
 	ctx context.Context,
 	tx pkgtx.Tx,
-	vacancyIDs []string,
-) (map[string][]string, error) {
+	now time.Time,
+	limit int,
+) (_ []string, rerr error) {
 	c := postgresql.FromTx(tx)
 
-	vacancyImageMap := make(map[string][]string, len(vacancyIDs))
-
-	if len(vacancyIDs) == 0 {
-		return vacancyImageMap, nil
-	}
-
 	rows, err := c.QueryContext(
 		ctx,
-		// nolint:gosec // will rework
-		`SELECT vacancy_id, image_url
-			FROM vacancies_images
-			WHERE vacancy_id = ANY($1::uuid[])
-			ORDER BY vacancy_id ASC, position ASC`,
-		pq.Array(vacancyIDs),
+		`SELECT id FROM vacancy
+			WHERE scheduled_publish_at <= $1
+			AND status IN ('vacancy_status_draft', 'vacancy_status_paused')
+			ORDER BY scheduled_publish_at, id
+			LIMIT $2`,
+		now,
+		limit,
 	)
-
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	for rows.Next() {
-		var (
-			vacancyID string
-			imageURL  string
-		)
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
 
-		err := rows.Scan(&vacancyID, &imageURL)
-		if err != nil {
-			_ = rows.Close()
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	ids := make([]string, 0)
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
 			return nil, errors.WithStack(err)
 		}
 
-		vacancyImageMap[vacancyID] = append(vacancyImageMap[vacancyID], imageURL)
+		ids = append(ids, id)
 	}
 
-	return vacancyImageMap, nil
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
 }
 
-func (s *Storage) TxPutVacancyImages(
+// TxBumpVacancy lists the vacancy as the newest one at now and counts the bump, created_at is kept. The vacancy
+// is bumped only while it has been bumped less than maxBumps times and not after bumpedBefore, ErrNotFound
+// is returned otherwise.
+func (s *Storage) TxBumpVacancy(
 	ctx context.Context,
 	tx pkgtx.Tx,
 	vacancyID string,
-	imageUrls []string,
+	now time.Time,
+	maxBumps int,
+	bumpedBefore time.Time,
 ) error {
 	c := postgresql.FromTx(tx)
 
-	queryFormat := `INSERT 
-		INTO vacancies_images (vacancy_id, position, image_url)
-		VALUES %s`
-
-	columns := 3
-	valueStrings := make([]string, len(imageUrls))
-	valueArgs := make([]interface{}, len(imageUrls)*columns)
-
-	for i := 0; i < len(imageUrls); i++ {
-		offset := i * columns
-		valueStrings[i] = fmt.Sprintf("($%d, $%d, $%d)", offset+1, offset+2, offset+3)
-		valueArgs[offset] = vacancyID
-		valueArgs[offset+1] = i
-		valueArgs[offset+2] = imageUrls[i]
-	}
-
-	if _, err := c.ExecContext(
+	res, err := c.ExecContext(
 		ctx,
-		fmt.Sprintf(queryFormat, strings.TrimSuffix(strings.Join(valueStrings, ","), ",")),
-		valueArgs...,
-	); err != nil {
+		`UPDATE vacancy SET
+				listed_at = $2,
+				bumped_at = $2,
+				bump_count = bump_count + 1
+			WHERE id = $1
+			AND bump_count < $3
+			AND (bumped_at IS NULL OR bumped_at <= $4)`,
+		vacancyID,
+		now,
+		maxBumps,
+		bumpedBefore,
+	)
+	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	return nil
-}
-
-func (s *Storage) TxDeleteVacancyImages(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
-	c := postgresql.FromTx(tx)
-
-	if _, err := c.ExecContext(
-		ctx,
-		`DELETE FROM vacancies_images 
-			WHERE vacancy_id = $1`,
-		vacancyID,
-	); err != nil {
+	affected, err := res.RowsAffected()
+	if err != nil {
 		return errors.WithStack(err)
 	}
 
+	if affected == 0 {
+		return errors.WithStack(ErrNotFound)
+	}
+
 	return nil
 }
 
 /**
-Vacancy images part end
+Scheduling part end
 */
 
 /**
-Vacancy cities part start
+Similar vacancies part start
 */
-func (s *Storage) TxGetVacancyCities(
+
+// SimilarVacancy is a vacancy with the features it's compared by. The salaries are monthly in the base
+// currency, nil without the exchange rate. Distance is in meters from the vacancy compared to, nil when
+// either of them isn't located, i.e. is put at the zero point.
+type SimilarVacancy struct {
+	Vacancy
+	NormalizedMinSalary *float64
+	NormalizedMaxSalary *float64
+	CategoriesIDs       []string
+	CityIDs             []string
+}
+
+// TxGetVacancySimilarity returns the vacancy with the features the similar ones are compared to.
+func (s *Storage) TxGetVacancySimilarity(ctx context.Context, tx pkgtx.Tx, vacancyID string) (*SimilarVacancy, error) {
+	svs, err := s.txGetSimilarVacancies(ctx, tx, vacancyID, `v.id = o.id`, 1)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(svs) == 0 {
+		return nil, errors.WithStack(ErrNotFound)
+	}
+
+	return svs[0], nil
+}
+
+// TxGetSimilarVacancyCandidates returns up to limit newest live vacancies sharing a category or a city
+// with the vacancy or matching its title. The vacancy, its reposts and its original are left out.
+func (s *Storage) TxGetSimilarVacancyCandidates(
 	ctx context.Context,
 	tx pkgtx.Tx,
-	vacancyIDs []string,
-) ([]*VacancyCity, error) {
+	vacancyID string,
+	limit int,
+) ([]*SimilarVacancy, error) {
+	svs, err := s.txGetSimilarVacancies(
+		ctx,
+		tx,
+		vacancyID,
+		`v.id <> o.id AND `+liveVacancyCondition+`
+			AND v.duplicate_of IS DISTINCT FROM o.id AND o.duplicate_of IS DISTINCT FROM v.id
+			AND (
+				v.id IN (
+					SELECT vscs.vacancy_id FROM vacancies_categories AS vscs
+					INNER JOIN vacancies_categories AS ovscs ON ovscs.category_id = vscs.category_id
+					WHERE ovscs.vacancy_id = o.id
+				)
+				OR v.id IN (
+					SELECT vcs.vacancy_id FROM vacancy_cities AS vcs
+					INNER JOIN vacancy_cities AS ovcs ON ovcs.city_id = vcs.city_id
+					WHERE ovcs.vacancy_id = o.id
+				)
+				OR v.search_vector @@ plainto_tsquery('vacancy_search', o.title)
+			)`,
+		limit,
+	)
+
+	return svs, errors.WithStack(err)
+}
+
+// txGetSimilarVacancies returns the vacancies aliased as v matching the condition, the vacancy compared to
+// is aliased as o.
+func (s *Storage) txGetSimilarVacancies(
+	ctx context.Context,
+	tx pkgtx.Tx,
+	vacancyID string,
+	condition string,
+	limit int,
+) (_ []*SimilarVacancy, rerr error) {
 	c := postgresql.FromTx(tx)
 
 	rows, err := c.QueryContext(
 		ctx,
-		`SELECT vscs.vacancy_id, c.id, c.name, country_code, rating
-			FROM vacancy_cities AS vscs
-			INNER JOIN city AS c
-			ON vscs.city_id = c.id
-			WHERE vacancy_id = ANY($1::uuid[])`,
-		pq.Array(vacancyIDs),
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.status, v.published_at, v.expires_at,
+			normalized_salary(v.min_salary, v.currency, v.salary_period),
+			normalized_salary(v.max_salary, v.currency, v.salary_period),
+			array(SELECT category_id::text FROM vacancies_categories WHERE vacancy_id = v.id ORDER BY category_id),
+			array(SELECT city_id::text FROM vacancy_cities WHERE vacancy_id = v.id ORDER BY city_id),
+			CASE WHEN ST_X(v.location::geometry) = 0 AND ST_Y(v.location::geometry) = 0
+					OR ST_X(o.location::geometry) = 0 AND ST_Y(o.location::geometry) = 0 THEN NULL
+				ELSE round(ST_Distance(v.location, o.location)::numeric, 2)
+			END
+		FROM vacancy AS o, vacancy AS v
+		WHERE o.id = $1 AND `+condition+`
+		ORDER BY v.listed_at DESC, v.position DESC
+		LIMIT $2`,
+		vacancyID,
+		limit,
 	)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
 
-	vcs := make([]*VacancyCity, 0)
+			rerr = errors.WithStack(err)
+		}
+	}()
+
+	svs := make([]*SimilarVacancy, 0)
 
 	for rows.Next() {
-		var vc VacancyCity
-		if err := rows.Scan(&vc.VacancyID, &vc.ID, &vc.Name, &vc.CountryCode, &vc.Rating); err != nil {
-			_ = rows.Close()
+		var (
+			sv                   SimilarVacancy
+			minSalary, maxSalary sql.NullFloat64
+			distance             sql.NullFloat64
+		)
+
+		if err := rows.Scan(
+			&sv.ID,
+			&sv.Title,
+			&sv.Phone,
+			&sv.MinSalary,
+			&sv.MaxSalary,
+			&sv.Currency,
+			&sv.SalaryPeriod,
+			&sv.SalaryGross,
+			&sv.CompanyID,
+			&sv.Status,
+			&sv.PublishedAt,
+			&sv.ExpiresAt,
+			&minSalary,
+			&maxSalary,
+			pq.Array(&sv.CategoriesIDs),
+			pq.Array(&sv.CityIDs),
+			&distance,
+		); err != nil {
 			return nil, errors.WithStack(err)
 		}
 
-		vcs = append(vcs, &vc)
+		if minSalary.Valid {
+			sv.NormalizedMinSalary = &minSalary.Float64
+		}
+
+		if maxSalary.Valid {
+			sv.NormalizedMaxSalary = &maxSalary.Float64
+		}
+
+		if distance.Valid {
+			sv.Distance = &distance.Float64
+		}
+
+		svs = append(svs, &sv)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
 	}
 
-	return vcs, nil
+	return svs, nil
 }
 
-func (s *Storage) TxPutVacancyCities(
+/**
+Similar vacancies part end
+*/
+
+/**
+Recommended vacancies part start
+*/
+
+// TxGetLiveVacanciesByIDs returns the live ones of the vacancies, in no particular order.
+func (s *Storage) TxGetLiveVacanciesByIDs(
 	ctx context.Context,
 	tx pkgtx.Tx,
-	vacancyID string,
-	cityIDs []string,
-) error {
+	vacancyIDs []string,
+) (_ []*Vacancy, rerr error) {
 	c := postgresql.FromTx(tx)
 
-	queryFormat := `INSERT 
-		INTO vacancy_cities (vacancy_id, city_id)
-		VALUES %s`
-
-	columns := 2
-	valueStrings := make([]string, len(cityIDs))
-	valueArgs := make([]interface{}, len(cityIDs)*columns)
-
-	for i := 0; i < len(cityIDs); i++ {
-		offset := i * columns
-		valueStrings[i] = fmt.Sprintf("($%d, $%d)", offset+1, offset+2)
-		valueArgs[offset] = vacancyID
-		valueArgs[offset+1] = cityIDs[i]
-	}
-
-	if _, err := c.ExecContext(
+	rows, err := c.QueryContext(
 		ctx,
-		fmt.Sprintf(queryFormat, strings.TrimSuffix(strings.Join(valueStrings, ","), ",")),
-		valueArgs...,
-	); err != nil {
-		return errors.WithStack(err)
+		// nolint:gosec // the query is built from constant expressions
+		`SELECT v.id, v.title, v.phone, v.min_salary, v.max_salary, v.currency, v.salary_period, v.salary_gross,
+			v.company_id, v.status, v.published_at, v.expires_at
+		FROM vacancy AS v
+		WHERE v.id = ANY($1::uuid[]) AND `+liveVacancyCondition,
+		pq.Array(vacancyIDs),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
 	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			if rerr != nil {
+				rerr = errors.WithSecondaryError(rerr, err)
+				return
+			}
 
-	return nil
-}
+			rerr = errors.WithStack(err)
+		}
+	}()
 
-func (s *Storage) TxDeleteVacancyCities(ctx context.Context, tx pkgtx.Tx, vacancyID string) error {
-	c := postgresql.FromTx(tx)
+	vs := make([]*Vacancy, 0, len(vacancyIDs))
 
-	if _, err := c.ExecContext(
-		ctx,
-		`DELETE FROM vacancy_cities 
-			WHERE vacancy_id = $1`,
-		vacancyID,
-	); err != nil {
-		return errors.WithStack(err)
+	for rows.Next() {
+		var v Vacancy
+
+		if err := rows.Scan(
+			&v.ID,
+			&v.Title,
+			&v.Phone,
+			&v.MinSalary,
+			&v.MaxSalary,
+			&v.Currency,
+			&v.SalaryPeriod,
+			&v.SalaryGross,
+			&v.CompanyID,
+			&v.Status,
+			&v.PublishedAt,
+			&v.ExpiresAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		vs = append(vs, &v)
+	}
+	if rows.Err() != nil {
+		return nil, errors.WithStack(rows.Err())
 	}
 
-	return nil
+	return vs, nil
 }
 
 /**
-Vacancy cities part end
+Recommended vacancies part end
 */
@@ -0,0 +1,25 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type Config struct {
+	Address     string
+	BaseURL     string
+	CacheMaxAge time.Duration
+}
+
+func (c *Config) Flags(name string) *pflag.FlagSet {
+	f := pflag.NewFlagSet(name, pflag.PanicOnError)
+
+	f.StringVar(&c.Address, "feed_address", "127.0.0.1:8080",
+		"Address of the vacancy feeds HTTP server in ip:port format. Empty value disables the feeds")
+	f.StringVar(&c.BaseURL, "feed_base_url", "https://personaapp.com",
+		"Public URL the vacancy pages and the feeds are linked at")
+	f.DurationVar(&c.CacheMaxAge, "feed_cache_max_age", 5*time.Minute, "Time the feeds are cached by the clients")
+
+	return f
+}
@@ -0,0 +1,234 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+)
+
+const (
+	// feedPageSize is the number of the vacancies of an XML feed page
+	feedPageSize = 100
+	// sitemapPageSize is the number of the vacancies of a sitemap, the protocol allows 50000 at most
+	sitemapPageSize = 10000
+)
+
+type VacancyController interface {
+	GetFeedVacancy(ctx context.Context, vacancyID string) (*vacancyController.FeedVacancy, error)
+	CountFeedVacancies(ctx context.Context) (int, error)
+	ListFeedVacancies(ctx context.Context, page int, limit int) ([]*vacancyController.FeedVacancy, error)
+	ListSitemapVacancies(ctx context.Context, page int, limit int) ([]*vacancyController.SitemapVacancy, error)
+}
+
+// Handler serves the feeds the job aggregators and the search engines index the live vacancies from:
+//
+//	/jobposting/<vacancy id>         schema.org JobPosting JSON-LD of the vacancy
+//	/feed/vacancies.xml?page=<n>     XML job feed, see xmlJobFeed
+//	/sitemap.xml                     sitemap index of the vacancy sitemaps
+//	/sitemap/vacancies.xml?page=<n>  sitemap of the vacancy pages
+//
+// The responses carry ETag and Last-Modified, the conditional requests are answered with 304 Not Modified.
+type Handler struct {
+	cfg    *Config
+	vc     VacancyController
+	logger *zap.SugaredLogger
+	mux    *http.ServeMux
+}
+
+func New(cfg *Config, vc VacancyController, logger *zap.SugaredLogger) *Handler {
+	h := &Handler{cfg: cfg, vc: vc, logger: logger, mux: http.NewServeMux()}
+
+	h.mux.HandleFunc("/jobposting/", h.jobPosting)
+	h.mux.HandleFunc("/feed/vacancies.xml", h.vacanciesFeed)
+	h.mux.HandleFunc("/sitemap.xml", h.sitemapIndex)
+	h.mux.HandleFunc("/sitemap/vacancies.xml", h.vacanciesSitemap)
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) jobPosting(w http.ResponseWriter, r *http.Request) {
+	vacancyID := strings.TrimPrefix(r.URL.Path, "/jobposting/")
+
+	v, err := h.vc.GetFeedVacancy(r.Context(), vacancyID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		http.NotFound(w, r)
+		return
+	default:
+		h.internalError(w, r, err)
+		return
+	}
+
+	body, err := marshalJobPosting(toJobPosting(v, h.vacancyURL(v.ID)))
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	h.serve(w, r, "application/ld+json", body, v.UpdatedAt)
+}
+
+func (h *Handler) vacanciesFeed(w http.ResponseWriter, r *http.Request) {
+	page, pages, ok := h.page(w, r, feedPageSize)
+	if !ok {
+		return
+	}
+
+	vacancies, err := h.vc.ListFeedVacancies(r.Context(), page, feedPageSize)
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	var modTime time.Time
+	for _, v := range vacancies {
+		if v.UpdatedAt.After(modTime) {
+			modTime = v.UpdatedAt
+		}
+	}
+
+	body, err := marshalXML(h.toXMLJobFeed(vacancies, modTime))
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	if page < pages {
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, h.pageURL("/feed/vacancies.xml", page+1)))
+	}
+
+	h.serve(w, r, "application/xml; charset=utf-8", body, modTime)
+}
+
+func (h *Handler) sitemapIndex(w http.ResponseWriter, r *http.Request) {
+	count, err := h.vc.CountFeedVacancies(r.Context())
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	body, err := marshalXML(h.toSitemapIndex(pagesCount(count, sitemapPageSize)))
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	h.serve(w, r, "application/xml; charset=utf-8", body, time.Time{})
+}
+
+func (h *Handler) vacanciesSitemap(w http.ResponseWriter, r *http.Request) {
+	page, _, ok := h.page(w, r, sitemapPageSize)
+	if !ok {
+		return
+	}
+
+	vacancies, err := h.vc.ListSitemapVacancies(r.Context(), page, sitemapPageSize)
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	var modTime time.Time
+	for _, v := range vacancies {
+		if v.UpdatedAt.After(modTime) {
+			modTime = v.UpdatedAt
+		}
+	}
+
+	body, err := marshalXML(h.toSitemap(vacancies))
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	h.serve(w, r, "application/xml; charset=utf-8", body, modTime)
+}
+
+// page reads the page query parameter, the first page when it's omitted. A page past the last one
+// is answered with 404 Not Found, the first page is served even when there are no live vacancies.
+func (h *Handler) page(w http.ResponseWriter, r *http.Request, pageSize int) (page int, pages int, ok bool) {
+	page = 1
+
+	if p := r.URL.Query().Get("page"); p != "" {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 {
+			http.Error(w, "invalid page", http.StatusBadRequest)
+			return 0, 0, false
+		}
+
+		page = n
+	}
+
+	count, err := h.vc.CountFeedVacancies(r.Context())
+	if err != nil {
+		h.internalError(w, r, err)
+		return 0, 0, false
+	}
+
+	pages = pagesCount(count, pageSize)
+	if page > pages {
+		http.NotFound(w, r)
+		return 0, 0, false
+	}
+
+	return page, pages, true
+}
+
+// serve writes the body with the cache validators. The ETag is the hash of the body, Last-Modified is left
+// out when modTime is zero.
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request, contentType string, body []byte, modTime time.Time) {
+	sum := sha256.Sum256(body)
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.cfg.CacheMaxAge.Seconds())))
+
+	http.ServeContent(w, r, "", modTime, bytes.NewReader(body))
+}
+
+func (h *Handler) internalError(w http.ResponseWriter, r *http.Request, err error) {
+	h.logger.Errorw("feed request failed", "path", r.URL.Path, "error", err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func (h *Handler) vacancyURL(vacancyID string) string {
+	return strings.TrimSuffix(h.cfg.BaseURL, "/") + "/vacancies/" + url.PathEscape(vacancyID)
+}
+
+func (h *Handler) pageURL(path string, page int) string {
+	return strings.TrimSuffix(h.cfg.BaseURL, "/") + path + "?page=" + strconv.Itoa(page)
+}
+
+// pagesCount returns the number of the pages of the vacancies, there is one page at least.
+func pagesCount(count int, pageSize int) int {
+	if count == 0 {
+		return 1
+	}
+
+	return (count + pageSize - 1) / pageSize
+}
@@ -0,0 +1,197 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+)
+
+type vacancyControllerStub struct {
+	vacancies []*vacancyController.FeedVacancy
+}
+
+func (s *vacancyControllerStub) GetFeedVacancy(
+	_ context.Context,
+	vacancyID string,
+) (*vacancyController.FeedVacancy, error) {
+	for _, v := range s.vacancies {
+		if v.ID == vacancyID {
+			return v, nil
+		}
+	}
+
+	return nil, errors.WithStack(vacancyController.ErrVacancyNotFound)
+}
+
+func (s *vacancyControllerStub) CountFeedVacancies(_ context.Context) (int, error) {
+	return len(s.vacancies), nil
+}
+
+func (s *vacancyControllerStub) ListFeedVacancies(
+	_ context.Context,
+	page int,
+	limit int,
+) ([]*vacancyController.FeedVacancy, error) {
+	from := (page - 1) * limit
+	if from >= len(s.vacancies) {
+		return nil, nil
+	}
+
+	to := from + limit
+	if to > len(s.vacancies) {
+		to = len(s.vacancies)
+	}
+
+	return s.vacancies[from:to], nil
+}
+
+func (s *vacancyControllerStub) ListSitemapVacancies(
+	ctx context.Context,
+	page int,
+	limit int,
+) ([]*vacancyController.SitemapVacancy, error) {
+	vacancies, _ := s.ListFeedVacancies(ctx, page, limit)
+
+	sitemap := make([]*vacancyController.SitemapVacancy, len(vacancies))
+	for idx, v := range vacancies {
+		sitemap[idx] = &vacancyController.SitemapVacancy{ID: v.ID, UpdatedAt: v.UpdatedAt}
+	}
+
+	return sitemap, nil
+}
+
+func newTestHandler() *Handler {
+	publishedAt := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := publishedAt.Add(30 * 24 * time.Hour)
+
+	vc := &vacancyControllerStub{vacancies: []*vacancyController.FeedVacancy{{
+		VacancyDetails: vacancyController.VacancyDetails{
+			Vacancy: vacancyController.Vacancy{
+				ID:           "5f1a0c4e-8a5f-4d3b-9a57-0d1c2b3a4f5e",
+				Title:        "Golang developer",
+				Phone:        "+380501234567",
+				MinSalary:    10000,
+				MaxSalary:    20000,
+				Currency:     "USD",
+				SalaryPeriod: vacancyController.SalaryPeriodYear,
+				PublishedAt:  &publishedAt,
+				ExpiresAt:    &expiresAt,
+			},
+			Description:          "Payments in Go",
+			WorkMonthsExperience: 12,
+			Type:                 vacancyController.VacancyTypeRemote,
+			Address:              "Khreshchatyk 1",
+		},
+		Company:   &vacancyController.FeedCompany{Title: "Persona", LogoURL: "https://cdn.personaapp.com/logo.png"},
+		Cities:    []*vacancyController.VacancyCity{{Name: "Kyiv"}},
+		UpdatedAt: publishedAt,
+	}}}
+
+	cfg := &Config{BaseURL: "https://personaapp.com/", CacheMaxAge: time.Minute}
+
+	return New(cfg, vc, zap.NewNop().Sugar())
+}
+
+func TestHandler_JobPosting(t *testing.T) {
+	h := newTestHandler()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobposting/5f1a0c4e-8a5f-4d3b-9a57-0d1c2b3a4f5e", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/ld+json", rec.Header().Get("Content-Type"))
+	require.Equal(t, "Mon, 01 Jun 2020 10:00:00 GMT", rec.Header().Get("Last-Modified"))
+	require.NotEmpty(t, rec.Header().Get("ETag"))
+	require.NotContains(t, rec.Body.String(), "+380501234567")
+
+	var jp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &jp))
+	require.Equal(t, "JobPosting", jp["@type"])
+	require.Equal(t, "https://personaapp.com/vacancies/5f1a0c4e-8a5f-4d3b-9a57-0d1c2b3a4f5e", jp["url"])
+	require.Equal(t, "2020-06-01T10:00:00Z", jp["datePosted"])
+	require.Equal(t, "TELECOMMUTE", jp["jobLocationType"])
+	require.Equal(t, map[string]interface{}{
+		"@type":    "MonetaryAmount",
+		"currency": "USD",
+		"value": map[string]interface{}{
+			"@type":    "QuantitativeValue",
+			"minValue": float64(10000),
+			"maxValue": float64(20000),
+			"unitText": "YEAR",
+		},
+	}, jp["baseSalary"])
+	require.Equal(t, map[string]interface{}{
+		"@type": "Organization",
+		"name":  "Persona",
+		"logo":  "https://cdn.personaapp.com/logo.png",
+	}, jp["hiringOrganization"])
+
+	req := httptest.NewRequest(http.MethodGet, "/jobposting/5f1a0c4e-8a5f-4d3b-9a57-0d1c2b3a4f5e", nil)
+	req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotModified, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobposting/unknown", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_Feeds(t *testing.T) {
+	h := newTestHandler()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feed/vacancies.xml", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "<referencenumber>5f1a0c4e-8a5f-4d3b-9a57-0d1c2b3a4f5e</referencenumber>")
+	require.Contains(t, rec.Body.String(), `<salary min="10000" max="20000" currency="USD" period="year"></salary>`)
+	require.NotContains(t, rec.Body.String(), "+380501234567")
+
+	feed := rec.Body.String()
+	rows, err := vacancyController.ParseImportXML(strings.NewReader(feed))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "Golang developer", rows[0].Title)
+	require.Equal(t, vacancyController.VacancyTypeRemote, rows[0].Type)
+
+	req := httptest.NewRequest(http.MethodGet, "/feed/vacancies.xml", nil)
+	req.Header.Set("If-Modified-Since", rec.Header().Get("Last-Modified"))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotModified, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feed/vacancies.xml?page=2", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/feed/vacancies.xml?page=zero", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "<loc>https://personaapp.com/sitemap/vacancies.xml?page=1</loc>")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sitemap/vacancies.xml", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(),
+		"<loc>https://personaapp.com/vacancies/5f1a0c4e-8a5f-4d3b-9a57-0d1c2b3a4f5e</loc>"+
+			"<lastmod>2020-06-01T10:00:00Z</lastmod>")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/sitemap.xml", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
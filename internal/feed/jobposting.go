@@ -0,0 +1,200 @@
+package feed
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+)
+
+// jobPosting is the schema.org JobPosting, see https://schema.org/JobPosting. The phone isn't published,
+// it's revealed to the signed in candidates only.
+type jobPosting struct {
+	Context                string                  `json:"@context"`
+	Type                   string                  `json:"@type"`
+	Title                  string                  `json:"title"`
+	Description            string                  `json:"description"`
+	Identifier             *propertyValue          `json:"identifier"`
+	URL                    string                  `json:"url"`
+	DatePosted             string                  `json:"datePosted,omitempty"`
+	ValidThrough           string                  `json:"validThrough,omitempty"`
+	HiringOrganization     *organization           `json:"hiringOrganization"`
+	JobLocation            []*place                `json:"jobLocation,omitempty"`
+	JobLocationType        string                  `json:"jobLocationType,omitempty"`
+	BaseSalary             *monetaryAmount         `json:"baseSalary,omitempty"`
+	ExperienceRequirements *experienceRequirements `json:"experienceRequirements,omitempty"`
+	WorkHours              string                  `json:"workHours,omitempty"`
+	OccupationalCategory   []string                `json:"occupationalCategory,omitempty"`
+}
+
+type propertyValue struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type organization struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	Logo string `json:"logo,omitempty"`
+}
+
+type place struct {
+	Type    string          `json:"@type"`
+	Address *postalAddress  `json:"address"`
+	Geo     *geoCoordinates `json:"geo,omitempty"`
+}
+
+type postalAddress struct {
+	Type            string `json:"@type"`
+	StreetAddress   string `json:"streetAddress,omitempty"`
+	AddressLocality string `json:"addressLocality,omitempty"`
+}
+
+type geoCoordinates struct {
+	Type      string  `json:"@type"`
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+}
+
+type monetaryAmount struct {
+	Type     string             `json:"@type"`
+	Currency string             `json:"currency"`
+	Value    *quantitativeValue `json:"value"`
+}
+
+type quantitativeValue struct {
+	Type     string `json:"@type"`
+	MinValue int32  `json:"minValue"`
+	MaxValue int32  `json:"maxValue"`
+	UnitText string `json:"unitText"`
+}
+
+type experienceRequirements struct {
+	Type               string `json:"@type"`
+	MonthsOfExperience int32  `json:"monthsOfExperience"`
+}
+
+func toJobPosting(v *vacancyController.FeedVacancy, vacancyURL string) *jobPosting {
+	jp := &jobPosting{
+		Context:     "https://schema.org/",
+		Type:        "JobPosting",
+		Title:       v.Title,
+		Description: v.Description,
+		Identifier:  &propertyValue{Type: "PropertyValue", Name: v.Company.Title, Value: v.ID},
+		URL:         vacancyURL,
+		HiringOrganization: &organization{
+			Type: "Organization",
+			Name: v.Company.Title,
+			Logo: v.Company.LogoURL,
+		},
+		WorkHours: v.WorkSchedule,
+	}
+
+	if v.PublishedAt != nil {
+		jp.DatePosted = v.PublishedAt.UTC().Format(time.RFC3339)
+	}
+
+	if v.ExpiresAt != nil {
+		jp.ValidThrough = v.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	if v.Type == vacancyController.VacancyTypeRemote {
+		jp.JobLocationType = "TELECOMMUTE"
+	}
+
+	jp.JobLocation = toJobLocation(v)
+
+	if v.MinSalary > 0 || v.MaxSalary > 0 {
+		jp.BaseSalary = &monetaryAmount{
+			Type:     "MonetaryAmount",
+			Currency: salaryCurrency(v.Currency),
+			Value: &quantitativeValue{
+				Type:     "QuantitativeValue",
+				MinValue: v.MinSalary,
+				MaxValue: v.MaxSalary,
+				UnitText: salaryUnitText(v.SalaryPeriod),
+			},
+		}
+	}
+
+	if v.WorkMonthsExperience > 0 {
+		jp.ExperienceRequirements = &experienceRequirements{
+			Type:               "OccupationalExperienceRequirements",
+			MonthsOfExperience: v.WorkMonthsExperience,
+		}
+	}
+
+	for _, category := range v.Categories {
+		jp.OccupationalCategory = append(jp.OccupationalCategory, category.Title)
+	}
+
+	return jp
+}
+
+// toJobLocation returns a place per city of the vacancy. The address and the location point are set
+// to the only city, or to the only place when the vacancy has no cities.
+func toJobLocation(v *vacancyController.FeedVacancy) []*place {
+	var geo *geoCoordinates
+	if v.LocationLatitude != 0 || v.LocationLongitude != 0 {
+		geo = &geoCoordinates{
+			Type:      "GeoCoordinates",
+			Latitude:  v.LocationLatitude,
+			Longitude: v.LocationLongitude,
+		}
+	}
+
+	if len(v.Cities) == 0 {
+		if v.Address == "" && geo == nil {
+			return nil
+		}
+
+		return []*place{{
+			Type:    "Place",
+			Address: &postalAddress{Type: "PostalAddress", StreetAddress: v.Address},
+			Geo:     geo,
+		}}
+	}
+
+	places := make([]*place, len(v.Cities))
+
+	for idx, city := range v.Cities {
+		places[idx] = &place{
+			Type:    "Place",
+			Address: &postalAddress{Type: "PostalAddress", AddressLocality: city.Name},
+		}
+	}
+
+	if len(places) == 1 {
+		places[0].Address.StreetAddress = v.Address
+		places[0].Geo = geo
+	}
+
+	return places
+}
+
+func salaryCurrency(currency vacancyController.Currency) string {
+	if currency == "" {
+		return "UAH"
+	}
+
+	return string(currency)
+}
+
+func salaryUnitText(period vacancyController.SalaryPeriod) string {
+	switch period {
+	case vacancyController.SalaryPeriodHour:
+		return "HOUR"
+	case vacancyController.SalaryPeriodYear:
+		return "YEAR"
+	default:
+		return "MONTH"
+	}
+}
+
+func marshalJobPosting(jp *jobPosting) ([]byte, error) {
+	b, err := json.Marshal(jp)
+	return b, errors.WithStack(err)
+}
@@ -0,0 +1,165 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	vacancyController "personaapp/internal/controllers/vacancy/controller"
+)
+
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// xmlJobFeed is the common XML job feed format of the job boards. It's the format the vacancies are imported
+// from with the company, the dates and the link of the vacancy added, and the phone left out.
+type xmlJobFeed struct {
+	XMLName       xml.Name  `xml:"source"`
+	Publisher     string    `xml:"publisher"`
+	PublisherURL  string    `xml:"publisherurl"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Jobs          []*xmlJob `xml:"job"`
+}
+
+type xmlJob struct {
+	ReferenceNumber string     `xml:"referencenumber"`
+	Title           cdata      `xml:"title"`
+	Date            string     `xml:"date,omitempty"`
+	ExpirationDate  string     `xml:"expirationdate,omitempty"`
+	URL             string     `xml:"url"`
+	Company         cdata      `xml:"company"`
+	CompanyLogo     string     `xml:"companylogo,omitempty"`
+	Description     cdata      `xml:"description"`
+	Categories      []string   `xml:"category"`
+	Cities          []string   `xml:"city"`
+	Address         string     `xml:"address,omitempty"`
+	Salary          *xmlSalary `xml:"salary,omitempty"`
+	JobType         string     `xml:"jobtype"`
+	Experience      int32      `xml:"experience"`
+	Schedule        string     `xml:"schedule,omitempty"`
+}
+
+type xmlSalary struct {
+	Min      int32  `xml:"min,attr"`
+	Max      int32  `xml:"max,attr"`
+	Currency string `xml:"currency,attr"`
+	Period   string `xml:"period,attr"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name      `xml:"sitemapindex"`
+	XMLNS    string        `xml:"xmlns,attr"`
+	Sitemaps []*sitemapLoc `xml:"sitemap"`
+}
+
+type sitemapLoc struct {
+	Loc string `xml:"loc"`
+}
+
+type urlSet struct {
+	XMLName xml.Name      `xml:"urlset"`
+	XMLNS   string        `xml:"xmlns,attr"`
+	URLs    []*sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+func (h *Handler) toXMLJobFeed(vacancies []*vacancyController.FeedVacancy, modTime time.Time) *xmlJobFeed {
+	feed := &xmlJobFeed{
+		Publisher:    "PersonaApp",
+		PublisherURL: h.cfg.BaseURL,
+		Jobs:         make([]*xmlJob, len(vacancies)),
+	}
+
+	if !modTime.IsZero() {
+		feed.LastBuildDate = modTime.UTC().Format(time.RFC1123)
+	}
+
+	for idx, v := range vacancies {
+		job := &xmlJob{
+			ReferenceNumber: v.ID,
+			Title:           cdata{Value: v.Title},
+			URL:             h.vacancyURL(v.ID),
+			Company:         cdata{Value: v.Company.Title},
+			CompanyLogo:     v.Company.LogoURL,
+			Description:     cdata{Value: v.Description},
+			Address:         v.Address,
+			JobType:         string(v.Type),
+			Experience:      v.WorkMonthsExperience,
+			Schedule:        v.WorkSchedule,
+		}
+
+		if v.PublishedAt != nil {
+			job.Date = v.PublishedAt.UTC().Format(time.RFC1123)
+		}
+
+		if v.ExpiresAt != nil {
+			job.ExpirationDate = v.ExpiresAt.UTC().Format(time.RFC1123)
+		}
+
+		for _, category := range v.Categories {
+			job.Categories = append(job.Categories, category.Title)
+		}
+
+		for _, city := range v.Cities {
+			job.Cities = append(job.Cities, city.Name)
+		}
+
+		if v.MinSalary > 0 || v.MaxSalary > 0 {
+			period := v.SalaryPeriod
+			if period == "" {
+				period = vacancyController.SalaryPeriodMonth
+			}
+
+			job.Salary = &xmlSalary{
+				Min:      v.MinSalary,
+				Max:      v.MaxSalary,
+				Currency: salaryCurrency(v.Currency),
+				Period:   string(period),
+			}
+		}
+
+		feed.Jobs[idx] = job
+	}
+
+	return feed
+}
+
+func (h *Handler) toSitemapIndex(pages int) *sitemapIndex {
+	index := &sitemapIndex{XMLNS: sitemapNamespace, Sitemaps: make([]*sitemapLoc, pages)}
+
+	for idx := range index.Sitemaps {
+		index.Sitemaps[idx] = &sitemapLoc{Loc: h.pageURL("/sitemap/vacancies.xml", idx+1)}
+	}
+
+	return index
+}
+
+func (h *Handler) toSitemap(vacancies []*vacancyController.SitemapVacancy) *urlSet {
+	set := &urlSet{XMLNS: sitemapNamespace, URLs: make([]*sitemapURL, len(vacancies))}
+
+	for idx, v := range vacancies {
+		set.URLs[idx] = &sitemapURL{
+			Loc:     h.vacancyURL(v.ID),
+			LastMod: v.UpdatedAt.UTC().Format(time.RFC3339),
+		}
+	}
+
+	return set
+}
+
+func marshalXML(v interface{}) ([]byte, error) {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
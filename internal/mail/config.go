@@ -0,0 +1,23 @@
+package mail
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type Config struct {
+	User     string
+	Password string
+}
+
+func (c *Config) Flags(prefix string) *pflag.FlagSet {
+	f := pflag.NewFlagSet("MailConfig", pflag.PanicOnError)
+
+	if prefix != "" {
+		prefix += "."
+	}
+
+	f.StringVar(&c.User, prefix+"user", "", "SMTP user the emails are sent from. Empty value disables notification emails")
+	f.StringVar(&c.Password, prefix+"password", "", "SMTP password")
+
+	return f
+}
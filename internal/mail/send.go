@@ -46,6 +46,15 @@ func (sender Sender) SendMail(dest []string, subject, bodyMessage string) {
 	fmt.Println("Mail sent successfully!")
 }
 
+// SendHTMLMail sends an html email and, unlike SendMail, reports delivery errors to the caller.
+func (sender *Sender) SendHTMLMail(dest []string, subject, bodyMessage string) error {
+	msg := sender.WriteHTMLEmail(dest, subject, bodyMessage)
+
+	return smtp.SendMail(SMTPServer+":587",
+		smtp.PlainAuth("", sender.User, sender.Password, SMTPServer),
+		sender.User, dest, []byte(msg))
+}
+
 func (sender Sender) WriteEmail(dest []string, contentType, subject, bodyMessage string) string {
 	header := make(map[string]string)
 	header["From"] = sender.User
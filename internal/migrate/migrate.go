@@ -442,6 +442,728 @@ var migrations = []*migrate.Migration{
 			`DROP TABLE IF EXISTS stories_episode;`,
 		},
 	},
+	{
+		Id: "26 - Create device table",
+		Up: []string{
+			`CREATE TYPE e_device_platform AS ENUM (
+				'device_platform_android',
+				'device_platform_ios'
+			);`,
+			`CREATE TABLE IF NOT EXISTS device (
+				id            			uuid					PRIMARY KEY,
+				account_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				platform				e_device_platform		NOT NULL,
+				push_token				VARCHAR(4096)			NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				updated_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE UNIQUE INDEX device_push_token_idx ON device (push_token);`,
+			`CREATE INDEX device_account_id_idx ON device (account_id);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS device_account_id_idx;`,
+			`DROP INDEX IF EXISTS device_push_token_idx;`,
+			`DROP TABLE IF EXISTS device;`,
+			`DROP TYPE IF EXISTS e_device_platform;`,
+		},
+	},
+	{
+		Id: "27 - Create notification table",
+		Up: []string{
+			`CREATE TABLE IF NOT EXISTS notification (
+				id            			uuid					PRIMARY KEY,
+				account_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				title					VARCHAR(255)			NOT NULL,
+				body					TEXT					NOT NULL,
+				data					JSONB					NOT NULL DEFAULT '{}',
+				push_attempts			INTEGER					NOT NULL DEFAULT 0,
+				push_lease_until		TIMESTAMPTZ				NULL,
+				pushed_at				TIMESTAMPTZ				NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE INDEX notification_pending_push_idx ON notification (created_at) WHERE pushed_at IS NULL;`,
+			`CREATE INDEX notification_account_id_idx ON notification (account_id);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS notification_account_id_idx;`,
+			`DROP INDEX IF EXISTS notification_pending_push_idx;`,
+			`DROP TABLE IF EXISTS notification;`,
+		},
+	},
+	{
+		Id: "28 - Create notification preference tables",
+		Up: []string{
+			`CREATE TYPE e_notification_event_type AS ENUM (
+				'notification_event_type_system',
+				'notification_event_type_vacancy_alert',
+				'notification_event_type_application_update',
+				'notification_event_type_company_update'
+			);`,
+			`CREATE TYPE e_notification_channel AS ENUM (
+				'notification_channel_email',
+				'notification_channel_push'
+			);`,
+			`CREATE TYPE e_digest_mode AS ENUM (
+				'digest_mode_off',
+				'digest_mode_daily',
+				'digest_mode_weekly'
+			);`,
+			`CREATE TABLE IF NOT EXISTS notification_preference (
+				account_id	  			uuid					PRIMARY KEY REFERENCES auth (account_id) ON DELETE CASCADE,
+				timezone				VARCHAR(64)				NOT NULL,
+				quiet_hours_start		SMALLINT				NULL,
+				quiet_hours_end			SMALLINT				NULL,
+				digest_mode				e_digest_mode			NOT NULL,
+				last_digest_at			TIMESTAMPTZ				NULL,
+				updated_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE TABLE IF NOT EXISTS notification_channel_setting (
+				account_id	  			uuid						NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				event_type				e_notification_event_type	NOT NULL,
+				channel					e_notification_channel		NOT NULL,
+				enabled					BOOLEAN						NOT NULL,
+				PRIMARY KEY (account_id, event_type, channel)
+			);`,
+			`ALTER TABLE notification ADD COLUMN deliver_after TIMESTAMPTZ NULL;`,
+			`CREATE TABLE IF NOT EXISTS notification_email (
+				id            			uuid						PRIMARY KEY,
+				account_id	  			uuid						NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				event_type				e_notification_event_type	NOT NULL,
+				subject					VARCHAR(255)				NOT NULL,
+				body					TEXT						NOT NULL,
+				digest					BOOLEAN						NOT NULL,
+				send_attempts			INTEGER						NOT NULL DEFAULT 0,
+				send_lease_until		TIMESTAMPTZ					NULL,
+				sent_at					TIMESTAMPTZ					NULL,
+				created_at       		TIMESTAMPTZ     			NOT NULL
+			);`,
+			`CREATE INDEX notification_email_pending_idx ON notification_email (account_id, created_at)
+				WHERE sent_at IS NULL;`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS notification_email_pending_idx;`,
+			`DROP TABLE IF EXISTS notification_email;`,
+			`ALTER TABLE notification DROP COLUMN IF EXISTS deliver_after;`,
+			`DROP TABLE IF EXISTS notification_channel_setting;`,
+			`DROP TABLE IF EXISTS notification_preference;`,
+			`DROP TYPE IF EXISTS e_digest_mode;`,
+			`DROP TYPE IF EXISTS e_notification_channel;`,
+			`DROP TYPE IF EXISTS e_notification_event_type;`,
+		},
+	},
+	{
+		Id: "29 - Create vacancy alert tables",
+		Up: []string{
+			`CREATE TABLE IF NOT EXISTS vacancy_alert (
+				id            			uuid					PRIMARY KEY,
+				account_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				category_ids			uuid[]					NOT NULL,
+				city_ids				uuid[]					NOT NULL,
+				min_salary	  			INTEGER					NULL,
+				max_salary	  			INTEGER					NULL,
+				type					e_vacancy_type			NULL,
+				keywords				VARCHAR(255)			NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE INDEX vacancy_alert_account_id_idx ON vacancy_alert (account_id);`,
+			`CREATE TABLE IF NOT EXISTS vacancy_alert_match (
+				alert_id				uuid            REFERENCES vacancy_alert (id) ON DELETE CASCADE,
+				vacancy_id           	uuid            REFERENCES vacancy (id) ON DELETE CASCADE,
+				created_at       		TIMESTAMPTZ     NOT NULL,
+				CONSTRAINT vacancy_alert_match_pkey PRIMARY KEY (alert_id, vacancy_id)
+			);`,
+			`CREATE INDEX vacancy_alert_match_vacancy_id_idx ON vacancy_alert_match (vacancy_id);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_alert_match_vacancy_id_idx;`,
+			`DROP TABLE IF EXISTS vacancy_alert_match;`,
+			`DROP INDEX IF EXISTS vacancy_alert_account_id_idx;`,
+			`DROP TABLE IF EXISTS vacancy_alert;`,
+		},
+	},
+	{
+		Id: "30 - Create event outbox table",
+		Up: []string{
+			`CREATE TABLE IF NOT EXISTS event_outbox (
+				id            			uuid					PRIMARY KEY,
+				subject					VARCHAR(255)			NOT NULL,
+				payload					BYTEA					NOT NULL,
+				publish_lease_until		TIMESTAMPTZ				NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				position				BIGSERIAL				NOT NULL
+			);`,
+			`CREATE INDEX event_outbox_position_idx ON event_outbox (position);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS event_outbox_position_idx;`,
+			`DROP TABLE IF EXISTS event_outbox;`,
+		},
+	},
+	{
+		// The search vector is maintained by triggers because generated columns can't reference the company
+		// title. Stemming is configured by the vacancy_search text search configuration: it starts as a copy
+		// of the russian one and may be altered to use other dictionaries, e.g. a ukrainian hunspell one,
+		// followed by "UPDATE vacancy SET search_vector = vacancy_search_vector(id)" to reindex.
+		Id: "31 - Create vacancy search vector",
+		Up: []string{
+			`CREATE TEXT SEARCH CONFIGURATION vacancy_search (COPY = pg_catalog.russian);`,
+			`ALTER TABLE vacancy ADD COLUMN search_vector TSVECTOR NOT NULL DEFAULT '';`,
+			`CREATE FUNCTION vacancy_search_vector(vacancy_title TEXT, vacancy_description TEXT, company_title TEXT)
+				RETURNS TSVECTOR AS $$
+					SELECT
+						setweight(to_tsvector('vacancy_search', coalesce(vacancy_title, '')), 'A') ||
+						setweight(to_tsvector('vacancy_search', coalesce(company_title, '')), 'B') ||
+						setweight(to_tsvector('vacancy_search', coalesce(vacancy_description, '')), 'C')
+				$$ LANGUAGE SQL STABLE;`,
+			`CREATE FUNCTION vacancy_search_vector(vacancy_id uuid) RETURNS TSVECTOR AS $$
+					SELECT vacancy_search_vector(v.title, v.description, c.title)
+					FROM vacancy AS v
+					LEFT JOIN company AS c ON c.auth_id = v.company_id
+					WHERE v.id = vacancy_id
+				$$ LANGUAGE SQL STABLE;`,
+			`CREATE FUNCTION vacancy_search_vector_trigger() RETURNS TRIGGER AS $$
+				BEGIN
+					NEW.search_vector := vacancy_search_vector(
+						NEW.title,
+						NEW.description,
+						(SELECT title FROM company WHERE auth_id = NEW.company_id)
+					);
+					RETURN NEW;
+				END
+				$$ LANGUAGE plpgsql;`,
+			`CREATE TRIGGER vacancy_search_vector_update
+				BEFORE INSERT OR UPDATE OF title, description, company_id ON vacancy
+				FOR EACH ROW EXECUTE PROCEDURE vacancy_search_vector_trigger();`,
+			`CREATE FUNCTION company_vacancies_search_vector_trigger() RETURNS TRIGGER AS $$
+				BEGIN
+					UPDATE vacancy SET
+						search_vector = vacancy_search_vector(title, description, NEW.title)
+					WHERE company_id = NEW.auth_id;
+					RETURN NEW;
+				END
+				$$ LANGUAGE plpgsql;`,
+			`CREATE TRIGGER company_vacancies_search_vector_update
+				AFTER UPDATE OF title ON company
+				FOR EACH ROW WHEN (OLD.title IS DISTINCT FROM NEW.title)
+				EXECUTE PROCEDURE company_vacancies_search_vector_trigger();`,
+			`UPDATE vacancy SET search_vector = vacancy_search_vector(id);`,
+			`CREATE INDEX vacancy_search_vector_idx ON vacancy USING GIN (search_vector);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_search_vector_idx;`,
+			`DROP TRIGGER IF EXISTS company_vacancies_search_vector_update ON company;`,
+			`DROP FUNCTION IF EXISTS company_vacancies_search_vector_trigger();`,
+			`DROP TRIGGER IF EXISTS vacancy_search_vector_update ON vacancy;`,
+			`DROP FUNCTION IF EXISTS vacancy_search_vector_trigger();`,
+			`DROP FUNCTION IF EXISTS vacancy_search_vector(uuid);`,
+			`DROP FUNCTION IF EXISTS vacancy_search_vector(TEXT, TEXT, TEXT);`,
+			`ALTER TABLE vacancy DROP COLUMN IF EXISTS search_vector;`,
+			`DROP TEXT SEARCH CONFIGURATION IF EXISTS vacancy_search;`,
+		},
+	},
+	{
+		Id: "32 - Create vacancy location index",
+		Up: []string{
+			`CREATE INDEX vacancy_location_idx ON vacancy USING GIST (location);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_location_idx;`,
+		},
+	},
+	{
+		Id: "33 - Add vacancy status",
+		Up: []string{
+			`CREATE TYPE e_vacancy_status AS ENUM (
+				'vacancy_status_draft',
+				'vacancy_status_published',
+				'vacancy_status_paused',
+				'vacancy_status_closed'
+			);`,
+			// Vacancies saved before were live, so they stay published
+			`ALTER TABLE vacancy
+				ADD COLUMN status e_vacancy_status NOT NULL DEFAULT 'vacancy_status_published',
+				ADD COLUMN published_at TIMESTAMPTZ,
+				ADD COLUMN expires_at TIMESTAMPTZ;`,
+			`UPDATE vacancy SET published_at = created_at, expires_at = now() + interval '30 days';`,
+			`ALTER TABLE vacancy ALTER COLUMN status SET DEFAULT 'vacancy_status_draft';`,
+			`CREATE INDEX vacancy_status_expires_at_idx ON vacancy (status, expires_at);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_status_expires_at_idx;`,
+			`ALTER TABLE vacancy
+				DROP COLUMN IF EXISTS status,
+				DROP COLUMN IF EXISTS published_at,
+				DROP COLUMN IF EXISTS expires_at;`,
+			`DROP TYPE IF EXISTS e_vacancy_status;`,
+		},
+	},
+	{
+		Id: "34 - Create application table",
+		Up: []string{
+			`CREATE TYPE e_application_status AS ENUM (
+				'application_status_submitted',
+				'application_status_withdrawn'
+			);`,
+			// cv_id is kept only as a reference, the company reviews the snapshot taken at apply time
+			`CREATE TABLE IF NOT EXISTS application (
+				id            			uuid					PRIMARY KEY,
+				vacancy_id	  			uuid					NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				persona_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				cv_id	  				uuid					NULL REFERENCES cv (id) ON DELETE SET NULL,
+				cv_snapshot				JSONB					NOT NULL,
+				message					TEXT					NOT NULL,
+				status					e_application_status	NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				updated_at       		TIMESTAMPTZ     		NOT NULL,
+				withdrawn_at       		TIMESTAMPTZ     		NULL
+			);`,
+			`CREATE UNIQUE INDEX application_vacancy_id_persona_id_idx ON application (vacancy_id, persona_id);`,
+			`CREATE INDEX application_persona_id_created_at_idx ON application (persona_id, created_at);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS application_persona_id_created_at_idx;`,
+			`DROP INDEX IF EXISTS application_vacancy_id_persona_id_idx;`,
+			`DROP TABLE IF EXISTS application;`,
+			`DROP TYPE IF EXISTS e_application_status;`,
+		},
+	},
+	{
+		Id: "35 - Create application pipeline",
+		Up: []string{
+			`CREATE TYPE e_pipeline_stage_kind AS ENUM (
+				'pipeline_stage_kind_new',
+				'pipeline_stage_kind_screening',
+				'pipeline_stage_kind_interview',
+				'pipeline_stage_kind_offer',
+				'pipeline_stage_kind_hired',
+				'pipeline_stage_kind_rejected'
+			);`,
+			`CREATE TABLE IF NOT EXISTS pipeline_stage (
+				id            			uuid					PRIMARY KEY,
+				company_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				name					VARCHAR(255)			NOT NULL,
+				kind					e_pipeline_stage_kind	NOT NULL,
+				position				INTEGER					NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				updated_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE UNIQUE INDEX pipeline_stage_company_id_name_idx ON pipeline_stage (company_id, name);`,
+			// Companies which already have applications get the default stages, their applications start at new
+			`INSERT INTO pipeline_stage (id, company_id, name, kind, position, created_at, updated_at)
+				SELECT md5(c.company_id::text || s.kind)::uuid, c.company_id, s.name, s.kind::e_pipeline_stage_kind,
+					s.position, now(), now()
+				FROM (
+					SELECT DISTINCT v.company_id
+					FROM application AS a
+					JOIN vacancy AS v ON v.id = a.vacancy_id
+				) AS c
+				CROSS JOIN (VALUES
+					('New', 'pipeline_stage_kind_new', 1),
+					('Screening', 'pipeline_stage_kind_screening', 2),
+					('Interview', 'pipeline_stage_kind_interview', 3),
+					('Offer', 'pipeline_stage_kind_offer', 4),
+					('Hired', 'pipeline_stage_kind_hired', 5),
+					('Rejected', 'pipeline_stage_kind_rejected', 6)
+				) AS s (name, kind, position);`,
+			`ALTER TABLE application ADD COLUMN stage_id uuid NULL REFERENCES pipeline_stage (id);`,
+			`UPDATE application AS a SET stage_id = ps.id
+				FROM vacancy AS v, pipeline_stage AS ps
+				WHERE v.id = a.vacancy_id AND ps.company_id = v.company_id AND ps.kind = 'pipeline_stage_kind_new';`,
+			`ALTER TABLE application ALTER COLUMN stage_id SET NOT NULL;`,
+			`CREATE INDEX application_vacancy_id_stage_id_idx ON application (vacancy_id, stage_id);`,
+			// Stage names are copied, so the history stays readable once a stage is renamed or deleted
+			`CREATE TABLE IF NOT EXISTS application_stage_history (
+				id            			uuid					PRIMARY KEY,
+				application_id			uuid					NOT NULL REFERENCES application (id) ON DELETE CASCADE,
+				from_stage_id			uuid					NULL REFERENCES pipeline_stage (id) ON DELETE SET NULL,
+				from_stage_name			VARCHAR(255)			NULL,
+				to_stage_id				uuid					NULL REFERENCES pipeline_stage (id) ON DELETE SET NULL,
+				to_stage_name			VARCHAR(255)			NOT NULL,
+				rejection_reason		TEXT					NULL,
+				moved_by				uuid					NULL REFERENCES auth (account_id) ON DELETE SET NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE INDEX application_stage_history_application_id_idx
+				ON application_stage_history (application_id, created_at);`,
+			`INSERT INTO application_stage_history (id, application_id, to_stage_id, to_stage_name, created_at)
+				SELECT md5(a.id::text || 'history')::uuid, a.id, ps.id, ps.name, a.created_at
+				FROM application AS a
+				JOIN pipeline_stage AS ps ON ps.id = a.stage_id;`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS application_stage_history_application_id_idx;`,
+			`DROP TABLE IF EXISTS application_stage_history;`,
+			`DROP INDEX IF EXISTS application_vacancy_id_stage_id_idx;`,
+			`ALTER TABLE application DROP COLUMN IF EXISTS stage_id;`,
+			`DROP INDEX IF EXISTS pipeline_stage_company_id_name_idx;`,
+			`DROP TABLE IF EXISTS pipeline_stage;`,
+			`DROP TYPE IF EXISTS e_pipeline_stage_kind;`,
+		},
+	},
+	{
+		Id: "36 - Create saved vacancy and company follow tables",
+		Up: []string{
+			`CREATE TABLE IF NOT EXISTS saved_vacancy (
+				account_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				vacancy_id           	uuid            		NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				CONSTRAINT saved_vacancy_pkey PRIMARY KEY (account_id, vacancy_id)
+			);`,
+			`CREATE INDEX saved_vacancy_account_id_created_at_idx ON saved_vacancy (account_id, created_at);`,
+			`CREATE TABLE IF NOT EXISTS company_follow (
+				account_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				company_id	  			uuid					NOT NULL REFERENCES auth (account_id) ON DELETE CASCADE,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				CONSTRAINT company_follow_pkey PRIMARY KEY (account_id, company_id)
+			);`,
+			`CREATE INDEX company_follow_company_id_idx ON company_follow (company_id);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS company_follow_company_id_idx;`,
+			`DROP TABLE IF EXISTS company_follow;`,
+			`DROP INDEX IF EXISTS saved_vacancy_account_id_created_at_idx;`,
+			`DROP TABLE IF EXISTS saved_vacancy;`,
+		},
+	},
+	{
+		Id: "37 - Create vacancy stats tables",
+		Up: []string{
+			`CREATE TYPE e_vacancy_event_type AS ENUM (
+				'vacancy_event_type_view',
+				'vacancy_event_type_impression',
+				'vacancy_event_type_save',
+				'vacancy_event_type_phone_reveal'
+			);`,
+			// Events are appended once per viewer a day, the key deduplicates them
+			`CREATE TABLE IF NOT EXISTS vacancy_event (
+				vacancy_id	  			uuid					NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				type					e_vacancy_event_type	NOT NULL,
+				viewer_key				VARCHAR(255)			NOT NULL,
+				day						DATE					NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				CONSTRAINT vacancy_event_pkey PRIMARY KEY (vacancy_id, type, viewer_key, day)
+			);`,
+			`CREATE INDEX vacancy_event_created_at_idx ON vacancy_event (created_at);`,
+			`CREATE TABLE IF NOT EXISTS vacancy_stats_hourly (
+				vacancy_id	  			uuid					NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				type					e_vacancy_event_type	NOT NULL,
+				hour       				TIMESTAMPTZ     		NOT NULL,
+				count					INTEGER					NOT NULL,
+				CONSTRAINT vacancy_stats_hourly_pkey PRIMARY KEY (vacancy_id, type, hour)
+			);`,
+			// The single row tells up to when the events were rolled up into the hourly stats
+			`CREATE TABLE IF NOT EXISTS vacancy_stats_rollup (
+				id						BOOLEAN					PRIMARY KEY DEFAULT TRUE CHECK (id),
+				rolled_up_till       	TIMESTAMPTZ     		NOT NULL
+			);`,
+			`INSERT INTO vacancy_stats_rollup (rolled_up_till) VALUES ('epoch');`,
+		},
+		Down: []string{
+			`DROP TABLE IF EXISTS vacancy_stats_rollup;`,
+			`DROP TABLE IF EXISTS vacancy_stats_hourly;`,
+			`DROP INDEX IF EXISTS vacancy_event_created_at_idx;`,
+			`DROP TABLE IF EXISTS vacancy_event;`,
+			`DROP TYPE IF EXISTS e_vacancy_event_type;`,
+		},
+	},
+	{
+		Id: "38 - Add salary currencies and exchange rates",
+		Up: []string{
+			`CREATE TYPE e_salary_period AS ENUM (
+				'salary_period_hour',
+				'salary_period_month',
+				'salary_period_year'
+			);`,
+			`ALTER TABLE vacancy
+				ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UAH',
+				ADD COLUMN salary_period e_salary_period NOT NULL DEFAULT 'salary_period_month',
+				ADD COLUMN salary_gross BOOLEAN NULL;`,
+			`ALTER TABLE cv
+				ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UAH',
+				ADD COLUMN salary_period e_salary_period NOT NULL DEFAULT 'salary_period_month',
+				ADD COLUMN salary_gross BOOLEAN NULL;`,
+			`ALTER TABLE vacancy_alert ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'UAH';`,
+			// The rate is the price of a currency unit in the base currency, which has the rate of 1
+			`CREATE TABLE IF NOT EXISTS exchange_rate (
+				currency				CHAR(3)					PRIMARY KEY,
+				rate					NUMERIC					NOT NULL CHECK (rate > 0),
+				updated_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`INSERT INTO exchange_rate (currency, rate, updated_at) VALUES ('UAH', 1, now());`,
+			// Salaries are compared as monthly amounts in the base currency, NULL without the exchange rate
+			`CREATE FUNCTION normalized_salary(NUMERIC, CHAR(3), e_salary_period) RETURNS NUMERIC AS $$
+				SELECT $1 * rate * CASE $3
+						WHEN 'salary_period_hour' THEN 2080 / 12.0
+						WHEN 'salary_period_year' THEN 1 / 12.0
+						ELSE 1
+					END
+				FROM exchange_rate
+				WHERE currency = $2
+			$$ LANGUAGE SQL STABLE;`,
+		},
+		Down: []string{
+			`DROP FUNCTION IF EXISTS normalized_salary(NUMERIC, CHAR(3), e_salary_period);`,
+			`DROP TABLE IF EXISTS exchange_rate;`,
+			`ALTER TABLE vacancy_alert DROP COLUMN IF EXISTS currency;`,
+			`ALTER TABLE cv
+				DROP COLUMN IF EXISTS salary_gross,
+				DROP COLUMN IF EXISTS salary_period,
+				DROP COLUMN IF EXISTS currency;`,
+			`ALTER TABLE vacancy
+				DROP COLUMN IF EXISTS salary_gross,
+				DROP COLUMN IF EXISTS salary_period,
+				DROP COLUMN IF EXISTS currency;`,
+			`DROP TYPE IF EXISTS e_salary_period;`,
+		},
+	},
+	{
+		Id: "39 - Add vacancy moderation",
+		Up: []string{
+			// Enum values can't be added inside a transaction before PostgreSQL 12, so the type is replaced
+			`ALTER TYPE e_vacancy_status RENAME TO e_vacancy_status_old;`,
+			`CREATE TYPE e_vacancy_status AS ENUM (
+				'vacancy_status_draft',
+				'vacancy_status_published',
+				'vacancy_status_paused',
+				'vacancy_status_closed',
+				'vacancy_status_pending_review',
+				'vacancy_status_rejected'
+			);`,
+			`ALTER TABLE vacancy
+				ALTER COLUMN status DROP DEFAULT,
+				ALTER COLUMN status TYPE e_vacancy_status USING status::text::e_vacancy_status,
+				ALTER COLUMN status SET DEFAULT 'vacancy_status_draft';`,
+			`DROP TYPE e_vacancy_status_old;`,
+			`ALTER TABLE vacancy ADD COLUMN needs_review BOOLEAN NOT NULL DEFAULT FALSE;`,
+			`CREATE TYPE e_moderation_decision AS ENUM (
+				'moderation_decision_submitted',
+				'moderation_decision_approved',
+				'moderation_decision_rejected'
+			);`,
+			`CREATE TYPE e_moderation_reason AS ENUM (
+				'moderation_reason_banned_words',
+				'moderation_reason_invalid_phone',
+				'moderation_reason_missing_salary',
+				'moderation_reason_duplicate',
+				'moderation_reason_misleading',
+				'moderation_reason_discriminatory',
+				'moderation_reason_other'
+			);`,
+			// The decisions are kept for auditing after the vacancy is deleted. The moderator is NULL
+			// for the automatic checks.
+			`CREATE TABLE IF NOT EXISTS vacancy_moderation_decision (
+				id						uuid					PRIMARY KEY,
+				vacancy_id				uuid					NOT NULL,
+				moderator_id			uuid					NULL,
+				decision				e_moderation_decision	NOT NULL,
+				reasons					e_moderation_reason[]	NOT NULL,
+				comment					TEXT					NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL
+			);`,
+			`CREATE INDEX vacancy_moderation_decision_vacancy_id_idx
+				ON vacancy_moderation_decision (vacancy_id, created_at);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_moderation_decision_vacancy_id_idx;`,
+			`DROP TABLE IF EXISTS vacancy_moderation_decision;`,
+			`DROP TYPE IF EXISTS e_moderation_reason;`,
+			`DROP TYPE IF EXISTS e_moderation_decision;`,
+			`ALTER TABLE vacancy DROP COLUMN IF EXISTS needs_review;`,
+			`UPDATE vacancy SET status = 'vacancy_status_draft'
+				WHERE status IN ('vacancy_status_pending_review', 'vacancy_status_rejected');`,
+			`ALTER TYPE e_vacancy_status RENAME TO e_vacancy_status_new;`,
+			`CREATE TYPE e_vacancy_status AS ENUM (
+				'vacancy_status_draft',
+				'vacancy_status_published',
+				'vacancy_status_paused',
+				'vacancy_status_closed'
+			);`,
+			`ALTER TABLE vacancy
+				ALTER COLUMN status DROP DEFAULT,
+				ALTER COLUMN status TYPE e_vacancy_status USING status::text::e_vacancy_status,
+				ALTER COLUMN status SET DEFAULT 'vacancy_status_draft';`,
+			`DROP TYPE e_vacancy_status_new;`,
+		},
+	},
+	{
+		Id: "40 - Add vacancy duplicates",
+		Up: []string{
+			`CREATE EXTENSION IF NOT EXISTS pg_trgm;`,
+			// Vacancies are compared by the trigram similarity of the words of the title and the description
+			`CREATE FUNCTION vacancy_fingerprint(TEXT, TEXT) RETURNS TEXT AS $$
+				SELECT lower(trim(regexp_replace($1 || ' ' || $2, '[^[:alnum:]]+', ' ', 'g')))
+			$$ LANGUAGE SQL IMMUTABLE;`,
+			`ALTER TABLE vacancy
+				ADD COLUMN fingerprint TEXT NOT NULL DEFAULT '',
+				ADD COLUMN duplicate_of uuid NULL REFERENCES vacancy (id) ON DELETE SET NULL;`,
+			`UPDATE vacancy SET fingerprint = vacancy_fingerprint(title, description);`,
+			`CREATE INDEX vacancy_fingerprint_idx ON vacancy USING gin (fingerprint gin_trgm_ops);`,
+			`CREATE INDEX vacancy_duplicate_of_idx ON vacancy (duplicate_of) WHERE duplicate_of IS NOT NULL;`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_duplicate_of_idx;`,
+			`DROP INDEX IF EXISTS vacancy_fingerprint_idx;`,
+			`ALTER TABLE vacancy
+				DROP COLUMN IF EXISTS duplicate_of,
+				DROP COLUMN IF EXISTS fingerprint;`,
+			`DROP FUNCTION IF EXISTS vacancy_fingerprint(TEXT, TEXT);`,
+			`DROP EXTENSION IF EXISTS pg_trgm;`,
+		},
+	},
+	{
+		Id: "41 - Create vacancy external id table",
+		Up: []string{
+			// Maps the ids of the vacancies imported from the job feeds of a company to our vacancies
+			`CREATE TABLE IF NOT EXISTS vacancy_external_id (
+				company_id				uuid					NOT NULL,
+				external_id				TEXT					NOT NULL,
+				vacancy_id				uuid					NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				PRIMARY KEY (company_id, external_id)
+			);`,
+		},
+		Down: []string{
+			`DROP TABLE IF EXISTS vacancy_external_id;`,
+		},
+	},
+	{
+		Id: "42 - Create vacancy revision table",
+		Up: []string{
+			// A revision is the snapshot of the vacancy content with its categories, cities and images.
+			// author_id is NULL for the changes made by the system, e.g. the imports from the command line
+			`CREATE TABLE IF NOT EXISTS vacancy_revision (
+				vacancy_id				uuid					NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				revision				INTEGER					NOT NULL,
+				author_id				uuid					NULL,
+				snapshot				JSONB					NOT NULL,
+				created_at       		TIMESTAMPTZ     		NOT NULL,
+				PRIMARY KEY (vacancy_id, revision)
+			);`,
+			`CREATE FUNCTION vacancy_revision_immutable() RETURNS TRIGGER AS $$
+			BEGIN
+				RAISE EXCEPTION 'vacancy revisions are immutable';
+			END;
+			$$ LANGUAGE plpgsql;`,
+			`CREATE TRIGGER vacancy_revision_immutable BEFORE UPDATE ON vacancy_revision
+				FOR EACH ROW EXECUTE PROCEDURE vacancy_revision_immutable();`,
+			// The vacancies put before get their current content as the first revision
+			`INSERT INTO vacancy_revision (vacancy_id, revision, author_id, snapshot, created_at)
+			SELECT v.id, 1, NULL, json_build_object(
+				'title', v.title,
+				'description', v.description,
+				'phone', v.phone,
+				'min_salary', v.min_salary,
+				'max_salary', v.max_salary,
+				'currency', v.currency,
+				'salary_period', v.salary_period,
+				'salary_gross', v.salary_gross,
+				'type', v.type,
+				'address', v.address,
+				'country_code', v.country_code,
+				'work_months_experience', v.work_months_experience,
+				'work_schedule', v.work_schedule,
+				'location_latitude', coalesce(ST_Y(v.location::geometry), 0),
+				'location_longitude', coalesce(ST_X(v.location::geometry), 0),
+				'categories_ids', coalesce((
+					SELECT array_agg(category_id ORDER BY category_id) FROM vacancies_categories WHERE vacancy_id = v.id
+				), '{}'),
+				'city_ids', coalesce((
+					SELECT array_agg(city_id ORDER BY city_id) FROM vacancy_cities WHERE vacancy_id = v.id
+				), '{}'),
+				'image_urls', coalesce((
+					SELECT array_agg(image_url ORDER BY position) FROM vacancies_images WHERE vacancy_id = v.id
+				), '{}')
+			), v.updated_at
+			FROM vacancy AS v;`,
+		},
+		Down: []string{
+			`DROP TRIGGER IF EXISTS vacancy_revision_immutable ON vacancy_revision;`,
+			`DROP FUNCTION IF EXISTS vacancy_revision_immutable();`,
+			`DROP TABLE IF EXISTS vacancy_revision;`,
+		},
+	},
+	{
+		Id: "43 - Add vacancy scheduled publishing and bumps",
+		Up: []string{
+			// listed_at orders the newest vacancies, a bump moves it to the bump time keeping created_at
+			`ALTER TABLE vacancy
+				ADD COLUMN scheduled_publish_at	TIMESTAMPTZ		NULL,
+				ADD COLUMN listed_at			TIMESTAMPTZ		NULL,
+				ADD COLUMN bumped_at			TIMESTAMPTZ		NULL,
+				ADD COLUMN bump_count			INTEGER			NOT NULL DEFAULT 0;`,
+			`UPDATE vacancy SET listed_at = created_at;`,
+			`ALTER TABLE vacancy ALTER COLUMN listed_at SET NOT NULL;`,
+			`CREATE INDEX vacancy_listed_at_position_idx ON vacancy (listed_at, position);`,
+			`CREATE INDEX vacancy_scheduled_publish_at_idx ON vacancy (scheduled_publish_at)
+				WHERE scheduled_publish_at IS NOT NULL;`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_scheduled_publish_at_idx;`,
+			`DROP INDEX IF EXISTS vacancy_listed_at_position_idx;`,
+			`ALTER TABLE vacancy
+				DROP COLUMN IF EXISTS scheduled_publish_at,
+				DROP COLUMN IF EXISTS listed_at,
+				DROP COLUMN IF EXISTS bumped_at,
+				DROP COLUMN IF EXISTS bump_count;`,
+		},
+	},
+	{
+		Id: "44 - Add vacancy category tree and localized titles",
+		Up: []string{
+			// path is the materialized path of the ids from the root, '/root/child/', a subtree is matched
+			// by the prefix of its root path. position orders the categories of the same parent.
+			`ALTER TABLE vacancy_category
+				ADD COLUMN parent_id	uuid		NULL REFERENCES vacancy_category (id),
+				ADD COLUMN path			TEXT		NULL,
+				ADD COLUMN position		INTEGER		NOT NULL DEFAULT 0;`,
+			`UPDATE vacancy_category AS vc SET path = '/' || vc.id || '/', position = o.position
+				FROM (SELECT id, row_number() OVER (ORDER BY title) - 1 AS position FROM vacancy_category) AS o
+				WHERE vc.id = o.id;`,
+			`ALTER TABLE vacancy_category ALTER COLUMN path SET NOT NULL;`,
+			`CREATE UNIQUE INDEX path_vacancy_category_idx ON vacancy_category (path text_pattern_ops);`,
+			`CREATE INDEX parent_id_position_vacancy_category_idx ON vacancy_category (parent_id, position);`,
+			`CREATE TABLE IF NOT EXISTS vacancy_category_title (
+				category_id		uuid			NOT NULL REFERENCES vacancy_category (id) ON DELETE CASCADE,
+				locale			VARCHAR(35)		NOT NULL,
+				title			VARCHAR(255)	NOT NULL,
+				PRIMARY KEY (category_id, locale)
+			);`,
+		},
+		Down: []string{
+			`DROP TABLE IF EXISTS vacancy_category_title;`,
+			`DROP INDEX IF EXISTS parent_id_position_vacancy_category_idx;`,
+			`DROP INDEX IF EXISTS path_vacancy_category_idx;`,
+			`ALTER TABLE vacancy_category
+				DROP COLUMN IF EXISTS parent_id,
+				DROP COLUMN IF EXISTS path,
+				DROP COLUMN IF EXISTS position;`,
+		},
+	},
+	{
+		Id: "45 - Add cv vacancy matches",
+		Up: []string{
+			// The matches are precomputed by the worker, every component score is from 0 to 1 and the score
+			// is their weighted sum
+			`CREATE TABLE IF NOT EXISTS cv_vacancy_match (
+				cv_id				uuid			NOT NULL REFERENCES cv (id) ON DELETE CASCADE,
+				vacancy_id			uuid			NOT NULL REFERENCES vacancy (id) ON DELETE CASCADE,
+				score				NUMERIC			NOT NULL,
+				job_kinds_score		NUMERIC			NOT NULL,
+				job_type_score		NUMERIC			NOT NULL,
+				experience_score	NUMERIC			NOT NULL,
+				salary_score		NUMERIC			NOT NULL,
+				position_score		NUMERIC			NOT NULL,
+				computed_at			TIMESTAMPTZ		NOT NULL,
+				PRIMARY KEY (cv_id, vacancy_id)
+			);`,
+			`CREATE INDEX cv_id_score_cv_vacancy_match_idx ON cv_vacancy_match (cv_id, score DESC);`,
+			`CREATE INDEX vacancy_id_score_cv_vacancy_match_idx ON cv_vacancy_match (vacancy_id, score DESC);`,
+		},
+		Down: []string{
+			`DROP INDEX IF EXISTS vacancy_id_score_cv_vacancy_match_idx;`,
+			`DROP INDEX IF EXISTS cv_id_score_cv_vacancy_match_idx;`,
+			`DROP TABLE IF EXISTS cv_vacancy_match;`,
+		},
+	},
 }
 
 func GetMigrations() []*migrate.Migration {
@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type Config struct {
+	LockKey int64
+}
+
+func (c *Config) Flags(name string) *pflag.FlagSet {
+	f := pflag.NewFlagSet(name, pflag.PanicOnError)
+
+	f.Int64Var(&c.LockKey, "scheduler_lock_key", 4711,
+		"Postgres advisory lock key the server instances elect the scheduler leader with")
+
+	return f
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"personaapp/pkg/periodic"
+)
+
+// Locker elects the leader among the instances, see postgresql.AdvisoryLock.
+type Locker interface {
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// Job is run every Interval, Fn returns the number of the items it has processed.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Fn       func(ctx context.Context) (int, error)
+}
+
+// Scheduler runs the jobs on the leader instance only. The leadership is checked before every run of a job,
+// another instance takes it over when the leader is gone.
+type Scheduler struct {
+	locker Locker
+	logger *zap.SugaredLogger
+	jobs   []*Job
+
+	mu sync.Mutex
+}
+
+func New(locker Locker, logger *zap.SugaredLogger, jobs []*Job) *Scheduler {
+	return &Scheduler{locker: locker, logger: logger, jobs: jobs}
+}
+
+// Run runs the jobs until ctx is done, then the leadership is given up.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	for _, j := range s.jobs {
+		j := j
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			periodic.Run(
+				ctx,
+				j.Interval,
+				func(ctx context.Context) error {
+					return s.runJob(ctx, j)
+				},
+				func(err error) {
+					s.logger.Errorw("scheduled job failed", "job", j.Name, "error", err)
+				},
+			)
+		}()
+	}
+
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.WithStack(s.locker.Unlock(context.Background()))
+}
+
+func (s *Scheduler) runJob(ctx context.Context, j *Job) error {
+	leader, err := s.isLeader(ctx)
+	if err != nil || !leader {
+		return errors.WithStack(err)
+	}
+
+	_, err = j.Fn(ctx)
+
+	return errors.WithStack(err)
+}
+
+func (s *Scheduler) isLeader(ctx context.Context) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	leader, err := s.locker.TryLock(ctx)
+
+	return leader, errors.WithStack(err)
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type lockerStub struct {
+	mu       sync.Mutex
+	leader   bool
+	tries    int
+	unlocked bool
+}
+
+func (l *lockerStub) TryLock(_ context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tries++
+
+	return l.leader, nil
+}
+
+func (l *lockerStub) Unlock(_ context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.unlocked = true
+
+	return nil
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Run("leader runs the jobs", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		locker := &lockerStub{leader: true}
+		runs := 0
+
+		s := New(locker, zap.NewNop().Sugar(), []*Job{{
+			Name:     "failing",
+			Interval: time.Millisecond,
+			Fn: func(ctx context.Context) (int, error) {
+				// A failed run doesn't stop the job
+				if runs++; runs == 3 {
+					cancel()
+				}
+
+				return 0, errors.New("failed")
+			},
+		}})
+
+		require.NoError(t, s.Run(ctx))
+		require.Equal(t, 3, runs)
+		require.Equal(t, 3, locker.tries)
+		require.True(t, locker.unlocked)
+	})
+
+	t.Run("follower doesn't run the jobs", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		locker := &lockerStub{}
+
+		s := New(locker, zap.NewNop().Sugar(), []*Job{{
+			Name:     "never run",
+			Interval: time.Millisecond,
+			Fn: func(ctx context.Context) (int, error) {
+				t.Error("the job is run by a follower")
+				return 0, nil
+			},
+		}})
+
+		require.NoError(t, s.Run(ctx))
+		require.NotZero(t, locker.tries)
+	})
+}
@@ -0,0 +1,620 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	applicationController "personaapp/internal/controllers/application/controller"
+	apiapplication "personaapp/pkg/grpcapi/application"
+)
+
+type ApplicationController interface {
+	ApplyToVacancy(
+		ctx context.Context,
+		personaID string,
+		vacancyID string,
+		cvID string,
+		message string,
+	) (applicationController.ApplicationID, error)
+	WithdrawApplication(ctx context.Context, personaID string, applicationID string) error
+	ListMyApplications(
+		ctx context.Context,
+		personaID string,
+		cursor *applicationController.Cursor,
+		limit int,
+	) ([]*applicationController.MyApplication, *applicationController.Cursor, error)
+	ListVacancyApplicants(
+		ctx context.Context,
+		companyID string,
+		vacancyID string,
+		cursor *applicationController.Cursor,
+		limit int,
+	) ([]*applicationController.Applicant, *applicationController.Cursor, error)
+	GetPipelineStages(ctx context.Context, companyID string) ([]*applicationController.PipelineStage, error)
+	UpdatePipelineStages(
+		ctx context.Context,
+		companyID string,
+		stages []*applicationController.PipelineStage,
+	) ([]*applicationController.PipelineStage, error)
+	MoveApplications(
+		ctx context.Context,
+		companyID string,
+		movedBy string,
+		move *applicationController.ApplicationsMove,
+	) error
+	GetPipeline(
+		ctx context.Context,
+		companyID string,
+		vacancyID string,
+		perStage int,
+	) ([]*applicationController.PipelineColumn, error)
+	GetApplicationHistory(
+		ctx context.Context,
+		companyID string,
+		applicationID string,
+	) ([]*applicationController.StageMove, error)
+}
+
+func (s *Server) ApplyToVacancy(
+	ctx context.Context,
+	req *apiapplication.ApplyToVacancyRequest,
+) (*apiapplication.ApplyToVacancyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	applicationID, err := s.ap.ApplyToVacancy(
+		ctx,
+		claims.AccountID,
+		req.GetVacancyId(),
+		req.GetCvId(),
+		req.GetMessage(),
+	)
+
+	var fv *errdetails.BadRequest_FieldViolation
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+		return &apiapplication.ApplyToVacancyResponse{Id: string(applicationID)}, nil
+	case applicationController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	case applicationController.ErrVacancyNotOpen:
+		return nil, status.Error(codes.FailedPrecondition, causeErr.Error())
+	case applicationController.ErrAlreadyApplied:
+		return nil, status.Error(codes.AlreadyExists, causeErr.Error())
+	case applicationController.ErrCVNotFound:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "CvId", Description: causeErr.Error()}
+	case applicationController.ErrInvalidMessage:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Message", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, fieldViolationStatus(fv).Err()
+}
+
+func (s *Server) WithdrawApplication(
+	ctx context.Context,
+	req *apiapplication.WithdrawApplicationRequest,
+) (*apiapplication.WithdrawApplicationResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	err = s.ap.WithdrawApplication(ctx, claims.AccountID, req.GetId())
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+		return &apiapplication.WithdrawApplicationResponse{}, nil
+	case applicationController.ErrApplicationNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (s *Server) ListMyApplications(
+	ctx context.Context,
+	req *apiapplication.ListMyApplicationsRequest,
+) (*apiapplication.ListMyApplicationsResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	mas, cursor, err := s.ap.ListMyApplications(
+		ctx,
+		claims.AccountID,
+		toControllerApplicationCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case applicationController.ErrInvalidCursor:
+		return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+			Field:       "Cursor",
+			Description: causeErr.Error(),
+		}).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applications := make([]*apiapplication.ListMyApplicationsResponse_MyApplication, len(mas))
+
+	for idx, ma := range mas {
+		application, err := toServerApplication(&ma.Application)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		applications[idx] = &apiapplication.ListMyApplicationsResponse_MyApplication{
+			Application:  application,
+			VacancyTitle: ma.VacancyTitle,
+			CompanyId:    ma.CompanyID,
+			CompanyTitle: ma.CompanyTitle,
+		}
+	}
+
+	return &apiapplication.ListMyApplicationsResponse{
+		Applications: applications,
+		Cursor:       toServerApplicationCursor(cursor),
+	}, nil
+}
+
+func (s *Server) ListVacancyApplicants(
+	ctx context.Context,
+	req *apiapplication.ListVacancyApplicantsRequest,
+) (*apiapplication.ListVacancyApplicantsResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	as, cursor, err := s.ap.ListVacancyApplicants(
+		ctx,
+		claims.AccountID,
+		req.GetVacancyId(),
+		toControllerApplicationCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case applicationController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	case applicationController.ErrInvalidCursor:
+		return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+			Field:       "Cursor",
+			Description: causeErr.Error(),
+		}).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	applicants, err := toServerApplicants(as)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &apiapplication.ListVacancyApplicantsResponse{
+		Applicants: applicants,
+		Cursor:     toServerApplicationCursor(cursor),
+	}, nil
+}
+
+func (s *Server) GetPipelineStages(
+	ctx context.Context,
+	_ *apiapplication.GetPipelineStagesRequest,
+) (*apiapplication.GetPipelineStagesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	stages, err := s.ap.GetPipelineStages(ctx, claims.AccountID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &apiapplication.GetPipelineStagesResponse{Stages: toServerPipelineStages(stages)}, nil
+}
+
+func (s *Server) UpdatePipelineStages(
+	ctx context.Context,
+	req *apiapplication.UpdatePipelineStagesRequest,
+) (*apiapplication.UpdatePipelineStagesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	stages := make([]*applicationController.PipelineStage, len(req.GetStages()))
+
+	for idx, ps := range req.GetStages() {
+		stages[idx] = &applicationController.PipelineStage{
+			ID:   ps.GetId(),
+			Name: ps.GetName(),
+			Kind: toControllerPipelineStageKind(ps.GetKind()),
+		}
+	}
+
+	stages, err = s.ap.UpdatePipelineStages(ctx, claims.AccountID, stages)
+
+	var fv *errdetails.BadRequest_FieldViolation
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+		return &apiapplication.UpdatePipelineStagesResponse{Stages: toServerPipelineStages(stages)}, nil
+	case applicationController.ErrPipelineStageNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	case applicationController.ErrPipelineStageNotEmpty:
+		return nil, status.Error(codes.FailedPrecondition, causeErr.Error())
+	case applicationController.ErrInvalidPipelineStages:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Stages", Description: err.Error()}
+	case applicationController.ErrInvalidPipelineStageName:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Name", Description: err.Error()}
+	case applicationController.ErrInvalidPipelineStageKind:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Kind", Description: err.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, fieldViolationStatus(fv).Err()
+}
+
+func (s *Server) MoveApplication(
+	ctx context.Context,
+	req *apiapplication.MoveApplicationRequest,
+) (*apiapplication.MoveApplicationResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	err = s.ap.MoveApplications(ctx, claims.AccountID, claims.AccountID, &applicationController.ApplicationsMove{
+		ApplicationIDs:  req.GetApplicationIds(),
+		StageID:         req.GetStageId(),
+		RejectionReason: req.GetRejectionReason(),
+		NotifyCandidate: req.GetNotifyCandidate(),
+		MessageTemplate: req.GetMessageTemplate(),
+	})
+
+	var fv *errdetails.BadRequest_FieldViolation
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+		return &apiapplication.MoveApplicationResponse{}, nil
+	case applicationController.ErrApplicationNotFound, applicationController.ErrPipelineStageNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	case applicationController.ErrInvalidApplicationsMove:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "ApplicationIds", Description: err.Error()}
+	case applicationController.ErrInvalidRejectionReason:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "RejectionReason", Description: err.Error()}
+	case applicationController.ErrInvalidMessageTemplate:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "MessageTemplate", Description: err.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, fieldViolationStatus(fv).Err()
+}
+
+func (s *Server) GetPipeline(
+	ctx context.Context,
+	req *apiapplication.GetPipelineRequest,
+) (*apiapplication.GetPipelineResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	pcs, err := s.ap.GetPipeline(ctx, claims.AccountID, req.GetVacancyId(), int(req.GetCount().GetValue()))
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case applicationController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	columns := make([]*apiapplication.GetPipelineResponse_Column, len(pcs))
+
+	for idx, pc := range pcs {
+		applicants, err := toServerApplicants(pc.Applicants)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		columns[idx] = &apiapplication.GetPipelineResponse_Column{
+			Stage:      toServerPipelineStage(&pc.Stage),
+			Count:      int32(pc.Count),
+			Applicants: applicants,
+		}
+	}
+
+	return &apiapplication.GetPipelineResponse{Columns: columns}, nil
+}
+
+func (s *Server) GetApplicationHistory(
+	ctx context.Context,
+	req *apiapplication.GetApplicationHistoryRequest,
+) (*apiapplication.GetApplicationHistoryResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	sms, err := s.ap.GetApplicationHistory(ctx, claims.AccountID, req.GetApplicationId())
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case applicationController.ErrApplicationNotFound:
+		return nil, status.Error(codes.NotFound, causeErr.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	moves := make([]*apiapplication.GetApplicationHistoryResponse_Move, len(sms))
+
+	for idx, sm := range sms {
+		createdAt, err := ptypes.TimestampProto(sm.CreatedAt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		moves[idx] = &apiapplication.GetApplicationHistoryResponse_Move{
+			FromStageId:     sm.FromStageID,
+			FromStageName:   sm.FromStageName,
+			ToStageId:       sm.ToStageID,
+			ToStageName:     sm.ToStageName,
+			RejectionReason: sm.RejectionReason,
+			MovedBy:         sm.MovedBy,
+			CreatedAt:       createdAt,
+		}
+	}
+
+	return &apiapplication.GetApplicationHistoryResponse{Moves: moves}, nil
+}
+
+func toControllerApplicationCursor(cursor *wrappers.StringValue) *applicationController.Cursor {
+	if cursor == nil {
+		return nil
+	}
+
+	c := applicationController.Cursor(cursor.Value)
+
+	return &c
+}
+
+func toServerApplicationCursor(cursor *applicationController.Cursor) *wrappers.StringValue {
+	if cursor == nil {
+		return nil
+	}
+
+	return &wrappers.StringValue{Value: string(*cursor)}
+}
+
+func toServerApplicants(as []*applicationController.Applicant) ([]*apiapplication.Applicant, error) {
+	applicants := make([]*apiapplication.Applicant, len(as))
+
+	for idx, a := range as {
+		application, err := toServerApplication(&a.Application)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		applicants[idx] = &apiapplication.Applicant{
+			Application:      application,
+			PersonaName:      a.PersonaName,
+			PersonaAvatarUrl: a.PersonaAvatarURL,
+			Stage:            toServerPipelineStage(&a.Stage),
+		}
+	}
+
+	return applicants, nil
+}
+
+func toServerApplication(a *applicationController.Application) (*apiapplication.Application, error) {
+	createdAt, err := ptypes.TimestampProto(a.CreatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	withdrawnAt, err := toServerOptionalTimestamp(a.WithdrawnAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	cv, err := toServerCVSnapshot(a.CV)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &apiapplication.Application{
+		Id:          a.ID,
+		VacancyId:   a.VacancyID,
+		PersonaId:   a.PersonaID,
+		CvId:        a.CVID,
+		Cv:          cv,
+		Message:     a.Message,
+		Status:      toServerApplicationStatus(a.Status),
+		CreatedAt:   createdAt,
+		WithdrawnAt: withdrawnAt,
+	}, nil
+}
+
+func toServerCVSnapshot(cv *applicationController.CVSnapshot) (*apiapplication.CVSnapshot, error) {
+	if cv == nil {
+		return nil, nil
+	}
+
+	updatedAt, err := ptypes.TimestampProto(cv.UpdatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	experiences := make([]*apiapplication.CVSnapshot_Experience, len(cv.Experiences))
+
+	for idx, e := range cv.Experiences {
+		dateFrom, err := toServerOptionalTimestamp(e.DateFrom)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		dateTill, err := toServerOptionalTimestamp(e.DateTill)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		experiences[idx] = &apiapplication.CVSnapshot_Experience{
+			CompanyName: e.CompanyName,
+			DateFrom:    dateFrom,
+			DateTill:    dateTill,
+			Position:    e.Position,
+			Description: e.Description,
+		}
+	}
+
+	educations := make([]*apiapplication.CVSnapshot_Education, len(cv.Educations))
+
+	for idx, e := range cv.Educations {
+		dateFrom, err := toServerOptionalTimestamp(e.DateFrom)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		dateTill, err := toServerOptionalTimestamp(e.DateTill)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		educations[idx] = &apiapplication.CVSnapshot_Education{
+			Institution: e.Institution,
+			DateFrom:    dateFrom,
+			DateTill:    dateTill,
+			Speciality:  e.Speciality,
+			Description: e.Description,
+		}
+	}
+
+	return &apiapplication.CVSnapshot{
+		Position:             cv.Position,
+		WorkMonthsExperience: cv.WorkMonthsExperience,
+		MinSalary:            cv.MinSalary,
+		MaxSalary:            cv.MaxSalary,
+		JobTypes:             cv.JobTypes,
+		JobKinds:             cv.JobKinds,
+		Experiences:          experiences,
+		Educations:           educations,
+		CustomSections:       cv.CustomSections,
+		UpdatedAt:            updatedAt,
+		Currency:             cv.Currency,
+		SalaryPeriod:         toServerSnapshotSalaryPeriod(cv.SalaryPeriod),
+		SalaryGross:          toServerOptionalBool(cv.SalaryGross),
+	}, nil
+}
+
+func toServerSnapshotSalaryPeriod(p string) apiapplication.SalaryPeriod {
+	switch p {
+	case "hour":
+		return apiapplication.SalaryPeriod_SALARY_PERIOD_HOUR
+	case "month":
+		return apiapplication.SalaryPeriod_SALARY_PERIOD_MONTH
+	case "year":
+		return apiapplication.SalaryPeriod_SALARY_PERIOD_YEAR
+	default:
+		return apiapplication.SalaryPeriod_SALARY_PERIOD_UNKNOWN
+	}
+}
+
+func toServerApplicationStatus(as applicationController.ApplicationStatus) apiapplication.ApplicationStatus {
+	switch as {
+	case applicationController.ApplicationStatusSubmitted:
+		return apiapplication.ApplicationStatus_APPLICATION_STATUS_SUBMITTED
+	case applicationController.ApplicationStatusWithdrawn:
+		return apiapplication.ApplicationStatus_APPLICATION_STATUS_WITHDRAWN
+	default:
+		return apiapplication.ApplicationStatus_APPLICATION_STATUS_UNKNOWN
+	}
+}
+
+func toServerPipelineStages(pss []*applicationController.PipelineStage) []*apiapplication.PipelineStage {
+	stages := make([]*apiapplication.PipelineStage, len(pss))
+
+	for idx, ps := range pss {
+		stages[idx] = toServerPipelineStage(ps)
+	}
+
+	return stages
+}
+
+func toServerPipelineStage(ps *applicationController.PipelineStage) *apiapplication.PipelineStage {
+	return &apiapplication.PipelineStage{
+		Id:   ps.ID,
+		Name: ps.Name,
+		Kind: toServerPipelineStageKind(ps.Kind),
+	}
+}
+
+func toServerPipelineStageKind(k applicationController.PipelineStageKind) apiapplication.PipelineStageKind {
+	switch k {
+	case applicationController.PipelineStageKindNew:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_NEW
+	case applicationController.PipelineStageKindScreening:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_SCREENING
+	case applicationController.PipelineStageKindInterview:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_INTERVIEW
+	case applicationController.PipelineStageKindOffer:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_OFFER
+	case applicationController.PipelineStageKindHired:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_HIRED
+	case applicationController.PipelineStageKindRejected:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_REJECTED
+	default:
+		return apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_UNKNOWN
+	}
+}
+
+func toControllerPipelineStageKind(k apiapplication.PipelineStageKind) applicationController.PipelineStageKind {
+	switch k {
+	case apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_NEW:
+		return applicationController.PipelineStageKindNew
+	case apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_SCREENING:
+		return applicationController.PipelineStageKindScreening
+	case apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_INTERVIEW:
+		return applicationController.PipelineStageKindInterview
+	case apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_OFFER:
+		return applicationController.PipelineStageKindOffer
+	case apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_HIRED:
+		return applicationController.PipelineStageKindHired
+	case apiapplication.PipelineStageKind_PIPELINE_STAGE_KIND_REJECTED:
+		return applicationController.PipelineStageKindRejected
+	default:
+		return ""
+	}
+}
+
+func toServerOptionalTimestamp(t *time.Time) (*timestamp.Timestamp, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	ts, err := ptypes.TimestampProto(*t)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ts, nil
+}
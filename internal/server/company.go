@@ -19,6 +19,9 @@ type CompanyController interface {
 	UpdateActivityFields(ctx context.Context, companyID string, activityFields []string) error
 	UpdateActivityField(ctx context.Context, activityFieldID *string, cd *companyController.ActivityField) error
 	DeleteCompanyActivityFieldsByCompanyID(ctx context.Context, authID string) error
+	FollowCompany(ctx context.Context, accountID string, companyID string) error
+	UnfollowCompany(ctx context.Context, accountID string, companyID string) error
+	ListFollowedCompanies(ctx context.Context, accountID string) ([]*companyController.Company, error)
 }
 
 // Company
@@ -172,3 +175,74 @@ func (s *Server) DeleteActivityFieldsByCompanyID(
 
 	return &apicompany.DeleteActivityFieldsByCompanyIDResponse{}, nil
 }
+
+// Company follows
+
+func (s *Server) FollowCompany(
+	ctx context.Context,
+	req *apicompany.FollowCompanyRequest,
+) (*apicompany.FollowCompanyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	switch err := s.cc.FollowCompany(ctx, claims.AccountID, req.GetCompanyId()); errors.Cause(err) {
+	case nil:
+	case companyController.ErrCompanyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case companyController.ErrFollowedCompaniesLimitExceeded:
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &apicompany.FollowCompanyResponse{}, nil
+}
+
+func (s *Server) UnfollowCompany(
+	ctx context.Context,
+	req *apicompany.UnfollowCompanyRequest,
+) (*apicompany.UnfollowCompanyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	switch err := s.cc.UnfollowCompany(ctx, claims.AccountID, req.GetCompanyId()); errors.Cause(err) {
+	case nil:
+	case companyController.ErrCompanyFollowNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &apicompany.UnfollowCompanyResponse{}, nil
+}
+
+func (s *Server) ListFollowedCompanies(
+	ctx context.Context,
+	_ *apicompany.ListFollowedCompaniesRequest,
+) (*apicompany.ListFollowedCompaniesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cs, err := s.cc.ListFollowedCompanies(ctx, claims.AccountID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	companies := make([]*apicompany.ListFollowedCompaniesResponse_Company, len(cs))
+	for idx, c := range cs {
+		companies[idx] = &apicompany.ListFollowedCompaniesResponse_Company{
+			Id:          c.ID,
+			Title:       c.Title,
+			Description: c.Description,
+			LogoUrl:     c.LogoURL,
+		}
+	}
+
+	return &apicompany.ListFollowedCompaniesResponse{Companies: companies}, nil
+}
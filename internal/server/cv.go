@@ -820,9 +820,18 @@ func (s *Server) UpdateCV(
 		WorkMonthsExperience: req.WorkMonthsExperience,
 		MinSalary:            req.MinSalary,
 		MaxSalary:            req.MaxSalary,
+		CVSalary: cvController.CVSalary{
+			Currency:     req.Currency,
+			SalaryPeriod: toControllerCVSalaryPeriod(req.SalaryPeriod),
+			SalaryGross:  getOptionalBool(req.SalaryGross),
+		},
 	})
 
-	if err != nil {
+	switch errors.Cause(err) {
+	case nil:
+	case cvController.ErrInvalidCVCurrency, cvController.ErrInvalidCVSalaryPeriod:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -852,6 +861,9 @@ func (s *Server) GetCV(
 			WorkMonthsExperience: vc.WorkMonthsExperience,
 			MinSalary:            vc.MinSalary,
 			MaxSalary:            vc.MaxSalary,
+			Currency:             vc.Currency,
+			SalaryPeriod:         toServerCVSalaryPeriod(vc.SalaryPeriod),
+			SalaryGross:          toServerOptionalBool(vc.SalaryGross),
 		},
 	}, nil
 }
@@ -877,6 +889,9 @@ func (s *Server) GetCVs(
 			WorkMonthsExperience: vc.WorkMonthsExperience,
 			MinSalary:            vc.MinSalary,
 			MaxSalary:            vc.MaxSalary,
+			Currency:             vc.Currency,
+			SalaryPeriod:         toServerCVSalaryPeriod(vc.SalaryPeriod),
+			SalaryGross:          toServerOptionalBool(vc.SalaryGross),
 		}
 	}
 	return &cvapi.GetCVsResponse{CvShort: cve}, nil
@@ -900,3 +915,31 @@ func (s *Server) DeleteCV(
 
 	return &cvapi.DeleteCVResponse{}, nil
 }
+
+func toControllerCVSalaryPeriod(p cvapi.SalaryPeriod) cvController.SalaryPeriod {
+	switch p {
+	case cvapi.SalaryPeriod_SALARY_PERIOD_UNKNOWN:
+		return ""
+	case cvapi.SalaryPeriod_SALARY_PERIOD_HOUR:
+		return cvController.SalaryPeriodHour
+	case cvapi.SalaryPeriod_SALARY_PERIOD_MONTH:
+		return cvController.SalaryPeriodMonth
+	case cvapi.SalaryPeriod_SALARY_PERIOD_YEAR:
+		return cvController.SalaryPeriodYear
+	default:
+		return cvController.SalaryPeriod(p.String())
+	}
+}
+
+func toServerCVSalaryPeriod(p cvController.SalaryPeriod) cvapi.SalaryPeriod {
+	switch p {
+	case cvController.SalaryPeriodHour:
+		return cvapi.SalaryPeriod_SALARY_PERIOD_HOUR
+	case cvController.SalaryPeriodMonth:
+		return cvapi.SalaryPeriod_SALARY_PERIOD_MONTH
+	case cvController.SalaryPeriodYear:
+		return cvapi.SalaryPeriod_SALARY_PERIOD_YEAR
+	default:
+		return cvapi.SalaryPeriod_SALARY_PERIOD_UNKNOWN
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cvController "personaapp/internal/controllers/cv/controller"
+	matchingController "personaapp/internal/controllers/matching/controller"
+	vacancyapi "personaapp/pkg/grpcapi/vacancy"
+)
+
+type MatchingController interface {
+	GetRecommendedVacancies(ctx context.Context, cvID string, limit int) ([]*matchingController.Match, error)
+	GetRecommendedCandidates(ctx context.Context, vacancyID string, limit int) ([]*matchingController.Candidate, error)
+}
+
+func (s *Server) GetRecommendedVacancies(
+	ctx context.Context,
+	req *vacancyapi.GetRecommendedVacanciesRequest,
+) (*vacancyapi.GetRecommendedVacanciesResponse, error) {
+	if err := s.authorizeCVPersona(ctx, req.CvId); err != nil {
+		return nil, err
+	}
+
+	matches, err := s.mc.GetRecommendedVacancies(ctx, req.CvId, int(req.GetCount().GetValue()))
+
+	switch errors.Cause(err) {
+	case nil:
+	case matchingController.ErrCVNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	vacanciesIDs := make([]string, len(matches))
+	scores := make(map[string]*vacancyapi.MatchScore, len(matches))
+
+	for idx, m := range matches {
+		vacanciesIDs[idx] = m.VacancyID
+		scores[m.VacancyID] = toServerMatchScore(m.MatchScore)
+	}
+
+	// A vacancy may stop being live since its matches were read
+	vcs, err := s.vc.GetLiveVacanciesByIDs(ctx, vacanciesIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordVacancyImpressions(ctx, vcs)
+
+	vacancies, err := s.toServerVacanciesList(ctx, vcs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for id := range scores {
+		if _, ok := vacancies.Vacancies[id]; !ok {
+			delete(scores, id)
+		}
+	}
+
+	return &vacancyapi.GetRecommendedVacanciesResponse{Vacancies: vacancies, Scores: scores}, nil
+}
+
+func (s *Server) GetRecommendedCandidates(
+	ctx context.Context,
+	req *vacancyapi.GetRecommendedCandidatesRequest,
+) (*vacancyapi.GetRecommendedCandidatesResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.VacancyId); err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.mc.GetRecommendedCandidates(ctx, req.VacancyId, int(req.GetCount().GetValue()))
+
+	switch errors.Cause(err) {
+	case nil:
+	case matchingController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vacancyapi.GetRecommendedCandidatesResponse{
+		Candidates: make([]*vacancyapi.GetRecommendedCandidatesResponse_Candidate, len(candidates)),
+	}
+
+	for idx, c := range candidates {
+		resp.Candidates[idx] = &vacancyapi.GetRecommendedCandidatesResponse_Candidate{
+			CvId:                 c.CVID,
+			PersonaId:            c.PersonaID,
+			Position:             c.Position,
+			WorkMonthsExperience: c.WorkMonthsExperience,
+			Score:                toServerMatchScore(c.MatchScore),
+		}
+	}
+
+	return resp, nil
+}
+
+// authorizeCVPersona lets the persona owning the cv or an admin through.
+func (s *Server) authorizeCVPersona(ctx context.Context, cvID string) error {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	cv, err := s.cv.GetCV(ctx, cvID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case cvController.ErrCVNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if s.isAdminAccountType(claims) || (s.isPersonaAccountType(claims) && cv.PersonaID == claims.AccountID) {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "wrong account")
+}
+
+func toServerMatchScore(ms matchingController.MatchScore) *vacancyapi.MatchScore {
+	return &vacancyapi.MatchScore{
+		Score:      ms.Score,
+		JobKinds:   ms.JobKinds,
+		JobType:    ms.JobType,
+		Experience: ms.Experience,
+		Salary:     ms.Salary,
+		Position:   ms.Position,
+	}
+}
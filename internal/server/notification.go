@@ -0,0 +1,303 @@
+package server
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notificationController "personaapp/internal/controllers/notification/controller"
+	apinotification "personaapp/pkg/grpcapi/notification"
+)
+
+type NotificationController interface {
+	RegisterDevice(
+		ctx context.Context,
+		accountID string,
+		device *notificationController.Device,
+	) (notificationController.DeviceID, error)
+	UnregisterDevice(ctx context.Context, accountID string, pushToken string) error
+	GetPreferences(ctx context.Context, accountID string) (*notificationController.Preferences, error)
+	UpdatePreferences(ctx context.Context, accountID string, p *notificationController.Preferences) error
+}
+
+func toControllerPlatform(p apinotification.DevicePlatform) (notificationController.Platform, error) {
+	switch p {
+	case apinotification.DevicePlatform_DEVICE_PLATFORM_ANDROID:
+		return notificationController.PlatformAndroid, nil
+	case apinotification.DevicePlatform_DEVICE_PLATFORM_IOS:
+		return notificationController.PlatformIOS, nil
+	default:
+		return "", errors.New("invalid device platform")
+	}
+}
+
+func (s *Server) RegisterDevice(
+	ctx context.Context,
+	req *apinotification.RegisterDeviceRequest,
+) (*apinotification.RegisterDeviceResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	platform, err := toControllerPlatform(req.GetPlatform())
+	if err != nil {
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Platform", Description: err.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	deviceID, err := s.nc.RegisterDevice(ctx, claims.AccountID, &notificationController.Device{
+		Platform:  platform,
+		PushToken: req.GetPushToken(),
+	})
+
+	var fv *errdetails.BadRequest_FieldViolation
+
+	// The validation sentinels are wrapped by the controller more than once
+	switch causeErr := errors.UnwrapAll(err); causeErr {
+	case nil:
+	case notificationController.ErrInvalidDevicePlatform:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Platform", Description: causeErr.Error()}
+	case notificationController.ErrInvalidPushToken:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "PushToken", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	return &apinotification.RegisterDeviceResponse{Id: string(deviceID)}, nil
+}
+
+func (s *Server) UnregisterDevice(
+	ctx context.Context,
+	req *apinotification.UnregisterDeviceRequest,
+) (*apinotification.UnregisterDeviceResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	switch err := s.nc.UnregisterDevice(ctx, claims.AccountID, req.GetPushToken()); errors.Cause(err) {
+	case nil:
+	case notificationController.ErrDeviceNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &apinotification.UnregisterDeviceResponse{}, nil
+}
+
+func toControllerEventType(et apinotification.NotificationEventType) (notificationController.EventType, error) {
+	switch et {
+	case apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_SYSTEM:
+		return notificationController.EventTypeSystem, nil
+	case apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_VACANCY_ALERT:
+		return notificationController.EventTypeVacancyAlert, nil
+	case apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_APPLICATION_UPDATE:
+		return notificationController.EventTypeApplicationUpdate, nil
+	case apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_COMPANY_UPDATE:
+		return notificationController.EventTypeCompanyUpdate, nil
+	default:
+		return "", errors.New("invalid notification event type")
+	}
+}
+
+func toServerEventType(et notificationController.EventType) apinotification.NotificationEventType {
+	switch et {
+	case notificationController.EventTypeSystem:
+		return apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_SYSTEM
+	case notificationController.EventTypeVacancyAlert:
+		return apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_VACANCY_ALERT
+	case notificationController.EventTypeApplicationUpdate:
+		return apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_APPLICATION_UPDATE
+	case notificationController.EventTypeCompanyUpdate:
+		return apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_COMPANY_UPDATE
+	default:
+		return apinotification.NotificationEventType_NOTIFICATION_EVENT_TYPE_UNKNOWN
+	}
+}
+
+func toControllerChannel(ch apinotification.NotificationChannel) (notificationController.Channel, error) {
+	switch ch {
+	case apinotification.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL:
+		return notificationController.ChannelEmail, nil
+	case apinotification.NotificationChannel_NOTIFICATION_CHANNEL_PUSH:
+		return notificationController.ChannelPush, nil
+	default:
+		return "", errors.New("invalid notification channel")
+	}
+}
+
+func toServerChannel(ch notificationController.Channel) apinotification.NotificationChannel {
+	switch ch {
+	case notificationController.ChannelEmail:
+		return apinotification.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	case notificationController.ChannelPush:
+		return apinotification.NotificationChannel_NOTIFICATION_CHANNEL_PUSH
+	default:
+		return apinotification.NotificationChannel_NOTIFICATION_CHANNEL_UNKNOWN
+	}
+}
+
+func toControllerDigestMode(dm apinotification.DigestMode) (notificationController.DigestMode, error) {
+	switch dm {
+	case apinotification.DigestMode_DIGEST_MODE_OFF:
+		return notificationController.DigestModeOff, nil
+	case apinotification.DigestMode_DIGEST_MODE_DAILY:
+		return notificationController.DigestModeDaily, nil
+	case apinotification.DigestMode_DIGEST_MODE_WEEKLY:
+		return notificationController.DigestModeWeekly, nil
+	default:
+		return "", errors.New("invalid digest mode")
+	}
+}
+
+func toServerDigestMode(dm notificationController.DigestMode) apinotification.DigestMode {
+	switch dm {
+	case notificationController.DigestModeOff:
+		return apinotification.DigestMode_DIGEST_MODE_OFF
+	case notificationController.DigestModeDaily:
+		return apinotification.DigestMode_DIGEST_MODE_DAILY
+	case notificationController.DigestModeWeekly:
+		return apinotification.DigestMode_DIGEST_MODE_WEEKLY
+	default:
+		return apinotification.DigestMode_DIGEST_MODE_UNKNOWN
+	}
+}
+
+func toControllerPreferences(
+	p *apinotification.NotificationPreferences,
+) (*notificationController.Preferences, *errdetails.BadRequest_FieldViolation) {
+	if p == nil {
+		return nil, &errdetails.BadRequest_FieldViolation{Field: "Preferences", Description: "empty preferences"}
+	}
+
+	digestMode, err := toControllerDigestMode(p.GetDigestMode())
+	if err != nil {
+		return nil, &errdetails.BadRequest_FieldViolation{Field: "DigestMode", Description: err.Error()}
+	}
+
+	prefs := &notificationController.Preferences{
+		Toggles:    make([]*notificationController.ChannelToggle, len(p.GetToggles())),
+		Timezone:   p.GetTimezone(),
+		DigestMode: digestMode,
+	}
+
+	for idx, t := range p.GetToggles() {
+		eventType, err := toControllerEventType(t.GetEventType())
+		if err != nil {
+			return nil, &errdetails.BadRequest_FieldViolation{Field: "Toggles", Description: err.Error()}
+		}
+
+		channel, err := toControllerChannel(t.GetChannel())
+		if err != nil {
+			return nil, &errdetails.BadRequest_FieldViolation{Field: "Toggles", Description: err.Error()}
+		}
+
+		prefs.Toggles[idx] = &notificationController.ChannelToggle{
+			EventType: eventType,
+			Channel:   channel,
+			Enabled:   t.GetEnabled(),
+		}
+	}
+
+	if qh := p.GetQuietHours(); qh.GetEnabled() {
+		prefs.QuietHours = &notificationController.QuietHours{
+			StartMinute: qh.GetStartMinute(),
+			EndMinute:   qh.GetEndMinute(),
+		}
+	}
+
+	return prefs, nil
+}
+
+func toServerPreferences(p *notificationController.Preferences) *apinotification.NotificationPreferences {
+	prefs := &apinotification.NotificationPreferences{
+		Toggles:    make([]*apinotification.ChannelToggle, len(p.Toggles)),
+		Timezone:   p.Timezone,
+		QuietHours: &apinotification.QuietHours{},
+		DigestMode: toServerDigestMode(p.DigestMode),
+	}
+
+	for idx, t := range p.Toggles {
+		prefs.Toggles[idx] = &apinotification.ChannelToggle{
+			EventType: toServerEventType(t.EventType),
+			Channel:   toServerChannel(t.Channel),
+			Enabled:   t.Enabled,
+		}
+	}
+
+	if p.QuietHours != nil {
+		prefs.QuietHours = &apinotification.QuietHours{
+			Enabled:     true,
+			StartMinute: p.QuietHours.StartMinute,
+			EndMinute:   p.QuietHours.EndMinute,
+		}
+	}
+
+	return prefs
+}
+
+func (s *Server) GetNotificationPreferences(
+	ctx context.Context,
+	req *apinotification.GetNotificationPreferencesRequest,
+) (*apinotification.GetNotificationPreferencesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	prefs, err := s.nc.GetPreferences(ctx, claims.AccountID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &apinotification.GetNotificationPreferencesResponse{Preferences: toServerPreferences(prefs)}, nil
+}
+
+func (s *Server) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *apinotification.UpdateNotificationPreferencesRequest,
+) (*apinotification.UpdateNotificationPreferencesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	prefs, fv := toControllerPreferences(req.GetPreferences())
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	err = s.nc.UpdatePreferences(ctx, claims.AccountID, prefs)
+
+	// The validation sentinels are wrapped by the controller more than once
+	switch causeErr := errors.UnwrapAll(err); causeErr {
+	case nil:
+	case notificationController.ErrInvalidPreferences:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Preferences", Description: causeErr.Error()}
+	case notificationController.ErrInvalidChannelToggle:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Toggles", Description: causeErr.Error()}
+	case notificationController.ErrInvalidTimezone:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Timezone", Description: causeErr.Error()}
+	case notificationController.ErrInvalidQuietHours:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "QuietHours", Description: causeErr.Error()}
+	case notificationController.ErrInvalidDigestMode:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "DigestMode", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	return &apinotification.UpdateNotificationPreferencesResponse{}, nil
+}
@@ -2,14 +2,19 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"net"
 	authController "personaapp/internal/controllers/auth/controller"
 	apiauth "personaapp/pkg/grpcapi/auth"
+	"strings"
 )
 
 type Server struct {
@@ -18,10 +23,22 @@ type Server struct {
 	vc VacancyController
 	cy CityController
 	cv CVController
+	nc NotificationController
+	ap ApplicationController
+	mc MatchingController
 }
 
-func New(ac AuthController, cc CompanyController, vc VacancyController, cy CityController, cv CVController) *Server {
-	return &Server{ac: ac, cc: cc, vc: vc, cy: cy, cv: cv}
+func New(
+	ac AuthController,
+	cc CompanyController,
+	vc VacancyController,
+	cy CityController,
+	cv CVController,
+	nc NotificationController,
+	ap ApplicationController,
+	mc MatchingController,
+) *Server {
+	return &Server{ac: ac, cc: cc, vc: vc, cy: cy, cv: cv, nc: nc, ap: ap, mc: mc}
 }
 
 func (s *Server) getAuthClaims(ctx context.Context) (*authController.AuthClaims, error) {
@@ -42,6 +59,68 @@ func (s *Server) getAuthClaims(ctx context.Context) (*authController.AuthClaims,
 	}
 }
 
+// getViewerCompanyID returns the account of the company making the request, empty for anybody else
+// including anonymous requests.
+func (s *Server) getViewerCompanyID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("Bearer")) == 0 {
+		return ""
+	}
+
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return ""
+	}
+
+	return claims.AccountID
+}
+
+// getViewerPersonaID returns the account of the persona making the request, empty for anybody else
+// including anonymous requests.
+func (s *Server) getViewerPersonaID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("Bearer")) == 0 {
+		return ""
+	}
+
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return ""
+	}
+
+	return claims.AccountID
+}
+
+// getViewerKey identifies the viewer in the vacancy stats: the account making the request or a hash of the
+// client address for anonymous requests.
+func (s *Server) getViewerKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("Bearer")) > 0 {
+		if claims, err := s.getAuthClaims(ctx); err == nil {
+			return claims.AccountID
+		}
+	}
+
+	var addr string
+
+	if forwarded := md.Get("X-Forwarded-For"); len(forwarded) > 0 {
+		addr = strings.TrimSpace(strings.Split(forwarded[0], ",")[0])
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+	}
+
+	if addr == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(addr))
+
+	return "anon:" + hex.EncodeToString(sum[:])
+}
+
 func (s *Server) isCompanyAccountType(c *authController.AuthClaims) bool {
 	return toServerAccount(c.AccountType) == apiauth.AccountType_ACCOUNT_TYPE_COMPANY
 }
@@ -50,6 +129,10 @@ func (s *Server) isAdminAccountType(c *authController.AuthClaims) bool {
 	return toServerAccount(c.AccountType) == apiauth.AccountType_ACCOUNT_TYPE_ADMIN
 }
 
+func (s *Server) isPersonaAccountType(c *authController.AuthClaims) bool {
+	return toServerAccount(c.AccountType) == apiauth.AccountType_ACCOUNT_TYPE_PERSONA
+}
+
 func getOptionalString(sw *wrappers.StringValue) *string {
 	if sw == nil {
 		return nil
@@ -66,6 +149,30 @@ func getOptionalInt32(sw *wrappers.Int32Value) *int32 {
 	return &sw.Value
 }
 
+func getOptionalBool(bw *wrappers.BoolValue) *bool {
+	if bw == nil {
+		return nil
+	}
+
+	return &bw.Value
+}
+
+func toServerOptionalBool(b *bool) *wrappers.BoolValue {
+	if b == nil {
+		return nil
+	}
+
+	return &wrappers.BoolValue{Value: *b}
+}
+
+func toServerOptionalString(s *string) *wrappers.StringValue {
+	if s == nil {
+		return nil
+	}
+
+	return &wrappers.StringValue{Value: *s}
+}
+
 func fieldViolationStatus(fieldViolation *errdetails.BadRequest_FieldViolation) *status.Status {
 	st, err := status.New(codes.InvalidArgument, fieldViolation.Description).WithDetails(fieldViolation)
 	if err != nil {
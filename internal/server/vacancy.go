@@ -1,29 +1,47 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	companyController "personaapp/internal/controllers/company/controller"
 	vacancyController "personaapp/internal/controllers/vacancy/controller"
 	vacancyapi "personaapp/pkg/grpcapi/vacancy"
+	"sort"
+	"strings"
+	"time"
 )
 
 type VacancyController interface {
-	GetVacancyCategory(ctx context.Context, categoryID string) (*vacancyController.VacancyCategory, error)
-	GetVacanciesCategoriesList(ctx context.Context, rating *int32) ([]*vacancyController.VacancyCategory, error)
+	GetVacancyCategory(
+		ctx context.Context,
+		categoryID string,
+		locales []string,
+	) (*vacancyController.VacancyCategory, error)
+	GetVacanciesCategoriesList(
+		ctx context.Context,
+		rating *int32,
+		parentID *string,
+		locales []string,
+	) ([]*vacancyController.VacancyCategory, error)
 	PutVacancyCategory(
 		ctx context.Context,
 		categoryID *string,
 		category *vacancyController.VacancyCategory,
 	) (vacancyController.VacancyCategoryID, error)
 	DeleteVacancyCategory(ctx context.Context, categoryID string) error
+	MoveVacancyCategory(ctx context.Context, categoryID string, parentID *string, position int32) error
 
 	PutVacancy(
 		ctx context.Context,
+		authorID string,
 		vacancyID *string,
 		vacancy *vacancyController.VacancyDetails,
 		categories []string,
@@ -32,13 +50,124 @@ type VacancyController interface {
 	GetVacancyDetails(ctx context.Context, vacancyID string) (*vacancyController.VacancyDetails, error)
 	GetVacanciesList(
 		ctx context.Context,
-		categoriesIDs []string,
+		filter *vacancyController.VacancyListFilter,
+		sortOrder vacancyController.VacancySortOrder,
 		cursor *vacancyController.Cursor,
 		limit int,
 	) ([]*vacancyController.Vacancy, *vacancyController.Cursor, error)
+	GetSimilarVacancies(ctx context.Context, vacancyID string, limit int) ([]*vacancyController.Vacancy, error)
+	GetLiveVacanciesByIDs(ctx context.Context, vacancyIDs []string) ([]*vacancyController.Vacancy, error)
+	GetVacanciesMap(
+		ctx context.Context,
+		bbox *vacancyController.BoundingBox,
+		zoom int,
+		categoriesIDs []string,
+	) (*vacancyController.VacanciesMap, error)
+	GetVacancyFacets(
+		ctx context.Context,
+		filter *vacancyController.VacancyListFilter,
+	) (*vacancyController.VacancyFacets, error)
+	PublishVacancy(ctx context.Context, vacancyID string, expiresAt *time.Time) error
+	PauseVacancy(ctx context.Context, vacancyID string) error
+	CloseVacancy(ctx context.Context, vacancyID string) error
+	ListMyVacancies(
+		ctx context.Context,
+		companyID string,
+		statuses []vacancyController.VacancyStatus,
+		cursor *vacancyController.Cursor,
+		limit int,
+	) ([]*vacancyController.MyVacancy, *vacancyController.Cursor, error)
+	SearchVacancies(
+		ctx context.Context,
+		query string,
+		categoriesIDs []string,
+		cursor *vacancyController.Cursor,
+		limit int,
+	) ([]*vacancyController.VacancySearchResult, *vacancyController.Cursor, error)
 	GetVacanciesCategories(ctx context.Context, vacancyIDs []string) ([]*vacancyController.VacancyCategoryShort, error)
 	GetVacancyCities(ctx context.Context, vacancyIDs []string) ([]*vacancyController.VacancyCity, error)
 	DeleteVacancy(ctx context.Context, vacancyID string) error
+
+	CreateVacancyAlert(
+		ctx context.Context,
+		accountID string,
+		filter *vacancyController.VacancyFilter,
+	) (vacancyController.VacancyAlertID, error)
+	ListVacancyAlerts(ctx context.Context, accountID string) ([]*vacancyController.VacancyAlert, error)
+	DeleteVacancyAlert(ctx context.Context, accountID string, alertID string) error
+
+	SaveVacancy(ctx context.Context, accountID string, vacancyID string) error
+	UnsaveVacancy(ctx context.Context, accountID string, vacancyID string) error
+	ListSavedVacancies(
+		ctx context.Context,
+		accountID string,
+		cursor *vacancyController.Cursor,
+		limit int,
+	) ([]*vacancyController.Vacancy, *vacancyController.Cursor, error)
+	GetSavedVacanciesIDs(ctx context.Context, accountID string, vacancyIDs []string) (map[string]bool, error)
+
+	RecordVacancyEvents(
+		ctx context.Context,
+		eventType vacancyController.VacancyEventType,
+		viewerKey string,
+		vacancyIDs []string,
+	) error
+	RevealVacancyPhone(ctx context.Context, viewerKey string, vacancyID string) (string, error)
+	GetVacancyStats(
+		ctx context.Context,
+		vacancyID string,
+		from *time.Time,
+		till *time.Time,
+		granularity vacancyController.VacancyStatsGranularity,
+	) ([]*vacancyController.VacancyStatsPoint, error)
+
+	ListModerationQueue(
+		ctx context.Context,
+		cursor *vacancyController.Cursor,
+		limit int,
+	) ([]*vacancyController.ModerationQueueItem, *vacancyController.Cursor, error)
+	ApproveVacancy(ctx context.Context, moderatorID string, vacancyID string) error
+	RejectVacancy(
+		ctx context.Context,
+		moderatorID string,
+		vacancyID string,
+		reasons []vacancyController.ModerationReason,
+		comment string,
+	) error
+	GetVacancyModerationDecisions(
+		ctx context.Context,
+		vacancyID string,
+	) ([]*vacancyController.VacancyModerationDecision, error)
+
+	ListDuplicateClusters(
+		ctx context.Context,
+		cursor *vacancyController.Cursor,
+		limit int,
+	) ([]*vacancyController.DuplicateCluster, *vacancyController.Cursor, error)
+
+	ImportVacancies(
+		ctx context.Context,
+		authorID *string,
+		companyID string,
+		rows []*vacancyController.ImportRow,
+	) (*vacancyController.ImportReport, error)
+
+	ListVacancyRevisions(ctx context.Context, vacancyID string) ([]*vacancyController.VacancyRevision, error)
+	GetVacancyRevision(
+		ctx context.Context,
+		vacancyID string,
+		revision int32,
+	) (*vacancyController.VacancyRevision, error)
+	DiffVacancyRevisions(
+		ctx context.Context,
+		vacancyID string,
+		from int32,
+		to int32,
+	) ([]*vacancyController.VacancyFieldChange, error)
+	RestoreVacancyRevision(ctx context.Context, authorID string, vacancyID string, revision int32) error
+
+	ScheduleVacancyPublish(ctx context.Context, vacancyID string, publishAt *time.Time) error
+	BumpVacancy(ctx context.Context, vacancyID string) error
 }
 
 // Vacancy
@@ -52,17 +181,13 @@ func (s *Server) GetVacancyCategory(
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	vc, err := s.vc.GetVacancyCategory(ctx, req.Id)
+	vc, err := s.vc.GetVacancyCategory(ctx, req.Id, getRequestLocales(ctx))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &vacancyapi.GetVacancyCategoryResponse{
-		Category: &vacancyapi.VacancyCategory{
-			Id:      vc.ID,
-			Title:   vc.Title,
-			IconUrl: vc.IconURL,
-		},
+		Category: toServerVacancyCategory(vc),
 	}, nil
 }
 
@@ -75,19 +200,19 @@ func (s *Server) GetVacancyCategoriesList(
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	vcs, err := s.vc.GetVacanciesCategoriesList(ctx, getOptionalInt32(req.GetRating()))
+	vcs, err := s.vc.GetVacanciesCategoriesList(
+		ctx,
+		getOptionalInt32(req.GetRating()),
+		getOptionalString(req.GetParentId()),
+		getRequestLocales(ctx),
+	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	svcs := map[string]*vacancyapi.VacancyCategory{}
 	for _, vc := range vcs {
-		svcs[vc.ID] = &vacancyapi.VacancyCategory{
-			Id:      vc.ID,
-			Title:   vc.Title,
-			IconUrl: vc.IconURL,
-			Rating:  vc.Rating,
-		}
+		svcs[vc.ID] = toServerVacancyCategory(vc)
 	}
 
 	return &vacancyapi.GetVacancyCategoriesListResponse{VacancyCategories: svcs}, nil
@@ -103,12 +228,14 @@ func (s *Server) UpdateVacancyCategory(
 	}
 
 	categoryID, err := s.vc.PutVacancyCategory(ctx, getOptionalString(req.Id), &vacancyController.VacancyCategory{
-		Title:   req.Title,
-		IconURL: req.IconUrl,
+		ParentID: getOptionalString(req.ParentId),
+		Title:    req.Title,
+		Titles:   req.Titles,
+		IconURL:  req.IconUrl,
 	})
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, vacancyCategoryStatusError(err)
 	}
 
 	return &vacancyapi.UpdateVacancyCategoryResponse{
@@ -116,6 +243,22 @@ func (s *Server) UpdateVacancyCategory(
 	}, nil
 }
 
+func (s *Server) MoveVacancyCategory(
+	ctx context.Context,
+	req *vacancyapi.MoveVacancyCategoryRequest,
+) (*vacancyapi.MoveVacancyCategoryResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.vc.MoveVacancyCategory(ctx, req.Id, getOptionalString(req.ParentId), req.Position); err != nil {
+		return nil, vacancyCategoryStatusError(err)
+	}
+
+	return &vacancyapi.MoveVacancyCategoryResponse{}, nil
+}
+
 func (s *Server) DeleteVacancyCategory(
 	ctx context.Context,
 	req *vacancyapi.DeleteVacancyCategoryRequest,
@@ -130,16 +273,66 @@ func (s *Server) DeleteVacancyCategory(
 	case nil:
 	case vacancyController.ErrVacancyCategoryNotFound:
 		return nil, status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrVacancyCategoryHasChildren:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	return &vacancyapi.DeleteVacancyCategoryResponse{}, nil
 }
 
+// getRequestLocales returns the locales of the accept-language metadata, the most preferred first.
+func getRequestLocales(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return vacancyController.ParseAcceptLanguage(strings.Join(md.Get("accept-language"), ","))
+}
+
+func vacancyCategoryStatusError(err error) error {
+	switch causeErr := errors.Cause(err); causeErr {
+	case vacancyController.ErrVacancyCategoryNotFound, vacancyController.ErrVacancyCategoryParentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrInvalidVacancyCategoryParent:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case vacancyController.ErrInvalidVacancyCategory, vacancyController.ErrInvalidVacancyCategoryTitle:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Title", Description: causeErr.Error()}
+		return fieldViolationStatus(fv).Err()
+	case vacancyController.ErrInvalidVacancyCategoryIconURL:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "IconUrl", Description: causeErr.Error()}
+		return fieldViolationStatus(fv).Err()
+	case vacancyController.ErrInvalidVacancyCategoryLocale:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Titles", Description: causeErr.Error()}
+		return fieldViolationStatus(fv).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toServerVacancyCategory(vc *vacancyController.VacancyCategory) *vacancyapi.VacancyCategory {
+	category := &vacancyapi.VacancyCategory{
+		Id:       vc.ID,
+		Title:    vc.Title,
+		IconUrl:  vc.IconURL,
+		Rating:   vc.Rating,
+		Path:     vc.Path,
+		Position: vc.Position,
+		Titles:   vc.Titles,
+	}
+
+	if vc.ParentID != nil {
+		category.ParentId = *vc.ParentID
+	}
+
+	return category
+}
+
 func (s *Server) GetVacancyDetails(
 	ctx context.Context,
 	req *vacancyapi.GetVacancyDetailsRequest,
 ) (*vacancyapi.GetVacancyDetailsResponse, error) {
-	_, err := s.getAuthClaims(ctx)
+	claims, err := s.getAuthClaims(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
@@ -149,6 +342,30 @@ func (s *Server) GetVacancyDetails(
 	case nil:
 	case vacancyController.ErrVacancyNotFound:
 		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Vacancies which aren't live are shown to their company only
+	if !vd.IsLive(time.Now()) && vd.CompanyID != claims.AccountID && !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.NotFound, vacancyController.ErrVacancyNotFound.Error())
+	}
+
+	s.recordVacancyEvents(ctx, vacancyController.VacancyEventTypeView, claims.AccountID, []string{vd.ID})
+
+	publishedAt, expiresAt, err := toServerVacancyStatusTimes(&vd.Vacancy)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	scheduledPublishAt, err := toServerOptionalTimestamp(vd.ScheduledPublishAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bumpedAt, err := toServerOptionalTimestamp(vd.BumpedAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	// Get companies
@@ -171,6 +388,11 @@ func (s *Server) GetVacancyDetails(
 		return nil, errors.WithStack(err)
 	}
 
+	saved, err := s.getSavedVacanciesIDs(ctx, []string{vd.ID})
+	if err != nil {
+		return nil, err
+	}
+
 	return &vacancyapi.GetVacancyDetailsResponse{
 		Vacancy: toServerVacancy(vd, vc),
 		Image:   &vacancyapi.GetVacancyDetailsResponse_VacancyImage{ImageUrls: vd.ImageURLs},
@@ -186,12 +408,77 @@ func (s *Server) GetVacancyDetails(
 			Address:              vd.Address,
 			CountryCode:          vd.CountryCode,
 		},
-		Company:    toServerCompany(cd),
-		Categories: categoriesMap,
-		City:       c,
+		Company:     toServerCompany(cd),
+		Categories:  categoriesMap,
+		City:        c,
+		Status:      toServerVacancyStatus(vd.Status),
+		PublishedAt: publishedAt,
+		ExpiresAt:   expiresAt,
+		IsSaved:     saved[vd.ID],
+		DuplicateOf: toServerOptionalString(vd.DuplicateOf),
+
+		ScheduledPublishAt: scheduledPublishAt,
+		BumpedAt:           bumpedAt,
+		BumpCount:          vd.BumpCount,
 	}, nil
 }
 
+func (s *Server) GetSimilarVacancies(
+	ctx context.Context,
+	req *vacancyapi.GetSimilarVacanciesRequest,
+) (*vacancyapi.GetVacanciesListResponse, error) {
+	vcs, err := s.vc.GetSimilarVacancies(ctx, req.VacancyId, int(req.GetCount().GetValue()))
+
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordVacancyImpressions(ctx, vcs)
+
+	return s.toServerVacanciesList(ctx, vcs, nil)
+}
+
+func (s *Server) GetCompanyVacancies(
+	ctx context.Context,
+	req *vacancyapi.GetCompanyVacanciesRequest,
+) (*vacancyapi.GetVacanciesListResponse, error) {
+	if req.CompanyId == "" {
+		fv := &errdetails.BadRequest_FieldViolation{Field: "CompanyId", Description: "company id is required"}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	vcs, cursor, err := s.vc.GetVacanciesList(
+		ctx,
+		&vacancyController.VacancyListFilter{
+			CompanyID:       req.CompanyId,
+			ViewerCompanyID: s.getViewerCompanyID(ctx),
+		},
+		vacancyController.VacancySortOrderNewest,
+		toControllerCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidFilterCompanyID:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "CompanyId", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	case vacancyController.ErrInvalidCursor:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordVacancyImpressions(ctx, vcs)
+
+	return s.toServerVacanciesList(ctx, vcs, cursor)
+}
+
 func getVacancyCategoriesFromStorage(
 	ctx context.Context,
 	req *vacancyapi.GetVacancyDetailsRequest,
@@ -242,27 +529,50 @@ func (s *Server) GetVacanciesList(
 	ctx context.Context,
 	req *vacancyapi.GetVacanciesListRequest,
 ) (*vacancyapi.GetVacanciesListResponse, error) {
-	categoriesIds := make([]string, 0, len(req.CategoriesIds))
-	for id := range req.CategoriesIds {
-		categoriesIds = append(categoriesIds, id)
+	filter, fv := toControllerVacancyListFilter(req)
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
 	}
 
+	filter.ViewerCompanyID = s.getViewerCompanyID(ctx)
+
 	vcs, cursor, err := s.vc.GetVacanciesList(
 		ctx,
-		categoriesIds,
+		filter,
+		toControllerVacancySortOrder(req.SortOrder),
 		toControllerCursor(req.Cursor),
 		int(req.Count.GetValue()),
 	)
 
 	switch causeErr := errors.Cause(err); causeErr {
 	case nil:
+	case vacancyController.ErrInvalidSortOrder,
+		vacancyController.ErrRelevanceSortWithoutKeywords,
+		vacancyController.ErrDistanceSortWithoutNear:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "SortOrder", Description: causeErr.Error()}
 	case vacancyController.ErrInvalidCursor:
-		fv := &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
-		return nil, fieldViolationStatus(fv).Err()
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
 	default:
-		return nil, status.Error(codes.Internal, err.Error())
+		if fv = vacancyListFilterFieldViolation(causeErr); fv == nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
 	}
 
+	s.recordVacancyImpressions(ctx, vcs)
+
+	return s.toServerVacanciesList(ctx, vcs, cursor)
+}
+
+// toServerVacanciesList denormalizes the vacancies with their companies and categories.
+func (s *Server) toServerVacanciesList(
+	ctx context.Context,
+	vcs []*vacancyController.Vacancy,
+	cursor *vacancyController.Cursor,
+) (*vacancyapi.GetVacanciesListResponse, error) {
 	vacanciesIDs := make([]string, len(vcs))
 	vacancies := map[string]*vacancyapi.GetVacanciesListResponse_VacancyDetails{}
 
@@ -278,149 +588,1406 @@ func (s *Server) GetVacanciesList(
 				MinSalary:     v.MinSalary,
 				MaxSalary:     v.MaxSalary,
 				CompanyId:     v.CompanyID,
-				Currency:      vacancyapi.Currency_CURRENCY_UAH,
+				Currency:      toServerCurrency(v.Currency),
+				CurrencyCode:  string(v.Currency),
+				SalaryPeriod:  toServerSalaryPeriod(v.SalaryPeriod),
+				SalaryGross:   toServerOptionalBool(v.SalaryGross),
 				CategoriesIds: []string{},
 			},
 			ImageUrls: v.ImageURLs,
 		}
+
+		if v.Distance != nil {
+			vacancies[v.ID].Distance = &wrappers.DoubleValue{Value: *v.Distance}
+		}
+
 		companyIdsMap[v.CompanyID] = true
 	}
 
 	// Get vacancy categories
-	categories, err := s.vc.GetVacanciesCategories(
-		ctx,
-		vacanciesIDs,
-	)
-
-	switch errors.Cause(err) {
-	case nil:
-	case companyController.ErrCategoryNotFound:
-		return nil, status.Error(codes.NotFound, err.Error())
+	vacanciesMap := make(map[string]*vacancyapi.Vacancy, len(vacancies))
+	for id, v := range vacancies {
+		vacanciesMap[id] = v.Vacancy
 	}
 
-	categoriesMap := map[string]*vacancyapi.VacancyCategoryShort{}
-
-	for _, c := range categories {
-		vc := vacancies[c.VacancyID].Vacancy.CategoriesIds
-		vca := append(vc, c.Title)
-		vacancies[c.VacancyID].Vacancy.CategoriesIds = vca
-		categoriesMap[c.ID] = &vacancyapi.VacancyCategoryShort{
-			Title: c.Title,
-		}
+	categoriesMap, err := s.getVacanciesCategories(ctx, vacanciesIDs, vacanciesMap)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get companies
-	companyIds := make([]string, 0, len(companyIdsMap))
-	for companyID := range companyIdsMap {
-		companyIds = append(companyIds, companyID)
+	companiesMap, err := s.getVacanciesCompanies(ctx, companyIdsMap)
+	if err != nil {
+		return nil, err
 	}
 
-	companies, err := s.cc.GetCompaniesList(ctx, companyIds)
-
+	saved, err := s.getSavedVacanciesIDs(ctx, vacanciesIDs)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	companiesMap := make(map[string]*vacancyapi.Company, len(companies))
-	for _, company := range companies {
-		companiesMap[company.ID] = &vacancyapi.Company{
-			Id:      company.ID,
-			Title:   company.Title,
-			LogoUrl: company.LogoURL,
-		}
+	for id, v := range vacancies {
+		v.IsSaved = saved[id]
 	}
 
 	return &vacancyapi.GetVacanciesListResponse{
 		VacanciesIds: vacanciesIDs,
 		Vacancies:    vacancies,
 		Companies:    companiesMap,
+		Categories:   categoriesMap,
 		Cursor:       toServerCursor(cursor),
 	}, nil
 }
 
-func (s *Server) UpdateVacancy(
+func (s *Server) SearchVacancies(
 	ctx context.Context,
-	req *vacancyapi.UpdateVacancyRequest,
-) (*vacancyapi.UpdateVacancyResponse, error) {
-	claims, err := s.getAuthClaims(ctx)
-	if err != nil || !s.isAdminAccountType(claims) || !s.isCompanyAccountType(claims) {
-		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	req *vacancyapi.SearchVacanciesRequest,
+) (*vacancyapi.SearchVacanciesResponse, error) {
+	categoriesIds := make([]string, 0, len(req.CategoriesIds))
+	for id := range req.CategoriesIds {
+		categoriesIds = append(categoriesIds, id)
+	}
+
+	rs, cursor, err := s.vc.SearchVacancies(
+		ctx,
+		req.Query,
+		categoriesIds,
+		toControllerCursor(req.Cursor),
+		int(req.Count.GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidSearchQuery:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Query", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	case vacancyController.ErrInvalidCursor:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if req.Vacancy.CompanyId != claims.AccountID {
-		return nil, status.Error(codes.PermissionDenied, "wrong account")
+	vacanciesIDs := make([]string, len(rs))
+	vacancies := make(map[string]*vacancyapi.SearchVacanciesResponse_VacancyDetails, len(rs))
+	vacanciesMap := make(map[string]*vacancyapi.Vacancy, len(rs))
+	companyIdsMap := make(map[string]bool)
+
+	for idx, r := range rs {
+		vacanciesIDs[idx] = r.ID
+		vacanciesMap[r.ID] = &vacancyapi.Vacancy{
+			Id:            r.ID,
+			Title:         r.Title,
+			Phone:         r.Phone,
+			MinSalary:     r.MinSalary,
+			MaxSalary:     r.MaxSalary,
+			CompanyId:     r.CompanyID,
+			Currency:      toServerCurrency(r.Currency),
+			CurrencyCode:  string(r.Currency),
+			SalaryPeriod:  toServerSalaryPeriod(r.SalaryPeriod),
+			SalaryGross:   toServerOptionalBool(r.SalaryGross),
+			CategoriesIds: []string{},
+		}
+		vacancies[r.ID] = &vacancyapi.SearchVacanciesResponse_VacancyDetails{
+			Vacancy:            vacanciesMap[r.ID],
+			ImageUrls:          r.ImageURLs,
+			TitleHighlight:     r.TitleHighlight,
+			DescriptionSnippet: r.DescriptionSnippet,
+		}
+		companyIdsMap[r.CompanyID] = true
 	}
 
-	vacancyType, err := toControllerVacancyType(req.Description.Type)
+	s.recordVacancyEvents(ctx, vacancyController.VacancyEventTypeImpression, s.getViewerKey(ctx), vacanciesIDs)
+
+	categoriesMap, err := s.getVacanciesCategories(ctx, vacanciesIDs, vacanciesMap)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, err
 	}
 
-	vacancyID, err := s.vc.PutVacancy(
-		ctx,
-		getOptionalString(req.Vacancy.Id),
-		&vacancyController.VacancyDetails{
-			Vacancy: vacancyController.Vacancy{
-				Title:     req.Vacancy.Title,
-				Phone:     req.Vacancy.Phone,
-				MinSalary: req.Vacancy.MinSalary,
-				MaxSalary: req.Vacancy.MaxSalary,
-				ImageURLs: req.ImageURLs,
-				CompanyID: req.Vacancy.CompanyId,
-			},
-			Description:          req.Description.Description,
-			WorkMonthsExperience: int32(req.Description.WorkMonthsExperience),
-			WorkSchedule:         req.Description.WorkSchedule,
-			LocationLatitude:     req.Location.Latitude,
-			LocationLongitude:    req.Location.Longitude,
-			Type:                 vacancyType,
-			Address:              req.Description.Address,
-			CountryCode:          req.Description.CountryCode,
-		},
-		req.CategoryIDs,
-		req.CityIDs,
-	)
+	companiesMap, err := s.getVacanciesCompanies(ctx, companyIdsMap)
+	if err != nil {
+		return nil, err
+	}
 
+	saved, err := s.getSavedVacanciesIDs(ctx, vacanciesIDs)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	return &vacancyapi.UpdateVacancyResponse{
-		Id: string(vacancyID),
+	for id, v := range vacancies {
+		v.IsSaved = saved[id]
+	}
+
+	return &vacancyapi.SearchVacanciesResponse{
+		VacanciesIds: vacanciesIDs,
+		Vacancies:    vacancies,
+		Companies:    companiesMap,
+		Categories:   categoriesMap,
+		Cursor:       toServerCursor(cursor),
 	}, nil
 }
 
-func (s *Server) DeleteVacancy(
+func (s *Server) GetVacanciesMap(
 	ctx context.Context,
-	req *vacancyapi.DeleteVacancyRequest,
-) (*vacancyapi.DeleteVacancyResponse, error) {
-	claims, err := s.getAuthClaims(ctx)
-	if err != nil || !s.isAdminAccountType(claims) {
-		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	req *vacancyapi.GetVacanciesMapRequest,
+) (*vacancyapi.GetVacanciesMapResponse, error) {
+	categoriesIds := make([]string, 0, len(req.CategoriesIds))
+	for id := range req.CategoriesIds {
+		categoriesIds = append(categoriesIds, id)
 	}
 
-	vd, err := s.vc.GetVacancyDetails(ctx, req.Id)
-	switch errors.Cause(err) {
-	case nil:
-	case vacancyController.ErrVacancyNotFound:
-		return nil, status.Error(codes.NotFound, err.Error())
+	var bbox *vacancyController.BoundingBox
+	if b := req.GetBounds(); b != nil {
+		bbox = &vacancyController.BoundingBox{
+			MinLatitude:  b.MinLatitude,
+			MinLongitude: b.MinLongitude,
+			MaxLatitude:  b.MaxLatitude,
+			MaxLongitude: b.MaxLongitude,
+		}
 	}
 
-	if vd.CompanyID != claims.AccountID {
-		return nil, status.Error(codes.PermissionDenied, "wrong account")
-	}
+	m, err := s.vc.GetVacanciesMap(ctx, bbox, int(req.Zoom), categoriesIds)
 
-	err = s.vc.DeleteVacancy(ctx, req.Id)
-	switch errors.Cause(err) {
-	case nil:
-	case vacancyController.ErrVacancyNotFound:
-		return nil, status.Error(codes.NotFound, err.Error())
-	}
+	var fv *errdetails.BadRequest_FieldViolation
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidBoundingBox:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Bounds", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidMapZoom:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Zoom", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	markers := make([]*vacancyapi.GetVacanciesMapResponse_Marker, len(m.Markers))
+	for idx, marker := range m.Markers {
+		markers[idx] = &vacancyapi.GetVacanciesMapResponse_Marker{
+			VacancyId: marker.ID,
+			Title:     marker.Title,
+			CompanyId: marker.CompanyID,
+			Latitude:  marker.Latitude,
+			Longitude: marker.Longitude,
+		}
+	}
+
+	clusters := make([]*vacancyapi.GetVacanciesMapResponse_Cluster, len(m.Clusters))
+	for idx, cluster := range m.Clusters {
+		clusters[idx] = &vacancyapi.GetVacanciesMapResponse_Cluster{
+			Latitude:  cluster.Latitude,
+			Longitude: cluster.Longitude,
+			Count:     int32(cluster.Count),
+		}
+	}
+
+	return &vacancyapi.GetVacanciesMapResponse{Markers: markers, Clusters: clusters}, nil
+}
+
+func (s *Server) GetVacancyFacets(
+	ctx context.Context,
+	req *vacancyapi.GetVacancyFacetsRequest,
+) (*vacancyapi.GetVacancyFacetsResponse, error) {
+	filter, fv := toControllerVacancyListFilter(req)
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	f, err := s.vc.GetVacancyFacets(ctx, filter)
+	if err != nil {
+		if fv = vacancyListFilterFieldViolation(errors.Cause(err)); fv != nil {
+			return nil, fieldViolationStatus(fv).Err()
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vacancyapi.GetVacancyFacetsResponse{
+		Categories:    make(map[string]int32, len(f.Categories)),
+		Cities:        make(map[string]int32, len(f.Cities)),
+		Types:         make([]*vacancyapi.GetVacancyFacetsResponse_TypeCount, 0, len(f.Types)),
+		SalaryBuckets: make([]*vacancyapi.GetVacancyFacetsResponse_SalaryBucket, len(f.SalaryBuckets)),
+	}
+
+	for id, count := range f.Categories {
+		resp.Categories[id] = int32(count)
+	}
+
+	for id, count := range f.Cities {
+		resp.Cities[id] = int32(count)
+	}
+
+	for vt, count := range f.Types {
+		resp.Types = append(resp.Types, &vacancyapi.GetVacancyFacetsResponse_TypeCount{
+			Type:  toServerVacancyType(vt),
+			Count: int32(count),
+		})
+	}
+
+	sort.Slice(resp.Types, func(i, j int) bool {
+		return resp.Types[i].Type < resp.Types[j].Type
+	})
+
+	for idx, b := range f.SalaryBuckets {
+		resp.SalaryBuckets[idx] = &vacancyapi.GetVacancyFacetsResponse_SalaryBucket{
+			From:  b.From,
+			Count: int32(b.Count),
+		}
+
+		if b.To != nil {
+			resp.SalaryBuckets[idx].To = &wrappers.Int32Value{Value: *b.To}
+		}
+	}
+
+	return resp, nil
+}
+
+// getVacanciesCategories appends the category titles to the vacancies and returns the categories by id.
+func (s *Server) getVacanciesCategories(
+	ctx context.Context,
+	vacanciesIDs []string,
+	vacancies map[string]*vacancyapi.Vacancy,
+) (map[string]*vacancyapi.VacancyCategoryShort, error) {
+	categories, err := s.vc.GetVacanciesCategories(ctx, vacanciesIDs)
+
+	switch errors.Cause(err) {
+	case nil:
+	case companyController.ErrCategoryNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	categoriesMap := map[string]*vacancyapi.VacancyCategoryShort{}
+
+	for _, c := range categories {
+		vacancies[c.VacancyID].CategoriesIds = append(vacancies[c.VacancyID].CategoriesIds, c.Title)
+		categoriesMap[c.ID] = &vacancyapi.VacancyCategoryShort{
+			Title: c.Title,
+		}
+	}
+
+	return categoriesMap, nil
+}
+
+func (s *Server) getVacanciesCompanies(
+	ctx context.Context,
+	companyIdsMap map[string]bool,
+) (map[string]*vacancyapi.Company, error) {
+	companyIds := make([]string, 0, len(companyIdsMap))
+	for companyID := range companyIdsMap {
+		companyIds = append(companyIds, companyID)
+	}
+
+	companies, err := s.cc.GetCompaniesList(ctx, companyIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	companiesMap := make(map[string]*vacancyapi.Company, len(companies))
+	for _, company := range companies {
+		companiesMap[company.ID] = &vacancyapi.Company{
+			Id:      company.ID,
+			Title:   company.Title,
+			LogoUrl: company.LogoURL,
+		}
+	}
+
+	return companiesMap, nil
+}
+
+// getSavedVacanciesIDs returns the ones of vacanciesIDs saved by the persona making the request,
+// nothing is saved for anybody else.
+func (s *Server) getSavedVacanciesIDs(ctx context.Context, vacanciesIDs []string) (map[string]bool, error) {
+	personaID := s.getViewerPersonaID(ctx)
+	if personaID == "" {
+		return map[string]bool{}, nil
+	}
+
+	saved, err := s.vc.GetSavedVacanciesIDs(ctx, personaID, vacanciesIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return saved, nil
+}
+
+func (s *Server) UpdateVacancy(
+	ctx context.Context,
+	req *vacancyapi.UpdateVacancyRequest,
+) (*vacancyapi.UpdateVacancyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || (!s.isAdminAccountType(claims) && !s.isCompanyAccountType(claims)) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// A company writes its own vacancies only, an admin writes them for any company
+	companyID := req.Vacancy.CompanyId
+	if s.isCompanyAccountType(claims) {
+		if companyID != "" && companyID != claims.AccountID {
+			return nil, status.Error(codes.PermissionDenied, "wrong account")
+		}
+
+		companyID = claims.AccountID
+	}
+
+	if vacancyID := getOptionalString(req.Vacancy.Id); vacancyID != nil {
+		if _, err := s.authorizeVacancyCompany(ctx, *vacancyID); err != nil {
+			return nil, err
+		}
+	}
+
+	vacancyType, err := toControllerVacancyType(req.Description.Type)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vacancyID, err := s.vc.PutVacancy(
+		ctx,
+		claims.AccountID,
+		getOptionalString(req.Vacancy.Id),
+		&vacancyController.VacancyDetails{
+			Vacancy: vacancyController.Vacancy{
+				Title:        req.Vacancy.Title,
+				Phone:        req.Vacancy.Phone,
+				MinSalary:    req.Vacancy.MinSalary,
+				MaxSalary:    req.Vacancy.MaxSalary,
+				ImageURLs:    req.ImageURLs,
+				CompanyID:    companyID,
+				Currency:     vacancyController.Currency(req.Vacancy.CurrencyCode),
+				SalaryPeriod: toControllerSalaryPeriod(req.Vacancy.SalaryPeriod),
+				SalaryGross:  getOptionalBool(req.Vacancy.SalaryGross),
+			},
+			Description:          req.Description.Description,
+			WorkMonthsExperience: int32(req.Description.WorkMonthsExperience),
+			WorkSchedule:         req.Description.WorkSchedule,
+			LocationLatitude:     req.Location.Latitude,
+			LocationLongitude:    req.Location.Longitude,
+			Type:                 vacancyType,
+			Address:              req.Description.Address,
+			CountryCode:          req.Description.CountryCode,
+		},
+		req.CategoryIDs,
+		req.CityIDs,
+	)
+
+	var fv *errdetails.BadRequest_FieldViolation
+
+	// The validation sentinels are wrapped by the controller more than once
+	switch causeErr := errors.UnwrapAll(err); causeErr {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrVacancyDuplicate:
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case vacancyController.ErrVacancyCompanyChanged, vacancyController.ErrInvalidVacancyCompanyID:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "CompanyId", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancy:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Vacancy", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyTitle:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Title", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyPhone:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Phone", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyMinSalary:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "MinSalary", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyMaxSalary:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "MaxSalary", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyImageURL:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "ImageURLs", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyDescription:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Description", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyWorkMonthsExperience:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "WorkMonthsExperience", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyWorkSchedule:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "WorkSchedule", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyLocationLatitude:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Latitude", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyLocationLongitude:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Longitude", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyCurrency:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "CurrencyCode", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancySalaryPeriod:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "SalaryPeriod", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	return &vacancyapi.UpdateVacancyResponse{
+		Id: string(vacancyID),
+	}, nil
+}
+
+func (s *Server) DeleteVacancy(
+	ctx context.Context,
+	req *vacancyapi.DeleteVacancyRequest,
+) (*vacancyapi.DeleteVacancyResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	err := s.vc.DeleteVacancy(ctx, req.Id)
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &vacancyapi.DeleteVacancyResponse{}, nil
 }
 
+func (s *Server) PublishVacancy(
+	ctx context.Context,
+	req *vacancyapi.PublishVacancyRequest,
+) (*vacancyapi.PublishVacancyResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+
+	if req.GetExpiresAt() != nil {
+		t, err := ptypes.Timestamp(req.GetExpiresAt())
+		if err != nil {
+			return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+				Field:       "ExpiresAt",
+				Description: err.Error(),
+			}).Err()
+		}
+
+		expiresAt = &t
+	}
+
+	if err := vacancyStatusError(s.vc.PublishVacancy(ctx, req.GetId(), expiresAt)); err != nil {
+		return nil, err
+	}
+
+	return &vacancyapi.PublishVacancyResponse{}, nil
+}
+
+func (s *Server) PauseVacancy(
+	ctx context.Context,
+	req *vacancyapi.PauseVacancyRequest,
+) (*vacancyapi.PauseVacancyResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := vacancyStatusError(s.vc.PauseVacancy(ctx, req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &vacancyapi.PauseVacancyResponse{}, nil
+}
+
+func (s *Server) CloseVacancy(
+	ctx context.Context,
+	req *vacancyapi.CloseVacancyRequest,
+) (*vacancyapi.CloseVacancyResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := vacancyStatusError(s.vc.CloseVacancy(ctx, req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &vacancyapi.CloseVacancyResponse{}, nil
+}
+
+func (s *Server) ScheduleVacancyPublish(
+	ctx context.Context,
+	req *vacancyapi.ScheduleVacancyPublishRequest,
+) (*vacancyapi.ScheduleVacancyPublishResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	var publishAt *time.Time
+
+	if req.GetPublishAt() != nil {
+		t, err := ptypes.Timestamp(req.GetPublishAt())
+		if err != nil {
+			return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+				Field:       "PublishAt",
+				Description: err.Error(),
+			}).Err()
+		}
+
+		publishAt = &t
+	}
+
+	err := s.vc.ScheduleVacancyPublish(ctx, req.GetId(), publishAt)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidVacancySchedule:
+		return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+			Field:       "PublishAt",
+			Description: causeErr.Error(),
+		}).Err()
+	default:
+		return nil, vacancyStatusError(err)
+	}
+
+	return &vacancyapi.ScheduleVacancyPublishResponse{}, nil
+}
+
+func (s *Server) BumpVacancy(
+	ctx context.Context,
+	req *vacancyapi.BumpVacancyRequest,
+) (*vacancyapi.BumpVacancyResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	switch err := s.vc.BumpVacancy(ctx, req.GetId()); errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotLive:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case vacancyController.ErrVacancyBumpsExceeded, vacancyController.ErrVacancyBumpedRecently:
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return nil, vacancyStatusError(err)
+	}
+
+	return &vacancyapi.BumpVacancyResponse{}, nil
+}
+
+func (s *Server) ListMyVacancies(
+	ctx context.Context,
+	req *vacancyapi.ListMyVacanciesRequest,
+) (*vacancyapi.ListMyVacanciesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isCompanyAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	statuses := make([]vacancyController.VacancyStatus, 0, len(req.GetStatuses()))
+
+	for _, vs := range req.GetStatuses() {
+		controllerStatus, err := toControllerVacancyStatus(vs)
+		if err != nil {
+			return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+				Field:       "Statuses",
+				Description: err.Error(),
+			}).Err()
+		}
+
+		statuses = append(statuses, controllerStatus)
+	}
+
+	mvs, cursor, err := s.vc.ListMyVacancies(
+		ctx,
+		claims.AccountID,
+		statuses,
+		toControllerCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidCursor:
+		return nil, fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+			Field:       "Cursor",
+			Description: causeErr.Error(),
+		}).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	vacancies := make([]*vacancyapi.ListMyVacanciesResponse_MyVacancy, len(mvs))
+
+	for idx, mv := range mvs {
+		publishedAt, expiresAt, err := toServerVacancyStatusTimes(&mv.Vacancy)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		vacancies[idx] = &vacancyapi.ListMyVacanciesResponse_MyVacancy{
+			Vacancy: &vacancyapi.Vacancy{
+				Id:           mv.ID,
+				Title:        mv.Title,
+				Phone:        mv.Phone,
+				MinSalary:    mv.MinSalary,
+				MaxSalary:    mv.MaxSalary,
+				CompanyId:    mv.CompanyID,
+				Currency:     toServerCurrency(mv.Currency),
+				CurrencyCode: string(mv.Currency),
+				SalaryPeriod: toServerSalaryPeriod(mv.SalaryPeriod),
+				SalaryGross:  toServerOptionalBool(mv.SalaryGross),
+			},
+			ImageUrls:   mv.ImageURLs,
+			Status:      toServerVacancyStatus(mv.Status),
+			PublishedAt: publishedAt,
+			ExpiresAt:   expiresAt,
+			Counters: &vacancyapi.ListMyVacanciesResponse_Counters{
+				AlertMatches: int32(mv.Counters.AlertMatches),
+				Applications: int32(mv.Counters.Applications),
+				Views:        int32(mv.Counters.Views),
+				Saves:        int32(mv.Counters.Saves),
+			},
+		}
+	}
+
+	return &vacancyapi.ListMyVacanciesResponse{
+		Vacancies: vacancies,
+		Cursor:    toServerCursor(cursor),
+	}, nil
+}
+
+// authorizeVacancyCompany admits the company owning the vacancy and admins, it returns the vacancy.
+func (s *Server) authorizeVacancyCompany(
+	ctx context.Context,
+	vacancyID string,
+) (*vacancyController.VacancyDetails, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	vd, err := s.vc.GetVacancyDetails(ctx, vacancyID)
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if s.isAdminAccountType(claims) || (s.isCompanyAccountType(claims) && vd.CompanyID == claims.AccountID) {
+		return vd, nil
+	}
+
+	return nil, status.Error(codes.PermissionDenied, "wrong account")
+}
+
+func vacancyStatusError(err error) error {
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+		return nil
+	case vacancyController.ErrVacancyNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrInvalidVacancyStatusTransition, vacancyController.ErrVacancyNotPendingReview:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case vacancyController.ErrInvalidVacancyExpiry:
+		return fieldViolationStatus(&errdetails.BadRequest_FieldViolation{
+			Field:       "ExpiresAt",
+			Description: causeErr.Error(),
+		}).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Vacancy alerts
+
+func (s *Server) CreateVacancyAlert(
+	ctx context.Context,
+	req *vacancyapi.CreateVacancyAlertRequest,
+) (*vacancyapi.CreateVacancyAlertResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	filter, fv := toControllerVacancyFilter(req.GetFilter())
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	alertID, err := s.vc.CreateVacancyAlert(ctx, claims.AccountID, filter)
+
+	// The validation sentinels are wrapped by the controller more than once
+	switch causeErr := errors.UnwrapAll(err); causeErr {
+	case nil:
+	case vacancyController.ErrVacancyAlertsLimitExceeded:
+		return nil, status.Error(codes.ResourceExhausted, causeErr.Error())
+	case vacancyController.ErrInvalidVacancyAlert:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Filter", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyAlertCategories:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "CategoriesIds", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyAlertCities:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "CitiesIds", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyAlertSalary:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Salary", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyAlertType:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Type", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyAlertKeywords:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Keywords", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyAlertCurrency, vacancyController.ErrNoExchangeRate:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "CurrencyCode", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	return &vacancyapi.CreateVacancyAlertResponse{Id: string(alertID)}, nil
+}
+
+func (s *Server) ListVacancyAlerts(
+	ctx context.Context,
+	req *vacancyapi.ListVacancyAlertsRequest,
+) (*vacancyapi.ListVacancyAlertsResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	alerts, err := s.vc.ListVacancyAlerts(ctx, claims.AccountID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sas := make([]*vacancyapi.VacancyAlert, len(alerts))
+	for idx, a := range alerts {
+		sas[idx] = &vacancyapi.VacancyAlert{
+			Id:     a.ID,
+			Filter: toServerVacancyFilter(&a.VacancyFilter),
+		}
+	}
+
+	return &vacancyapi.ListVacancyAlertsResponse{Alerts: sas}, nil
+}
+
+func (s *Server) DeleteVacancyAlert(
+	ctx context.Context,
+	req *vacancyapi.DeleteVacancyAlertRequest,
+) (*vacancyapi.DeleteVacancyAlertResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	switch err := s.vc.DeleteVacancyAlert(ctx, claims.AccountID, req.GetId()); errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyAlertNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vacancyapi.DeleteVacancyAlertResponse{}, nil
+}
+
+// Saved vacancies
+
+func (s *Server) SaveVacancy(
+	ctx context.Context,
+	req *vacancyapi.SaveVacancyRequest,
+) (*vacancyapi.SaveVacancyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	switch err := s.vc.SaveVacancy(ctx, claims.AccountID, req.GetVacancyId()); errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrSavedVacanciesLimitExceeded:
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vacancyapi.SaveVacancyResponse{}, nil
+}
+
+func (s *Server) UnsaveVacancy(
+	ctx context.Context,
+	req *vacancyapi.UnsaveVacancyRequest,
+) (*vacancyapi.UnsaveVacancyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	switch err := s.vc.UnsaveVacancy(ctx, claims.AccountID, req.GetVacancyId()); errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrSavedVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vacancyapi.UnsaveVacancyResponse{}, nil
+}
+
+func (s *Server) ListSavedVacancies(
+	ctx context.Context,
+	req *vacancyapi.ListSavedVacanciesRequest,
+) (*vacancyapi.GetVacanciesListResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isPersonaAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	vcs, cursor, err := s.vc.ListSavedVacancies(
+		ctx,
+		claims.AccountID,
+		toControllerCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidCursor:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return s.toServerVacanciesList(ctx, vcs, cursor)
+}
+
+// Vacancy stats
+
+// recordVacancyEvents records the stats events of the vacancies shown. Stats are best-effort, a failed
+// recording doesn't fail the request.
+func (s *Server) recordVacancyEvents(
+	ctx context.Context,
+	eventType vacancyController.VacancyEventType,
+	viewerKey string,
+	vacanciesIDs []string,
+) {
+	_ = s.vc.RecordVacancyEvents(ctx, eventType, viewerKey, vacanciesIDs)
+}
+
+func (s *Server) recordVacancyImpressions(ctx context.Context, vcs []*vacancyController.Vacancy) {
+	vacanciesIDs := make([]string, len(vcs))
+	for idx, v := range vcs {
+		vacanciesIDs[idx] = v.ID
+	}
+
+	s.recordVacancyEvents(ctx, vacancyController.VacancyEventTypeImpression, s.getViewerKey(ctx), vacanciesIDs)
+}
+
+func (s *Server) RevealVacancyPhone(
+	ctx context.Context,
+	req *vacancyapi.RevealVacancyPhoneRequest,
+) (*vacancyapi.RevealVacancyPhoneResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	vd, err := s.vc.GetVacancyDetails(ctx, req.GetVacancyId())
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Vacancies which aren't live are shown to their company only
+	if !vd.IsLive(time.Now()) && vd.CompanyID != claims.AccountID && !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.NotFound, vacancyController.ErrVacancyNotFound.Error())
+	}
+
+	phone, err := s.vc.RevealVacancyPhone(ctx, claims.AccountID, vd.ID)
+
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vacancyapi.RevealVacancyPhoneResponse{Phone: phone}, nil
+}
+
+func (s *Server) GetVacancyStats(
+	ctx context.Context,
+	req *vacancyapi.GetVacancyStatsRequest,
+) (*vacancyapi.GetVacancyStatsResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetVacancyId()); err != nil {
+		return nil, err
+	}
+
+	var fv *errdetails.BadRequest_FieldViolation
+
+	from, err := toControllerOptionalTime(req.GetFrom())
+	if err != nil {
+		fv = &errdetails.BadRequest_FieldViolation{Field: "From", Description: err.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	till, err := toControllerOptionalTime(req.GetTill())
+	if err != nil {
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Till", Description: err.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	points, err := s.vc.GetVacancyStats(
+		ctx,
+		req.GetVacancyId(),
+		from,
+		till,
+		toControllerVacancyStatsGranularity(req.GetGranularity()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrInvalidVacancyStatsRange:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "From", Description: causeErr.Error()}
+	case vacancyController.ErrInvalidVacancyStatsGranularity:
+		fv = &errdetails.BadRequest_FieldViolation{Field: "Granularity", Description: causeErr.Error()}
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if fv != nil {
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	res := &vacancyapi.GetVacancyStatsResponse{
+		Points: make([]*vacancyapi.GetVacancyStatsResponse_Point, len(points)),
+	}
+
+	for idx, p := range points {
+		t, err := ptypes.TimestampProto(p.Time)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.Points[idx] = &vacancyapi.GetVacancyStatsResponse_Point{
+			Time:         t,
+			Views:        int32(p.Views),
+			Impressions:  int32(p.Impressions),
+			Saves:        int32(p.Saves),
+			PhoneReveals: int32(p.PhoneReveals),
+		}
+	}
+
+	return res, nil
+}
+
+// Vacancy moderation
+
+func (s *Server) ListModerationQueue(
+	ctx context.Context,
+	req *vacancyapi.ListModerationQueueRequest,
+) (*vacancyapi.ListModerationQueueResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	items, cursor, err := s.vc.ListModerationQueue(
+		ctx,
+		toControllerCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidCursor:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &vacancyapi.ListModerationQueueResponse{
+		Items: make([]*vacancyapi.ListModerationQueueResponse_Item, len(items)),
+	}
+
+	for idx, item := range items {
+		submittedAt, err := ptypes.TimestampProto(item.SubmittedAt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.Items[idx] = &vacancyapi.ListModerationQueueResponse_Item{
+			Vacancy:     toServerListedVacancy(&item.Vacancy),
+			SubmittedAt: submittedAt,
+			Reasons:     toServerModerationReasons(item.Reasons),
+		}
+	}
+
+	if cursor != nil {
+		res.Cursor = &wrappers.StringValue{Value: string(*cursor)}
+	}
+
+	return res, nil
+}
+
+func (s *Server) ApproveVacancy(
+	ctx context.Context,
+	req *vacancyapi.ApproveVacancyRequest,
+) (*vacancyapi.ApproveVacancyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := vacancyStatusError(s.vc.ApproveVacancy(ctx, claims.AccountID, req.GetVacancyId())); err != nil {
+		return nil, err
+	}
+
+	return &vacancyapi.ApproveVacancyResponse{}, nil
+}
+
+func (s *Server) RejectVacancy(
+	ctx context.Context,
+	req *vacancyapi.RejectVacancyRequest,
+) (*vacancyapi.RejectVacancyResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	reasons := make([]vacancyController.ModerationReason, len(req.GetReasons()))
+	for idx, r := range req.GetReasons() {
+		reasons[idx] = toControllerModerationReason(r)
+	}
+
+	err = s.vc.RejectVacancy(ctx, claims.AccountID, req.GetVacancyId(), reasons, req.GetComment())
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case vacancyController.ErrInvalidModerationReasons:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Reasons", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	case vacancyController.ErrInvalidModerationComment:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Comment", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	if err := vacancyStatusError(err); err != nil {
+		return nil, err
+	}
+
+	return &vacancyapi.RejectVacancyResponse{}, nil
+}
+
+func (s *Server) GetVacancyModerationHistory(
+	ctx context.Context,
+	req *vacancyapi.GetVacancyModerationHistoryRequest,
+) (*vacancyapi.GetVacancyModerationHistoryResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetVacancyId()); err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.vc.GetVacancyModerationDecisions(ctx, req.GetVacancyId())
+
+	switch errors.Cause(err) {
+	case nil:
+	case vacancyController.ErrVacancyNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &vacancyapi.GetVacancyModerationHistoryResponse{
+		Decisions: make([]*vacancyapi.GetVacancyModerationHistoryResponse_Decision, len(decisions)),
+	}
+
+	for idx, d := range decisions {
+		createdAt, err := ptypes.TimestampProto(d.CreatedAt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.Decisions[idx] = &vacancyapi.GetVacancyModerationHistoryResponse_Decision{
+			Decision:  toServerModerationDecision(d.Decision),
+			Reasons:   toServerModerationReasons(d.Reasons),
+			Comment:   d.Comment,
+			CreatedAt: createdAt,
+		}
+
+		if d.ModeratorID != nil {
+			res.Decisions[idx].ModeratorId = *d.ModeratorID
+		}
+	}
+
+	return res, nil
+}
+
+// Vacancy duplicates
+
+func (s *Server) ListVacancyDuplicates(
+	ctx context.Context,
+	req *vacancyapi.ListVacancyDuplicatesRequest,
+) (*vacancyapi.ListVacancyDuplicatesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || !s.isAdminAccountType(claims) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	clusters, cursor, err := s.vc.ListDuplicateClusters(
+		ctx,
+		toControllerCursor(req.GetCursor()),
+		int(req.GetCount().GetValue()),
+	)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrInvalidCursor:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Cursor", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &vacancyapi.ListVacancyDuplicatesResponse{
+		Clusters: make([]*vacancyapi.ListVacancyDuplicatesResponse_Cluster, len(clusters)),
+	}
+
+	for idx, cluster := range clusters {
+		repostedAt, err := ptypes.TimestampProto(cluster.RepostedAt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.Clusters[idx] = &vacancyapi.ListVacancyDuplicatesResponse_Cluster{
+			Original:      toServerListedVacancy(&cluster.Vacancy),
+			DuplicatesIds: cluster.DuplicatesIDs,
+			RepostedAt:    repostedAt,
+		}
+	}
+
+	if cursor != nil {
+		res.Cursor = &wrappers.StringValue{Value: string(*cursor)}
+	}
+
+	return res, nil
+}
+
+func (s *Server) ImportVacancies(
+	ctx context.Context,
+	req *vacancyapi.ImportVacanciesRequest,
+) (*vacancyapi.ImportVacanciesResponse, error) {
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil || (!s.isAdminAccountType(claims) && !s.isCompanyAccountType(claims)) {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// A company imports its own vacancies only, an admin imports them for any company
+	companyID := req.CompanyId
+	if s.isCompanyAccountType(claims) {
+		if companyID != "" && companyID != claims.AccountID {
+			return nil, status.Error(codes.PermissionDenied, "wrong account")
+		}
+
+		companyID = claims.AccountID
+	}
+
+	if companyID == "" {
+		fv := &errdetails.BadRequest_FieldViolation{Field: "CompanyId", Description: "company id is required"}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	var rows []*vacancyController.ImportRow
+
+	switch req.Format {
+	case vacancyapi.ImportVacanciesRequest_FORMAT_CSV:
+		rows, err = vacancyController.ParseImportCSV(bytes.NewReader(req.Feed))
+	case vacancyapi.ImportVacanciesRequest_FORMAT_XML:
+		rows, err = vacancyController.ParseImportXML(bytes.NewReader(req.Feed))
+	default:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Format", Description: "unknown feed format"}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	if err != nil {
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Feed", Description: errors.Cause(err).Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	}
+
+	report, err := s.vc.ImportVacancies(ctx, &claims.AccountID, companyID, rows)
+
+	switch causeErr := errors.Cause(err); causeErr {
+	case nil:
+	case vacancyController.ErrTooManyImportRows:
+		fv := &errdetails.BadRequest_FieldViolation{Field: "Feed", Description: causeErr.Error()}
+		return nil, fieldViolationStatus(fv).Err()
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &vacancyapi.ImportVacanciesResponse{
+		Created: int32(report.Created),
+		Updated: int32(report.Updated),
+		Errors:  make([]*vacancyapi.ImportVacanciesResponse_RowError, len(report.Errors)),
+	}
+
+	for idx, rowErr := range report.Errors {
+		res.Errors[idx] = &vacancyapi.ImportVacanciesResponse_RowError{
+			Line:        int32(rowErr.Line),
+			ExternalId:  rowErr.ExternalID,
+			Field:       rowErr.Field,
+			Description: rowErr.Err.Error(),
+		}
+	}
+
+	return res, nil
+}
+
 // Mappings
+// Vacancy revisions
+
+func (s *Server) ListVacancyRevisions(
+	ctx context.Context,
+	req *vacancyapi.ListVacancyRevisionsRequest,
+) (*vacancyapi.ListVacancyRevisionsResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetVacancyId()); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.vc.ListVacancyRevisions(ctx, req.GetVacancyId())
+	if err != nil {
+		return nil, vacancyRevisionStatusError(err)
+	}
+
+	res := &vacancyapi.ListVacancyRevisionsResponse{
+		Revisions: make([]*vacancyapi.VacancyRevision, len(revisions)),
+	}
+
+	for idx, r := range revisions {
+		if res.Revisions[idx], err = toServerVacancyRevision(r); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) GetVacancyRevision(
+	ctx context.Context,
+	req *vacancyapi.GetVacancyRevisionRequest,
+) (*vacancyapi.GetVacancyRevisionResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetVacancyId()); err != nil {
+		return nil, err
+	}
+
+	r, err := s.vc.GetVacancyRevision(ctx, req.GetVacancyId(), req.GetRevision())
+	if err != nil {
+		return nil, vacancyRevisionStatusError(err)
+	}
+
+	revision, err := toServerVacancyRevision(r)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vacancyapi.GetVacancyRevisionResponse{
+		Revision:  revision,
+		Vacancy:   toServerVacancy(r.Vacancy, r.CategoriesIDs),
+		ImageUrls: r.Vacancy.ImageURLs,
+		Location: &vacancyapi.GetVacancyRevisionResponse_VacancyLocation{
+			Latitude:  r.Vacancy.LocationLatitude,
+			Longitude: r.Vacancy.LocationLongitude,
+		},
+		Description: &vacancyapi.GetVacancyRevisionResponse_VacancyDescription{
+			Description:          r.Vacancy.Description,
+			WorkMonthsExperience: uint32(r.Vacancy.WorkMonthsExperience),
+			WorkSchedule:         r.Vacancy.WorkSchedule,
+			Type:                 toServerVacancyType(r.Vacancy.Type),
+			Address:              r.Vacancy.Address,
+			CountryCode:          r.Vacancy.CountryCode,
+		},
+		CityIds: r.CityIDs,
+	}, nil
+}
+
+func (s *Server) DiffVacancyRevisions(
+	ctx context.Context,
+	req *vacancyapi.DiffVacancyRevisionsRequest,
+) (*vacancyapi.DiffVacancyRevisionsResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetVacancyId()); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.vc.DiffVacancyRevisions(ctx, req.GetVacancyId(), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, vacancyRevisionStatusError(err)
+	}
+
+	res := &vacancyapi.DiffVacancyRevisionsResponse{
+		Changes: make([]*vacancyapi.DiffVacancyRevisionsResponse_FieldChange, len(changes)),
+	}
+
+	for idx, change := range changes {
+		res.Changes[idx] = &vacancyapi.DiffVacancyRevisionsResponse_FieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) RestoreVacancyRevision(
+	ctx context.Context,
+	req *vacancyapi.RestoreVacancyRevisionRequest,
+) (*vacancyapi.RestoreVacancyRevisionResponse, error) {
+	if _, err := s.authorizeVacancyCompany(ctx, req.GetVacancyId()); err != nil {
+		return nil, err
+	}
+
+	claims, err := s.getAuthClaims(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	err = s.vc.RestoreVacancyRevision(ctx, claims.AccountID, req.GetVacancyId(), req.GetRevision())
+	if err != nil {
+		return nil, vacancyRevisionStatusError(err)
+	}
+
+	return &vacancyapi.RestoreVacancyRevisionResponse{}, nil
+}
+
+func vacancyRevisionStatusError(err error) error {
+	switch errors.Cause(err) {
+	case vacancyController.ErrVacancyNotFound, vacancyController.ErrVacancyRevisionNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case vacancyController.ErrInvalidVacancyRevision:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case vacancyController.ErrVacancyDuplicate:
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toServerVacancyRevision(r *vacancyController.VacancyRevision) (*vacancyapi.VacancyRevision, error) {
+	createdAt, err := ptypes.TimestampProto(r.CreatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	revision := &vacancyapi.VacancyRevision{
+		Revision:      r.Revision,
+		CreatedAt:     createdAt,
+		ChangedFields: r.ChangedFields,
+	}
+
+	if r.AuthorID != nil {
+		revision.AuthorId = *r.AuthorID
+	}
+
+	return revision, nil
+}
+
 func toServerVacancy(vd *vacancyController.VacancyDetails, vc []string) *vacancyapi.Vacancy {
 	return &vacancyapi.Vacancy{
 		Id:            vd.ID,
@@ -429,11 +1996,68 @@ func toServerVacancy(vd *vacancyController.VacancyDetails, vc []string) *vacancy
 		MinSalary:     vd.MinSalary,
 		MaxSalary:     vd.MaxSalary,
 		CompanyId:     vd.CompanyID,
-		Currency:      vacancyapi.Currency_CURRENCY_UAH,
+		Currency:      toServerCurrency(vd.Currency),
+		CurrencyCode:  string(vd.Currency),
+		SalaryPeriod:  toServerSalaryPeriod(vd.SalaryPeriod),
+		SalaryGross:   toServerOptionalBool(vd.SalaryGross),
 		CategoriesIds: vc,
 	}
 }
 
+// toServerListedVacancy maps a vacancy of a list, the categories aren't loaded for those.
+func toServerListedVacancy(v *vacancyController.Vacancy) *vacancyapi.Vacancy {
+	return &vacancyapi.Vacancy{
+		Id:            v.ID,
+		Title:         v.Title,
+		Phone:         v.Phone,
+		MinSalary:     v.MinSalary,
+		MaxSalary:     v.MaxSalary,
+		CompanyId:     v.CompanyID,
+		Currency:      toServerCurrency(v.Currency),
+		CurrencyCode:  string(v.Currency),
+		SalaryPeriod:  toServerSalaryPeriod(v.SalaryPeriod),
+		SalaryGross:   toServerOptionalBool(v.SalaryGross),
+		CategoriesIds: []string{},
+	}
+}
+
+// toServerCurrency fills the deprecated currency enum, which knows UAH only.
+func toServerCurrency(c vacancyController.Currency) vacancyapi.Currency {
+	if c == vacancyController.CurrencyUAH {
+		return vacancyapi.Currency_CURRENCY_UAH
+	}
+
+	return vacancyapi.Currency_CURRENCY_UNKNOWN
+}
+
+func toControllerSalaryPeriod(p vacancyapi.SalaryPeriod) vacancyController.SalaryPeriod {
+	switch p {
+	case vacancyapi.SalaryPeriod_SALARY_PERIOD_UNKNOWN:
+		return ""
+	case vacancyapi.SalaryPeriod_SALARY_PERIOD_HOUR:
+		return vacancyController.SalaryPeriodHour
+	case vacancyapi.SalaryPeriod_SALARY_PERIOD_MONTH:
+		return vacancyController.SalaryPeriodMonth
+	case vacancyapi.SalaryPeriod_SALARY_PERIOD_YEAR:
+		return vacancyController.SalaryPeriodYear
+	default:
+		return vacancyController.SalaryPeriod(p.String())
+	}
+}
+
+func toServerSalaryPeriod(p vacancyController.SalaryPeriod) vacancyapi.SalaryPeriod {
+	switch p {
+	case vacancyController.SalaryPeriodHour:
+		return vacancyapi.SalaryPeriod_SALARY_PERIOD_HOUR
+	case vacancyController.SalaryPeriodMonth:
+		return vacancyapi.SalaryPeriod_SALARY_PERIOD_MONTH
+	case vacancyController.SalaryPeriodYear:
+		return vacancyapi.SalaryPeriod_SALARY_PERIOD_YEAR
+	default:
+		return vacancyapi.SalaryPeriod_SALARY_PERIOD_UNKNOWN
+	}
+}
+
 func toServerCompany(cd *companyController.Company) *vacancyapi.GetVacancyDetailsResponse_VacancyCompany {
 	afs := make(map[string]*vacancyapi.Empty)
 
@@ -463,6 +2087,130 @@ func toControllerCursor(cursor *wrappers.StringValue) *vacancyController.Cursor
 	return &vc
 }
 
+// vacancyListFilterRequest is a request with the vacancies list filter fields,
+// such as GetVacanciesListRequest and GetVacancyFacetsRequest.
+type vacancyListFilterRequest interface {
+	GetCategoriesIds() map[string]*vacancyapi.Empty
+	GetCitiesIds() map[string]*vacancyapi.Empty
+	GetMinSalary() *wrappers.Int32Value
+	GetMaxSalary() *wrappers.Int32Value
+	GetCurrency() vacancyapi.Currency
+	GetCurrencyCode() string
+	GetType() vacancyapi.VacancyType
+	GetMaxExperienceMonths() *wrappers.Int32Value
+	GetCompanyId() *wrappers.StringValue
+	GetCountryCode() *wrappers.Int32Value
+	GetKeywords() string
+	GetNear() *vacancyapi.GeoRadius
+}
+
+func toControllerVacancyListFilter(
+	req vacancyListFilterRequest,
+) (*vacancyController.VacancyListFilter, *errdetails.BadRequest_FieldViolation) {
+	filter := &vacancyController.VacancyListFilter{
+		VacancyFilter: vacancyController.VacancyFilter{
+			CategoriesIDs: make([]string, 0, len(req.GetCategoriesIds())),
+			CityIDs:       make([]string, 0, len(req.GetCitiesIds())),
+			MinSalary:     getOptionalInt32(req.GetMinSalary()),
+			MaxSalary:     getOptionalInt32(req.GetMaxSalary()),
+			Keywords:      req.GetKeywords(),
+		},
+		MaxExperienceMonths: getOptionalInt32(req.GetMaxExperienceMonths()),
+		CountryCode:         getOptionalInt32(req.GetCountryCode()),
+		CompanyID:           req.GetCompanyId().GetValue(),
+		Near:                toControllerGeoRadius(req.GetNear()),
+	}
+
+	for id := range req.GetCategoriesIds() {
+		filter.CategoriesIDs = append(filter.CategoriesIDs, id)
+	}
+
+	for id := range req.GetCitiesIds() {
+		filter.CityIDs = append(filter.CityIDs, id)
+	}
+
+	// The currency code takes precedence over the deprecated currency enum
+	switch {
+	case req.GetCurrencyCode() != "":
+		filter.Currency = vacancyController.Currency(req.GetCurrencyCode())
+	case req.GetCurrency() == vacancyapi.Currency_CURRENCY_UNKNOWN:
+	case req.GetCurrency() == vacancyapi.Currency_CURRENCY_UAH:
+		filter.Currency = vacancyController.CurrencyUAH
+	default:
+		return nil, &errdetails.BadRequest_FieldViolation{Field: "Currency", Description: "unknown currency"}
+	}
+
+	if req.GetType() != vacancyapi.VacancyType_VACANCY_TYPE_UNKNOWN {
+		vacancyType, err := toControllerVacancyType(req.GetType())
+		if err != nil {
+			return nil, &errdetails.BadRequest_FieldViolation{Field: "Type", Description: err.Error()}
+		}
+
+		filter.Type = &vacancyType
+	}
+
+	return filter, nil
+}
+
+// vacancyListFilterFieldViolation returns nil unless err is a validation error of the vacancies list filter.
+func vacancyListFilterFieldViolation(err error) *errdetails.BadRequest_FieldViolation {
+	var field string
+
+	switch err {
+	case vacancyController.ErrInvalidFilterCategories:
+		field = "CategoriesIds"
+	case vacancyController.ErrInvalidFilterCities:
+		field = "CitiesIds"
+	case vacancyController.ErrInvalidFilterSalary:
+		field = "MinSalary"
+	case vacancyController.ErrInvalidFilterCurrency, vacancyController.ErrNoExchangeRate:
+		field = "CurrencyCode"
+	case vacancyController.ErrInvalidFilterType:
+		field = "Type"
+	case vacancyController.ErrInvalidFilterKeywords:
+		field = "Keywords"
+	case vacancyController.ErrInvalidFilterMaxExperience:
+		field = "MaxExperienceMonths"
+	case vacancyController.ErrInvalidFilterCompanyID:
+		field = "CompanyId"
+	case vacancyController.ErrInvalidFilterCountryCode:
+		field = "CountryCode"
+	case vacancyController.ErrInvalidGeoRadius:
+		field = "Near"
+	default:
+		return nil
+	}
+
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: err.Error()}
+}
+
+func toControllerVacancySortOrder(so vacancyapi.VacancySortOrder) vacancyController.VacancySortOrder {
+	switch so {
+	case vacancyapi.VacancySortOrder_VACANCY_SORT_ORDER_NEWEST:
+		return vacancyController.VacancySortOrderNewest
+	case vacancyapi.VacancySortOrder_VACANCY_SORT_ORDER_SALARY:
+		return vacancyController.VacancySortOrderSalary
+	case vacancyapi.VacancySortOrder_VACANCY_SORT_ORDER_RELEVANCE:
+		return vacancyController.VacancySortOrderRelevance
+	case vacancyapi.VacancySortOrder_VACANCY_SORT_ORDER_DISTANCE:
+		return vacancyController.VacancySortOrderDistance
+	default:
+		return ""
+	}
+}
+
+func toControllerGeoRadius(r *vacancyapi.GeoRadius) *vacancyController.GeoRadius {
+	if r == nil {
+		return nil
+	}
+
+	return &vacancyController.GeoRadius{
+		Latitude:  r.Latitude,
+		Longitude: r.Longitude,
+		RadiusKm:  r.RadiusKm,
+	}
+}
+
 func toServerCursor(cursor *vacancyController.Cursor) *wrappers.StringValue {
 	if cursor == nil {
 		return nil
@@ -471,6 +2219,124 @@ func toServerCursor(cursor *vacancyController.Cursor) *wrappers.StringValue {
 	return &wrappers.StringValue{Value: cursor.String()}
 }
 
+func toServerVacancyStatus(vs vacancyController.VacancyStatus) vacancyapi.VacancyStatus {
+	switch vs {
+	case vacancyController.VacancyStatusDraft:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_DRAFT
+	case vacancyController.VacancyStatusPublished:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_PUBLISHED
+	case vacancyController.VacancyStatusPaused:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_PAUSED
+	case vacancyController.VacancyStatusClosed:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_CLOSED
+	case vacancyController.VacancyStatusPendingReview:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_PENDING_REVIEW
+	case vacancyController.VacancyStatusRejected:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_REJECTED
+	default:
+		return vacancyapi.VacancyStatus_VACANCY_STATUS_UNKNOWN
+	}
+}
+
+func toControllerVacancyStatus(vs vacancyapi.VacancyStatus) (vacancyController.VacancyStatus, error) {
+	switch vs {
+	case vacancyapi.VacancyStatus_VACANCY_STATUS_DRAFT:
+		return vacancyController.VacancyStatusDraft, nil
+	case vacancyapi.VacancyStatus_VACANCY_STATUS_PUBLISHED:
+		return vacancyController.VacancyStatusPublished, nil
+	case vacancyapi.VacancyStatus_VACANCY_STATUS_PAUSED:
+		return vacancyController.VacancyStatusPaused, nil
+	case vacancyapi.VacancyStatus_VACANCY_STATUS_CLOSED:
+		return vacancyController.VacancyStatusClosed, nil
+	case vacancyapi.VacancyStatus_VACANCY_STATUS_PENDING_REVIEW:
+		return vacancyController.VacancyStatusPendingReview, nil
+	case vacancyapi.VacancyStatus_VACANCY_STATUS_REJECTED:
+		return vacancyController.VacancyStatusRejected, nil
+	default:
+		return "", errors.New("unknown vacancy status")
+	}
+}
+
+// toControllerModerationReason maps an unknown reason to an empty one, which the controller rejects.
+func toControllerModerationReason(r vacancyapi.ModerationReason) vacancyController.ModerationReason {
+	switch r {
+	case vacancyapi.ModerationReason_MODERATION_REASON_BANNED_WORDS:
+		return vacancyController.ModerationReasonBannedWords
+	case vacancyapi.ModerationReason_MODERATION_REASON_INVALID_PHONE:
+		return vacancyController.ModerationReasonInvalidPhone
+	case vacancyapi.ModerationReason_MODERATION_REASON_MISSING_SALARY:
+		return vacancyController.ModerationReasonMissingSalary
+	case vacancyapi.ModerationReason_MODERATION_REASON_DUPLICATE:
+		return vacancyController.ModerationReasonDuplicate
+	case vacancyapi.ModerationReason_MODERATION_REASON_MISLEADING:
+		return vacancyController.ModerationReasonMisleading
+	case vacancyapi.ModerationReason_MODERATION_REASON_DISCRIMINATORY:
+		return vacancyController.ModerationReasonDiscriminatory
+	case vacancyapi.ModerationReason_MODERATION_REASON_OTHER:
+		return vacancyController.ModerationReasonOther
+	default:
+		return ""
+	}
+}
+
+func toServerModerationReasons(rs []vacancyController.ModerationReason) []vacancyapi.ModerationReason {
+	reasons := make([]vacancyapi.ModerationReason, len(rs))
+
+	for idx, r := range rs {
+		switch r {
+		case vacancyController.ModerationReasonBannedWords:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_BANNED_WORDS
+		case vacancyController.ModerationReasonInvalidPhone:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_INVALID_PHONE
+		case vacancyController.ModerationReasonMissingSalary:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_MISSING_SALARY
+		case vacancyController.ModerationReasonDuplicate:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_DUPLICATE
+		case vacancyController.ModerationReasonMisleading:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_MISLEADING
+		case vacancyController.ModerationReasonDiscriminatory:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_DISCRIMINATORY
+		case vacancyController.ModerationReasonOther:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_OTHER
+		default:
+			reasons[idx] = vacancyapi.ModerationReason_MODERATION_REASON_UNKNOWN
+		}
+	}
+
+	return reasons
+}
+
+func toServerModerationDecision(d vacancyController.ModerationDecision) vacancyapi.ModerationDecision {
+	switch d {
+	case vacancyController.ModerationDecisionSubmitted:
+		return vacancyapi.ModerationDecision_MODERATION_DECISION_SUBMITTED
+	case vacancyController.ModerationDecisionApproved:
+		return vacancyapi.ModerationDecision_MODERATION_DECISION_APPROVED
+	case vacancyController.ModerationDecisionRejected:
+		return vacancyapi.ModerationDecision_MODERATION_DECISION_REJECTED
+	default:
+		return vacancyapi.ModerationDecision_MODERATION_DECISION_UNKNOWN
+	}
+}
+
+func toServerVacancyStatusTimes(
+	v *vacancyController.Vacancy,
+) (publishedAt *timestamp.Timestamp, expiresAt *timestamp.Timestamp, err error) {
+	if v.PublishedAt != nil {
+		if publishedAt, err = ptypes.TimestampProto(*v.PublishedAt); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+
+	if v.ExpiresAt != nil {
+		if expiresAt, err = ptypes.TimestampProto(*v.ExpiresAt); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+
+	return publishedAt, expiresAt, nil
+}
+
 func toServerVacancyType(at vacancyController.VacancyType) vacancyapi.VacancyType {
 	switch at {
 	case vacancyController.VacancyTypeNormal:
@@ -494,3 +2360,82 @@ func toControllerVacancyType(at vacancyapi.VacancyType) (vacancyController.Vacan
 		return "", errors.New("unknown account type")
 	}
 }
+
+func toControllerVacancyFilter(
+	f *vacancyapi.VacancyAlertFilter,
+) (*vacancyController.VacancyFilter, *errdetails.BadRequest_FieldViolation) {
+	if f == nil {
+		return nil, &errdetails.BadRequest_FieldViolation{Field: "Filter", Description: "empty filter"}
+	}
+
+	filter := &vacancyController.VacancyFilter{
+		CategoriesIDs: f.GetCategoriesIds(),
+		CityIDs:       f.GetCitiesIds(),
+		MinSalary:     getOptionalInt32(f.GetMinSalary()),
+		MaxSalary:     getOptionalInt32(f.GetMaxSalary()),
+		Currency:      vacancyController.Currency(f.GetCurrencyCode()),
+		Keywords:      f.GetKeywords(),
+	}
+
+	if f.GetType() != vacancyapi.VacancyType_VACANCY_TYPE_UNKNOWN {
+		vacancyType, err := toControllerVacancyType(f.GetType())
+		if err != nil {
+			return nil, &errdetails.BadRequest_FieldViolation{Field: "Type", Description: err.Error()}
+		}
+
+		filter.Type = &vacancyType
+	}
+
+	return filter, nil
+}
+
+func toServerVacancyFilter(f *vacancyController.VacancyFilter) *vacancyapi.VacancyAlertFilter {
+	filter := &vacancyapi.VacancyAlertFilter{
+		CategoriesIds: f.CategoriesIDs,
+		CitiesIds:     f.CityIDs,
+		CurrencyCode:  string(f.Currency),
+		Keywords:      f.Keywords,
+	}
+
+	if f.MinSalary != nil {
+		filter.MinSalary = &wrappers.Int32Value{Value: *f.MinSalary}
+	}
+
+	if f.MaxSalary != nil {
+		filter.MaxSalary = &wrappers.Int32Value{Value: *f.MaxSalary}
+	}
+
+	if f.Type != nil {
+		filter.Type = toServerVacancyType(*f.Type)
+	}
+
+	return filter
+}
+
+func toControllerVacancyStatsGranularity(
+	g vacancyapi.VacancyStatsGranularity,
+) vacancyController.VacancyStatsGranularity {
+	switch g {
+	case vacancyapi.VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_UNKNOWN:
+		return ""
+	case vacancyapi.VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_HOUR:
+		return vacancyController.VacancyStatsGranularityHour
+	case vacancyapi.VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_DAY:
+		return vacancyController.VacancyStatsGranularityDay
+	default:
+		return vacancyController.VacancyStatsGranularity(g.String())
+	}
+}
+
+func toControllerOptionalTime(ts *timestamp.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &t, nil
+}
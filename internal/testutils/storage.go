@@ -5,12 +5,15 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	natsServer "github.com/nats-io/nats-server/v2/server"
+	natsTest "github.com/nats-io/nats-server/v2/test"
 	sqlMigrate "github.com/rubenv/sql-migrate"
 	"github.com/stretchr/testify/require"
 
 	"personaapp/internal/controllers/auth/storage"
 	"personaapp/internal/migrate"
 	"personaapp/pkg/dockertest"
+	"personaapp/pkg/nats"
 	"personaapp/pkg/postgresql"
 )
 
@@ -49,3 +52,23 @@ func InitAuthStorage(t *testing.T) (_ *storage.Storage, closer func() error) {
 
 	return storage.New(pg), pg.Close
 }
+
+// RunNats starts an in-process nats server on a random port and connects the bus to it. The closer closes
+// the bus and shuts the server down.
+func RunNats(t *testing.T) (_ *nats.Bus, addr string, closer func()) {
+	opts := natsTest.DefaultTestOptions
+	opts.Port = natsServer.RANDOM_PORT
+
+	srv := natsTest.RunServer(&opts)
+
+	bus, err := nats.New(nats.Config{Addr: srv.ClientURL()})
+	if err != nil {
+		srv.Shutdown()
+	}
+	require.NoError(t, err)
+
+	return bus, srv.ClientURL(), func() {
+		bus.Close()
+		srv.Shutdown()
+	}
+}
@@ -0,0 +1,3134 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: application/application.proto
+
+package personaappapi_application
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Entity
+type SalaryPeriod int32
+
+const (
+	SalaryPeriod_SALARY_PERIOD_UNKNOWN SalaryPeriod = 0
+	SalaryPeriod_SALARY_PERIOD_HOUR    SalaryPeriod = 1
+	SalaryPeriod_SALARY_PERIOD_MONTH   SalaryPeriod = 2
+	SalaryPeriod_SALARY_PERIOD_YEAR    SalaryPeriod = 3
+)
+
+// Enum value maps for SalaryPeriod.
+var (
+	SalaryPeriod_name = map[int32]string{
+		0: "SALARY_PERIOD_UNKNOWN",
+		1: "SALARY_PERIOD_HOUR",
+		2: "SALARY_PERIOD_MONTH",
+		3: "SALARY_PERIOD_YEAR",
+	}
+	SalaryPeriod_value = map[string]int32{
+		"SALARY_PERIOD_UNKNOWN": 0,
+		"SALARY_PERIOD_HOUR":    1,
+		"SALARY_PERIOD_MONTH":   2,
+		"SALARY_PERIOD_YEAR":    3,
+	}
+)
+
+func (x SalaryPeriod) Enum() *SalaryPeriod {
+	p := new(SalaryPeriod)
+	*p = x
+	return p
+}
+
+func (x SalaryPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SalaryPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_application_application_proto_enumTypes[0].Descriptor()
+}
+
+func (SalaryPeriod) Type() protoreflect.EnumType {
+	return &file_application_application_proto_enumTypes[0]
+}
+
+func (x SalaryPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SalaryPeriod.Descriptor instead.
+func (SalaryPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{0}
+}
+
+type ApplicationStatus int32
+
+const (
+	ApplicationStatus_APPLICATION_STATUS_UNKNOWN   ApplicationStatus = 0
+	ApplicationStatus_APPLICATION_STATUS_SUBMITTED ApplicationStatus = 1
+	ApplicationStatus_APPLICATION_STATUS_WITHDRAWN ApplicationStatus = 2
+)
+
+// Enum value maps for ApplicationStatus.
+var (
+	ApplicationStatus_name = map[int32]string{
+		0: "APPLICATION_STATUS_UNKNOWN",
+		1: "APPLICATION_STATUS_SUBMITTED",
+		2: "APPLICATION_STATUS_WITHDRAWN",
+	}
+	ApplicationStatus_value = map[string]int32{
+		"APPLICATION_STATUS_UNKNOWN":   0,
+		"APPLICATION_STATUS_SUBMITTED": 1,
+		"APPLICATION_STATUS_WITHDRAWN": 2,
+	}
+)
+
+func (x ApplicationStatus) Enum() *ApplicationStatus {
+	p := new(ApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x ApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_application_application_proto_enumTypes[1].Descriptor()
+}
+
+func (ApplicationStatus) Type() protoreflect.EnumType {
+	return &file_application_application_proto_enumTypes[1]
+}
+
+func (x ApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationStatus.Descriptor instead.
+func (ApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{1}
+}
+
+type PipelineStageKind int32
+
+const (
+	PipelineStageKind_PIPELINE_STAGE_KIND_UNKNOWN   PipelineStageKind = 0
+	PipelineStageKind_PIPELINE_STAGE_KIND_NEW       PipelineStageKind = 1
+	PipelineStageKind_PIPELINE_STAGE_KIND_SCREENING PipelineStageKind = 2
+	PipelineStageKind_PIPELINE_STAGE_KIND_INTERVIEW PipelineStageKind = 3
+	PipelineStageKind_PIPELINE_STAGE_KIND_OFFER     PipelineStageKind = 4
+	PipelineStageKind_PIPELINE_STAGE_KIND_HIRED     PipelineStageKind = 5
+	PipelineStageKind_PIPELINE_STAGE_KIND_REJECTED  PipelineStageKind = 6
+)
+
+// Enum value maps for PipelineStageKind.
+var (
+	PipelineStageKind_name = map[int32]string{
+		0: "PIPELINE_STAGE_KIND_UNKNOWN",
+		1: "PIPELINE_STAGE_KIND_NEW",
+		2: "PIPELINE_STAGE_KIND_SCREENING",
+		3: "PIPELINE_STAGE_KIND_INTERVIEW",
+		4: "PIPELINE_STAGE_KIND_OFFER",
+		5: "PIPELINE_STAGE_KIND_HIRED",
+		6: "PIPELINE_STAGE_KIND_REJECTED",
+	}
+	PipelineStageKind_value = map[string]int32{
+		"PIPELINE_STAGE_KIND_UNKNOWN":   0,
+		"PIPELINE_STAGE_KIND_NEW":       1,
+		"PIPELINE_STAGE_KIND_SCREENING": 2,
+		"PIPELINE_STAGE_KIND_INTERVIEW": 3,
+		"PIPELINE_STAGE_KIND_OFFER":     4,
+		"PIPELINE_STAGE_KIND_HIRED":     5,
+		"PIPELINE_STAGE_KIND_REJECTED":  6,
+	}
+)
+
+func (x PipelineStageKind) Enum() *PipelineStageKind {
+	p := new(PipelineStageKind)
+	*p = x
+	return p
+}
+
+func (x PipelineStageKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PipelineStageKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_application_application_proto_enumTypes[2].Descriptor()
+}
+
+func (PipelineStageKind) Type() protoreflect.EnumType {
+	return &file_application_application_proto_enumTypes[2]
+}
+
+func (x PipelineStageKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PipelineStageKind.Descriptor instead.
+func (PipelineStageKind) EnumDescriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{2}
+}
+
+// Apply to vacancy
+type ApplyToVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	CvId      string `protobuf:"bytes,2,opt,name=cv_id,json=cvId,proto3" json:"cv_id,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ApplyToVacancyRequest) Reset() {
+	*x = ApplyToVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyToVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToVacancyRequest) ProtoMessage() {}
+
+func (x *ApplyToVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToVacancyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyToVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplyToVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *ApplyToVacancyRequest) GetCvId() string {
+	if x != nil {
+		return x.CvId
+	}
+	return ""
+}
+
+func (x *ApplyToVacancyRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApplyToVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApplyToVacancyResponse) Reset() {
+	*x = ApplyToVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyToVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToVacancyResponse) ProtoMessage() {}
+
+func (x *ApplyToVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToVacancyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyToVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplyToVacancyResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Withdraw application
+type WithdrawApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WithdrawApplicationRequest) Reset() {
+	*x = WithdrawApplicationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawApplicationRequest) ProtoMessage() {}
+
+func (x *WithdrawApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawApplicationRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WithdrawApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WithdrawApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WithdrawApplicationResponse) Reset() {
+	*x = WithdrawApplicationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawApplicationResponse) ProtoMessage() {}
+
+func (x *WithdrawApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawApplicationResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{3}
+}
+
+// List my applications
+type ListMyApplicationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor *wrappers.StringValue `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListMyApplicationsRequest) Reset() {
+	*x = ListMyApplicationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyApplicationsRequest) ProtoMessage() {}
+
+func (x *ListMyApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMyApplicationsRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListMyApplicationsRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListMyApplicationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applications []*ListMyApplicationsResponse_MyApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Cursor       *wrappers.StringValue                       `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListMyApplicationsResponse) Reset() {
+	*x = ListMyApplicationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyApplicationsResponse) ProtoMessage() {}
+
+func (x *ListMyApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMyApplicationsResponse) GetApplications() []*ListMyApplicationsResponse_MyApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListMyApplicationsResponse) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// List vacancy applicants
+type ListVacancyApplicantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string                `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Cursor    *wrappers.StringValue `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count     *wrappers.Int32Value  `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListVacancyApplicantsRequest) Reset() {
+	*x = ListVacancyApplicantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyApplicantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyApplicantsRequest) ProtoMessage() {}
+
+func (x *ListVacancyApplicantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyApplicantsRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyApplicantsRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListVacancyApplicantsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *ListVacancyApplicantsRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListVacancyApplicantsRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListVacancyApplicantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applicants []*Applicant          `protobuf:"bytes,1,rep,name=applicants,proto3" json:"applicants,omitempty"`
+	Cursor     *wrappers.StringValue `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListVacancyApplicantsResponse) Reset() {
+	*x = ListVacancyApplicantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyApplicantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyApplicantsResponse) ProtoMessage() {}
+
+func (x *ListVacancyApplicantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyApplicantsResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyApplicantsResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListVacancyApplicantsResponse) GetApplicants() []*Applicant {
+	if x != nil {
+		return x.Applicants
+	}
+	return nil
+}
+
+func (x *ListVacancyApplicantsResponse) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// Get pipeline stages
+type GetPipelineStagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPipelineStagesRequest) Reset() {
+	*x = GetPipelineStagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineStagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineStagesRequest) ProtoMessage() {}
+
+func (x *GetPipelineStagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineStagesRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineStagesRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{8}
+}
+
+type GetPipelineStagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stages []*PipelineStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+}
+
+func (x *GetPipelineStagesResponse) Reset() {
+	*x = GetPipelineStagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineStagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineStagesResponse) ProtoMessage() {}
+
+func (x *GetPipelineStagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineStagesResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineStagesResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPipelineStagesResponse) GetStages() []*PipelineStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+// Update pipeline stages
+type UpdatePipelineStagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stages in their order, a stage without an id is created and the missing ones are deleted.
+	// Exactly one stage of the new, hired and rejected kinds each is required
+	Stages []*PipelineStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+}
+
+func (x *UpdatePipelineStagesRequest) Reset() {
+	*x = UpdatePipelineStagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePipelineStagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineStagesRequest) ProtoMessage() {}
+
+func (x *UpdatePipelineStagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineStagesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineStagesRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdatePipelineStagesRequest) GetStages() []*PipelineStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+type UpdatePipelineStagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stages []*PipelineStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+}
+
+func (x *UpdatePipelineStagesResponse) Reset() {
+	*x = UpdatePipelineStagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePipelineStagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePipelineStagesResponse) ProtoMessage() {}
+
+func (x *UpdatePipelineStagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePipelineStagesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePipelineStagesResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdatePipelineStagesResponse) GetStages() []*PipelineStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+// Move application
+type MoveApplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds []string `protobuf:"bytes,1,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	StageId        string   `protobuf:"bytes,2,opt,name=stage_id,json=stageId,proto3" json:"stage_id,omitempty"`
+	// Only for a move to the rejected stage
+	RejectionReason string `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	NotifyCandidate bool   `protobuf:"varint,4,opt,name=notify_candidate,json=notifyCandidate,proto3" json:"notify_candidate,omitempty"`
+	// Go text/template of the notification, may refer to {{.CandidateName}}, {{.VacancyTitle}},
+	// {{.CompanyTitle}}, {{.StageName}} and {{.RejectionReason}}. The default one of the stage is used when empty
+	MessageTemplate string `protobuf:"bytes,5,opt,name=message_template,json=messageTemplate,proto3" json:"message_template,omitempty"`
+}
+
+func (x *MoveApplicationRequest) Reset() {
+	*x = MoveApplicationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveApplicationRequest) ProtoMessage() {}
+
+func (x *MoveApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveApplicationRequest.ProtoReflect.Descriptor instead.
+func (*MoveApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveApplicationRequest) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *MoveApplicationRequest) GetStageId() string {
+	if x != nil {
+		return x.StageId
+	}
+	return ""
+}
+
+func (x *MoveApplicationRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *MoveApplicationRequest) GetNotifyCandidate() bool {
+	if x != nil {
+		return x.NotifyCandidate
+	}
+	return false
+}
+
+func (x *MoveApplicationRequest) GetMessageTemplate() string {
+	if x != nil {
+		return x.MessageTemplate
+	}
+	return ""
+}
+
+type MoveApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveApplicationResponse) Reset() {
+	*x = MoveApplicationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveApplicationResponse) ProtoMessage() {}
+
+func (x *MoveApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveApplicationResponse.ProtoReflect.Descriptor instead.
+func (*MoveApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{13}
+}
+
+// Get pipeline
+type GetPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	// Applicants listed per stage
+	Count *wrappers.Int32Value `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetPipelineRequest) Reset() {
+	*x = GetPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineRequest) ProtoMessage() {}
+
+func (x *GetPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPipelineRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetPipelineRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type GetPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Columns []*GetPipelineResponse_Column `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *GetPipelineResponse) Reset() {
+	*x = GetPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineResponse) ProtoMessage() {}
+
+func (x *GetPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPipelineResponse) GetColumns() []*GetPipelineResponse_Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// Get application history
+type GetApplicationHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+}
+
+func (x *GetApplicationHistoryRequest) Reset() {
+	*x = GetApplicationHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHistoryRequest) ProtoMessage() {}
+
+func (x *GetApplicationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetApplicationHistoryRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type GetApplicationHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Moves []*GetApplicationHistoryResponse_Move `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+}
+
+func (x *GetApplicationHistoryResponse) Reset() {
+	*x = GetApplicationHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHistoryResponse) ProtoMessage() {}
+
+func (x *GetApplicationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetApplicationHistoryResponse) GetMoves() []*GetApplicationHistoryResponse_Move {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type PipelineStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind PipelineStageKind `protobuf:"varint,3,opt,name=kind,proto3,enum=personaappapi.application.PipelineStageKind" json:"kind,omitempty"`
+}
+
+func (x *PipelineStage) Reset() {
+	*x = PipelineStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStage) ProtoMessage() {}
+
+func (x *PipelineStage) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStage.ProtoReflect.Descriptor instead.
+func (*PipelineStage) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PipelineStage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PipelineStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStage) GetKind() PipelineStageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PipelineStageKind_PIPELINE_STAGE_KIND_UNKNOWN
+}
+
+type Applicant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application      *Application   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	PersonaName      string         `protobuf:"bytes,2,opt,name=persona_name,json=personaName,proto3" json:"persona_name,omitempty"`
+	PersonaAvatarUrl string         `protobuf:"bytes,3,opt,name=persona_avatar_url,json=personaAvatarUrl,proto3" json:"persona_avatar_url,omitempty"`
+	Stage            *PipelineStage `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+}
+
+func (x *Applicant) Reset() {
+	*x = Applicant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Applicant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Applicant) ProtoMessage() {}
+
+func (x *Applicant) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Applicant.ProtoReflect.Descriptor instead.
+func (*Applicant) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Applicant) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *Applicant) GetPersonaName() string {
+	if x != nil {
+		return x.PersonaName
+	}
+	return ""
+}
+
+func (x *Applicant) GetPersonaAvatarUrl() string {
+	if x != nil {
+		return x.PersonaAvatarUrl
+	}
+	return ""
+}
+
+func (x *Applicant) GetStage() *PipelineStage {
+	if x != nil {
+		return x.Stage
+	}
+	return nil
+}
+
+type Application struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VacancyId string `protobuf:"bytes,2,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	PersonaId string `protobuf:"bytes,3,opt,name=persona_id,json=personaId,proto3" json:"persona_id,omitempty"`
+	// Empty when the cv was deleted since, the snapshot is kept
+	CvId        string               `protobuf:"bytes,4,opt,name=cv_id,json=cvId,proto3" json:"cv_id,omitempty"`
+	Cv          *CVSnapshot          `protobuf:"bytes,5,opt,name=cv,proto3" json:"cv,omitempty"`
+	Message     string               `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Status      ApplicationStatus    `protobuf:"varint,7,opt,name=status,proto3,enum=personaappapi.application.ApplicationStatus" json:"status,omitempty"`
+	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WithdrawnAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=withdrawn_at,json=withdrawnAt,proto3" json:"withdrawn_at,omitempty"`
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Application) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Application) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *Application) GetPersonaId() string {
+	if x != nil {
+		return x.PersonaId
+	}
+	return ""
+}
+
+func (x *Application) GetCvId() string {
+	if x != nil {
+		return x.CvId
+	}
+	return ""
+}
+
+func (x *Application) GetCv() *CVSnapshot {
+	if x != nil {
+		return x.Cv
+	}
+	return nil
+}
+
+func (x *Application) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Application) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_UNKNOWN
+}
+
+func (x *Application) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Application) GetWithdrawnAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.WithdrawnAt
+	}
+	return nil
+}
+
+// The cv as it was when the persona applied
+type CVSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position             string                   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	WorkMonthsExperience int32                    `protobuf:"varint,2,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
+	MinSalary            int32                    `protobuf:"varint,3,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary            int32                    `protobuf:"varint,4,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	JobTypes             []string                 `protobuf:"bytes,5,rep,name=job_types,json=jobTypes,proto3" json:"job_types,omitempty"`
+	JobKinds             []string                 `protobuf:"bytes,6,rep,name=job_kinds,json=jobKinds,proto3" json:"job_kinds,omitempty"`
+	Experiences          []*CVSnapshot_Experience `protobuf:"bytes,7,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Educations           []*CVSnapshot_Education  `protobuf:"bytes,8,rep,name=educations,proto3" json:"educations,omitempty"`
+	CustomSections       []string                 `protobuf:"bytes,9,rep,name=custom_sections,json=customSections,proto3" json:"custom_sections,omitempty"`
+	UpdatedAt            *timestamp.Timestamp     `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// ISO 4217 code of the salary range
+	Currency     string       `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	SalaryPeriod SalaryPeriod `protobuf:"varint,12,opt,name=salary_period,json=salaryPeriod,proto3,enum=personaappapi.application.SalaryPeriod" json:"salary_period,omitempty"`
+	// Whether the salary is before taxes, not specified when unset
+	SalaryGross *wrappers.BoolValue `protobuf:"bytes,13,opt,name=salary_gross,json=salaryGross,proto3" json:"salary_gross,omitempty"`
+}
+
+func (x *CVSnapshot) Reset() {
+	*x = CVSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CVSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CVSnapshot) ProtoMessage() {}
+
+func (x *CVSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CVSnapshot.ProtoReflect.Descriptor instead.
+func (*CVSnapshot) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CVSnapshot) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *CVSnapshot) GetWorkMonthsExperience() int32 {
+	if x != nil {
+		return x.WorkMonthsExperience
+	}
+	return 0
+}
+
+func (x *CVSnapshot) GetMinSalary() int32 {
+	if x != nil {
+		return x.MinSalary
+	}
+	return 0
+}
+
+func (x *CVSnapshot) GetMaxSalary() int32 {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return 0
+}
+
+func (x *CVSnapshot) GetJobTypes() []string {
+	if x != nil {
+		return x.JobTypes
+	}
+	return nil
+}
+
+func (x *CVSnapshot) GetJobKinds() []string {
+	if x != nil {
+		return x.JobKinds
+	}
+	return nil
+}
+
+func (x *CVSnapshot) GetExperiences() []*CVSnapshot_Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *CVSnapshot) GetEducations() []*CVSnapshot_Education {
+	if x != nil {
+		return x.Educations
+	}
+	return nil
+}
+
+func (x *CVSnapshot) GetCustomSections() []string {
+	if x != nil {
+		return x.CustomSections
+	}
+	return nil
+}
+
+func (x *CVSnapshot) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CVSnapshot) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CVSnapshot) GetSalaryPeriod() SalaryPeriod {
+	if x != nil {
+		return x.SalaryPeriod
+	}
+	return SalaryPeriod_SALARY_PERIOD_UNKNOWN
+}
+
+func (x *CVSnapshot) GetSalaryGross() *wrappers.BoolValue {
+	if x != nil {
+		return x.SalaryGross
+	}
+	return nil
+}
+
+type ListMyApplicationsResponse_MyApplication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Application  *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	VacancyTitle string       `protobuf:"bytes,2,opt,name=vacancy_title,json=vacancyTitle,proto3" json:"vacancy_title,omitempty"`
+	CompanyId    string       `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CompanyTitle string       `protobuf:"bytes,4,opt,name=company_title,json=companyTitle,proto3" json:"company_title,omitempty"`
+}
+
+func (x *ListMyApplicationsResponse_MyApplication) Reset() {
+	*x = ListMyApplicationsResponse_MyApplication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyApplicationsResponse_MyApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyApplicationsResponse_MyApplication) ProtoMessage() {}
+
+func (x *ListMyApplicationsResponse_MyApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyApplicationsResponse_MyApplication.ProtoReflect.Descriptor instead.
+func (*ListMyApplicationsResponse_MyApplication) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ListMyApplicationsResponse_MyApplication) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ListMyApplicationsResponse_MyApplication) GetVacancyTitle() string {
+	if x != nil {
+		return x.VacancyTitle
+	}
+	return ""
+}
+
+func (x *ListMyApplicationsResponse_MyApplication) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListMyApplicationsResponse_MyApplication) GetCompanyTitle() string {
+	if x != nil {
+		return x.CompanyTitle
+	}
+	return ""
+}
+
+type GetPipelineResponse_Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage      *PipelineStage `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Count      int32          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Applicants []*Applicant   `protobuf:"bytes,3,rep,name=applicants,proto3" json:"applicants,omitempty"`
+}
+
+func (x *GetPipelineResponse_Column) Reset() {
+	*x = GetPipelineResponse_Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineResponse_Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineResponse_Column) ProtoMessage() {}
+
+func (x *GetPipelineResponse_Column) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineResponse_Column.ProtoReflect.Descriptor instead.
+func (*GetPipelineResponse_Column) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetPipelineResponse_Column) GetStage() *PipelineStage {
+	if x != nil {
+		return x.Stage
+	}
+	return nil
+}
+
+func (x *GetPipelineResponse_Column) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetPipelineResponse_Column) GetApplicants() []*Applicant {
+	if x != nil {
+		return x.Applicants
+	}
+	return nil
+}
+
+type GetApplicationHistoryResponse_Move struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for the first move into the pipeline
+	FromStageId   string `protobuf:"bytes,1,opt,name=from_stage_id,json=fromStageId,proto3" json:"from_stage_id,omitempty"`
+	FromStageName string `protobuf:"bytes,2,opt,name=from_stage_name,json=fromStageName,proto3" json:"from_stage_name,omitempty"`
+	// Empty when the stage was deleted since
+	ToStageId       string               `protobuf:"bytes,3,opt,name=to_stage_id,json=toStageId,proto3" json:"to_stage_id,omitempty"`
+	ToStageName     string               `protobuf:"bytes,4,opt,name=to_stage_name,json=toStageName,proto3" json:"to_stage_name,omitempty"`
+	RejectionReason string               `protobuf:"bytes,5,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	MovedBy         string               `protobuf:"bytes,6,opt,name=moved_by,json=movedBy,proto3" json:"moved_by,omitempty"`
+	CreatedAt       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetApplicationHistoryResponse_Move) Reset() {
+	*x = GetApplicationHistoryResponse_Move{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationHistoryResponse_Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHistoryResponse_Move) ProtoMessage() {}
+
+func (x *GetApplicationHistoryResponse_Move) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHistoryResponse_Move.ProtoReflect.Descriptor instead.
+func (*GetApplicationHistoryResponse_Move) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetFromStageId() string {
+	if x != nil {
+		return x.FromStageId
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetFromStageName() string {
+	if x != nil {
+		return x.FromStageName
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetToStageId() string {
+	if x != nil {
+		return x.ToStageId
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetToStageName() string {
+	if x != nil {
+		return x.ToStageName
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetMovedBy() string {
+	if x != nil {
+		return x.MovedBy
+	}
+	return ""
+}
+
+func (x *GetApplicationHistoryResponse_Move) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CVSnapshot_Experience struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyName string               `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	DateFrom    *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTill    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_till,json=dateTill,proto3" json:"date_till,omitempty"`
+	Position    string               `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Description string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CVSnapshot_Experience) Reset() {
+	*x = CVSnapshot_Experience{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CVSnapshot_Experience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CVSnapshot_Experience) ProtoMessage() {}
+
+func (x *CVSnapshot_Experience) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CVSnapshot_Experience.ProtoReflect.Descriptor instead.
+func (*CVSnapshot_Experience) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *CVSnapshot_Experience) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *CVSnapshot_Experience) GetDateFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *CVSnapshot_Experience) GetDateTill() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateTill
+	}
+	return nil
+}
+
+func (x *CVSnapshot_Experience) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *CVSnapshot_Experience) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CVSnapshot_Education struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Institution string               `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	DateFrom    *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTill    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_till,json=dateTill,proto3" json:"date_till,omitempty"`
+	Speciality  string               `protobuf:"bytes,4,opt,name=speciality,proto3" json:"speciality,omitempty"`
+	Description string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CVSnapshot_Education) Reset() {
+	*x = CVSnapshot_Education{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_application_application_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CVSnapshot_Education) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CVSnapshot_Education) ProtoMessage() {}
+
+func (x *CVSnapshot_Education) ProtoReflect() protoreflect.Message {
+	mi := &file_application_application_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CVSnapshot_Education.ProtoReflect.Descriptor instead.
+func (*CVSnapshot_Education) Descriptor() ([]byte, []int) {
+	return file_application_application_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *CVSnapshot_Education) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *CVSnapshot_Education) GetDateFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *CVSnapshot_Education) GetDateTill() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateTill
+	}
+	return nil
+}
+
+func (x *CVSnapshot_Education) GetSpeciality() string {
+	if x != nil {
+		return x.Speciality
+	}
+	return ""
+}
+
+func (x *CVSnapshot_Education) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_application_application_proto protoreflect.FileDescriptor
+
+var file_application_application_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x19, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x65, 0x0a, 0x15, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x63, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x76, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x1a,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x80, 0x03, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x79, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xc2,
+	0x01, 0x0a, 0x0d, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a,
+	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x76,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x6f, 0x76, 0x65,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8d, 0x02, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x1a, 0xa4, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12,
+	0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x8e, 0x03, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x1a, 0x97, 0x02, 0x0a, 0x04, 0x4d, 0x6f,
+	0x76, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x09, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x55, 0x72, 0x6c, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x22, 0x81, 0x03, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x49,
+	0x64, 0x12, 0x13, 0x0a, 0x05, 0x63, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x76, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x02, 0x63, 0x76, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x56, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x02, 0x63, 0x76, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x6e, 0x41, 0x74, 0x22, 0xce, 0x08, 0x0a, 0x0a, 0x43, 0x56, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x73, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73,
+	0x12, 0x52, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x56, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x65, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x56, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
+	0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x64, 0x75, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x72,
+	0x6f, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x47, 0x72, 0x6f,
+	0x73, 0x73, 0x1a, 0xdf, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x37, 0x0a,
+	0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xe1, 0x01, 0x0a, 0x09, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x37, 0x0a,
+	0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x72, 0x0a, 0x0c, 0x53, 0x61, 0x6c, 0x61,
+	0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x4c, 0x41,
+	0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x45,
+	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e,
+	0x54, 0x48, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50,
+	0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x03, 0x2a, 0x77, 0x0a, 0x11,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52,
+	0x41, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0xf7, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x50,
+	0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x49, 0x50,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d,
+	0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x49, 0x45, 0x57, 0x10, 0x03, 0x12,
+	0x1d, 0x0a, 0x19, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1d,
+	0x0a, 0x19, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x32,
+	0xa6, 0x09, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41, 0x70, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x75, 0x0a, 0x0e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x54, 0x6f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x30, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x6f,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x54, 0x6f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x38, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x33,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x36, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2d, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x24, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x42, 0x0f, 0x47,
+	0x72, 0x70, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_application_application_proto_rawDescOnce sync.Once
+	file_application_application_proto_rawDescData = file_application_application_proto_rawDesc
+)
+
+func file_application_application_proto_rawDescGZIP() []byte {
+	file_application_application_proto_rawDescOnce.Do(func() {
+		file_application_application_proto_rawDescData = protoimpl.X.CompressGZIP(file_application_application_proto_rawDescData)
+	})
+	return file_application_application_proto_rawDescData
+}
+
+var file_application_application_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_application_application_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_application_application_proto_goTypes = []interface{}{
+	(SalaryPeriod)(0),                                // 0: personaappapi.application.SalaryPeriod
+	(ApplicationStatus)(0),                           // 1: personaappapi.application.ApplicationStatus
+	(PipelineStageKind)(0),                           // 2: personaappapi.application.PipelineStageKind
+	(*ApplyToVacancyRequest)(nil),                    // 3: personaappapi.application.ApplyToVacancyRequest
+	(*ApplyToVacancyResponse)(nil),                   // 4: personaappapi.application.ApplyToVacancyResponse
+	(*WithdrawApplicationRequest)(nil),               // 5: personaappapi.application.WithdrawApplicationRequest
+	(*WithdrawApplicationResponse)(nil),              // 6: personaappapi.application.WithdrawApplicationResponse
+	(*ListMyApplicationsRequest)(nil),                // 7: personaappapi.application.ListMyApplicationsRequest
+	(*ListMyApplicationsResponse)(nil),               // 8: personaappapi.application.ListMyApplicationsResponse
+	(*ListVacancyApplicantsRequest)(nil),             // 9: personaappapi.application.ListVacancyApplicantsRequest
+	(*ListVacancyApplicantsResponse)(nil),            // 10: personaappapi.application.ListVacancyApplicantsResponse
+	(*GetPipelineStagesRequest)(nil),                 // 11: personaappapi.application.GetPipelineStagesRequest
+	(*GetPipelineStagesResponse)(nil),                // 12: personaappapi.application.GetPipelineStagesResponse
+	(*UpdatePipelineStagesRequest)(nil),              // 13: personaappapi.application.UpdatePipelineStagesRequest
+	(*UpdatePipelineStagesResponse)(nil),             // 14: personaappapi.application.UpdatePipelineStagesResponse
+	(*MoveApplicationRequest)(nil),                   // 15: personaappapi.application.MoveApplicationRequest
+	(*MoveApplicationResponse)(nil),                  // 16: personaappapi.application.MoveApplicationResponse
+	(*GetPipelineRequest)(nil),                       // 17: personaappapi.application.GetPipelineRequest
+	(*GetPipelineResponse)(nil),                      // 18: personaappapi.application.GetPipelineResponse
+	(*GetApplicationHistoryRequest)(nil),             // 19: personaappapi.application.GetApplicationHistoryRequest
+	(*GetApplicationHistoryResponse)(nil),            // 20: personaappapi.application.GetApplicationHistoryResponse
+	(*PipelineStage)(nil),                            // 21: personaappapi.application.PipelineStage
+	(*Applicant)(nil),                                // 22: personaappapi.application.Applicant
+	(*Application)(nil),                              // 23: personaappapi.application.Application
+	(*CVSnapshot)(nil),                               // 24: personaappapi.application.CVSnapshot
+	(*ListMyApplicationsResponse_MyApplication)(nil), // 25: personaappapi.application.ListMyApplicationsResponse.MyApplication
+	(*GetPipelineResponse_Column)(nil),               // 26: personaappapi.application.GetPipelineResponse.Column
+	(*GetApplicationHistoryResponse_Move)(nil),       // 27: personaappapi.application.GetApplicationHistoryResponse.Move
+	(*CVSnapshot_Experience)(nil),                    // 28: personaappapi.application.CVSnapshot.Experience
+	(*CVSnapshot_Education)(nil),                     // 29: personaappapi.application.CVSnapshot.Education
+	(*wrappers.StringValue)(nil),                     // 30: google.protobuf.StringValue
+	(*wrappers.Int32Value)(nil),                      // 31: google.protobuf.Int32Value
+	(*timestamp.Timestamp)(nil),                      // 32: google.protobuf.Timestamp
+	(*wrappers.BoolValue)(nil),                       // 33: google.protobuf.BoolValue
+}
+var file_application_application_proto_depIdxs = []int32{
+	30, // 0: personaappapi.application.ListMyApplicationsRequest.cursor:type_name -> google.protobuf.StringValue
+	31, // 1: personaappapi.application.ListMyApplicationsRequest.count:type_name -> google.protobuf.Int32Value
+	25, // 2: personaappapi.application.ListMyApplicationsResponse.applications:type_name -> personaappapi.application.ListMyApplicationsResponse.MyApplication
+	30, // 3: personaappapi.application.ListMyApplicationsResponse.cursor:type_name -> google.protobuf.StringValue
+	30, // 4: personaappapi.application.ListVacancyApplicantsRequest.cursor:type_name -> google.protobuf.StringValue
+	31, // 5: personaappapi.application.ListVacancyApplicantsRequest.count:type_name -> google.protobuf.Int32Value
+	22, // 6: personaappapi.application.ListVacancyApplicantsResponse.applicants:type_name -> personaappapi.application.Applicant
+	30, // 7: personaappapi.application.ListVacancyApplicantsResponse.cursor:type_name -> google.protobuf.StringValue
+	21, // 8: personaappapi.application.GetPipelineStagesResponse.stages:type_name -> personaappapi.application.PipelineStage
+	21, // 9: personaappapi.application.UpdatePipelineStagesRequest.stages:type_name -> personaappapi.application.PipelineStage
+	21, // 10: personaappapi.application.UpdatePipelineStagesResponse.stages:type_name -> personaappapi.application.PipelineStage
+	31, // 11: personaappapi.application.GetPipelineRequest.count:type_name -> google.protobuf.Int32Value
+	26, // 12: personaappapi.application.GetPipelineResponse.columns:type_name -> personaappapi.application.GetPipelineResponse.Column
+	27, // 13: personaappapi.application.GetApplicationHistoryResponse.moves:type_name -> personaappapi.application.GetApplicationHistoryResponse.Move
+	2,  // 14: personaappapi.application.PipelineStage.kind:type_name -> personaappapi.application.PipelineStageKind
+	23, // 15: personaappapi.application.Applicant.application:type_name -> personaappapi.application.Application
+	21, // 16: personaappapi.application.Applicant.stage:type_name -> personaappapi.application.PipelineStage
+	24, // 17: personaappapi.application.Application.cv:type_name -> personaappapi.application.CVSnapshot
+	1,  // 18: personaappapi.application.Application.status:type_name -> personaappapi.application.ApplicationStatus
+	32, // 19: personaappapi.application.Application.created_at:type_name -> google.protobuf.Timestamp
+	32, // 20: personaappapi.application.Application.withdrawn_at:type_name -> google.protobuf.Timestamp
+	28, // 21: personaappapi.application.CVSnapshot.experiences:type_name -> personaappapi.application.CVSnapshot.Experience
+	29, // 22: personaappapi.application.CVSnapshot.educations:type_name -> personaappapi.application.CVSnapshot.Education
+	32, // 23: personaappapi.application.CVSnapshot.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 24: personaappapi.application.CVSnapshot.salary_period:type_name -> personaappapi.application.SalaryPeriod
+	33, // 25: personaappapi.application.CVSnapshot.salary_gross:type_name -> google.protobuf.BoolValue
+	23, // 26: personaappapi.application.ListMyApplicationsResponse.MyApplication.application:type_name -> personaappapi.application.Application
+	21, // 27: personaappapi.application.GetPipelineResponse.Column.stage:type_name -> personaappapi.application.PipelineStage
+	22, // 28: personaappapi.application.GetPipelineResponse.Column.applicants:type_name -> personaappapi.application.Applicant
+	32, // 29: personaappapi.application.GetApplicationHistoryResponse.Move.created_at:type_name -> google.protobuf.Timestamp
+	32, // 30: personaappapi.application.CVSnapshot.Experience.date_from:type_name -> google.protobuf.Timestamp
+	32, // 31: personaappapi.application.CVSnapshot.Experience.date_till:type_name -> google.protobuf.Timestamp
+	32, // 32: personaappapi.application.CVSnapshot.Education.date_from:type_name -> google.protobuf.Timestamp
+	32, // 33: personaappapi.application.CVSnapshot.Education.date_till:type_name -> google.protobuf.Timestamp
+	3,  // 34: personaappapi.application.PersonaAppApplications.ApplyToVacancy:input_type -> personaappapi.application.ApplyToVacancyRequest
+	5,  // 35: personaappapi.application.PersonaAppApplications.WithdrawApplication:input_type -> personaappapi.application.WithdrawApplicationRequest
+	7,  // 36: personaappapi.application.PersonaAppApplications.ListMyApplications:input_type -> personaappapi.application.ListMyApplicationsRequest
+	9,  // 37: personaappapi.application.PersonaAppApplications.ListVacancyApplicants:input_type -> personaappapi.application.ListVacancyApplicantsRequest
+	11, // 38: personaappapi.application.PersonaAppApplications.GetPipelineStages:input_type -> personaappapi.application.GetPipelineStagesRequest
+	13, // 39: personaappapi.application.PersonaAppApplications.UpdatePipelineStages:input_type -> personaappapi.application.UpdatePipelineStagesRequest
+	15, // 40: personaappapi.application.PersonaAppApplications.MoveApplication:input_type -> personaappapi.application.MoveApplicationRequest
+	17, // 41: personaappapi.application.PersonaAppApplications.GetPipeline:input_type -> personaappapi.application.GetPipelineRequest
+	19, // 42: personaappapi.application.PersonaAppApplications.GetApplicationHistory:input_type -> personaappapi.application.GetApplicationHistoryRequest
+	4,  // 43: personaappapi.application.PersonaAppApplications.ApplyToVacancy:output_type -> personaappapi.application.ApplyToVacancyResponse
+	6,  // 44: personaappapi.application.PersonaAppApplications.WithdrawApplication:output_type -> personaappapi.application.WithdrawApplicationResponse
+	8,  // 45: personaappapi.application.PersonaAppApplications.ListMyApplications:output_type -> personaappapi.application.ListMyApplicationsResponse
+	10, // 46: personaappapi.application.PersonaAppApplications.ListVacancyApplicants:output_type -> personaappapi.application.ListVacancyApplicantsResponse
+	12, // 47: personaappapi.application.PersonaAppApplications.GetPipelineStages:output_type -> personaappapi.application.GetPipelineStagesResponse
+	14, // 48: personaappapi.application.PersonaAppApplications.UpdatePipelineStages:output_type -> personaappapi.application.UpdatePipelineStagesResponse
+	16, // 49: personaappapi.application.PersonaAppApplications.MoveApplication:output_type -> personaappapi.application.MoveApplicationResponse
+	18, // 50: personaappapi.application.PersonaAppApplications.GetPipeline:output_type -> personaappapi.application.GetPipelineResponse
+	20, // 51: personaappapi.application.PersonaAppApplications.GetApplicationHistory:output_type -> personaappapi.application.GetApplicationHistoryResponse
+	43, // [43:52] is the sub-list for method output_type
+	34, // [34:43] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_application_application_proto_init() }
+func file_application_application_proto_init() {
+	if File_application_application_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_application_application_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyToVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyToVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawApplicationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawApplicationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyApplicationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyApplicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyApplicantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyApplicantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipelineStagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipelineStagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipelineStagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePipelineStagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveApplicationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveApplicationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PipelineStage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Applicant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Application); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CVSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyApplicationsResponse_MyApplication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPipelineResponse_Column); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationHistoryResponse_Move); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CVSnapshot_Experience); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_application_application_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CVSnapshot_Education); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_application_application_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_application_application_proto_goTypes,
+		DependencyIndexes: file_application_application_proto_depIdxs,
+		EnumInfos:         file_application_application_proto_enumTypes,
+		MessageInfos:      file_application_application_proto_msgTypes,
+	}.Build()
+	File_application_application_proto = out.File
+	file_application_application_proto_rawDesc = nil
+	file_application_application_proto_goTypes = nil
+	file_application_application_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PersonaAppApplicationsClient is the client API for PersonaAppApplications service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PersonaAppApplicationsClient interface {
+	// Persona
+	ApplyToVacancy(ctx context.Context, in *ApplyToVacancyRequest, opts ...grpc.CallOption) (*ApplyToVacancyResponse, error)
+	WithdrawApplication(ctx context.Context, in *WithdrawApplicationRequest, opts ...grpc.CallOption) (*WithdrawApplicationResponse, error)
+	ListMyApplications(ctx context.Context, in *ListMyApplicationsRequest, opts ...grpc.CallOption) (*ListMyApplicationsResponse, error)
+	// Company
+	ListVacancyApplicants(ctx context.Context, in *ListVacancyApplicantsRequest, opts ...grpc.CallOption) (*ListVacancyApplicantsResponse, error)
+	// Company pipeline
+	GetPipelineStages(ctx context.Context, in *GetPipelineStagesRequest, opts ...grpc.CallOption) (*GetPipelineStagesResponse, error)
+	UpdatePipelineStages(ctx context.Context, in *UpdatePipelineStagesRequest, opts ...grpc.CallOption) (*UpdatePipelineStagesResponse, error)
+	MoveApplication(ctx context.Context, in *MoveApplicationRequest, opts ...grpc.CallOption) (*MoveApplicationResponse, error)
+	GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*GetPipelineResponse, error)
+	GetApplicationHistory(ctx context.Context, in *GetApplicationHistoryRequest, opts ...grpc.CallOption) (*GetApplicationHistoryResponse, error)
+}
+
+type personaAppApplicationsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPersonaAppApplicationsClient(cc grpc.ClientConnInterface) PersonaAppApplicationsClient {
+	return &personaAppApplicationsClient{cc}
+}
+
+func (c *personaAppApplicationsClient) ApplyToVacancy(ctx context.Context, in *ApplyToVacancyRequest, opts ...grpc.CallOption) (*ApplyToVacancyResponse, error) {
+	out := new(ApplyToVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/ApplyToVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) WithdrawApplication(ctx context.Context, in *WithdrawApplicationRequest, opts ...grpc.CallOption) (*WithdrawApplicationResponse, error) {
+	out := new(WithdrawApplicationResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/WithdrawApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) ListMyApplications(ctx context.Context, in *ListMyApplicationsRequest, opts ...grpc.CallOption) (*ListMyApplicationsResponse, error) {
+	out := new(ListMyApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/ListMyApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) ListVacancyApplicants(ctx context.Context, in *ListVacancyApplicantsRequest, opts ...grpc.CallOption) (*ListVacancyApplicantsResponse, error) {
+	out := new(ListVacancyApplicantsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/ListVacancyApplicants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) GetPipelineStages(ctx context.Context, in *GetPipelineStagesRequest, opts ...grpc.CallOption) (*GetPipelineStagesResponse, error) {
+	out := new(GetPipelineStagesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/GetPipelineStages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) UpdatePipelineStages(ctx context.Context, in *UpdatePipelineStagesRequest, opts ...grpc.CallOption) (*UpdatePipelineStagesResponse, error) {
+	out := new(UpdatePipelineStagesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/UpdatePipelineStages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) MoveApplication(ctx context.Context, in *MoveApplicationRequest, opts ...grpc.CallOption) (*MoveApplicationResponse, error) {
+	out := new(MoveApplicationResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/MoveApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*GetPipelineResponse, error) {
+	out := new(GetPipelineResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/GetPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppApplicationsClient) GetApplicationHistory(ctx context.Context, in *GetApplicationHistoryRequest, opts ...grpc.CallOption) (*GetApplicationHistoryResponse, error) {
+	out := new(GetApplicationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.application.PersonaAppApplications/GetApplicationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PersonaAppApplicationsServer is the server API for PersonaAppApplications service.
+type PersonaAppApplicationsServer interface {
+	// Persona
+	ApplyToVacancy(context.Context, *ApplyToVacancyRequest) (*ApplyToVacancyResponse, error)
+	WithdrawApplication(context.Context, *WithdrawApplicationRequest) (*WithdrawApplicationResponse, error)
+	ListMyApplications(context.Context, *ListMyApplicationsRequest) (*ListMyApplicationsResponse, error)
+	// Company
+	ListVacancyApplicants(context.Context, *ListVacancyApplicantsRequest) (*ListVacancyApplicantsResponse, error)
+	// Company pipeline
+	GetPipelineStages(context.Context, *GetPipelineStagesRequest) (*GetPipelineStagesResponse, error)
+	UpdatePipelineStages(context.Context, *UpdatePipelineStagesRequest) (*UpdatePipelineStagesResponse, error)
+	MoveApplication(context.Context, *MoveApplicationRequest) (*MoveApplicationResponse, error)
+	GetPipeline(context.Context, *GetPipelineRequest) (*GetPipelineResponse, error)
+	GetApplicationHistory(context.Context, *GetApplicationHistoryRequest) (*GetApplicationHistoryResponse, error)
+}
+
+// UnimplementedPersonaAppApplicationsServer can be embedded to have forward compatible implementations.
+type UnimplementedPersonaAppApplicationsServer struct {
+}
+
+func (*UnimplementedPersonaAppApplicationsServer) ApplyToVacancy(context.Context, *ApplyToVacancyRequest) (*ApplyToVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToVacancy not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) WithdrawApplication(context.Context, *WithdrawApplicationRequest) (*WithdrawApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawApplication not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) ListMyApplications(context.Context, *ListMyApplicationsRequest) (*ListMyApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyApplications not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) ListVacancyApplicants(context.Context, *ListVacancyApplicantsRequest) (*ListVacancyApplicantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVacancyApplicants not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) GetPipelineStages(context.Context, *GetPipelineStagesRequest) (*GetPipelineStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineStages not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) UpdatePipelineStages(context.Context, *UpdatePipelineStagesRequest) (*UpdatePipelineStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePipelineStages not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) MoveApplication(context.Context, *MoveApplicationRequest) (*MoveApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveApplication not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) GetPipeline(context.Context, *GetPipelineRequest) (*GetPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipeline not implemented")
+}
+func (*UnimplementedPersonaAppApplicationsServer) GetApplicationHistory(context.Context, *GetApplicationHistoryRequest) (*GetApplicationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationHistory not implemented")
+}
+
+func RegisterPersonaAppApplicationsServer(s *grpc.Server, srv PersonaAppApplicationsServer) {
+	s.RegisterService(&_PersonaAppApplications_serviceDesc, srv)
+}
+
+func _PersonaAppApplications_ApplyToVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyToVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).ApplyToVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/ApplyToVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).ApplyToVacancy(ctx, req.(*ApplyToVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_WithdrawApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).WithdrawApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/WithdrawApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).WithdrawApplication(ctx, req.(*WithdrawApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_ListMyApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).ListMyApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/ListMyApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).ListMyApplications(ctx, req.(*ListMyApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_ListVacancyApplicants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVacancyApplicantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).ListVacancyApplicants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/ListVacancyApplicants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).ListVacancyApplicants(ctx, req.(*ListVacancyApplicantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_GetPipelineStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineStagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).GetPipelineStages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/GetPipelineStages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).GetPipelineStages(ctx, req.(*GetPipelineStagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_UpdatePipelineStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePipelineStagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).UpdatePipelineStages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/UpdatePipelineStages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).UpdatePipelineStages(ctx, req.(*UpdatePipelineStagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_MoveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).MoveApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/MoveApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).MoveApplication(ctx, req.(*MoveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/GetPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).GetPipeline(ctx, req.(*GetPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppApplications_GetApplicationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppApplicationsServer).GetApplicationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.application.PersonaAppApplications/GetApplicationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppApplicationsServer).GetApplicationHistory(ctx, req.(*GetApplicationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PersonaAppApplications_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "personaappapi.application.PersonaAppApplications",
+	HandlerType: (*PersonaAppApplicationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyToVacancy",
+			Handler:    _PersonaAppApplications_ApplyToVacancy_Handler,
+		},
+		{
+			MethodName: "WithdrawApplication",
+			Handler:    _PersonaAppApplications_WithdrawApplication_Handler,
+		},
+		{
+			MethodName: "ListMyApplications",
+			Handler:    _PersonaAppApplications_ListMyApplications_Handler,
+		},
+		{
+			MethodName: "ListVacancyApplicants",
+			Handler:    _PersonaAppApplications_ListVacancyApplicants_Handler,
+		},
+		{
+			MethodName: "GetPipelineStages",
+			Handler:    _PersonaAppApplications_GetPipelineStages_Handler,
+		},
+		{
+			MethodName: "UpdatePipelineStages",
+			Handler:    _PersonaAppApplications_UpdatePipelineStages_Handler,
+		},
+		{
+			MethodName: "MoveApplication",
+			Handler:    _PersonaAppApplications_MoveApplication_Handler,
+		},
+		{
+			MethodName: "GetPipeline",
+			Handler:    _PersonaAppApplications_GetPipeline_Handler,
+		},
+		{
+			MethodName: "GetApplicationHistory",
+			Handler:    _PersonaAppApplications_GetApplicationHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "application/application.proto",
+}
@@ -485,6 +485,266 @@ func (*DeleteActivityFieldsByCompanyIDResponse) Descriptor() ([]byte, []int) {
 	return file_company_company_proto_rawDescGZIP(), []int{9}
 }
 
+// Follow company
+// Following a company again does nothing
+type FollowCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *FollowCompanyRequest) Reset() {
+	*x = FollowCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowCompanyRequest) ProtoMessage() {}
+
+func (x *FollowCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowCompanyRequest.ProtoReflect.Descriptor instead.
+func (*FollowCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FollowCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type FollowCompanyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FollowCompanyResponse) Reset() {
+	*x = FollowCompanyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowCompanyResponse) ProtoMessage() {}
+
+func (x *FollowCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowCompanyResponse.ProtoReflect.Descriptor instead.
+func (*FollowCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{11}
+}
+
+// Unfollow company
+type UnfollowCompanyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *UnfollowCompanyRequest) Reset() {
+	*x = UnfollowCompanyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowCompanyRequest) ProtoMessage() {}
+
+func (x *UnfollowCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnfollowCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type UnfollowCompanyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnfollowCompanyResponse) Reset() {
+	*x = UnfollowCompanyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowCompanyResponse) ProtoMessage() {}
+
+func (x *UnfollowCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowCompanyResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{13}
+}
+
+// List followed companies
+// Companies followed by the persona making the request, the most recently followed first
+type ListFollowedCompaniesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFollowedCompaniesRequest) Reset() {
+	*x = ListFollowedCompaniesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowedCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedCompaniesRequest) ProtoMessage() {}
+
+func (x *ListFollowedCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowedCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{14}
+}
+
+type ListFollowedCompaniesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Companies []*ListFollowedCompaniesResponse_Company `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+}
+
+func (x *ListFollowedCompaniesResponse) Reset() {
+	*x = ListFollowedCompaniesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowedCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedCompaniesResponse) ProtoMessage() {}
+
+func (x *ListFollowedCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowedCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListFollowedCompaniesResponse) GetCompanies() []*ListFollowedCompaniesResponse_Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
 // Entities
 type Empty struct {
 	state         protoimpl.MessageState
@@ -495,7 +755,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_company_company_proto_msgTypes[10]
+		mi := &file_company_company_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -508,7 +768,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_company_company_proto_msgTypes[10]
+	mi := &file_company_company_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +781,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_company_company_proto_rawDescGZIP(), []int{10}
+	return file_company_company_proto_rawDescGZIP(), []int{16}
 }
 
 type CompanyActivityField struct {
@@ -537,7 +797,7 @@ type CompanyActivityField struct {
 func (x *CompanyActivityField) Reset() {
 	*x = CompanyActivityField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_company_company_proto_msgTypes[11]
+		mi := &file_company_company_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -550,7 +810,7 @@ func (x *CompanyActivityField) String() string {
 func (*CompanyActivityField) ProtoMessage() {}
 
 func (x *CompanyActivityField) ProtoReflect() protoreflect.Message {
-	mi := &file_company_company_proto_msgTypes[11]
+	mi := &file_company_company_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +823,7 @@ func (x *CompanyActivityField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompanyActivityField.ProtoReflect.Descriptor instead.
 func (*CompanyActivityField) Descriptor() ([]byte, []int) {
-	return file_company_company_proto_rawDescGZIP(), []int{11}
+	return file_company_company_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CompanyActivityField) GetId() string {
@@ -602,7 +862,7 @@ type GetCompanyResponse_Company struct {
 func (x *GetCompanyResponse_Company) Reset() {
 	*x = GetCompanyResponse_Company{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_company_company_proto_msgTypes[13]
+		mi := &file_company_company_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -615,7 +875,7 @@ func (x *GetCompanyResponse_Company) String() string {
 func (*GetCompanyResponse_Company) ProtoMessage() {}
 
 func (x *GetCompanyResponse_Company) ProtoReflect() protoreflect.Message {
-	mi := &file_company_company_proto_msgTypes[13]
+	mi := &file_company_company_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,6 +926,77 @@ func (x *GetCompanyResponse_Company) GetActivityFields() map[string]*CompanyActi
 	return nil
 }
 
+type ListFollowedCompaniesResponse_Company struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl     string `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+}
+
+func (x *ListFollowedCompaniesResponse_Company) Reset() {
+	*x = ListFollowedCompaniesResponse_Company{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_company_company_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowedCompaniesResponse_Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedCompaniesResponse_Company) ProtoMessage() {}
+
+func (x *ListFollowedCompaniesResponse_Company) ProtoReflect() protoreflect.Message {
+	mi := &file_company_company_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedCompaniesResponse_Company.ProtoReflect.Descriptor instead.
+func (*ListFollowedCompaniesResponse_Company) Descriptor() ([]byte, []int) {
+	return file_company_company_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ListFollowedCompaniesResponse_Company) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListFollowedCompaniesResponse_Company) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListFollowedCompaniesResponse_Company) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListFollowedCompaniesResponse_Company) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
 var File_company_company_proto protoreflect.FileDescriptor
 
 var file_company_company_proto_rawDesc = []byte{
@@ -762,13 +1093,40 @@ var file_company_company_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x79,
 	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x57, 0x0a, 0x14, 0x43, 0x6f,
+	0x65, 0x22, 0x35, 0x0a, 0x14, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x0a, 0x16, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x6e,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72,
+	0x6c, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x57, 0x0a, 0x14, 0x43, 0x6f,
 	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65,
 	0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e,
 	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e,
-	0x55, 0x72, 0x6c, 0x32, 0xbc, 0x05, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41,
+	0x55, 0x72, 0x6c, 0x32, 0x9f, 0x08, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41,
 	0x70, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x6a, 0x0a, 0x0d, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61,
@@ -812,9 +1170,31 @@ var file_company_company_proto_rawDesc = []byte{
 	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42,
 	0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x20, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x42, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70,
+	0x0a, 0x0f, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x82, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x42, 0x0b, 0x47, 0x72, 0x70, 0x63,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -829,7 +1209,7 @@ func file_company_company_proto_rawDescGZIP() []byte {
 	return file_company_company_proto_rawDescData
 }
 
-var file_company_company_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_company_company_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_company_company_proto_goTypes = []interface{}{
 	(*UpdateCompanyRequest)(nil),                    // 0: personaappapi.company.UpdateCompanyRequest
 	(*UpdateCompanyResponse)(nil),                   // 1: personaappapi.company.UpdateCompanyResponse
@@ -841,40 +1221,54 @@ var file_company_company_proto_goTypes = []interface{}{
 	(*GetCompaniesActivityFieldsListResponse)(nil),  // 7: personaappapi.company.GetCompaniesActivityFieldsListResponse
 	(*DeleteActivityFieldsByCompanyIDRequest)(nil),  // 8: personaappapi.company.DeleteActivityFieldsByCompanyIDRequest
 	(*DeleteActivityFieldsByCompanyIDResponse)(nil), // 9: personaappapi.company.DeleteActivityFieldsByCompanyIDResponse
-	(*Empty)(nil),                      // 10: personaappapi.company.Empty
-	(*CompanyActivityField)(nil),       // 11: personaappapi.company.CompanyActivityField
-	nil,                                // 12: personaappapi.company.UpdateCompanyActivityFieldsRequest.ActivityFieldsEntry
-	(*GetCompanyResponse_Company)(nil), // 13: personaappapi.company.GetCompanyResponse.Company
-	nil,                                // 14: personaappapi.company.GetCompanyResponse.Company.ActivityFieldsEntry
-	nil,                                // 15: personaappapi.company.GetCompaniesActivityFieldsListResponse.ActivityFieldsEntry
-	(*wrappers.StringValue)(nil),       // 16: google.protobuf.StringValue
+	(*FollowCompanyRequest)(nil),                    // 10: personaappapi.company.FollowCompanyRequest
+	(*FollowCompanyResponse)(nil),                   // 11: personaappapi.company.FollowCompanyResponse
+	(*UnfollowCompanyRequest)(nil),                  // 12: personaappapi.company.UnfollowCompanyRequest
+	(*UnfollowCompanyResponse)(nil),                 // 13: personaappapi.company.UnfollowCompanyResponse
+	(*ListFollowedCompaniesRequest)(nil),            // 14: personaappapi.company.ListFollowedCompaniesRequest
+	(*ListFollowedCompaniesResponse)(nil),           // 15: personaappapi.company.ListFollowedCompaniesResponse
+	(*Empty)(nil),                                   // 16: personaappapi.company.Empty
+	(*CompanyActivityField)(nil),                    // 17: personaappapi.company.CompanyActivityField
+	nil,                                             // 18: personaappapi.company.UpdateCompanyActivityFieldsRequest.ActivityFieldsEntry
+	(*GetCompanyResponse_Company)(nil),              // 19: personaappapi.company.GetCompanyResponse.Company
+	nil,                                             // 20: personaappapi.company.GetCompanyResponse.Company.ActivityFieldsEntry
+	nil,                                             // 21: personaappapi.company.GetCompaniesActivityFieldsListResponse.ActivityFieldsEntry
+	(*ListFollowedCompaniesResponse_Company)(nil),   // 22: personaappapi.company.ListFollowedCompaniesResponse.Company
+	(*wrappers.StringValue)(nil),                    // 23: google.protobuf.StringValue
 }
 var file_company_company_proto_depIdxs = []int32{
-	16, // 0: personaappapi.company.UpdateCompanyRequest.title:type_name -> google.protobuf.StringValue
-	16, // 1: personaappapi.company.UpdateCompanyRequest.description:type_name -> google.protobuf.StringValue
-	16, // 2: personaappapi.company.UpdateCompanyRequest.logo_url:type_name -> google.protobuf.StringValue
-	12, // 3: personaappapi.company.UpdateCompanyActivityFieldsRequest.activity_fields:type_name -> personaappapi.company.UpdateCompanyActivityFieldsRequest.ActivityFieldsEntry
-	13, // 4: personaappapi.company.GetCompanyResponse.company:type_name -> personaappapi.company.GetCompanyResponse.Company
-	15, // 5: personaappapi.company.GetCompaniesActivityFieldsListResponse.activity_fields:type_name -> personaappapi.company.GetCompaniesActivityFieldsListResponse.ActivityFieldsEntry
-	10, // 6: personaappapi.company.UpdateCompanyActivityFieldsRequest.ActivityFieldsEntry.value:type_name -> personaappapi.company.Empty
-	14, // 7: personaappapi.company.GetCompanyResponse.Company.activity_fields:type_name -> personaappapi.company.GetCompanyResponse.Company.ActivityFieldsEntry
-	11, // 8: personaappapi.company.GetCompanyResponse.Company.ActivityFieldsEntry.value:type_name -> personaappapi.company.CompanyActivityField
-	11, // 9: personaappapi.company.GetCompaniesActivityFieldsListResponse.ActivityFieldsEntry.value:type_name -> personaappapi.company.CompanyActivityField
-	0,  // 10: personaappapi.company.PersonaAppCompany.UpdateCompany:input_type -> personaappapi.company.UpdateCompanyRequest
-	2,  // 11: personaappapi.company.PersonaAppCompany.UpdateCompanyActivityFields:input_type -> personaappapi.company.UpdateCompanyActivityFieldsRequest
-	4,  // 12: personaappapi.company.PersonaAppCompany.GetCompany:input_type -> personaappapi.company.GetCompanyRequest
-	6,  // 13: personaappapi.company.PersonaAppCompany.GetCompaniesActivityFieldsList:input_type -> personaappapi.company.GetCompaniesActivityFieldsListRequest
-	8,  // 14: personaappapi.company.PersonaAppCompany.DeleteActivityFieldsByCompanyID:input_type -> personaappapi.company.DeleteActivityFieldsByCompanyIDRequest
-	1,  // 15: personaappapi.company.PersonaAppCompany.UpdateCompany:output_type -> personaappapi.company.UpdateCompanyResponse
-	3,  // 16: personaappapi.company.PersonaAppCompany.UpdateCompanyActivityFields:output_type -> personaappapi.company.UpdateCompanyActivityFieldsResponse
-	5,  // 17: personaappapi.company.PersonaAppCompany.GetCompany:output_type -> personaappapi.company.GetCompanyResponse
-	7,  // 18: personaappapi.company.PersonaAppCompany.GetCompaniesActivityFieldsList:output_type -> personaappapi.company.GetCompaniesActivityFieldsListResponse
-	9,  // 19: personaappapi.company.PersonaAppCompany.DeleteActivityFieldsByCompanyID:output_type -> personaappapi.company.DeleteActivityFieldsByCompanyIDResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	23, // 0: personaappapi.company.UpdateCompanyRequest.title:type_name -> google.protobuf.StringValue
+	23, // 1: personaappapi.company.UpdateCompanyRequest.description:type_name -> google.protobuf.StringValue
+	23, // 2: personaappapi.company.UpdateCompanyRequest.logo_url:type_name -> google.protobuf.StringValue
+	18, // 3: personaappapi.company.UpdateCompanyActivityFieldsRequest.activity_fields:type_name -> personaappapi.company.UpdateCompanyActivityFieldsRequest.ActivityFieldsEntry
+	19, // 4: personaappapi.company.GetCompanyResponse.company:type_name -> personaappapi.company.GetCompanyResponse.Company
+	21, // 5: personaappapi.company.GetCompaniesActivityFieldsListResponse.activity_fields:type_name -> personaappapi.company.GetCompaniesActivityFieldsListResponse.ActivityFieldsEntry
+	22, // 6: personaappapi.company.ListFollowedCompaniesResponse.companies:type_name -> personaappapi.company.ListFollowedCompaniesResponse.Company
+	16, // 7: personaappapi.company.UpdateCompanyActivityFieldsRequest.ActivityFieldsEntry.value:type_name -> personaappapi.company.Empty
+	20, // 8: personaappapi.company.GetCompanyResponse.Company.activity_fields:type_name -> personaappapi.company.GetCompanyResponse.Company.ActivityFieldsEntry
+	17, // 9: personaappapi.company.GetCompanyResponse.Company.ActivityFieldsEntry.value:type_name -> personaappapi.company.CompanyActivityField
+	17, // 10: personaappapi.company.GetCompaniesActivityFieldsListResponse.ActivityFieldsEntry.value:type_name -> personaappapi.company.CompanyActivityField
+	0,  // 11: personaappapi.company.PersonaAppCompany.UpdateCompany:input_type -> personaappapi.company.UpdateCompanyRequest
+	2,  // 12: personaappapi.company.PersonaAppCompany.UpdateCompanyActivityFields:input_type -> personaappapi.company.UpdateCompanyActivityFieldsRequest
+	4,  // 13: personaappapi.company.PersonaAppCompany.GetCompany:input_type -> personaappapi.company.GetCompanyRequest
+	6,  // 14: personaappapi.company.PersonaAppCompany.GetCompaniesActivityFieldsList:input_type -> personaappapi.company.GetCompaniesActivityFieldsListRequest
+	8,  // 15: personaappapi.company.PersonaAppCompany.DeleteActivityFieldsByCompanyID:input_type -> personaappapi.company.DeleteActivityFieldsByCompanyIDRequest
+	10, // 16: personaappapi.company.PersonaAppCompany.FollowCompany:input_type -> personaappapi.company.FollowCompanyRequest
+	12, // 17: personaappapi.company.PersonaAppCompany.UnfollowCompany:input_type -> personaappapi.company.UnfollowCompanyRequest
+	14, // 18: personaappapi.company.PersonaAppCompany.ListFollowedCompanies:input_type -> personaappapi.company.ListFollowedCompaniesRequest
+	1,  // 19: personaappapi.company.PersonaAppCompany.UpdateCompany:output_type -> personaappapi.company.UpdateCompanyResponse
+	3,  // 20: personaappapi.company.PersonaAppCompany.UpdateCompanyActivityFields:output_type -> personaappapi.company.UpdateCompanyActivityFieldsResponse
+	5,  // 21: personaappapi.company.PersonaAppCompany.GetCompany:output_type -> personaappapi.company.GetCompanyResponse
+	7,  // 22: personaappapi.company.PersonaAppCompany.GetCompaniesActivityFieldsList:output_type -> personaappapi.company.GetCompaniesActivityFieldsListResponse
+	9,  // 23: personaappapi.company.PersonaAppCompany.DeleteActivityFieldsByCompanyID:output_type -> personaappapi.company.DeleteActivityFieldsByCompanyIDResponse
+	11, // 24: personaappapi.company.PersonaAppCompany.FollowCompany:output_type -> personaappapi.company.FollowCompanyResponse
+	13, // 25: personaappapi.company.PersonaAppCompany.UnfollowCompany:output_type -> personaappapi.company.UnfollowCompanyResponse
+	15, // 26: personaappapi.company.PersonaAppCompany.ListFollowedCompanies:output_type -> personaappapi.company.ListFollowedCompaniesResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_company_company_proto_init() }
@@ -1004,7 +1398,7 @@ func file_company_company_proto_init() {
 			}
 		}
 		file_company_company_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+			switch v := v.(*FollowCompanyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1016,7 +1410,19 @@ func file_company_company_proto_init() {
 			}
 		}
 		file_company_company_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompanyActivityField); i {
+			switch v := v.(*FollowCompanyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_company_company_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowCompanyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1028,6 +1434,66 @@ func file_company_company_proto_init() {
 			}
 		}
 		file_company_company_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowCompanyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_company_company_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowedCompaniesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_company_company_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowedCompaniesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_company_company_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_company_company_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompanyActivityField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_company_company_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCompanyResponse_Company); i {
 			case 0:
 				return &v.state
@@ -1039,6 +1505,18 @@ func file_company_company_proto_init() {
 				return nil
 			}
 		}
+		file_company_company_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowedCompaniesResponse_Company); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1046,7 +1524,7 @@ func file_company_company_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_company_company_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1077,6 +1555,10 @@ type PersonaAppCompanyClient interface {
 	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error)
 	GetCompaniesActivityFieldsList(ctx context.Context, in *GetCompaniesActivityFieldsListRequest, opts ...grpc.CallOption) (*GetCompaniesActivityFieldsListResponse, error)
 	DeleteActivityFieldsByCompanyID(ctx context.Context, in *DeleteActivityFieldsByCompanyIDRequest, opts ...grpc.CallOption) (*DeleteActivityFieldsByCompanyIDResponse, error)
+	// Company follows
+	FollowCompany(ctx context.Context, in *FollowCompanyRequest, opts ...grpc.CallOption) (*FollowCompanyResponse, error)
+	UnfollowCompany(ctx context.Context, in *UnfollowCompanyRequest, opts ...grpc.CallOption) (*UnfollowCompanyResponse, error)
+	ListFollowedCompanies(ctx context.Context, in *ListFollowedCompaniesRequest, opts ...grpc.CallOption) (*ListFollowedCompaniesResponse, error)
 }
 
 type personaAppCompanyClient struct {
@@ -1132,6 +1614,33 @@ func (c *personaAppCompanyClient) DeleteActivityFieldsByCompanyID(ctx context.Co
 	return out, nil
 }
 
+func (c *personaAppCompanyClient) FollowCompany(ctx context.Context, in *FollowCompanyRequest, opts ...grpc.CallOption) (*FollowCompanyResponse, error) {
+	out := new(FollowCompanyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.company.PersonaAppCompany/FollowCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppCompanyClient) UnfollowCompany(ctx context.Context, in *UnfollowCompanyRequest, opts ...grpc.CallOption) (*UnfollowCompanyResponse, error) {
+	out := new(UnfollowCompanyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.company.PersonaAppCompany/UnfollowCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppCompanyClient) ListFollowedCompanies(ctx context.Context, in *ListFollowedCompaniesRequest, opts ...grpc.CallOption) (*ListFollowedCompaniesResponse, error) {
+	out := new(ListFollowedCompaniesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.company.PersonaAppCompany/ListFollowedCompanies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PersonaAppCompanyServer is the server API for PersonaAppCompany service.
 type PersonaAppCompanyServer interface {
 	UpdateCompany(context.Context, *UpdateCompanyRequest) (*UpdateCompanyResponse, error)
@@ -1139,6 +1648,10 @@ type PersonaAppCompanyServer interface {
 	GetCompany(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error)
 	GetCompaniesActivityFieldsList(context.Context, *GetCompaniesActivityFieldsListRequest) (*GetCompaniesActivityFieldsListResponse, error)
 	DeleteActivityFieldsByCompanyID(context.Context, *DeleteActivityFieldsByCompanyIDRequest) (*DeleteActivityFieldsByCompanyIDResponse, error)
+	// Company follows
+	FollowCompany(context.Context, *FollowCompanyRequest) (*FollowCompanyResponse, error)
+	UnfollowCompany(context.Context, *UnfollowCompanyRequest) (*UnfollowCompanyResponse, error)
+	ListFollowedCompanies(context.Context, *ListFollowedCompaniesRequest) (*ListFollowedCompaniesResponse, error)
 }
 
 // UnimplementedPersonaAppCompanyServer can be embedded to have forward compatible implementations.
@@ -1160,6 +1673,15 @@ func (*UnimplementedPersonaAppCompanyServer) GetCompaniesActivityFieldsList(cont
 func (*UnimplementedPersonaAppCompanyServer) DeleteActivityFieldsByCompanyID(context.Context, *DeleteActivityFieldsByCompanyIDRequest) (*DeleteActivityFieldsByCompanyIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteActivityFieldsByCompanyID not implemented")
 }
+func (*UnimplementedPersonaAppCompanyServer) FollowCompany(context.Context, *FollowCompanyRequest) (*FollowCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowCompany not implemented")
+}
+func (*UnimplementedPersonaAppCompanyServer) UnfollowCompany(context.Context, *UnfollowCompanyRequest) (*UnfollowCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowCompany not implemented")
+}
+func (*UnimplementedPersonaAppCompanyServer) ListFollowedCompanies(context.Context, *ListFollowedCompaniesRequest) (*ListFollowedCompaniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowedCompanies not implemented")
+}
 
 func RegisterPersonaAppCompanyServer(s *grpc.Server, srv PersonaAppCompanyServer) {
 	s.RegisterService(&_PersonaAppCompany_serviceDesc, srv)
@@ -1255,6 +1777,60 @@ func _PersonaAppCompany_DeleteActivityFieldsByCompanyID_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PersonaAppCompany_FollowCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppCompanyServer).FollowCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.company.PersonaAppCompany/FollowCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppCompanyServer).FollowCompany(ctx, req.(*FollowCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppCompany_UnfollowCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppCompanyServer).UnfollowCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.company.PersonaAppCompany/UnfollowCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppCompanyServer).UnfollowCompany(ctx, req.(*UnfollowCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppCompany_ListFollowedCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowedCompaniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppCompanyServer).ListFollowedCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.company.PersonaAppCompany/ListFollowedCompanies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppCompanyServer).ListFollowedCompanies(ctx, req.(*ListFollowedCompaniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PersonaAppCompany_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "personaappapi.company.PersonaAppCompany",
 	HandlerType: (*PersonaAppCompanyServer)(nil),
@@ -1279,6 +1855,18 @@ var _PersonaAppCompany_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteActivityFieldsByCompanyID",
 			Handler:    _PersonaAppCompany_DeleteActivityFieldsByCompanyID_Handler,
 		},
+		{
+			MethodName: "FollowCompany",
+			Handler:    _PersonaAppCompany_FollowCompany_Handler,
+		},
+		{
+			MethodName: "UnfollowCompany",
+			Handler:    _PersonaAppCompany_UnfollowCompany_Handler,
+		},
+		{
+			MethodName: "ListFollowedCompanies",
+			Handler:    _PersonaAppCompany_ListFollowedCompanies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "company/company.proto",
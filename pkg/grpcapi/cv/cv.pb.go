@@ -31,6 +31,59 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Models
+type SalaryPeriod int32
+
+const (
+	SalaryPeriod_SALARY_PERIOD_UNKNOWN SalaryPeriod = 0
+	SalaryPeriod_SALARY_PERIOD_HOUR    SalaryPeriod = 1
+	SalaryPeriod_SALARY_PERIOD_MONTH   SalaryPeriod = 2
+	SalaryPeriod_SALARY_PERIOD_YEAR    SalaryPeriod = 3
+)
+
+// Enum value maps for SalaryPeriod.
+var (
+	SalaryPeriod_name = map[int32]string{
+		0: "SALARY_PERIOD_UNKNOWN",
+		1: "SALARY_PERIOD_HOUR",
+		2: "SALARY_PERIOD_MONTH",
+		3: "SALARY_PERIOD_YEAR",
+	}
+	SalaryPeriod_value = map[string]int32{
+		"SALARY_PERIOD_UNKNOWN": 0,
+		"SALARY_PERIOD_HOUR":    1,
+		"SALARY_PERIOD_MONTH":   2,
+		"SALARY_PERIOD_YEAR":    3,
+	}
+)
+
+func (x SalaryPeriod) Enum() *SalaryPeriod {
+	p := new(SalaryPeriod)
+	*p = x
+	return p
+}
+
+func (x SalaryPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SalaryPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_cv_cv_proto_enumTypes[0].Descriptor()
+}
+
+func (SalaryPeriod) Type() protoreflect.EnumType {
+	return &file_cv_cv_proto_enumTypes[0]
+}
+
+func (x SalaryPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SalaryPeriod.Descriptor instead.
+func (SalaryPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_cv_cv_proto_rawDescGZIP(), []int{0}
+}
+
 // Update CV
 type UpdateCVRequest struct {
 	state         protoimpl.MessageState
@@ -43,6 +96,12 @@ type UpdateCVRequest struct {
 	WorkMonthsExperience int32                 `protobuf:"varint,4,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
 	MinSalary            int32                 `protobuf:"varint,5,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
 	MaxSalary            int32                 `protobuf:"varint,6,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	// ISO 4217 code of the salary range, UAH when empty
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// A month when unknown
+	SalaryPeriod SalaryPeriod `protobuf:"varint,8,opt,name=salary_period,json=salaryPeriod,proto3,enum=personaappapi.cv.SalaryPeriod" json:"salary_period,omitempty"`
+	// Whether the salary is before taxes, not specified when unset
+	SalaryGross *wrappers.BoolValue `protobuf:"bytes,9,opt,name=salary_gross,json=salaryGross,proto3" json:"salary_gross,omitempty"`
 }
 
 func (x *UpdateCVRequest) Reset() {
@@ -119,6 +178,27 @@ func (x *UpdateCVRequest) GetMaxSalary() int32 {
 	return 0
 }
 
+func (x *UpdateCVRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateCVRequest) GetSalaryPeriod() SalaryPeriod {
+	if x != nil {
+		return x.SalaryPeriod
+	}
+	return SalaryPeriod_SALARY_PERIOD_UNKNOWN
+}
+
+func (x *UpdateCVRequest) GetSalaryGross() *wrappers.BoolValue {
+	if x != nil {
+		return x.SalaryGross
+	}
+	return nil
+}
+
 type UpdateCVResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3083,7 +3163,6 @@ func (*DeleteJobTypeResponse) Descriptor() ([]byte, []int) {
 	return file_cv_cv_proto_rawDescGZIP(), []int{61}
 }
 
-// Models
 type CV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3095,6 +3174,10 @@ type CV struct {
 	WorkMonthsExperience int32  `protobuf:"varint,4,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
 	MinSalary            int32  `protobuf:"varint,5,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
 	MaxSalary            int32  `protobuf:"varint,6,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	// ISO 4217 code of the salary range
+	Currency     string              `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	SalaryPeriod SalaryPeriod        `protobuf:"varint,8,opt,name=salary_period,json=salaryPeriod,proto3,enum=personaappapi.cv.SalaryPeriod" json:"salary_period,omitempty"`
+	SalaryGross  *wrappers.BoolValue `protobuf:"bytes,9,opt,name=salary_gross,json=salaryGross,proto3" json:"salary_gross,omitempty"`
 }
 
 func (x *CV) Reset() {
@@ -3171,6 +3254,27 @@ func (x *CV) GetMaxSalary() int32 {
 	return 0
 }
 
+func (x *CV) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CV) GetSalaryPeriod() SalaryPeriod {
+	if x != nil {
+		return x.SalaryPeriod
+	}
+	return SalaryPeriod_SALARY_PERIOD_UNKNOWN
+}
+
+func (x *CV) GetSalaryGross() *wrappers.BoolValue {
+	if x != nil {
+		return x.SalaryGross
+	}
+	return nil
+}
+
 type CVShort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3181,6 +3285,10 @@ type CVShort struct {
 	WorkMonthsExperience int32  `protobuf:"varint,3,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
 	MinSalary            int32  `protobuf:"varint,4,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
 	MaxSalary            int32  `protobuf:"varint,5,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	// ISO 4217 code of the salary range
+	Currency     string              `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	SalaryPeriod SalaryPeriod        `protobuf:"varint,7,opt,name=salary_period,json=salaryPeriod,proto3,enum=personaappapi.cv.SalaryPeriod" json:"salary_period,omitempty"`
+	SalaryGross  *wrappers.BoolValue `protobuf:"bytes,8,opt,name=salary_gross,json=salaryGross,proto3" json:"salary_gross,omitempty"`
 }
 
 func (x *CVShort) Reset() {
@@ -3250,6 +3358,27 @@ func (x *CVShort) GetMaxSalary() int32 {
 	return 0
 }
 
+func (x *CVShort) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CVShort) GetSalaryPeriod() SalaryPeriod {
+	if x != nil {
+		return x.SalaryPeriod
+	}
+	return SalaryPeriod_SALARY_PERIOD_UNKNOWN
+}
+
+func (x *CVShort) GetSalaryGross() *wrappers.BoolValue {
+	if x != nil {
+		return x.SalaryGross
+	}
+	return nil
+}
+
 type StoryEpisode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3834,7 +3963,7 @@ var file_cv_cv_proto_rawDesc = []byte{
 	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xee, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x71,
+	0x22, 0x8e, 0x03, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
@@ -3849,7 +3978,17 @@ var file_cv_cv_proto_rawDesc = []byte{
 	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61,
 	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72,
-	0x79, 0x22, 0x22, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x73,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x43, 0x0a,
+	0x0d, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x72, 0x6f,
+	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x73,
+	0x73, 0x22, 0x22, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x56, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
@@ -4089,7 +4228,7 @@ var file_cv_cv_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x02,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x02,
 	0x43, 0x56, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x5f, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x49,
@@ -4102,7 +4241,17 @@ var file_cv_cv_proto_rawDesc = []byte{
 	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61,
 	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72,
-	0x79, 0x22, 0xa9, 0x01, 0x0a, 0x07, 0x43, 0x56, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x43, 0x0a,
+	0x0d, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x72, 0x6f,
+	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x73,
+	0x73, 0x22, 0xc9, 0x02, 0x0a, 0x07, 0x43, 0x56, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
 	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x6f, 0x72,
@@ -4112,7 +4261,17 @@ var file_cv_cv_proto_rawDesc = []byte{
 	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1d,
 	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x22, 0x56, 0x0a,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x61, 0x6c,
+	0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3d,
+	0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x22, 0x56, 0x0a,
 	0x0c, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
 	0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -4171,204 +4330,211 @@ var file_cv_cv_proto_rawDesc = []byte{
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
 	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xae, 0x18, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x41, 0x70, 0x70, 0x43, 0x56, 0x12, 0x51, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x56, 0x12, 0x21, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x47, 0x65, 0x74,
-	0x43, 0x56, 0x12, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x43, 0x56, 0x73, 0x12, 0x1f, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x72, 0x0a, 0x0c, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f,
+	0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x41, 0x4c, 0x41,
+	0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49,
+	0x4f, 0x44, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x03, 0x32, 0xae, 0x18, 0x0a, 0x0c, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41, 0x70, 0x70, 0x43, 0x56, 0x12, 0x51, 0x0a, 0x08, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x12, 0x21, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x05, 0x47, 0x65, 0x74, 0x43, 0x56, 0x12, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x43, 0x56,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56,
+	0x12, 0x21, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12,
+	0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
 	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x51, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x12, 0x21, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x65,
+	0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x75, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x65,
 	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x14, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73,
-	0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
-	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x70,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x23, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70,
 	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x70,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70,
 	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e,
 	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
+	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x64, 0x75, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x64, 0x75,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x70, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64,
+	0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x70,
 	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65,
-	0x74, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x75,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x29,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f,
+	0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a,
+	0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
 	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x45, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x75, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e,
-	0x64, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69,
-	0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a,
-	0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f,
-	0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
-	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x12,
-	0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12,
-	0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4b,
-	0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
-	0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76,
-	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1b, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
-	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x42, 0x06, 0x47, 0x72, 0x70,
-	0x63, 0x43, 0x56, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x56, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4b, 0x69,
+	0x6e, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x60, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x56, 0x4a,
+	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a,
+	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x56, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x76, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1b, 0x0a, 0x11, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x42,
+	0x06, 0x47, 0x72, 0x70, 0x63, 0x43, 0x56, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4383,179 +4549,188 @@ func file_cv_cv_proto_rawDescGZIP() []byte {
 	return file_cv_cv_proto_rawDescData
 }
 
+var file_cv_cv_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_cv_cv_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_cv_cv_proto_goTypes = []interface{}{
-	(*UpdateCVRequest)(nil),              // 0: personaappapi.cv.UpdateCVRequest
-	(*UpdateCVResponse)(nil),             // 1: personaappapi.cv.UpdateCVResponse
-	(*GetCVsRequest)(nil),                // 2: personaappapi.cv.GetCVsRequest
-	(*GetCVsResponse)(nil),               // 3: personaappapi.cv.GetCVsResponse
-	(*GetCVRequest)(nil),                 // 4: personaappapi.cv.GetCVRequest
-	(*GetCVResponse)(nil),                // 5: personaappapi.cv.GetCVResponse
-	(*DeleteCVRequest)(nil),              // 6: personaappapi.cv.DeleteCVRequest
-	(*DeleteCVResponse)(nil),             // 7: personaappapi.cv.DeleteCVResponse
-	(*UpdateStoriesEpisodeRequest)(nil),  // 8: personaappapi.cv.UpdateStoriesEpisodeRequest
-	(*UpdateStoriesEpisodeResponse)(nil), // 9: personaappapi.cv.UpdateStoriesEpisodeResponse
-	(*GetStoriesEpisodesRequest)(nil),    // 10: personaappapi.cv.GetStoriesEpisodesRequest
-	(*GetStoriesEpisodesResponse)(nil),   // 11: personaappapi.cv.GetStoriesEpisodesResponse
-	(*DeleteStoriesEpisodeRequest)(nil),  // 12: personaappapi.cv.DeleteStoriesEpisodeRequest
-	(*DeleteStoriesEpisodeResponse)(nil), // 13: personaappapi.cv.DeleteStoriesEpisodeResponse
-	(*UpdateStoryRequest)(nil),           // 14: personaappapi.cv.UpdateStoryRequest
-	(*UpdateStoryResponse)(nil),          // 15: personaappapi.cv.UpdateStoryResponse
-	(*GetStoriesRequest)(nil),            // 16: personaappapi.cv.GetStoriesRequest
-	(*GetStoriesResponse)(nil),           // 17: personaappapi.cv.GetStoriesResponse
-	(*DeleteStoryRequest)(nil),           // 18: personaappapi.cv.DeleteStoryRequest
-	(*DeleteStoryResponse)(nil),          // 19: personaappapi.cv.DeleteStoryResponse
-	(*UpdateCustomSectionRequest)(nil),   // 20: personaappapi.cv.UpdateCustomSectionRequest
-	(*UpdateCustomSectionResponse)(nil),  // 21: personaappapi.cv.UpdateCustomSectionResponse
-	(*GetCustomSectionsRequest)(nil),     // 22: personaappapi.cv.GetCustomSectionsRequest
-	(*GetCustomSectionsResponse)(nil),    // 23: personaappapi.cv.GetCustomSectionsResponse
-	(*DeleteCustomSectionRequest)(nil),   // 24: personaappapi.cv.DeleteCustomSectionRequest
-	(*DeleteCustomSectionResponse)(nil),  // 25: personaappapi.cv.DeleteCustomSectionResponse
-	(*UpdateEducationRequest)(nil),       // 26: personaappapi.cv.UpdateEducationRequest
-	(*UpdateEducationResponse)(nil),      // 27: personaappapi.cv.UpdateEducationResponse
-	(*GetEducationsRequest)(nil),         // 28: personaappapi.cv.GetEducationsRequest
-	(*GetEducationsResponse)(nil),        // 29: personaappapi.cv.GetEducationsResponse
-	(*DeleteEducationRequest)(nil),       // 30: personaappapi.cv.DeleteEducationRequest
-	(*DeleteEducationResponse)(nil),      // 31: personaappapi.cv.DeleteEducationResponse
-	(*UpdateExperienceRequest)(nil),      // 32: personaappapi.cv.UpdateExperienceRequest
-	(*UpdateExperienceResponse)(nil),     // 33: personaappapi.cv.UpdateExperienceResponse
-	(*GetExperiencesRequest)(nil),        // 34: personaappapi.cv.GetExperiencesRequest
-	(*GetExperiencesResponse)(nil),       // 35: personaappapi.cv.GetExperiencesResponse
-	(*DeleteExperienceRequest)(nil),      // 36: personaappapi.cv.DeleteExperienceRequest
-	(*DeleteExperienceResponse)(nil),     // 37: personaappapi.cv.DeleteExperienceResponse
-	(*UpdateCVJobKindsRequest)(nil),      // 38: personaappapi.cv.UpdateCVJobKindsRequest
-	(*UpdateCVJobKindsResponse)(nil),     // 39: personaappapi.cv.UpdateCVJobKindsResponse
-	(*GetCVJobKindsRequest)(nil),         // 40: personaappapi.cv.GetCVJobKindsRequest
-	(*GetCVJobKindsResponse)(nil),        // 41: personaappapi.cv.GetCVJobKindsResponse
-	(*DeleteCVJobKindsRequest)(nil),      // 42: personaappapi.cv.DeleteCVJobKindsRequest
-	(*DeleteCVJobKindsResponse)(nil),     // 43: personaappapi.cv.DeleteCVJobKindsResponse
-	(*UpdateJobKindRequest)(nil),         // 44: personaappapi.cv.UpdateJobKindRequest
-	(*UpdateJobKindResponse)(nil),        // 45: personaappapi.cv.UpdateJobKindResponse
-	(*GetJobKindsRequest)(nil),           // 46: personaappapi.cv.GetJobKindsRequest
-	(*GetJobKindsResponse)(nil),          // 47: personaappapi.cv.GetJobKindsResponse
-	(*DeleteJobKindRequest)(nil),         // 48: personaappapi.cv.DeleteJobKindRequest
-	(*DeleteJobKindResponse)(nil),        // 49: personaappapi.cv.DeleteJobKindResponse
-	(*UpdateCVJobTypesRequest)(nil),      // 50: personaappapi.cv.UpdateCVJobTypesRequest
-	(*UpdateCVJobTypesResponse)(nil),     // 51: personaappapi.cv.UpdateCVJobTypesResponse
-	(*GetCVJobTypesRequest)(nil),         // 52: personaappapi.cv.GetCVJobTypesRequest
-	(*GetCVJobTypesResponse)(nil),        // 53: personaappapi.cv.GetCVJobTypesResponse
-	(*DeleteCVJobTypesRequest)(nil),      // 54: personaappapi.cv.DeleteCVJobTypesRequest
-	(*DeleteCVJobTypesResponse)(nil),     // 55: personaappapi.cv.DeleteCVJobTypesResponse
-	(*UpdateJobTypeRequest)(nil),         // 56: personaappapi.cv.UpdateJobTypeRequest
-	(*UpdateJobTypeResponse)(nil),        // 57: personaappapi.cv.UpdateJobTypeResponse
-	(*GetJobTypesRequest)(nil),           // 58: personaappapi.cv.GetJobTypesRequest
-	(*GetJobTypesResponse)(nil),          // 59: personaappapi.cv.GetJobTypesResponse
-	(*DeleteJobTypeRequest)(nil),         // 60: personaappapi.cv.DeleteJobTypeRequest
-	(*DeleteJobTypeResponse)(nil),        // 61: personaappapi.cv.DeleteJobTypeResponse
-	(*CV)(nil),                           // 62: personaappapi.cv.CV
-	(*CVShort)(nil),                      // 63: personaappapi.cv.CVShort
-	(*StoryEpisode)(nil),                 // 64: personaappapi.cv.StoryEpisode
-	(*CVCustomStory)(nil),                // 65: personaappapi.cv.CVCustomStory
-	(*CVCustomSection)(nil),              // 66: personaappapi.cv.CVCustomSection
-	(*CVEducation)(nil),                  // 67: personaappapi.cv.CVEducation
-	(*CVExperience)(nil),                 // 68: personaappapi.cv.CVExperience
-	(*CVJobKind)(nil),                    // 69: personaappapi.cv.CVJobKind
-	(*JobKind)(nil),                      // 70: personaappapi.cv.JobKind
-	(*CVJobType)(nil),                    // 71: personaappapi.cv.CVJobType
-	(*JobType)(nil),                      // 72: personaappapi.cv.JobType
-	(*wrappers.StringValue)(nil),         // 73: google.protobuf.StringValue
-	(*timestamp.Timestamp)(nil),          // 74: google.protobuf.Timestamp
+	(SalaryPeriod)(0),                    // 0: personaappapi.cv.SalaryPeriod
+	(*UpdateCVRequest)(nil),              // 1: personaappapi.cv.UpdateCVRequest
+	(*UpdateCVResponse)(nil),             // 2: personaappapi.cv.UpdateCVResponse
+	(*GetCVsRequest)(nil),                // 3: personaappapi.cv.GetCVsRequest
+	(*GetCVsResponse)(nil),               // 4: personaappapi.cv.GetCVsResponse
+	(*GetCVRequest)(nil),                 // 5: personaappapi.cv.GetCVRequest
+	(*GetCVResponse)(nil),                // 6: personaappapi.cv.GetCVResponse
+	(*DeleteCVRequest)(nil),              // 7: personaappapi.cv.DeleteCVRequest
+	(*DeleteCVResponse)(nil),             // 8: personaappapi.cv.DeleteCVResponse
+	(*UpdateStoriesEpisodeRequest)(nil),  // 9: personaappapi.cv.UpdateStoriesEpisodeRequest
+	(*UpdateStoriesEpisodeResponse)(nil), // 10: personaappapi.cv.UpdateStoriesEpisodeResponse
+	(*GetStoriesEpisodesRequest)(nil),    // 11: personaappapi.cv.GetStoriesEpisodesRequest
+	(*GetStoriesEpisodesResponse)(nil),   // 12: personaappapi.cv.GetStoriesEpisodesResponse
+	(*DeleteStoriesEpisodeRequest)(nil),  // 13: personaappapi.cv.DeleteStoriesEpisodeRequest
+	(*DeleteStoriesEpisodeResponse)(nil), // 14: personaappapi.cv.DeleteStoriesEpisodeResponse
+	(*UpdateStoryRequest)(nil),           // 15: personaappapi.cv.UpdateStoryRequest
+	(*UpdateStoryResponse)(nil),          // 16: personaappapi.cv.UpdateStoryResponse
+	(*GetStoriesRequest)(nil),            // 17: personaappapi.cv.GetStoriesRequest
+	(*GetStoriesResponse)(nil),           // 18: personaappapi.cv.GetStoriesResponse
+	(*DeleteStoryRequest)(nil),           // 19: personaappapi.cv.DeleteStoryRequest
+	(*DeleteStoryResponse)(nil),          // 20: personaappapi.cv.DeleteStoryResponse
+	(*UpdateCustomSectionRequest)(nil),   // 21: personaappapi.cv.UpdateCustomSectionRequest
+	(*UpdateCustomSectionResponse)(nil),  // 22: personaappapi.cv.UpdateCustomSectionResponse
+	(*GetCustomSectionsRequest)(nil),     // 23: personaappapi.cv.GetCustomSectionsRequest
+	(*GetCustomSectionsResponse)(nil),    // 24: personaappapi.cv.GetCustomSectionsResponse
+	(*DeleteCustomSectionRequest)(nil),   // 25: personaappapi.cv.DeleteCustomSectionRequest
+	(*DeleteCustomSectionResponse)(nil),  // 26: personaappapi.cv.DeleteCustomSectionResponse
+	(*UpdateEducationRequest)(nil),       // 27: personaappapi.cv.UpdateEducationRequest
+	(*UpdateEducationResponse)(nil),      // 28: personaappapi.cv.UpdateEducationResponse
+	(*GetEducationsRequest)(nil),         // 29: personaappapi.cv.GetEducationsRequest
+	(*GetEducationsResponse)(nil),        // 30: personaappapi.cv.GetEducationsResponse
+	(*DeleteEducationRequest)(nil),       // 31: personaappapi.cv.DeleteEducationRequest
+	(*DeleteEducationResponse)(nil),      // 32: personaappapi.cv.DeleteEducationResponse
+	(*UpdateExperienceRequest)(nil),      // 33: personaappapi.cv.UpdateExperienceRequest
+	(*UpdateExperienceResponse)(nil),     // 34: personaappapi.cv.UpdateExperienceResponse
+	(*GetExperiencesRequest)(nil),        // 35: personaappapi.cv.GetExperiencesRequest
+	(*GetExperiencesResponse)(nil),       // 36: personaappapi.cv.GetExperiencesResponse
+	(*DeleteExperienceRequest)(nil),      // 37: personaappapi.cv.DeleteExperienceRequest
+	(*DeleteExperienceResponse)(nil),     // 38: personaappapi.cv.DeleteExperienceResponse
+	(*UpdateCVJobKindsRequest)(nil),      // 39: personaappapi.cv.UpdateCVJobKindsRequest
+	(*UpdateCVJobKindsResponse)(nil),     // 40: personaappapi.cv.UpdateCVJobKindsResponse
+	(*GetCVJobKindsRequest)(nil),         // 41: personaappapi.cv.GetCVJobKindsRequest
+	(*GetCVJobKindsResponse)(nil),        // 42: personaappapi.cv.GetCVJobKindsResponse
+	(*DeleteCVJobKindsRequest)(nil),      // 43: personaappapi.cv.DeleteCVJobKindsRequest
+	(*DeleteCVJobKindsResponse)(nil),     // 44: personaappapi.cv.DeleteCVJobKindsResponse
+	(*UpdateJobKindRequest)(nil),         // 45: personaappapi.cv.UpdateJobKindRequest
+	(*UpdateJobKindResponse)(nil),        // 46: personaappapi.cv.UpdateJobKindResponse
+	(*GetJobKindsRequest)(nil),           // 47: personaappapi.cv.GetJobKindsRequest
+	(*GetJobKindsResponse)(nil),          // 48: personaappapi.cv.GetJobKindsResponse
+	(*DeleteJobKindRequest)(nil),         // 49: personaappapi.cv.DeleteJobKindRequest
+	(*DeleteJobKindResponse)(nil),        // 50: personaappapi.cv.DeleteJobKindResponse
+	(*UpdateCVJobTypesRequest)(nil),      // 51: personaappapi.cv.UpdateCVJobTypesRequest
+	(*UpdateCVJobTypesResponse)(nil),     // 52: personaappapi.cv.UpdateCVJobTypesResponse
+	(*GetCVJobTypesRequest)(nil),         // 53: personaappapi.cv.GetCVJobTypesRequest
+	(*GetCVJobTypesResponse)(nil),        // 54: personaappapi.cv.GetCVJobTypesResponse
+	(*DeleteCVJobTypesRequest)(nil),      // 55: personaappapi.cv.DeleteCVJobTypesRequest
+	(*DeleteCVJobTypesResponse)(nil),     // 56: personaappapi.cv.DeleteCVJobTypesResponse
+	(*UpdateJobTypeRequest)(nil),         // 57: personaappapi.cv.UpdateJobTypeRequest
+	(*UpdateJobTypeResponse)(nil),        // 58: personaappapi.cv.UpdateJobTypeResponse
+	(*GetJobTypesRequest)(nil),           // 59: personaappapi.cv.GetJobTypesRequest
+	(*GetJobTypesResponse)(nil),          // 60: personaappapi.cv.GetJobTypesResponse
+	(*DeleteJobTypeRequest)(nil),         // 61: personaappapi.cv.DeleteJobTypeRequest
+	(*DeleteJobTypeResponse)(nil),        // 62: personaappapi.cv.DeleteJobTypeResponse
+	(*CV)(nil),                           // 63: personaappapi.cv.CV
+	(*CVShort)(nil),                      // 64: personaappapi.cv.CVShort
+	(*StoryEpisode)(nil),                 // 65: personaappapi.cv.StoryEpisode
+	(*CVCustomStory)(nil),                // 66: personaappapi.cv.CVCustomStory
+	(*CVCustomSection)(nil),              // 67: personaappapi.cv.CVCustomSection
+	(*CVEducation)(nil),                  // 68: personaappapi.cv.CVEducation
+	(*CVExperience)(nil),                 // 69: personaappapi.cv.CVExperience
+	(*CVJobKind)(nil),                    // 70: personaappapi.cv.CVJobKind
+	(*JobKind)(nil),                      // 71: personaappapi.cv.JobKind
+	(*CVJobType)(nil),                    // 72: personaappapi.cv.CVJobType
+	(*JobType)(nil),                      // 73: personaappapi.cv.JobType
+	(*wrappers.StringValue)(nil),         // 74: google.protobuf.StringValue
+	(*wrappers.BoolValue)(nil),           // 75: google.protobuf.BoolValue
+	(*timestamp.Timestamp)(nil),          // 76: google.protobuf.Timestamp
 }
 var file_cv_cv_proto_depIdxs = []int32{
-	73, // 0: personaappapi.cv.UpdateCVRequest.id:type_name -> google.protobuf.StringValue
-	63, // 1: personaappapi.cv.GetCVsResponse.cv_short:type_name -> personaappapi.cv.CVShort
-	62, // 2: personaappapi.cv.GetCVResponse.cv:type_name -> personaappapi.cv.CV
-	73, // 3: personaappapi.cv.UpdateStoriesEpisodeRequest.id:type_name -> google.protobuf.StringValue
-	64, // 4: personaappapi.cv.GetStoriesEpisodesResponse.story_episode:type_name -> personaappapi.cv.StoryEpisode
-	73, // 5: personaappapi.cv.UpdateStoryRequest.id:type_name -> google.protobuf.StringValue
-	65, // 6: personaappapi.cv.GetStoriesResponse.cv_custom_story:type_name -> personaappapi.cv.CVCustomStory
-	73, // 7: personaappapi.cv.UpdateCustomSectionRequest.id:type_name -> google.protobuf.StringValue
-	66, // 8: personaappapi.cv.GetCustomSectionsResponse.cv_custom_section:type_name -> personaappapi.cv.CVCustomSection
-	73, // 9: personaappapi.cv.UpdateEducationRequest.id:type_name -> google.protobuf.StringValue
-	74, // 10: personaappapi.cv.UpdateEducationRequest.date_from:type_name -> google.protobuf.Timestamp
-	74, // 11: personaappapi.cv.UpdateEducationRequest.date_till:type_name -> google.protobuf.Timestamp
-	67, // 12: personaappapi.cv.GetEducationsResponse.cv_education:type_name -> personaappapi.cv.CVEducation
-	73, // 13: personaappapi.cv.UpdateExperienceRequest.id:type_name -> google.protobuf.StringValue
-	74, // 14: personaappapi.cv.UpdateExperienceRequest.date_from:type_name -> google.protobuf.Timestamp
-	74, // 15: personaappapi.cv.UpdateExperienceRequest.date_till:type_name -> google.protobuf.Timestamp
-	68, // 16: personaappapi.cv.GetExperiencesResponse.cv_experience:type_name -> personaappapi.cv.CVExperience
-	69, // 17: personaappapi.cv.GetCVJobKindsResponse.cv_job_kind:type_name -> personaappapi.cv.CVJobKind
-	73, // 18: personaappapi.cv.UpdateJobKindRequest.id:type_name -> google.protobuf.StringValue
-	70, // 19: personaappapi.cv.GetJobKindsResponse.job_kind:type_name -> personaappapi.cv.JobKind
-	71, // 20: personaappapi.cv.GetCVJobTypesResponse.cv_job_type:type_name -> personaappapi.cv.CVJobType
-	73, // 21: personaappapi.cv.UpdateJobTypeRequest.id:type_name -> google.protobuf.StringValue
-	72, // 22: personaappapi.cv.GetJobTypesResponse.job_type:type_name -> personaappapi.cv.JobType
-	74, // 23: personaappapi.cv.CVEducation.date_from:type_name -> google.protobuf.Timestamp
-	74, // 24: personaappapi.cv.CVEducation.date_till:type_name -> google.protobuf.Timestamp
-	74, // 25: personaappapi.cv.CVExperience.date_from:type_name -> google.protobuf.Timestamp
-	74, // 26: personaappapi.cv.CVExperience.date_till:type_name -> google.protobuf.Timestamp
-	0,  // 27: personaappapi.cv.PersonaAppCV.UpdateCV:input_type -> personaappapi.cv.UpdateCVRequest
-	4,  // 28: personaappapi.cv.PersonaAppCV.GetCV:input_type -> personaappapi.cv.GetCVRequest
-	2,  // 29: personaappapi.cv.PersonaAppCV.GetCVs:input_type -> personaappapi.cv.GetCVsRequest
-	6,  // 30: personaappapi.cv.PersonaAppCV.DeleteCV:input_type -> personaappapi.cv.DeleteCVRequest
-	8,  // 31: personaappapi.cv.PersonaAppCV.UpdateStoriesEpisode:input_type -> personaappapi.cv.UpdateStoriesEpisodeRequest
-	10, // 32: personaappapi.cv.PersonaAppCV.GetStoriesEpisodes:input_type -> personaappapi.cv.GetStoriesEpisodesRequest
-	12, // 33: personaappapi.cv.PersonaAppCV.DeleteStoriesEpisode:input_type -> personaappapi.cv.DeleteStoriesEpisodeRequest
-	14, // 34: personaappapi.cv.PersonaAppCV.UpdateStory:input_type -> personaappapi.cv.UpdateStoryRequest
-	16, // 35: personaappapi.cv.PersonaAppCV.GetStories:input_type -> personaappapi.cv.GetStoriesRequest
-	18, // 36: personaappapi.cv.PersonaAppCV.DeleteStory:input_type -> personaappapi.cv.DeleteStoryRequest
-	20, // 37: personaappapi.cv.PersonaAppCV.UpdateCustomSection:input_type -> personaappapi.cv.UpdateCustomSectionRequest
-	22, // 38: personaappapi.cv.PersonaAppCV.GetCustomSections:input_type -> personaappapi.cv.GetCustomSectionsRequest
-	24, // 39: personaappapi.cv.PersonaAppCV.DeleteCustomSection:input_type -> personaappapi.cv.DeleteCustomSectionRequest
-	26, // 40: personaappapi.cv.PersonaAppCV.UpdateEducation:input_type -> personaappapi.cv.UpdateEducationRequest
-	28, // 41: personaappapi.cv.PersonaAppCV.GetEducations:input_type -> personaappapi.cv.GetEducationsRequest
-	30, // 42: personaappapi.cv.PersonaAppCV.DeleteEducation:input_type -> personaappapi.cv.DeleteEducationRequest
-	32, // 43: personaappapi.cv.PersonaAppCV.UpdateExperience:input_type -> personaappapi.cv.UpdateExperienceRequest
-	34, // 44: personaappapi.cv.PersonaAppCV.GetExperiences:input_type -> personaappapi.cv.GetExperiencesRequest
-	36, // 45: personaappapi.cv.PersonaAppCV.DeleteExperience:input_type -> personaappapi.cv.DeleteExperienceRequest
-	38, // 46: personaappapi.cv.PersonaAppCV.UpdateCVJobKinds:input_type -> personaappapi.cv.UpdateCVJobKindsRequest
-	40, // 47: personaappapi.cv.PersonaAppCV.GetCVJobKinds:input_type -> personaappapi.cv.GetCVJobKindsRequest
-	42, // 48: personaappapi.cv.PersonaAppCV.DeleteCVJobKinds:input_type -> personaappapi.cv.DeleteCVJobKindsRequest
-	44, // 49: personaappapi.cv.PersonaAppCV.UpdateJobKind:input_type -> personaappapi.cv.UpdateJobKindRequest
-	46, // 50: personaappapi.cv.PersonaAppCV.GetJobKinds:input_type -> personaappapi.cv.GetJobKindsRequest
-	48, // 51: personaappapi.cv.PersonaAppCV.DeleteJobKind:input_type -> personaappapi.cv.DeleteJobKindRequest
-	50, // 52: personaappapi.cv.PersonaAppCV.UpdateCVJobTypes:input_type -> personaappapi.cv.UpdateCVJobTypesRequest
-	52, // 53: personaappapi.cv.PersonaAppCV.GetCVJobTypes:input_type -> personaappapi.cv.GetCVJobTypesRequest
-	54, // 54: personaappapi.cv.PersonaAppCV.DeleteCVJobTypes:input_type -> personaappapi.cv.DeleteCVJobTypesRequest
-	56, // 55: personaappapi.cv.PersonaAppCV.UpdateJobType:input_type -> personaappapi.cv.UpdateJobTypeRequest
-	58, // 56: personaappapi.cv.PersonaAppCV.GetJobTypes:input_type -> personaappapi.cv.GetJobTypesRequest
-	60, // 57: personaappapi.cv.PersonaAppCV.DeleteJobType:input_type -> personaappapi.cv.DeleteJobTypeRequest
-	1,  // 58: personaappapi.cv.PersonaAppCV.UpdateCV:output_type -> personaappapi.cv.UpdateCVResponse
-	5,  // 59: personaappapi.cv.PersonaAppCV.GetCV:output_type -> personaappapi.cv.GetCVResponse
-	3,  // 60: personaappapi.cv.PersonaAppCV.GetCVs:output_type -> personaappapi.cv.GetCVsResponse
-	7,  // 61: personaappapi.cv.PersonaAppCV.DeleteCV:output_type -> personaappapi.cv.DeleteCVResponse
-	9,  // 62: personaappapi.cv.PersonaAppCV.UpdateStoriesEpisode:output_type -> personaappapi.cv.UpdateStoriesEpisodeResponse
-	11, // 63: personaappapi.cv.PersonaAppCV.GetStoriesEpisodes:output_type -> personaappapi.cv.GetStoriesEpisodesResponse
-	13, // 64: personaappapi.cv.PersonaAppCV.DeleteStoriesEpisode:output_type -> personaappapi.cv.DeleteStoriesEpisodeResponse
-	15, // 65: personaappapi.cv.PersonaAppCV.UpdateStory:output_type -> personaappapi.cv.UpdateStoryResponse
-	17, // 66: personaappapi.cv.PersonaAppCV.GetStories:output_type -> personaappapi.cv.GetStoriesResponse
-	19, // 67: personaappapi.cv.PersonaAppCV.DeleteStory:output_type -> personaappapi.cv.DeleteStoryResponse
-	21, // 68: personaappapi.cv.PersonaAppCV.UpdateCustomSection:output_type -> personaappapi.cv.UpdateCustomSectionResponse
-	23, // 69: personaappapi.cv.PersonaAppCV.GetCustomSections:output_type -> personaappapi.cv.GetCustomSectionsResponse
-	25, // 70: personaappapi.cv.PersonaAppCV.DeleteCustomSection:output_type -> personaappapi.cv.DeleteCustomSectionResponse
-	27, // 71: personaappapi.cv.PersonaAppCV.UpdateEducation:output_type -> personaappapi.cv.UpdateEducationResponse
-	29, // 72: personaappapi.cv.PersonaAppCV.GetEducations:output_type -> personaappapi.cv.GetEducationsResponse
-	31, // 73: personaappapi.cv.PersonaAppCV.DeleteEducation:output_type -> personaappapi.cv.DeleteEducationResponse
-	33, // 74: personaappapi.cv.PersonaAppCV.UpdateExperience:output_type -> personaappapi.cv.UpdateExperienceResponse
-	35, // 75: personaappapi.cv.PersonaAppCV.GetExperiences:output_type -> personaappapi.cv.GetExperiencesResponse
-	37, // 76: personaappapi.cv.PersonaAppCV.DeleteExperience:output_type -> personaappapi.cv.DeleteExperienceResponse
-	39, // 77: personaappapi.cv.PersonaAppCV.UpdateCVJobKinds:output_type -> personaappapi.cv.UpdateCVJobKindsResponse
-	41, // 78: personaappapi.cv.PersonaAppCV.GetCVJobKinds:output_type -> personaappapi.cv.GetCVJobKindsResponse
-	43, // 79: personaappapi.cv.PersonaAppCV.DeleteCVJobKinds:output_type -> personaappapi.cv.DeleteCVJobKindsResponse
-	45, // 80: personaappapi.cv.PersonaAppCV.UpdateJobKind:output_type -> personaappapi.cv.UpdateJobKindResponse
-	47, // 81: personaappapi.cv.PersonaAppCV.GetJobKinds:output_type -> personaappapi.cv.GetJobKindsResponse
-	49, // 82: personaappapi.cv.PersonaAppCV.DeleteJobKind:output_type -> personaappapi.cv.DeleteJobKindResponse
-	51, // 83: personaappapi.cv.PersonaAppCV.UpdateCVJobTypes:output_type -> personaappapi.cv.UpdateCVJobTypesResponse
-	53, // 84: personaappapi.cv.PersonaAppCV.GetCVJobTypes:output_type -> personaappapi.cv.GetCVJobTypesResponse
-	55, // 85: personaappapi.cv.PersonaAppCV.DeleteCVJobTypes:output_type -> personaappapi.cv.DeleteCVJobTypesResponse
-	57, // 86: personaappapi.cv.PersonaAppCV.UpdateJobType:output_type -> personaappapi.cv.UpdateJobTypeResponse
-	59, // 87: personaappapi.cv.PersonaAppCV.GetJobTypes:output_type -> personaappapi.cv.GetJobTypesResponse
-	61, // 88: personaappapi.cv.PersonaAppCV.DeleteJobType:output_type -> personaappapi.cv.DeleteJobTypeResponse
-	58, // [58:89] is the sub-list for method output_type
-	27, // [27:58] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	74, // 0: personaappapi.cv.UpdateCVRequest.id:type_name -> google.protobuf.StringValue
+	0,  // 1: personaappapi.cv.UpdateCVRequest.salary_period:type_name -> personaappapi.cv.SalaryPeriod
+	75, // 2: personaappapi.cv.UpdateCVRequest.salary_gross:type_name -> google.protobuf.BoolValue
+	64, // 3: personaappapi.cv.GetCVsResponse.cv_short:type_name -> personaappapi.cv.CVShort
+	63, // 4: personaappapi.cv.GetCVResponse.cv:type_name -> personaappapi.cv.CV
+	74, // 5: personaappapi.cv.UpdateStoriesEpisodeRequest.id:type_name -> google.protobuf.StringValue
+	65, // 6: personaappapi.cv.GetStoriesEpisodesResponse.story_episode:type_name -> personaappapi.cv.StoryEpisode
+	74, // 7: personaappapi.cv.UpdateStoryRequest.id:type_name -> google.protobuf.StringValue
+	66, // 8: personaappapi.cv.GetStoriesResponse.cv_custom_story:type_name -> personaappapi.cv.CVCustomStory
+	74, // 9: personaappapi.cv.UpdateCustomSectionRequest.id:type_name -> google.protobuf.StringValue
+	67, // 10: personaappapi.cv.GetCustomSectionsResponse.cv_custom_section:type_name -> personaappapi.cv.CVCustomSection
+	74, // 11: personaappapi.cv.UpdateEducationRequest.id:type_name -> google.protobuf.StringValue
+	76, // 12: personaappapi.cv.UpdateEducationRequest.date_from:type_name -> google.protobuf.Timestamp
+	76, // 13: personaappapi.cv.UpdateEducationRequest.date_till:type_name -> google.protobuf.Timestamp
+	68, // 14: personaappapi.cv.GetEducationsResponse.cv_education:type_name -> personaappapi.cv.CVEducation
+	74, // 15: personaappapi.cv.UpdateExperienceRequest.id:type_name -> google.protobuf.StringValue
+	76, // 16: personaappapi.cv.UpdateExperienceRequest.date_from:type_name -> google.protobuf.Timestamp
+	76, // 17: personaappapi.cv.UpdateExperienceRequest.date_till:type_name -> google.protobuf.Timestamp
+	69, // 18: personaappapi.cv.GetExperiencesResponse.cv_experience:type_name -> personaappapi.cv.CVExperience
+	70, // 19: personaappapi.cv.GetCVJobKindsResponse.cv_job_kind:type_name -> personaappapi.cv.CVJobKind
+	74, // 20: personaappapi.cv.UpdateJobKindRequest.id:type_name -> google.protobuf.StringValue
+	71, // 21: personaappapi.cv.GetJobKindsResponse.job_kind:type_name -> personaappapi.cv.JobKind
+	72, // 22: personaappapi.cv.GetCVJobTypesResponse.cv_job_type:type_name -> personaappapi.cv.CVJobType
+	74, // 23: personaappapi.cv.UpdateJobTypeRequest.id:type_name -> google.protobuf.StringValue
+	73, // 24: personaappapi.cv.GetJobTypesResponse.job_type:type_name -> personaappapi.cv.JobType
+	0,  // 25: personaappapi.cv.CV.salary_period:type_name -> personaappapi.cv.SalaryPeriod
+	75, // 26: personaappapi.cv.CV.salary_gross:type_name -> google.protobuf.BoolValue
+	0,  // 27: personaappapi.cv.CVShort.salary_period:type_name -> personaappapi.cv.SalaryPeriod
+	75, // 28: personaappapi.cv.CVShort.salary_gross:type_name -> google.protobuf.BoolValue
+	76, // 29: personaappapi.cv.CVEducation.date_from:type_name -> google.protobuf.Timestamp
+	76, // 30: personaappapi.cv.CVEducation.date_till:type_name -> google.protobuf.Timestamp
+	76, // 31: personaappapi.cv.CVExperience.date_from:type_name -> google.protobuf.Timestamp
+	76, // 32: personaappapi.cv.CVExperience.date_till:type_name -> google.protobuf.Timestamp
+	1,  // 33: personaappapi.cv.PersonaAppCV.UpdateCV:input_type -> personaappapi.cv.UpdateCVRequest
+	5,  // 34: personaappapi.cv.PersonaAppCV.GetCV:input_type -> personaappapi.cv.GetCVRequest
+	3,  // 35: personaappapi.cv.PersonaAppCV.GetCVs:input_type -> personaappapi.cv.GetCVsRequest
+	7,  // 36: personaappapi.cv.PersonaAppCV.DeleteCV:input_type -> personaappapi.cv.DeleteCVRequest
+	9,  // 37: personaappapi.cv.PersonaAppCV.UpdateStoriesEpisode:input_type -> personaappapi.cv.UpdateStoriesEpisodeRequest
+	11, // 38: personaappapi.cv.PersonaAppCV.GetStoriesEpisodes:input_type -> personaappapi.cv.GetStoriesEpisodesRequest
+	13, // 39: personaappapi.cv.PersonaAppCV.DeleteStoriesEpisode:input_type -> personaappapi.cv.DeleteStoriesEpisodeRequest
+	15, // 40: personaappapi.cv.PersonaAppCV.UpdateStory:input_type -> personaappapi.cv.UpdateStoryRequest
+	17, // 41: personaappapi.cv.PersonaAppCV.GetStories:input_type -> personaappapi.cv.GetStoriesRequest
+	19, // 42: personaappapi.cv.PersonaAppCV.DeleteStory:input_type -> personaappapi.cv.DeleteStoryRequest
+	21, // 43: personaappapi.cv.PersonaAppCV.UpdateCustomSection:input_type -> personaappapi.cv.UpdateCustomSectionRequest
+	23, // 44: personaappapi.cv.PersonaAppCV.GetCustomSections:input_type -> personaappapi.cv.GetCustomSectionsRequest
+	25, // 45: personaappapi.cv.PersonaAppCV.DeleteCustomSection:input_type -> personaappapi.cv.DeleteCustomSectionRequest
+	27, // 46: personaappapi.cv.PersonaAppCV.UpdateEducation:input_type -> personaappapi.cv.UpdateEducationRequest
+	29, // 47: personaappapi.cv.PersonaAppCV.GetEducations:input_type -> personaappapi.cv.GetEducationsRequest
+	31, // 48: personaappapi.cv.PersonaAppCV.DeleteEducation:input_type -> personaappapi.cv.DeleteEducationRequest
+	33, // 49: personaappapi.cv.PersonaAppCV.UpdateExperience:input_type -> personaappapi.cv.UpdateExperienceRequest
+	35, // 50: personaappapi.cv.PersonaAppCV.GetExperiences:input_type -> personaappapi.cv.GetExperiencesRequest
+	37, // 51: personaappapi.cv.PersonaAppCV.DeleteExperience:input_type -> personaappapi.cv.DeleteExperienceRequest
+	39, // 52: personaappapi.cv.PersonaAppCV.UpdateCVJobKinds:input_type -> personaappapi.cv.UpdateCVJobKindsRequest
+	41, // 53: personaappapi.cv.PersonaAppCV.GetCVJobKinds:input_type -> personaappapi.cv.GetCVJobKindsRequest
+	43, // 54: personaappapi.cv.PersonaAppCV.DeleteCVJobKinds:input_type -> personaappapi.cv.DeleteCVJobKindsRequest
+	45, // 55: personaappapi.cv.PersonaAppCV.UpdateJobKind:input_type -> personaappapi.cv.UpdateJobKindRequest
+	47, // 56: personaappapi.cv.PersonaAppCV.GetJobKinds:input_type -> personaappapi.cv.GetJobKindsRequest
+	49, // 57: personaappapi.cv.PersonaAppCV.DeleteJobKind:input_type -> personaappapi.cv.DeleteJobKindRequest
+	51, // 58: personaappapi.cv.PersonaAppCV.UpdateCVJobTypes:input_type -> personaappapi.cv.UpdateCVJobTypesRequest
+	53, // 59: personaappapi.cv.PersonaAppCV.GetCVJobTypes:input_type -> personaappapi.cv.GetCVJobTypesRequest
+	55, // 60: personaappapi.cv.PersonaAppCV.DeleteCVJobTypes:input_type -> personaappapi.cv.DeleteCVJobTypesRequest
+	57, // 61: personaappapi.cv.PersonaAppCV.UpdateJobType:input_type -> personaappapi.cv.UpdateJobTypeRequest
+	59, // 62: personaappapi.cv.PersonaAppCV.GetJobTypes:input_type -> personaappapi.cv.GetJobTypesRequest
+	61, // 63: personaappapi.cv.PersonaAppCV.DeleteJobType:input_type -> personaappapi.cv.DeleteJobTypeRequest
+	2,  // 64: personaappapi.cv.PersonaAppCV.UpdateCV:output_type -> personaappapi.cv.UpdateCVResponse
+	6,  // 65: personaappapi.cv.PersonaAppCV.GetCV:output_type -> personaappapi.cv.GetCVResponse
+	4,  // 66: personaappapi.cv.PersonaAppCV.GetCVs:output_type -> personaappapi.cv.GetCVsResponse
+	8,  // 67: personaappapi.cv.PersonaAppCV.DeleteCV:output_type -> personaappapi.cv.DeleteCVResponse
+	10, // 68: personaappapi.cv.PersonaAppCV.UpdateStoriesEpisode:output_type -> personaappapi.cv.UpdateStoriesEpisodeResponse
+	12, // 69: personaappapi.cv.PersonaAppCV.GetStoriesEpisodes:output_type -> personaappapi.cv.GetStoriesEpisodesResponse
+	14, // 70: personaappapi.cv.PersonaAppCV.DeleteStoriesEpisode:output_type -> personaappapi.cv.DeleteStoriesEpisodeResponse
+	16, // 71: personaappapi.cv.PersonaAppCV.UpdateStory:output_type -> personaappapi.cv.UpdateStoryResponse
+	18, // 72: personaappapi.cv.PersonaAppCV.GetStories:output_type -> personaappapi.cv.GetStoriesResponse
+	20, // 73: personaappapi.cv.PersonaAppCV.DeleteStory:output_type -> personaappapi.cv.DeleteStoryResponse
+	22, // 74: personaappapi.cv.PersonaAppCV.UpdateCustomSection:output_type -> personaappapi.cv.UpdateCustomSectionResponse
+	24, // 75: personaappapi.cv.PersonaAppCV.GetCustomSections:output_type -> personaappapi.cv.GetCustomSectionsResponse
+	26, // 76: personaappapi.cv.PersonaAppCV.DeleteCustomSection:output_type -> personaappapi.cv.DeleteCustomSectionResponse
+	28, // 77: personaappapi.cv.PersonaAppCV.UpdateEducation:output_type -> personaappapi.cv.UpdateEducationResponse
+	30, // 78: personaappapi.cv.PersonaAppCV.GetEducations:output_type -> personaappapi.cv.GetEducationsResponse
+	32, // 79: personaappapi.cv.PersonaAppCV.DeleteEducation:output_type -> personaappapi.cv.DeleteEducationResponse
+	34, // 80: personaappapi.cv.PersonaAppCV.UpdateExperience:output_type -> personaappapi.cv.UpdateExperienceResponse
+	36, // 81: personaappapi.cv.PersonaAppCV.GetExperiences:output_type -> personaappapi.cv.GetExperiencesResponse
+	38, // 82: personaappapi.cv.PersonaAppCV.DeleteExperience:output_type -> personaappapi.cv.DeleteExperienceResponse
+	40, // 83: personaappapi.cv.PersonaAppCV.UpdateCVJobKinds:output_type -> personaappapi.cv.UpdateCVJobKindsResponse
+	42, // 84: personaappapi.cv.PersonaAppCV.GetCVJobKinds:output_type -> personaappapi.cv.GetCVJobKindsResponse
+	44, // 85: personaappapi.cv.PersonaAppCV.DeleteCVJobKinds:output_type -> personaappapi.cv.DeleteCVJobKindsResponse
+	46, // 86: personaappapi.cv.PersonaAppCV.UpdateJobKind:output_type -> personaappapi.cv.UpdateJobKindResponse
+	48, // 87: personaappapi.cv.PersonaAppCV.GetJobKinds:output_type -> personaappapi.cv.GetJobKindsResponse
+	50, // 88: personaappapi.cv.PersonaAppCV.DeleteJobKind:output_type -> personaappapi.cv.DeleteJobKindResponse
+	52, // 89: personaappapi.cv.PersonaAppCV.UpdateCVJobTypes:output_type -> personaappapi.cv.UpdateCVJobTypesResponse
+	54, // 90: personaappapi.cv.PersonaAppCV.GetCVJobTypes:output_type -> personaappapi.cv.GetCVJobTypesResponse
+	56, // 91: personaappapi.cv.PersonaAppCV.DeleteCVJobTypes:output_type -> personaappapi.cv.DeleteCVJobTypesResponse
+	58, // 92: personaappapi.cv.PersonaAppCV.UpdateJobType:output_type -> personaappapi.cv.UpdateJobTypeResponse
+	60, // 93: personaappapi.cv.PersonaAppCV.GetJobTypes:output_type -> personaappapi.cv.GetJobTypesResponse
+	62, // 94: personaappapi.cv.PersonaAppCV.DeleteJobType:output_type -> personaappapi.cv.DeleteJobTypeResponse
+	64, // [64:95] is the sub-list for method output_type
+	33, // [33:64] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_cv_cv_proto_init() }
@@ -5446,13 +5621,14 @@ func file_cv_cv_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cv_cv_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cv_cv_proto_goTypes,
 		DependencyIndexes: file_cv_cv_proto_depIdxs,
+		EnumInfos:         file_cv_cv_proto_enumTypes,
 		MessageInfos:      file_cv_cv_proto_msgTypes,
 	}.Build()
 	File_cv_cv_proto = out.File
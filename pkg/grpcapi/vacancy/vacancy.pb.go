@@ -9,6 +9,7 @@ package personaappapi_vacancy
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -31,6 +32,284 @@ const (
 const _ = proto.ProtoPackageIsVersion4
 
 // Entity
+type VacancyStatus int32
+
+const (
+	VacancyStatus_VACANCY_STATUS_UNKNOWN        VacancyStatus = 0
+	VacancyStatus_VACANCY_STATUS_DRAFT          VacancyStatus = 1
+	VacancyStatus_VACANCY_STATUS_PUBLISHED      VacancyStatus = 2
+	VacancyStatus_VACANCY_STATUS_PAUSED         VacancyStatus = 3
+	VacancyStatus_VACANCY_STATUS_CLOSED         VacancyStatus = 4
+	VacancyStatus_VACANCY_STATUS_PENDING_REVIEW VacancyStatus = 5
+	VacancyStatus_VACANCY_STATUS_REJECTED       VacancyStatus = 6
+)
+
+// Enum value maps for VacancyStatus.
+var (
+	VacancyStatus_name = map[int32]string{
+		0: "VACANCY_STATUS_UNKNOWN",
+		1: "VACANCY_STATUS_DRAFT",
+		2: "VACANCY_STATUS_PUBLISHED",
+		3: "VACANCY_STATUS_PAUSED",
+		4: "VACANCY_STATUS_CLOSED",
+		5: "VACANCY_STATUS_PENDING_REVIEW",
+		6: "VACANCY_STATUS_REJECTED",
+	}
+	VacancyStatus_value = map[string]int32{
+		"VACANCY_STATUS_UNKNOWN":        0,
+		"VACANCY_STATUS_DRAFT":          1,
+		"VACANCY_STATUS_PUBLISHED":      2,
+		"VACANCY_STATUS_PAUSED":         3,
+		"VACANCY_STATUS_CLOSED":         4,
+		"VACANCY_STATUS_PENDING_REVIEW": 5,
+		"VACANCY_STATUS_REJECTED":       6,
+	}
+)
+
+func (x VacancyStatus) Enum() *VacancyStatus {
+	p := new(VacancyStatus)
+	*p = x
+	return p
+}
+
+func (x VacancyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VacancyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[0].Descriptor()
+}
+
+func (VacancyStatus) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[0]
+}
+
+func (x VacancyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VacancyStatus.Descriptor instead.
+func (VacancyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{0}
+}
+
+type ModerationReason int32
+
+const (
+	ModerationReason_MODERATION_REASON_UNKNOWN        ModerationReason = 0
+	ModerationReason_MODERATION_REASON_BANNED_WORDS   ModerationReason = 1
+	ModerationReason_MODERATION_REASON_INVALID_PHONE  ModerationReason = 2
+	ModerationReason_MODERATION_REASON_MISSING_SALARY ModerationReason = 3
+	ModerationReason_MODERATION_REASON_DUPLICATE      ModerationReason = 4
+	ModerationReason_MODERATION_REASON_MISLEADING     ModerationReason = 5
+	ModerationReason_MODERATION_REASON_DISCRIMINATORY ModerationReason = 6
+	ModerationReason_MODERATION_REASON_OTHER          ModerationReason = 7
+)
+
+// Enum value maps for ModerationReason.
+var (
+	ModerationReason_name = map[int32]string{
+		0: "MODERATION_REASON_UNKNOWN",
+		1: "MODERATION_REASON_BANNED_WORDS",
+		2: "MODERATION_REASON_INVALID_PHONE",
+		3: "MODERATION_REASON_MISSING_SALARY",
+		4: "MODERATION_REASON_DUPLICATE",
+		5: "MODERATION_REASON_MISLEADING",
+		6: "MODERATION_REASON_DISCRIMINATORY",
+		7: "MODERATION_REASON_OTHER",
+	}
+	ModerationReason_value = map[string]int32{
+		"MODERATION_REASON_UNKNOWN":        0,
+		"MODERATION_REASON_BANNED_WORDS":   1,
+		"MODERATION_REASON_INVALID_PHONE":  2,
+		"MODERATION_REASON_MISSING_SALARY": 3,
+		"MODERATION_REASON_DUPLICATE":      4,
+		"MODERATION_REASON_MISLEADING":     5,
+		"MODERATION_REASON_DISCRIMINATORY": 6,
+		"MODERATION_REASON_OTHER":          7,
+	}
+)
+
+func (x ModerationReason) Enum() *ModerationReason {
+	p := new(ModerationReason)
+	*p = x
+	return p
+}
+
+func (x ModerationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationReason) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[1]
+}
+
+func (x ModerationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationReason.Descriptor instead.
+func (ModerationReason) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{1}
+}
+
+type ModerationDecision int32
+
+const (
+	ModerationDecision_MODERATION_DECISION_UNKNOWN   ModerationDecision = 0
+	ModerationDecision_MODERATION_DECISION_SUBMITTED ModerationDecision = 1
+	ModerationDecision_MODERATION_DECISION_APPROVED  ModerationDecision = 2
+	ModerationDecision_MODERATION_DECISION_REJECTED  ModerationDecision = 3
+)
+
+// Enum value maps for ModerationDecision.
+var (
+	ModerationDecision_name = map[int32]string{
+		0: "MODERATION_DECISION_UNKNOWN",
+		1: "MODERATION_DECISION_SUBMITTED",
+		2: "MODERATION_DECISION_APPROVED",
+		3: "MODERATION_DECISION_REJECTED",
+	}
+	ModerationDecision_value = map[string]int32{
+		"MODERATION_DECISION_UNKNOWN":   0,
+		"MODERATION_DECISION_SUBMITTED": 1,
+		"MODERATION_DECISION_APPROVED":  2,
+		"MODERATION_DECISION_REJECTED":  3,
+	}
+)
+
+func (x ModerationDecision) Enum() *ModerationDecision {
+	p := new(ModerationDecision)
+	*p = x
+	return p
+}
+
+func (x ModerationDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[2].Descriptor()
+}
+
+func (ModerationDecision) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[2]
+}
+
+func (x ModerationDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationDecision.Descriptor instead.
+func (ModerationDecision) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{2}
+}
+
+type VacancyStatsGranularity int32
+
+const (
+	VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_UNKNOWN VacancyStatsGranularity = 0
+	VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_HOUR    VacancyStatsGranularity = 1
+	VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_DAY     VacancyStatsGranularity = 2
+)
+
+// Enum value maps for VacancyStatsGranularity.
+var (
+	VacancyStatsGranularity_name = map[int32]string{
+		0: "VACANCY_STATS_GRANULARITY_UNKNOWN",
+		1: "VACANCY_STATS_GRANULARITY_HOUR",
+		2: "VACANCY_STATS_GRANULARITY_DAY",
+	}
+	VacancyStatsGranularity_value = map[string]int32{
+		"VACANCY_STATS_GRANULARITY_UNKNOWN": 0,
+		"VACANCY_STATS_GRANULARITY_HOUR":    1,
+		"VACANCY_STATS_GRANULARITY_DAY":     2,
+	}
+)
+
+func (x VacancyStatsGranularity) Enum() *VacancyStatsGranularity {
+	p := new(VacancyStatsGranularity)
+	*p = x
+	return p
+}
+
+func (x VacancyStatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VacancyStatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[3].Descriptor()
+}
+
+func (VacancyStatsGranularity) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[3]
+}
+
+func (x VacancyStatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VacancyStatsGranularity.Descriptor instead.
+func (VacancyStatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{3}
+}
+
+type SalaryPeriod int32
+
+const (
+	SalaryPeriod_SALARY_PERIOD_UNKNOWN SalaryPeriod = 0
+	SalaryPeriod_SALARY_PERIOD_HOUR    SalaryPeriod = 1
+	SalaryPeriod_SALARY_PERIOD_MONTH   SalaryPeriod = 2
+	SalaryPeriod_SALARY_PERIOD_YEAR    SalaryPeriod = 3
+)
+
+// Enum value maps for SalaryPeriod.
+var (
+	SalaryPeriod_name = map[int32]string{
+		0: "SALARY_PERIOD_UNKNOWN",
+		1: "SALARY_PERIOD_HOUR",
+		2: "SALARY_PERIOD_MONTH",
+		3: "SALARY_PERIOD_YEAR",
+	}
+	SalaryPeriod_value = map[string]int32{
+		"SALARY_PERIOD_UNKNOWN": 0,
+		"SALARY_PERIOD_HOUR":    1,
+		"SALARY_PERIOD_MONTH":   2,
+		"SALARY_PERIOD_YEAR":    3,
+	}
+)
+
+func (x SalaryPeriod) Enum() *SalaryPeriod {
+	p := new(SalaryPeriod)
+	*p = x
+	return p
+}
+
+func (x SalaryPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SalaryPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[4].Descriptor()
+}
+
+func (SalaryPeriod) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[4]
+}
+
+func (x SalaryPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SalaryPeriod.Descriptor instead.
+func (SalaryPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{4}
+}
+
 type VacancyType int32
 
 const (
@@ -64,11 +343,11 @@ func (x VacancyType) String() string {
 }
 
 func (VacancyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_vacancy_vacancy_proto_enumTypes[0].Descriptor()
+	return file_vacancy_vacancy_proto_enumTypes[5].Descriptor()
 }
 
 func (VacancyType) Type() protoreflect.EnumType {
-	return &file_vacancy_vacancy_proto_enumTypes[0]
+	return &file_vacancy_vacancy_proto_enumTypes[5]
 }
 
 func (x VacancyType) Number() protoreflect.EnumNumber {
@@ -77,7 +356,62 @@ func (x VacancyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VacancyType.Descriptor instead.
 func (VacancyType) EnumDescriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{0}
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{5}
+}
+
+type VacancySortOrder int32
+
+const (
+	VacancySortOrder_VACANCY_SORT_ORDER_UNKNOWN   VacancySortOrder = 0
+	VacancySortOrder_VACANCY_SORT_ORDER_NEWEST    VacancySortOrder = 1
+	VacancySortOrder_VACANCY_SORT_ORDER_SALARY    VacancySortOrder = 2
+	VacancySortOrder_VACANCY_SORT_ORDER_RELEVANCE VacancySortOrder = 3
+	VacancySortOrder_VACANCY_SORT_ORDER_DISTANCE  VacancySortOrder = 4
+)
+
+// Enum value maps for VacancySortOrder.
+var (
+	VacancySortOrder_name = map[int32]string{
+		0: "VACANCY_SORT_ORDER_UNKNOWN",
+		1: "VACANCY_SORT_ORDER_NEWEST",
+		2: "VACANCY_SORT_ORDER_SALARY",
+		3: "VACANCY_SORT_ORDER_RELEVANCE",
+		4: "VACANCY_SORT_ORDER_DISTANCE",
+	}
+	VacancySortOrder_value = map[string]int32{
+		"VACANCY_SORT_ORDER_UNKNOWN":   0,
+		"VACANCY_SORT_ORDER_NEWEST":    1,
+		"VACANCY_SORT_ORDER_SALARY":    2,
+		"VACANCY_SORT_ORDER_RELEVANCE": 3,
+		"VACANCY_SORT_ORDER_DISTANCE":  4,
+	}
+)
+
+func (x VacancySortOrder) Enum() *VacancySortOrder {
+	p := new(VacancySortOrder)
+	*p = x
+	return p
+}
+
+func (x VacancySortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VacancySortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[6].Descriptor()
+}
+
+func (VacancySortOrder) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[6]
+}
+
+func (x VacancySortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VacancySortOrder.Descriptor instead.
+func (VacancySortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{6}
 }
 
 type Currency int32
@@ -110,11 +444,11 @@ func (x Currency) String() string {
 }
 
 func (Currency) Descriptor() protoreflect.EnumDescriptor {
-	return file_vacancy_vacancy_proto_enumTypes[1].Descriptor()
+	return file_vacancy_vacancy_proto_enumTypes[7].Descriptor()
 }
 
 func (Currency) Type() protoreflect.EnumType {
-	return &file_vacancy_vacancy_proto_enumTypes[1]
+	return &file_vacancy_vacancy_proto_enumTypes[7]
 }
 
 func (x Currency) Number() protoreflect.EnumNumber {
@@ -123,7 +457,56 @@ func (x Currency) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Currency.Descriptor instead.
 func (Currency) EnumDescriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{1}
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{7}
+}
+
+type ImportVacanciesRequest_Format int32
+
+const (
+	ImportVacanciesRequest_FORMAT_UNKNOWN ImportVacanciesRequest_Format = 0
+	ImportVacanciesRequest_FORMAT_CSV     ImportVacanciesRequest_Format = 1
+	ImportVacanciesRequest_FORMAT_XML     ImportVacanciesRequest_Format = 2
+)
+
+// Enum value maps for ImportVacanciesRequest_Format.
+var (
+	ImportVacanciesRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNKNOWN",
+		1: "FORMAT_CSV",
+		2: "FORMAT_XML",
+	}
+	ImportVacanciesRequest_Format_value = map[string]int32{
+		"FORMAT_UNKNOWN": 0,
+		"FORMAT_CSV":     1,
+		"FORMAT_XML":     2,
+	}
+)
+
+func (x ImportVacanciesRequest_Format) Enum() *ImportVacanciesRequest_Format {
+	p := new(ImportVacanciesRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ImportVacanciesRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportVacanciesRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_vacancy_proto_enumTypes[8].Descriptor()
+}
+
+func (ImportVacanciesRequest_Format) Type() protoreflect.EnumType {
+	return &file_vacancy_vacancy_proto_enumTypes[8]
+}
+
+func (x ImportVacanciesRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportVacanciesRequest_Format.Descriptor instead.
+func (ImportVacanciesRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{68, 0}
 }
 
 // Get vacancy category
@@ -227,9 +610,15 @@ type UpdateVacancyCategoryRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      *wrappers.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title   string                `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	IconUrl string                `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Id *wrappers.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The default title, the one of the request locale is chosen of the titles
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	IconUrl string `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	// The parent of a new category, a root when not set. The place of an existing category is changed
+	// with MoveVacancyCategory.
+	ParentId *wrappers.StringValue `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// The localized titles by BCP 47 locales, "uk" or "en-US", replace the current ones
+	Titles map[string]string `protobuf:"bytes,5,rep,name=titles,proto3" json:"titles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *UpdateVacancyCategoryRequest) Reset() {
@@ -285,6 +674,20 @@ func (x *UpdateVacancyCategoryRequest) GetIconUrl() string {
 	return ""
 }
 
+func (x *UpdateVacancyCategoryRequest) GetParentId() *wrappers.StringValue {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *UpdateVacancyCategoryRequest) GetTitles() map[string]string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
 type UpdateVacancyCategoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -425,6 +828,8 @@ type GetVacancyCategoriesListRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Rating *wrappers.Int32Value `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	// The descendants of the parent only when set
+	ParentId *wrappers.StringValue `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
 }
 
 func (x *GetVacancyCategoriesListRequest) Reset() {
@@ -466,6 +871,13 @@ func (x *GetVacancyCategoriesListRequest) GetRating() *wrappers.Int32Value {
 	return nil
 }
 
+func (x *GetVacancyCategoriesListRequest) GetParentId() *wrappers.StringValue {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
 type GetVacancyCategoriesListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -513,22 +925,21 @@ func (x *GetVacancyCategoriesListResponse) GetVacancyCategories() map[string]*Va
 	return nil
 }
 
-// Update vacancy
-type UpdateVacancyRequest struct {
+// Move vacancy category with its subtree
+type MoveVacancyCategoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vacancy     *UpdateVacancyRequest_Vacancy            `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
-	ImageURLs   []string                                 `protobuf:"bytes,2,rep,name=imageURLs,proto3" json:"imageURLs,omitempty"`
-	Location    *UpdateVacancyRequest_VacancyLocation    `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Description *UpdateVacancyRequest_VacancyDescription `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CityIDs     []string                                 `protobuf:"bytes,5,rep,name=cityIDs,proto3" json:"cityIDs,omitempty"`
-	CategoryIDs []string                                 `protobuf:"bytes,6,rep,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A root category when not set
+	ParentId *wrappers.StringValue `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// The position among the children of the parent from 0, the last one when out of range
+	Position int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *UpdateVacancyRequest) Reset() {
-	*x = UpdateVacancyRequest{}
+func (x *MoveVacancyCategoryRequest) Reset() {
+	*x = MoveVacancyCategoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,13 +947,13 @@ func (x *UpdateVacancyRequest) Reset() {
 	}
 }
 
-func (x *UpdateVacancyRequest) String() string {
+func (x *MoveVacancyCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVacancyRequest) ProtoMessage() {}
+func (*MoveVacancyCategoryRequest) ProtoMessage() {}
 
-func (x *UpdateVacancyRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveVacancyCategoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,55 +965,160 @@ func (x *UpdateVacancyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVacancyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVacancyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveVacancyCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveVacancyCategoryRequest) Descriptor() ([]byte, []int) {
 	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateVacancyRequest) GetVacancy() *UpdateVacancyRequest_Vacancy {
+func (x *MoveVacancyCategoryRequest) GetId() string {
 	if x != nil {
-		return x.Vacancy
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateVacancyRequest) GetImageURLs() []string {
+func (x *MoveVacancyCategoryRequest) GetParentId() *wrappers.StringValue {
 	if x != nil {
-		return x.ImageURLs
+		return x.ParentId
 	}
 	return nil
 }
 
-func (x *UpdateVacancyRequest) GetLocation() *UpdateVacancyRequest_VacancyLocation {
+func (x *MoveVacancyCategoryRequest) GetPosition() int32 {
 	if x != nil {
-		return x.Location
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateVacancyRequest) GetDescription() *UpdateVacancyRequest_VacancyDescription {
-	if x != nil {
-		return x.Description
-	}
-	return nil
+type MoveVacancyCategoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateVacancyRequest) GetCityIDs() []string {
-	if x != nil {
-		return x.CityIDs
+func (x *MoveVacancyCategoryResponse) Reset() {
+	*x = MoveVacancyCategoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UpdateVacancyRequest) GetCategoryIDs() []string {
-	if x != nil {
-		return x.CategoryIDs
-	}
-	return nil
+func (x *MoveVacancyCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type UpdateVacancyResponse struct {
-	state         protoimpl.MessageState
+func (*MoveVacancyCategoryResponse) ProtoMessage() {}
+
+func (x *MoveVacancyCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveVacancyCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveVacancyCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{9}
+}
+
+// Update vacancy
+type UpdateVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancy     *UpdateVacancyRequest_Vacancy            `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	ImageURLs   []string                                 `protobuf:"bytes,2,rep,name=imageURLs,proto3" json:"imageURLs,omitempty"`
+	Location    *UpdateVacancyRequest_VacancyLocation    `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Description *UpdateVacancyRequest_VacancyDescription `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CityIDs     []string                                 `protobuf:"bytes,5,rep,name=cityIDs,proto3" json:"cityIDs,omitempty"`
+	CategoryIDs []string                                 `protobuf:"bytes,6,rep,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
+}
+
+func (x *UpdateVacancyRequest) Reset() {
+	*x = UpdateVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVacancyRequest) ProtoMessage() {}
+
+func (x *UpdateVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVacancyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateVacancyRequest) GetVacancy() *UpdateVacancyRequest_Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetImageURLs() []string {
+	if x != nil {
+		return x.ImageURLs
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetLocation() *UpdateVacancyRequest_VacancyLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetDescription() *UpdateVacancyRequest_VacancyDescription {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetCityIDs() []string {
+	if x != nil {
+		return x.CityIDs
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetCategoryIDs() []string {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
+type UpdateVacancyResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -612,7 +1128,7 @@ type UpdateVacancyResponse struct {
 func (x *UpdateVacancyResponse) Reset() {
 	*x = UpdateVacancyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[9]
+		mi := &file_vacancy_vacancy_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -625,7 +1141,7 @@ func (x *UpdateVacancyResponse) String() string {
 func (*UpdateVacancyResponse) ProtoMessage() {}
 
 func (x *UpdateVacancyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[9]
+	mi := &file_vacancy_vacancy_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +1154,7 @@ func (x *UpdateVacancyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVacancyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateVacancyResponse) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{9}
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateVacancyResponse) GetId() string {
@@ -657,12 +1173,31 @@ type GetVacanciesListRequest struct {
 	CategoriesIds map[string]*Empty     `protobuf:"bytes,1,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Cursor        *wrappers.StringValue `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	Count         *wrappers.Int32Value  `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Vacancies within the radius, the nearest first unless sort_order is set
+	Near      *GeoRadius           `protobuf:"bytes,4,opt,name=near,proto3" json:"near,omitempty"`
+	CitiesIds map[string]*Empty    `protobuf:"bytes,5,rep,name=cities_ids,json=citiesIds,proto3" json:"cities_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MinSalary *wrappers.Int32Value `protobuf:"bytes,6,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary *wrappers.Int32Value `protobuf:"bytes,7,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	// Currency of the salary range, UAH when unknown
+	//
+	// Deprecated: Do not use.
+	Currency            Currency              `protobuf:"varint,8,opt,name=currency,proto3,enum=personaappapi.vacancy.Currency" json:"currency,omitempty"`
+	Type                VacancyType           `protobuf:"varint,9,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	MaxExperienceMonths *wrappers.Int32Value  `protobuf:"bytes,10,opt,name=max_experience_months,json=maxExperienceMonths,proto3" json:"max_experience_months,omitempty"`
+	CompanyId           *wrappers.StringValue `protobuf:"bytes,11,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CountryCode         *wrappers.Int32Value  `protobuf:"bytes,12,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Keywords            string                `protobuf:"bytes,13,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	// The salary order compares the upper salaries converted to monthly ones in the same currency
+	SortOrder VacancySortOrder `protobuf:"varint,14,opt,name=sort_order,json=sortOrder,proto3,enum=personaappapi.vacancy.VacancySortOrder" json:"sort_order,omitempty"`
+	// ISO 4217 code of the monthly salary range, UAH when empty. Takes precedence over currency,
+	// the vacancy salaries are converted by the exchange rates
+	CurrencyCode string `protobuf:"bytes,15,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 }
 
 func (x *GetVacanciesListRequest) Reset() {
 	*x = GetVacanciesListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[10]
+		mi := &file_vacancy_vacancy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -675,7 +1210,7 @@ func (x *GetVacanciesListRequest) String() string {
 func (*GetVacanciesListRequest) ProtoMessage() {}
 
 func (x *GetVacanciesListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[10]
+	mi := &file_vacancy_vacancy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +1223,7 @@ func (x *GetVacanciesListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVacanciesListRequest.ProtoReflect.Descriptor instead.
 func (*GetVacanciesListRequest) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{10}
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetVacanciesListRequest) GetCategoriesIds() map[string]*Empty {
@@ -712,6 +1247,91 @@ func (x *GetVacanciesListRequest) GetCount() *wrappers.Int32Value {
 	return nil
 }
 
+func (x *GetVacanciesListRequest) GetNear() *GeoRadius {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+func (x *GetVacanciesListRequest) GetCitiesIds() map[string]*Empty {
+	if x != nil {
+		return x.CitiesIds
+	}
+	return nil
+}
+
+func (x *GetVacanciesListRequest) GetMinSalary() *wrappers.Int32Value {
+	if x != nil {
+		return x.MinSalary
+	}
+	return nil
+}
+
+func (x *GetVacanciesListRequest) GetMaxSalary() *wrappers.Int32Value {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *GetVacanciesListRequest) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_CURRENCY_UNKNOWN
+}
+
+func (x *GetVacanciesListRequest) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
+}
+
+func (x *GetVacanciesListRequest) GetMaxExperienceMonths() *wrappers.Int32Value {
+	if x != nil {
+		return x.MaxExperienceMonths
+	}
+	return nil
+}
+
+func (x *GetVacanciesListRequest) GetCompanyId() *wrappers.StringValue {
+	if x != nil {
+		return x.CompanyId
+	}
+	return nil
+}
+
+func (x *GetVacanciesListRequest) GetCountryCode() *wrappers.Int32Value {
+	if x != nil {
+		return x.CountryCode
+	}
+	return nil
+}
+
+func (x *GetVacanciesListRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *GetVacanciesListRequest) GetSortOrder() VacancySortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return VacancySortOrder_VACANCY_SORT_ORDER_UNKNOWN
+}
+
+func (x *GetVacanciesListRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type GetVacanciesListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -727,7 +1347,7 @@ type GetVacanciesListResponse struct {
 func (x *GetVacanciesListResponse) Reset() {
 	*x = GetVacanciesListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[11]
+		mi := &file_vacancy_vacancy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -740,7 +1360,7 @@ func (x *GetVacanciesListResponse) String() string {
 func (*GetVacanciesListResponse) ProtoMessage() {}
 
 func (x *GetVacanciesListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[11]
+	mi := &file_vacancy_vacancy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1373,7 @@ func (x *GetVacanciesListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVacanciesListResponse.ProtoReflect.Descriptor instead.
 func (*GetVacanciesListResponse) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{11}
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetVacanciesListResponse) GetVacanciesIds() []string {
@@ -791,32 +1411,35 @@ func (x *GetVacanciesListResponse) GetCursor() *wrappers.StringValue {
 	return nil
 }
 
-// Get Vacancy Details
-type GetVacancyDetailsRequest struct {
+// Search Vacancies
+type SearchVacanciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Query         string                `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CategoriesIds map[string]*Empty     `protobuf:"bytes,2,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cursor        *wrappers.StringValue `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count         *wrappers.Int32Value  `protobuf:"bytes,4,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetVacancyDetailsRequest) Reset() {
-	*x = GetVacancyDetailsRequest{}
+func (x *SearchVacanciesRequest) Reset() {
+	*x = SearchVacanciesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[12]
+		mi := &file_vacancy_vacancy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVacancyDetailsRequest) String() string {
+func (x *SearchVacanciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsRequest) ProtoMessage() {}
+func (*SearchVacanciesRequest) ProtoMessage() {}
 
-func (x *GetVacancyDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[12]
+func (x *SearchVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,49 +1450,68 @@ func (x *GetVacancyDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SearchVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*SearchVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetVacancyDetailsRequest) GetVacancyId() string {
+func (x *SearchVacanciesRequest) GetQuery() string {
 	if x != nil {
-		return x.VacancyId
+		return x.Query
 	}
 	return ""
 }
 
-type GetVacancyDetailsResponse struct {
+func (x *SearchVacanciesRequest) GetCategoriesIds() map[string]*Empty {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *SearchVacanciesRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *SearchVacanciesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type SearchVacanciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vacancy     *Vacancy                                      `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
-	Image       *GetVacancyDetailsResponse_VacancyImage       `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Location    *GetVacancyDetailsResponse_VacancyLocation    `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Description *GetVacancyDetailsResponse_VacancyDescription `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Company     *GetVacancyDetailsResponse_VacancyCompany     `protobuf:"bytes,5,opt,name=company,proto3" json:"company,omitempty"`
-	City        *City                                         `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
-	Categories  map[string]*VacancyCategoryShort              `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	VacanciesIds []string                                           `protobuf:"bytes,1,rep,name=vacancies_ids,json=vacanciesIds,proto3" json:"vacancies_ids,omitempty"`
+	Vacancies    map[string]*SearchVacanciesResponse_VacancyDetails `protobuf:"bytes,2,rep,name=vacancies,proto3" json:"vacancies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Companies    map[string]*Company                                `protobuf:"bytes,3,rep,name=companies,proto3" json:"companies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Categories   map[string]*VacancyCategoryShort                   `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cursor       *wrappers.StringValue                              `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetVacancyDetailsResponse) Reset() {
-	*x = GetVacancyDetailsResponse{}
+func (x *SearchVacanciesResponse) Reset() {
+	*x = SearchVacanciesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[13]
+		mi := &file_vacancy_vacancy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVacancyDetailsResponse) String() string {
+func (x *SearchVacanciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsResponse) ProtoMessage() {}
+func (*SearchVacanciesResponse) ProtoMessage() {}
 
-func (x *GetVacancyDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[13]
+func (x *SearchVacanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,86 +1522,75 @@ func (x *GetVacancyDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SearchVacanciesResponse.ProtoReflect.Descriptor instead.
+func (*SearchVacanciesResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetVacancyDetailsResponse) GetVacancy() *Vacancy {
+func (x *SearchVacanciesResponse) GetVacanciesIds() []string {
 	if x != nil {
-		return x.Vacancy
+		return x.VacanciesIds
 	}
 	return nil
 }
 
-func (x *GetVacancyDetailsResponse) GetImage() *GetVacancyDetailsResponse_VacancyImage {
+func (x *SearchVacanciesResponse) GetVacancies() map[string]*SearchVacanciesResponse_VacancyDetails {
 	if x != nil {
-		return x.Image
+		return x.Vacancies
 	}
 	return nil
 }
 
-func (x *GetVacancyDetailsResponse) GetLocation() *GetVacancyDetailsResponse_VacancyLocation {
+func (x *SearchVacanciesResponse) GetCompanies() map[string]*Company {
 	if x != nil {
-		return x.Location
+		return x.Companies
 	}
 	return nil
 }
 
-func (x *GetVacancyDetailsResponse) GetDescription() *GetVacancyDetailsResponse_VacancyDescription {
+func (x *SearchVacanciesResponse) GetCategories() map[string]*VacancyCategoryShort {
 	if x != nil {
-		return x.Description
-	}
-	return nil
-}
-
-func (x *GetVacancyDetailsResponse) GetCompany() *GetVacancyDetailsResponse_VacancyCompany {
-	if x != nil {
-		return x.Company
-	}
-	return nil
-}
-
-func (x *GetVacancyDetailsResponse) GetCity() *City {
-	if x != nil {
-		return x.City
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *GetVacancyDetailsResponse) GetCategories() map[string]*VacancyCategoryShort {
+func (x *SearchVacanciesResponse) GetCursor() *wrappers.StringValue {
 	if x != nil {
-		return x.Categories
+		return x.Cursor
 	}
 	return nil
 }
 
-// Delete Vacancy
-type DeleteVacancyRequest struct {
+// Get Vacancies Map
+type GetVacanciesMapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bounds *BoundingBox `protobuf:"bytes,1,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	// Web map zoom level from 0 to 20, vacancies are clustered below 14
+	Zoom          int32             `protobuf:"varint,2,opt,name=zoom,proto3" json:"zoom,omitempty"`
+	CategoriesIds map[string]*Empty `protobuf:"bytes,3,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DeleteVacancyRequest) Reset() {
-	*x = DeleteVacancyRequest{}
+func (x *GetVacanciesMapRequest) Reset() {
+	*x = GetVacanciesMapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[14]
+		mi := &file_vacancy_vacancy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteVacancyRequest) String() string {
+func (x *GetVacanciesMapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVacancyRequest) ProtoMessage() {}
+func (*GetVacanciesMapRequest) ProtoMessage() {}
 
-func (x *DeleteVacancyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[14]
+func (x *GetVacanciesMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,41 +1601,58 @@ func (x *DeleteVacancyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVacancyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVacancyRequest) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetVacanciesMapRequest.ProtoReflect.Descriptor instead.
+func (*GetVacanciesMapRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteVacancyRequest) GetId() string {
+func (x *GetVacanciesMapRequest) GetBounds() *BoundingBox {
 	if x != nil {
-		return x.Id
+		return x.Bounds
 	}
-	return ""
+	return nil
 }
 
-type DeleteVacancyResponse struct {
+func (x *GetVacanciesMapRequest) GetZoom() int32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+func (x *GetVacanciesMapRequest) GetCategoriesIds() map[string]*Empty {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+type GetVacanciesMapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Markers  []*GetVacanciesMapResponse_Marker  `protobuf:"bytes,1,rep,name=markers,proto3" json:"markers,omitempty"`
+	Clusters []*GetVacanciesMapResponse_Cluster `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
 }
 
-func (x *DeleteVacancyResponse) Reset() {
-	*x = DeleteVacancyResponse{}
+func (x *GetVacanciesMapResponse) Reset() {
+	*x = GetVacanciesMapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[15]
+		mi := &file_vacancy_vacancy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteVacancyResponse) String() string {
+func (x *GetVacanciesMapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVacancyResponse) ProtoMessage() {}
+func (*GetVacanciesMapResponse) ProtoMessage() {}
 
-func (x *DeleteVacancyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[15]
+func (x *GetVacanciesMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,34 +1663,65 @@ func (x *DeleteVacancyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVacancyResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVacancyResponse) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetVacanciesMapResponse.ProtoReflect.Descriptor instead.
+func (*GetVacanciesMapResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{17}
 }
 
-type Empty struct {
+func (x *GetVacanciesMapResponse) GetMarkers() []*GetVacanciesMapResponse_Marker {
+	if x != nil {
+		return x.Markers
+	}
+	return nil
+}
+
+func (x *GetVacanciesMapResponse) GetClusters() []*GetVacanciesMapResponse_Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// Get Vacancy Facets
+// The filter fields are the ones of GetVacanciesListRequest
+type GetVacancyFacetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+	CategoriesIds map[string]*Empty    `protobuf:"bytes,1,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Near          *GeoRadius           `protobuf:"bytes,4,opt,name=near,proto3" json:"near,omitempty"`
+	CitiesIds     map[string]*Empty    `protobuf:"bytes,5,rep,name=cities_ids,json=citiesIds,proto3" json:"cities_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MinSalary     *wrappers.Int32Value `protobuf:"bytes,6,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary     *wrappers.Int32Value `protobuf:"bytes,7,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	// Deprecated: Do not use.
+	Currency            Currency              `protobuf:"varint,8,opt,name=currency,proto3,enum=personaappapi.vacancy.Currency" json:"currency,omitempty"`
+	Type                VacancyType           `protobuf:"varint,9,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	MaxExperienceMonths *wrappers.Int32Value  `protobuf:"bytes,10,opt,name=max_experience_months,json=maxExperienceMonths,proto3" json:"max_experience_months,omitempty"`
+	CompanyId           *wrappers.StringValue `protobuf:"bytes,11,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CountryCode         *wrappers.Int32Value  `protobuf:"bytes,12,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Keywords            string                `protobuf:"bytes,13,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	// ISO 4217 code of the monthly salary range and the salary buckets, UAH when empty
+	CurrencyCode string `protobuf:"bytes,14,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+}
+
+func (x *GetVacancyFacetsRequest) Reset() {
+	*x = GetVacancyFacetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[16]
+		mi := &file_vacancy_vacancy_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Empty) String() string {
+func (x *GetVacancyFacetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*GetVacancyFacetsRequest) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[16]
+func (x *GetVacancyFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,102 +1732,125 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetVacancyFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{18}
 }
 
-type Company struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetVacancyFacetsRequest) GetCategoriesIds() map[string]*Empty {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	LogoUrl string `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+func (x *GetVacancyFacetsRequest) GetNear() *GeoRadius {
+	if x != nil {
+		return x.Near
+	}
+	return nil
 }
 
-func (x *Company) Reset() {
-	*x = Company{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetVacancyFacetsRequest) GetCitiesIds() map[string]*Empty {
+	if x != nil {
+		return x.CitiesIds
 	}
+	return nil
 }
 
-func (x *Company) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetVacancyFacetsRequest) GetMinSalary() *wrappers.Int32Value {
+	if x != nil {
+		return x.MinSalary
+	}
+	return nil
 }
 
-func (*Company) ProtoMessage() {}
+func (x *GetVacancyFacetsRequest) GetMaxSalary() *wrappers.Int32Value {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return nil
+}
 
-func (x *Company) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Deprecated: Do not use.
+func (x *GetVacancyFacetsRequest) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
 	}
-	return mi.MessageOf(x)
+	return Currency_CURRENCY_UNKNOWN
 }
 
-// Deprecated: Use Company.ProtoReflect.Descriptor instead.
-func (*Company) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{17}
+func (x *GetVacancyFacetsRequest) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
 }
 
-func (x *Company) GetId() string {
+func (x *GetVacancyFacetsRequest) GetMaxExperienceMonths() *wrappers.Int32Value {
 	if x != nil {
-		return x.Id
+		return x.MaxExperienceMonths
 	}
-	return ""
+	return nil
 }
 
-func (x *Company) GetTitle() string {
+func (x *GetVacancyFacetsRequest) GetCompanyId() *wrappers.StringValue {
 	if x != nil {
-		return x.Title
+		return x.CompanyId
+	}
+	return nil
+}
+
+func (x *GetVacancyFacetsRequest) GetCountryCode() *wrappers.Int32Value {
+	if x != nil {
+		return x.CountryCode
+	}
+	return nil
+}
+
+func (x *GetVacancyFacetsRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
 	}
 	return ""
 }
 
-func (x *Company) GetLogoUrl() string {
+func (x *GetVacancyFacetsRequest) GetCurrencyCode() string {
 	if x != nil {
-		return x.LogoUrl
+		return x.CurrencyCode
 	}
 	return ""
 }
 
-type VacancyCategory struct {
+// Each facet ignores its own filter field, so the counts of the other values are kept for multi-select
+type GetVacancyFacetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	IconUrl string `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	Rating  int32  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Categories    map[string]int32                         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Cities        map[string]int32                         `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Types         []*GetVacancyFacetsResponse_TypeCount    `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	SalaryBuckets []*GetVacancyFacetsResponse_SalaryBucket `protobuf:"bytes,4,rep,name=salary_buckets,json=salaryBuckets,proto3" json:"salary_buckets,omitempty"`
 }
 
-func (x *VacancyCategory) Reset() {
-	*x = VacancyCategory{}
+func (x *GetVacancyFacetsResponse) Reset() {
+	*x = GetVacancyFacetsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[18]
+		mi := &file_vacancy_vacancy_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VacancyCategory) String() string {
+func (x *GetVacancyFacetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VacancyCategory) ProtoMessage() {}
+func (*GetVacancyFacetsResponse) ProtoMessage() {}
 
-func (x *VacancyCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[18]
+func (x *GetVacancyFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,71 +1861,65 @@ func (x *VacancyCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VacancyCategory.ProtoReflect.Descriptor instead.
-func (*VacancyCategory) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetVacancyFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetVacancyFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *VacancyCategory) GetId() string {
+func (x *GetVacancyFacetsResponse) GetCategories() map[string]int32 {
 	if x != nil {
-		return x.Id
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-func (x *VacancyCategory) GetTitle() string {
+func (x *GetVacancyFacetsResponse) GetCities() map[string]int32 {
 	if x != nil {
-		return x.Title
+		return x.Cities
 	}
-	return ""
+	return nil
 }
 
-func (x *VacancyCategory) GetIconUrl() string {
+func (x *GetVacancyFacetsResponse) GetTypes() []*GetVacancyFacetsResponse_TypeCount {
 	if x != nil {
-		return x.IconUrl
+		return x.Types
 	}
-	return ""
+	return nil
 }
 
-func (x *VacancyCategory) GetRating() int32 {
+func (x *GetVacancyFacetsResponse) GetSalaryBuckets() []*GetVacancyFacetsResponse_SalaryBucket {
 	if x != nil {
-		return x.Rating
+		return x.SalaryBuckets
 	}
-	return 0
+	return nil
 }
 
-type Vacancy struct {
+// Get Vacancy Details
+type GetVacancyDetailsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Phone         string   `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	MinSalary     int32    `protobuf:"varint,4,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
-	MaxSalary     int32    `protobuf:"varint,5,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
-	CompanyId     string   `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	Currency      Currency `protobuf:"varint,7,opt,name=currency,proto3,enum=personaappapi.vacancy.Currency" json:"currency,omitempty"`
-	CategoriesIds []string `protobuf:"bytes,8,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
 }
 
-func (x *Vacancy) Reset() {
-	*x = Vacancy{}
+func (x *GetVacancyDetailsRequest) Reset() {
+	*x = GetVacancyDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[19]
+		mi := &file_vacancy_vacancy_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Vacancy) String() string {
+func (x *GetVacancyDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vacancy) ProtoMessage() {}
+func (*GetVacancyDetailsRequest) ProtoMessage() {}
 
-func (x *Vacancy) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[19]
+func (x *GetVacancyDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,142 +1930,206 @@ func (x *Vacancy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vacancy.ProtoReflect.Descriptor instead.
-func (*Vacancy) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetVacancyDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Vacancy) GetId() string {
+func (x *GetVacancyDetailsRequest) GetVacancyId() string {
 	if x != nil {
-		return x.Id
+		return x.VacancyId
 	}
 	return ""
 }
 
-func (x *Vacancy) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type GetVacancyDetailsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancy     *Vacancy                                      `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	Image       *GetVacancyDetailsResponse_VacancyImage       `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Location    *GetVacancyDetailsResponse_VacancyLocation    `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Description *GetVacancyDetailsResponse_VacancyDescription `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Company     *GetVacancyDetailsResponse_VacancyCompany     `protobuf:"bytes,5,opt,name=company,proto3" json:"company,omitempty"`
+	City        *City                                         `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Categories  map[string]*VacancyCategoryShort              `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Status      VacancyStatus                                 `protobuf:"varint,8,opt,name=status,proto3,enum=personaappapi.vacancy.VacancyStatus" json:"status,omitempty"`
+	PublishedAt *timestamp.Timestamp                          `protobuf:"bytes,9,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ExpiresAt   *timestamp.Timestamp                          `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Set for a persona who saved the vacancy
+	IsSaved bool `protobuf:"varint,11,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
+	// The vacancy this one reposts, it isn't listed while the original is live
+	DuplicateOf *wrappers.StringValue `protobuf:"bytes,12,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	// Set while the vacancy is scheduled to be published
+	ScheduledPublishAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=scheduled_publish_at,json=scheduledPublishAt,proto3" json:"scheduled_publish_at,omitempty"`
+	BumpedAt           *timestamp.Timestamp `protobuf:"bytes,14,opt,name=bumped_at,json=bumpedAt,proto3" json:"bumped_at,omitempty"`
+	BumpCount          int32                `protobuf:"varint,15,opt,name=bump_count,json=bumpCount,proto3" json:"bump_count,omitempty"`
 }
 
-func (x *Vacancy) GetPhone() string {
-	if x != nil {
-		return x.Phone
+func (x *GetVacancyDetailsResponse) Reset() {
+	*x = GetVacancyDetailsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Vacancy) GetMinSalary() int32 {
+func (x *GetVacancyDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyDetailsResponse) ProtoMessage() {}
+
+func (x *GetVacancyDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetVacancyDetailsResponse) GetVacancy() *Vacancy {
 	if x != nil {
-		return x.MinSalary
+		return x.Vacancy
 	}
-	return 0
+	return nil
 }
 
-func (x *Vacancy) GetMaxSalary() int32 {
+func (x *GetVacancyDetailsResponse) GetImage() *GetVacancyDetailsResponse_VacancyImage {
 	if x != nil {
-		return x.MaxSalary
+		return x.Image
 	}
-	return 0
+	return nil
 }
 
-func (x *Vacancy) GetCompanyId() string {
+func (x *GetVacancyDetailsResponse) GetLocation() *GetVacancyDetailsResponse_VacancyLocation {
 	if x != nil {
-		return x.CompanyId
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *Vacancy) GetCurrency() Currency {
+func (x *GetVacancyDetailsResponse) GetDescription() *GetVacancyDetailsResponse_VacancyDescription {
 	if x != nil {
-		return x.Currency
+		return x.Description
 	}
-	return Currency_CURRENCY_UNKNOWN
+	return nil
 }
 
-func (x *Vacancy) GetCategoriesIds() []string {
+func (x *GetVacancyDetailsResponse) GetCompany() *GetVacancyDetailsResponse_VacancyCompany {
 	if x != nil {
-		return x.CategoriesIds
+		return x.Company
 	}
 	return nil
 }
 
-type VacancyCategoryShort struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetVacancyDetailsResponse) GetCity() *City {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+func (x *GetVacancyDetailsResponse) GetCategories() map[string]*VacancyCategoryShort {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
 }
 
-func (x *VacancyCategoryShort) Reset() {
-	*x = VacancyCategoryShort{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetVacancyDetailsResponse) GetStatus() VacancyStatus {
+	if x != nil {
+		return x.Status
 	}
+	return VacancyStatus_VACANCY_STATUS_UNKNOWN
 }
 
-func (x *VacancyCategoryShort) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetVacancyDetailsResponse) GetPublishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
 }
 
-func (*VacancyCategoryShort) ProtoMessage() {}
+func (x *GetVacancyDetailsResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
 
-func (x *VacancyCategoryShort) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetVacancyDetailsResponse) GetIsSaved() bool {
+	if x != nil {
+		return x.IsSaved
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use VacancyCategoryShort.ProtoReflect.Descriptor instead.
-func (*VacancyCategoryShort) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{20}
+func (x *GetVacancyDetailsResponse) GetDuplicateOf() *wrappers.StringValue {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return nil
 }
 
-func (x *VacancyCategoryShort) GetTitle() string {
+func (x *GetVacancyDetailsResponse) GetScheduledPublishAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Title
+		return x.ScheduledPublishAt
 	}
-	return ""
+	return nil
 }
 
-type City struct {
+func (x *GetVacancyDetailsResponse) GetBumpedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.BumpedAt
+	}
+	return nil
+}
+
+func (x *GetVacancyDetailsResponse) GetBumpCount() int32 {
+	if x != nil {
+		return x.BumpCount
+	}
+	return 0
+}
+
+// Delete Vacancy
+type DeleteVacancyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CountryCode int32  `protobuf:"varint,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
-	Rating      int32  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *City) Reset() {
-	*x = City{}
+func (x *DeleteVacancyRequest) Reset() {
+	*x = DeleteVacancyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[21]
+		mi := &file_vacancy_vacancy_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *City) String() string {
+func (x *DeleteVacancyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*City) ProtoMessage() {}
+func (*DeleteVacancyRequest) ProtoMessage() {}
 
-func (x *City) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[21]
+func (x *DeleteVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,50 +2140,26 @@ func (x *City) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use City.ProtoReflect.Descriptor instead.
-func (*City) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteVacancyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *City) GetId() string {
+func (x *DeleteVacancyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *City) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *City) GetCountryCode() int32 {
-	if x != nil {
-		return x.CountryCode
-	}
-	return 0
-}
-
-func (x *City) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-type UpdateVacancyRequest_VacancyLocation struct {
+type DeleteVacancyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 }
 
-func (x *UpdateVacancyRequest_VacancyLocation) Reset() {
-	*x = UpdateVacancyRequest_VacancyLocation{}
+func (x *DeleteVacancyResponse) Reset() {
+	*x = DeleteVacancyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1431,13 +2167,13 @@ func (x *UpdateVacancyRequest_VacancyLocation) Reset() {
 	}
 }
 
-func (x *UpdateVacancyRequest_VacancyLocation) String() string {
+func (x *DeleteVacancyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVacancyRequest_VacancyLocation) ProtoMessage() {}
+func (*DeleteVacancyResponse) ProtoMessage() {}
 
-func (x *UpdateVacancyRequest_VacancyLocation) ProtoReflect() protoreflect.Message {
+func (x *DeleteVacancyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1449,40 +2185,25 @@ func (x *UpdateVacancyRequest_VacancyLocation) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVacancyRequest_VacancyLocation.ProtoReflect.Descriptor instead.
-func (*UpdateVacancyRequest_VacancyLocation) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *UpdateVacancyRequest_VacancyLocation) GetLatitude() float32 {
-	if x != nil {
-		return x.Latitude
-	}
-	return 0
-}
-
-func (x *UpdateVacancyRequest_VacancyLocation) GetLongitude() float32 {
-	if x != nil {
-		return x.Longitude
-	}
-	return 0
+// Deprecated: Use DeleteVacancyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{23}
 }
 
-type UpdateVacancyRequest_VacancyDescription struct {
+// Publish Vacancy
+// A draft or paused vacancy is shown to everybody until expires_at, the longest lifetime when empty.
+// While moderation is on, a vacancy edited since the last approval goes on review instead
+type PublishVacancyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description          string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	WorkMonthsExperience uint32      `protobuf:"varint,2,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
-	WorkSchedule         string      `protobuf:"bytes,3,opt,name=work_schedule,json=workSchedule,proto3" json:"work_schedule,omitempty"`
-	Type                 VacancyType `protobuf:"varint,4,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
-	Address              string      `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	CountryCode          int32       `protobuf:"varint,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *UpdateVacancyRequest_VacancyDescription) Reset() {
-	*x = UpdateVacancyRequest_VacancyDescription{}
+func (x *PublishVacancyRequest) Reset() {
+	*x = PublishVacancyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,13 +2211,13 @@ func (x *UpdateVacancyRequest_VacancyDescription) Reset() {
 	}
 }
 
-func (x *UpdateVacancyRequest_VacancyDescription) String() string {
+func (x *PublishVacancyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVacancyRequest_VacancyDescription) ProtoMessage() {}
+func (*PublishVacancyRequest) ProtoMessage() {}
 
-func (x *UpdateVacancyRequest_VacancyDescription) ProtoReflect() protoreflect.Message {
+func (x *PublishVacancyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1508,69 +2229,33 @@ func (x *UpdateVacancyRequest_VacancyDescription) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVacancyRequest_VacancyDescription.ProtoReflect.Descriptor instead.
-func (*UpdateVacancyRequest_VacancyDescription) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{8, 1}
-}
-
-func (x *UpdateVacancyRequest_VacancyDescription) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdateVacancyRequest_VacancyDescription) GetWorkMonthsExperience() uint32 {
-	if x != nil {
-		return x.WorkMonthsExperience
-	}
-	return 0
-}
-
-func (x *UpdateVacancyRequest_VacancyDescription) GetWorkSchedule() string {
-	if x != nil {
-		return x.WorkSchedule
-	}
-	return ""
-}
-
-func (x *UpdateVacancyRequest_VacancyDescription) GetType() VacancyType {
-	if x != nil {
-		return x.Type
-	}
-	return VacancyType_VACANCY_TYPE_UNKNOWN
+// Deprecated: Use PublishVacancyRequest.ProtoReflect.Descriptor instead.
+func (*PublishVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateVacancyRequest_VacancyDescription) GetAddress() string {
+func (x *PublishVacancyRequest) GetId() string {
 	if x != nil {
-		return x.Address
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateVacancyRequest_VacancyDescription) GetCountryCode() int32 {
+func (x *PublishVacancyRequest) GetExpiresAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.CountryCode
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
-type UpdateVacancyRequest_Vacancy struct {
+type PublishVacancyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id        *wrappers.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title     string                `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Phone     string                `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	MinSalary int32                 `protobuf:"varint,4,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
-	MaxSalary int32                 `protobuf:"varint,5,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
-	CompanyId string                `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	Currency  Currency              `protobuf:"varint,7,opt,name=currency,proto3,enum=personaappapi.vacancy.Currency" json:"currency,omitempty"`
 }
 
-func (x *UpdateVacancyRequest_Vacancy) Reset() {
-	*x = UpdateVacancyRequest_Vacancy{}
+func (x *PublishVacancyResponse) Reset() {
+	*x = PublishVacancyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1578,13 +2263,13 @@ func (x *UpdateVacancyRequest_Vacancy) Reset() {
 	}
 }
 
-func (x *UpdateVacancyRequest_Vacancy) String() string {
+func (x *PublishVacancyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVacancyRequest_Vacancy) ProtoMessage() {}
+func (*PublishVacancyResponse) ProtoMessage() {}
 
-func (x *UpdateVacancyRequest_Vacancy) ProtoReflect() protoreflect.Message {
+func (x *PublishVacancyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,71 +2281,76 @@ func (x *UpdateVacancyRequest_Vacancy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVacancyRequest_Vacancy.ProtoReflect.Descriptor instead.
-func (*UpdateVacancyRequest_Vacancy) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use PublishVacancyResponse.ProtoReflect.Descriptor instead.
+func (*PublishVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetId() *wrappers.StringValue {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// Schedule Vacancy Publish
+// A draft or paused vacancy is published at publish_at as with PublishVacancy, unset publish_at cancels it
+type ScheduleVacancyPublishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *ScheduleVacancyPublishRequest) Reset() {
+	*x = ScheduleVacancyPublishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x *ScheduleVacancyPublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetMinSalary() int32 {
-	if x != nil {
-		return x.MinSalary
+func (*ScheduleVacancyPublishRequest) ProtoMessage() {}
+
+func (x *ScheduleVacancyPublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetMaxSalary() int32 {
-	if x != nil {
-		return x.MaxSalary
-	}
-	return 0
+// Deprecated: Use ScheduleVacancyPublishRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleVacancyPublishRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetCompanyId() string {
+func (x *ScheduleVacancyPublishRequest) GetId() string {
 	if x != nil {
-		return x.CompanyId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateVacancyRequest_Vacancy) GetCurrency() Currency {
+func (x *ScheduleVacancyPublishRequest) GetPublishAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Currency
+		return x.PublishAt
 	}
-	return Currency_CURRENCY_UNKNOWN
+	return nil
 }
 
-type GetVacanciesListResponse_VacancyDetails struct {
+type ScheduleVacancyPublishResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Vacancy   *Vacancy `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
-	ImageUrls []string `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
 }
 
-func (x *GetVacanciesListResponse_VacancyDetails) Reset() {
-	*x = GetVacanciesListResponse_VacancyDetails{}
+func (x *ScheduleVacancyPublishResponse) Reset() {
+	*x = ScheduleVacancyPublishResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1668,13 +2358,13 @@ func (x *GetVacanciesListResponse_VacancyDetails) Reset() {
 	}
 }
 
-func (x *GetVacanciesListResponse_VacancyDetails) String() string {
+func (x *ScheduleVacancyPublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacanciesListResponse_VacancyDetails) ProtoMessage() {}
+func (*ScheduleVacancyPublishResponse) ProtoMessage() {}
 
-func (x *GetVacanciesListResponse_VacancyDetails) ProtoReflect() protoreflect.Message {
+func (x *ScheduleVacancyPublishResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1686,50 +2376,83 @@ func (x *GetVacanciesListResponse_VacancyDetails) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacanciesListResponse_VacancyDetails.ProtoReflect.Descriptor instead.
-func (*GetVacanciesListResponse_VacancyDetails) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use ScheduleVacancyPublishResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleVacancyPublishResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetVacanciesListResponse_VacancyDetails) GetVacancy() *Vacancy {
-	if x != nil {
-		return x.Vacancy
+// Bump Vacancy
+// A live vacancy is listed as the newest one again, a limited number of times
+type BumpVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BumpVacancyRequest) Reset() {
+	*x = BumpVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetVacanciesListResponse_VacancyDetails) GetImageUrls() []string {
+func (x *BumpVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpVacancyRequest) ProtoMessage() {}
+
+func (x *BumpVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpVacancyRequest.ProtoReflect.Descriptor instead.
+func (*BumpVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BumpVacancyRequest) GetId() string {
 	if x != nil {
-		return x.ImageUrls
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetVacancyDetailsResponse_CompanyDescription struct {
+type BumpVacancyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *GetVacancyDetailsResponse_CompanyDescription) Reset() {
-	*x = GetVacancyDetailsResponse_CompanyDescription{}
+func (x *BumpVacancyResponse) Reset() {
+	*x = BumpVacancyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[31]
+		mi := &file_vacancy_vacancy_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVacancyDetailsResponse_CompanyDescription) String() string {
+func (x *BumpVacancyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsResponse_CompanyDescription) ProtoMessage() {}
+func (*BumpVacancyResponse) ProtoMessage() {}
 
-func (x *GetVacancyDetailsResponse_CompanyDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[31]
+func (x *BumpVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,44 +2463,37 @@ func (x *GetVacancyDetailsResponse_CompanyDescription) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsResponse_CompanyDescription.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsResponse_CompanyDescription) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *GetVacancyDetailsResponse_CompanyDescription) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use BumpVacancyResponse.ProtoReflect.Descriptor instead.
+func (*BumpVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{29}
 }
 
-type GetVacancyDetailsResponse_VacancyLocation struct {
+// Pause Vacancy
+type PauseVacancyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetVacancyDetailsResponse_VacancyLocation) Reset() {
-	*x = GetVacancyDetailsResponse_VacancyLocation{}
+func (x *PauseVacancyRequest) Reset() {
+	*x = PauseVacancyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[32]
+		mi := &file_vacancy_vacancy_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyLocation) String() string {
+func (x *PauseVacancyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsResponse_VacancyLocation) ProtoMessage() {}
+func (*PauseVacancyRequest) ProtoMessage() {}
 
-func (x *GetVacancyDetailsResponse_VacancyLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[32]
+func (x *PauseVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,55 +2504,82 @@ func (x *GetVacancyDetailsResponse_VacancyLocation) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsResponse_VacancyLocation.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsResponse_VacancyLocation) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13, 1}
+// Deprecated: Use PauseVacancyRequest.ProtoReflect.Descriptor instead.
+func (*PauseVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyLocation) GetLatitude() float32 {
+func (x *PauseVacancyRequest) GetId() string {
 	if x != nil {
-		return x.Latitude
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetVacancyDetailsResponse_VacancyLocation) GetLongitude() float32 {
-	if x != nil {
-		return x.Longitude
+type PauseVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PauseVacancyResponse) Reset() {
+	*x = PauseVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type GetVacancyDetailsResponse_VacancyDescription struct {
+func (x *PauseVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseVacancyResponse) ProtoMessage() {}
+
+func (x *PauseVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseVacancyResponse.ProtoReflect.Descriptor instead.
+func (*PauseVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{31}
+}
+
+// Close Vacancy
+type CloseVacancyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description          string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	WorkMonthsExperience uint32      `protobuf:"varint,2,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
-	WorkSchedule         string      `protobuf:"bytes,3,opt,name=work_schedule,json=workSchedule,proto3" json:"work_schedule,omitempty"`
-	Type                 VacancyType `protobuf:"varint,4,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
-	Address              string      `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	CountryCode          int32       `protobuf:"varint,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) Reset() {
-	*x = GetVacancyDetailsResponse_VacancyDescription{}
+func (x *CloseVacancyRequest) Reset() {
+	*x = CloseVacancyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vacancy_vacancy_proto_msgTypes[33]
+		mi := &file_vacancy_vacancy_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) String() string {
+func (x *CloseVacancyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsResponse_VacancyDescription) ProtoMessage() {}
+func (*CloseVacancyRequest) ProtoMessage() {}
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_vacancy_vacancy_proto_msgTypes[33]
+func (x *CloseVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1847,64 +2590,71 @@ func (x *GetVacancyDetailsResponse_VacancyDescription) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsResponse_VacancyDescription.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsResponse_VacancyDescription) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13, 2}
+// Deprecated: Use CloseVacancyRequest.ProtoReflect.Descriptor instead.
+func (*CloseVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) GetDescription() string {
+func (x *CloseVacancyRequest) GetId() string {
 	if x != nil {
-		return x.Description
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) GetWorkMonthsExperience() uint32 {
-	if x != nil {
-		return x.WorkMonthsExperience
-	}
-	return 0
+type CloseVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) GetWorkSchedule() string {
-	if x != nil {
-		return x.WorkSchedule
+func (x *CloseVacancyResponse) Reset() {
+	*x = CloseVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) GetType() VacancyType {
-	if x != nil {
-		return x.Type
-	}
-	return VacancyType_VACANCY_TYPE_UNKNOWN
+func (x *CloseVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (*CloseVacancyResponse) ProtoMessage() {}
+
+func (x *CloseVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetVacancyDetailsResponse_VacancyDescription) GetCountryCode() int32 {
-	if x != nil {
-		return x.CountryCode
-	}
-	return 0
+// Deprecated: Use CloseVacancyResponse.ProtoReflect.Descriptor instead.
+func (*CloseVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{33}
 }
 
-type GetVacancyDetailsResponse_VacancyCompany struct {
+// List My Vacancies
+// Vacancies of the company making the request whatever their status, the newest first
+type ListMyVacanciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Company     *Company                                      `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
-	Description *GetVacancyDetailsResponse_CompanyDescription `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Any status when empty
+	Statuses []VacancyStatus       `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=personaappapi.vacancy.VacancyStatus" json:"statuses,omitempty"`
+	Cursor   *wrappers.StringValue `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count    *wrappers.Int32Value  `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetVacancyDetailsResponse_VacancyCompany) Reset() {
-	*x = GetVacancyDetailsResponse_VacancyCompany{}
+func (x *ListMyVacanciesRequest) Reset() {
+	*x = ListMyVacanciesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1912,13 +2662,13 @@ func (x *GetVacancyDetailsResponse_VacancyCompany) Reset() {
 	}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyCompany) String() string {
+func (x *ListMyVacanciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsResponse_VacancyCompany) ProtoMessage() {}
+func (*ListMyVacanciesRequest) ProtoMessage() {}
 
-func (x *GetVacancyDetailsResponse_VacancyCompany) ProtoReflect() protoreflect.Message {
+func (x *ListMyVacanciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1930,35 +2680,43 @@ func (x *GetVacancyDetailsResponse_VacancyCompany) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsResponse_VacancyCompany.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsResponse_VacancyCompany) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13, 3}
+// Deprecated: Use ListMyVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyCompany) GetCompany() *Company {
+func (x *ListMyVacanciesRequest) GetStatuses() []VacancyStatus {
 	if x != nil {
-		return x.Company
+		return x.Statuses
 	}
 	return nil
 }
 
-func (x *GetVacancyDetailsResponse_VacancyCompany) GetDescription() *GetVacancyDetailsResponse_CompanyDescription {
+func (x *ListMyVacanciesRequest) GetCursor() *wrappers.StringValue {
 	if x != nil {
-		return x.Description
+		return x.Cursor
 	}
 	return nil
 }
 
-type GetVacancyDetailsResponse_VacancyImage struct {
+func (x *ListMyVacanciesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListMyVacanciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImageUrls []string `protobuf:"bytes,1,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	Vacancies []*ListMyVacanciesResponse_MyVacancy `protobuf:"bytes,1,rep,name=vacancies,proto3" json:"vacancies,omitempty"`
+	Cursor    *wrappers.StringValue                `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetVacancyDetailsResponse_VacancyImage) Reset() {
-	*x = GetVacancyDetailsResponse_VacancyImage{}
+func (x *ListMyVacanciesResponse) Reset() {
+	*x = ListMyVacanciesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vacancy_vacancy_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1966,13 +2724,13 @@ func (x *GetVacancyDetailsResponse_VacancyImage) Reset() {
 	}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyImage) String() string {
+func (x *ListMyVacanciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyDetailsResponse_VacancyImage) ProtoMessage() {}
+func (*ListMyVacanciesResponse) ProtoMessage() {}
 
-func (x *GetVacancyDetailsResponse_VacancyImage) ProtoReflect() protoreflect.Message {
+func (x *ListMyVacanciesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vacancy_vacancy_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1984,1224 +2742,9602 @@ func (x *GetVacancyDetailsResponse_VacancyImage) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyDetailsResponse_VacancyImage.ProtoReflect.Descriptor instead.
-func (*GetVacancyDetailsResponse_VacancyImage) Descriptor() ([]byte, []int) {
-	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13, 4}
+// Deprecated: Use ListMyVacanciesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyVacanciesResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetVacancyDetailsResponse_VacancyImage) GetImageUrls() []string {
+func (x *ListMyVacanciesResponse) GetVacancies() []*ListMyVacanciesResponse_MyVacancy {
 	if x != nil {
-		return x.ImageUrls
+		return x.Vacancies
 	}
 	return nil
 }
 
-var File_vacancy_vacancy_proto protoreflect.FileDescriptor
+func (x *ListMyVacanciesResponse) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
 
-var file_vacancy_vacancy_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2f, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x1a, 0x1e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x7d, 0x0a,
-	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0x2f, 0x0a, 0x1d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a,
-	0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a,
-	0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56,
-	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x8f, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x76,
-	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x16, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x07, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4d, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x57,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x69, 0x74,
-	0x79, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79,
-	0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
-	0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x49, 0x44, 0x73, 0x1a, 0x4b, 0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x1a, 0x86, 0x02, 0x0a, 0x12, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x77,
-	0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x77, 0x6f, 0x72,
-	0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
-	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0xfd, 0x01, 0x0a, 0x07,
-	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x3b,
-	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x27, 0x0a, 0x15, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xcc, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x68, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x5e, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xc5, 0x06, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65,
-	0x73, 0x12, 0x5f, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x69, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55,
-	0x72, 0x6c, 0x73, 0x1a, 0x7c, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x5c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
-	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x6a, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x22, 0xba, 0x0a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x53,
-	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x5c, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x65, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x04,
-	0x63, 0x69, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x36, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x4b,
-	0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0x86, 0x02, 0x0a, 0x12,
-	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e,
-	0x74, 0x68, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
-	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
-	0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x43, 0x6f, 0x64, 0x65, 0x1a, 0xb1, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x12, 0x65, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2d, 0x0a, 0x0c, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x1a, 0x6a, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4a, 0x0a,
-	0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x6a, 0x0a, 0x0f, 0x56, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x22, 0x2c,
-	0x0a, 0x14, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x65, 0x0a, 0x04,
-	0x43, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x2a, 0x59, 0x0a, 0x0b, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
-	0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d,
-	0x4f, 0x54, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x32,
-	0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55,
-	0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x41, 0x48,
-	0x10, 0x01, 0x32, 0xeb, 0x07, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41, 0x70,
-	0x70, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56,
-	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x30,
-	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x2e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x33, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x2e, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
-	0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
-	0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x12, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63,
-	0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61,
-	0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
-	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x20, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x61, 0x70, 0x70, 0x42, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x56, 0x61, 0x63, 0x61, 0x6e,
-	0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Create vacancy alert
+type CreateVacancyAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *VacancyAlertFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-var (
-	file_vacancy_vacancy_proto_rawDescOnce sync.Once
-	file_vacancy_vacancy_proto_rawDescData = file_vacancy_vacancy_proto_rawDesc
-)
+func (x *CreateVacancyAlertRequest) Reset() {
+	*x = CreateVacancyAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_vacancy_vacancy_proto_rawDescGZIP() []byte {
-	file_vacancy_vacancy_proto_rawDescOnce.Do(func() {
-		file_vacancy_vacancy_proto_rawDescData = protoimpl.X.CompressGZIP(file_vacancy_vacancy_proto_rawDescData)
-	})
-	return file_vacancy_vacancy_proto_rawDescData
+func (x *CreateVacancyAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_vacancy_vacancy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_vacancy_vacancy_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
-var file_vacancy_vacancy_proto_goTypes = []interface{}{
-	(VacancyType)(0),                                // 0: personaappapi.vacancy.VacancyType
-	(Currency)(0),                                   // 1: personaappapi.vacancy.Currency
-	(*GetVacancyCategoryRequest)(nil),               // 2: personaappapi.vacancy.GetVacancyCategoryRequest
-	(*GetVacancyCategoryResponse)(nil),              // 3: personaappapi.vacancy.GetVacancyCategoryResponse
-	(*UpdateVacancyCategoryRequest)(nil),            // 4: personaappapi.vacancy.UpdateVacancyCategoryRequest
-	(*UpdateVacancyCategoryResponse)(nil),           // 5: personaappapi.vacancy.UpdateVacancyCategoryResponse
-	(*DeleteVacancyCategoryRequest)(nil),            // 6: personaappapi.vacancy.DeleteVacancyCategoryRequest
-	(*DeleteVacancyCategoryResponse)(nil),           // 7: personaappapi.vacancy.DeleteVacancyCategoryResponse
-	(*GetVacancyCategoriesListRequest)(nil),         // 8: personaappapi.vacancy.GetVacancyCategoriesListRequest
-	(*GetVacancyCategoriesListResponse)(nil),        // 9: personaappapi.vacancy.GetVacancyCategoriesListResponse
-	(*UpdateVacancyRequest)(nil),                    // 10: personaappapi.vacancy.UpdateVacancyRequest
-	(*UpdateVacancyResponse)(nil),                   // 11: personaappapi.vacancy.UpdateVacancyResponse
-	(*GetVacanciesListRequest)(nil),                 // 12: personaappapi.vacancy.GetVacanciesListRequest
-	(*GetVacanciesListResponse)(nil),                // 13: personaappapi.vacancy.GetVacanciesListResponse
-	(*GetVacancyDetailsRequest)(nil),                // 14: personaappapi.vacancy.GetVacancyDetailsRequest
-	(*GetVacancyDetailsResponse)(nil),               // 15: personaappapi.vacancy.GetVacancyDetailsResponse
-	(*DeleteVacancyRequest)(nil),                    // 16: personaappapi.vacancy.DeleteVacancyRequest
-	(*DeleteVacancyResponse)(nil),                   // 17: personaappapi.vacancy.DeleteVacancyResponse
-	(*Empty)(nil),                                   // 18: personaappapi.vacancy.Empty
-	(*Company)(nil),                                 // 19: personaappapi.vacancy.Company
-	(*VacancyCategory)(nil),                         // 20: personaappapi.vacancy.VacancyCategory
-	(*Vacancy)(nil),                                 // 21: personaappapi.vacancy.Vacancy
-	(*VacancyCategoryShort)(nil),                    // 22: personaappapi.vacancy.VacancyCategoryShort
-	(*City)(nil),                                    // 23: personaappapi.vacancy.City
-	nil,                                             // 24: personaappapi.vacancy.GetVacancyCategoriesListResponse.VacancyCategoriesEntry
-	(*UpdateVacancyRequest_VacancyLocation)(nil),    // 25: personaappapi.vacancy.UpdateVacancyRequest.VacancyLocation
-	(*UpdateVacancyRequest_VacancyDescription)(nil), // 26: personaappapi.vacancy.UpdateVacancyRequest.VacancyDescription
-	(*UpdateVacancyRequest_Vacancy)(nil),            // 27: personaappapi.vacancy.UpdateVacancyRequest.Vacancy
-	nil,                                             // 28: personaappapi.vacancy.GetVacanciesListRequest.CategoriesIdsEntry
-	(*GetVacanciesListResponse_VacancyDetails)(nil), // 29: personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails
-	nil, // 30: personaappapi.vacancy.GetVacanciesListResponse.VacanciesEntry
-	nil, // 31: personaappapi.vacancy.GetVacanciesListResponse.CompaniesEntry
-	nil, // 32: personaappapi.vacancy.GetVacanciesListResponse.CategoriesEntry
-	(*GetVacancyDetailsResponse_CompanyDescription)(nil), // 33: personaappapi.vacancy.GetVacancyDetailsResponse.CompanyDescription
-	(*GetVacancyDetailsResponse_VacancyLocation)(nil),    // 34: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyLocation
-	(*GetVacancyDetailsResponse_VacancyDescription)(nil), // 35: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyDescription
-	(*GetVacancyDetailsResponse_VacancyCompany)(nil),     // 36: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany
-	(*GetVacancyDetailsResponse_VacancyImage)(nil),       // 37: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyImage
-	nil,                          // 38: personaappapi.vacancy.GetVacancyDetailsResponse.CategoriesEntry
-	(*wrappers.StringValue)(nil), // 39: google.protobuf.StringValue
-	(*wrappers.Int32Value)(nil),  // 40: google.protobuf.Int32Value
+func (*CreateVacancyAlertRequest) ProtoMessage() {}
+
+func (x *CreateVacancyAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_vacancy_vacancy_proto_depIdxs = []int32{
-	20, // 0: personaappapi.vacancy.GetVacancyCategoryResponse.category:type_name -> personaappapi.vacancy.VacancyCategory
-	39, // 1: personaappapi.vacancy.UpdateVacancyCategoryRequest.id:type_name -> google.protobuf.StringValue
-	40, // 2: personaappapi.vacancy.GetVacancyCategoriesListRequest.rating:type_name -> google.protobuf.Int32Value
-	24, // 3: personaappapi.vacancy.GetVacancyCategoriesListResponse.vacancy_categories:type_name -> personaappapi.vacancy.GetVacancyCategoriesListResponse.VacancyCategoriesEntry
-	27, // 4: personaappapi.vacancy.UpdateVacancyRequest.vacancy:type_name -> personaappapi.vacancy.UpdateVacancyRequest.Vacancy
-	25, // 5: personaappapi.vacancy.UpdateVacancyRequest.location:type_name -> personaappapi.vacancy.UpdateVacancyRequest.VacancyLocation
-	26, // 6: personaappapi.vacancy.UpdateVacancyRequest.description:type_name -> personaappapi.vacancy.UpdateVacancyRequest.VacancyDescription
-	28, // 7: personaappapi.vacancy.GetVacanciesListRequest.categories_ids:type_name -> personaappapi.vacancy.GetVacanciesListRequest.CategoriesIdsEntry
-	39, // 8: personaappapi.vacancy.GetVacanciesListRequest.cursor:type_name -> google.protobuf.StringValue
-	40, // 9: personaappapi.vacancy.GetVacanciesListRequest.count:type_name -> google.protobuf.Int32Value
-	30, // 10: personaappapi.vacancy.GetVacanciesListResponse.vacancies:type_name -> personaappapi.vacancy.GetVacanciesListResponse.VacanciesEntry
-	31, // 11: personaappapi.vacancy.GetVacanciesListResponse.companies:type_name -> personaappapi.vacancy.GetVacanciesListResponse.CompaniesEntry
-	32, // 12: personaappapi.vacancy.GetVacanciesListResponse.categories:type_name -> personaappapi.vacancy.GetVacanciesListResponse.CategoriesEntry
-	39, // 13: personaappapi.vacancy.GetVacanciesListResponse.cursor:type_name -> google.protobuf.StringValue
-	21, // 14: personaappapi.vacancy.GetVacancyDetailsResponse.vacancy:type_name -> personaappapi.vacancy.Vacancy
-	37, // 15: personaappapi.vacancy.GetVacancyDetailsResponse.image:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyImage
-	34, // 16: personaappapi.vacancy.GetVacancyDetailsResponse.location:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyLocation
-	35, // 17: personaappapi.vacancy.GetVacancyDetailsResponse.description:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyDescription
-	36, // 18: personaappapi.vacancy.GetVacancyDetailsResponse.company:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany
-	23, // 19: personaappapi.vacancy.GetVacancyDetailsResponse.city:type_name -> personaappapi.vacancy.City
-	38, // 20: personaappapi.vacancy.GetVacancyDetailsResponse.categories:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.CategoriesEntry
-	1,  // 21: personaappapi.vacancy.Vacancy.currency:type_name -> personaappapi.vacancy.Currency
-	20, // 22: personaappapi.vacancy.GetVacancyCategoriesListResponse.VacancyCategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategory
-	0,  // 23: personaappapi.vacancy.UpdateVacancyRequest.VacancyDescription.type:type_name -> personaappapi.vacancy.VacancyType
-	39, // 24: personaappapi.vacancy.UpdateVacancyRequest.Vacancy.id:type_name -> google.protobuf.StringValue
-	1,  // 25: personaappapi.vacancy.UpdateVacancyRequest.Vacancy.currency:type_name -> personaappapi.vacancy.Currency
-	18, // 26: personaappapi.vacancy.GetVacanciesListRequest.CategoriesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
-	21, // 27: personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails.vacancy:type_name -> personaappapi.vacancy.Vacancy
-	29, // 28: personaappapi.vacancy.GetVacanciesListResponse.VacanciesEntry.value:type_name -> personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails
-	19, // 29: personaappapi.vacancy.GetVacanciesListResponse.CompaniesEntry.value:type_name -> personaappapi.vacancy.Company
-	22, // 30: personaappapi.vacancy.GetVacanciesListResponse.CategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategoryShort
-	0,  // 31: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyDescription.type:type_name -> personaappapi.vacancy.VacancyType
-	19, // 32: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany.company:type_name -> personaappapi.vacancy.Company
-	33, // 33: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany.description:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.CompanyDescription
-	22, // 34: personaappapi.vacancy.GetVacancyDetailsResponse.CategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategoryShort
-	2,  // 35: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategory:input_type -> personaappapi.vacancy.GetVacancyCategoryRequest
-	4,  // 36: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancyCategory:input_type -> personaappapi.vacancy.UpdateVacancyCategoryRequest
-	6,  // 37: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancyCategory:input_type -> personaappapi.vacancy.DeleteVacancyCategoryRequest
-	8,  // 38: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategoriesList:input_type -> personaappapi.vacancy.GetVacancyCategoriesListRequest
-	10, // 39: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancy:input_type -> personaappapi.vacancy.UpdateVacancyRequest
-	12, // 40: personaappapi.vacancy.PersonaAppVacancy.GetVacanciesList:input_type -> personaappapi.vacancy.GetVacanciesListRequest
-	14, // 41: personaappapi.vacancy.PersonaAppVacancy.GetVacancyDetails:input_type -> personaappapi.vacancy.GetVacancyDetailsRequest
-	16, // 42: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancy:input_type -> personaappapi.vacancy.DeleteVacancyRequest
-	3,  // 43: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategory:output_type -> personaappapi.vacancy.GetVacancyCategoryResponse
-	5,  // 44: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancyCategory:output_type -> personaappapi.vacancy.UpdateVacancyCategoryResponse
-	7,  // 45: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancyCategory:output_type -> personaappapi.vacancy.DeleteVacancyCategoryResponse
-	9,  // 46: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategoriesList:output_type -> personaappapi.vacancy.GetVacancyCategoriesListResponse
-	11, // 47: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancy:output_type -> personaappapi.vacancy.UpdateVacancyResponse
-	13, // 48: personaappapi.vacancy.PersonaAppVacancy.GetVacanciesList:output_type -> personaappapi.vacancy.GetVacanciesListResponse
-	15, // 49: personaappapi.vacancy.PersonaAppVacancy.GetVacancyDetails:output_type -> personaappapi.vacancy.GetVacancyDetailsResponse
-	17, // 50: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancy:output_type -> personaappapi.vacancy.DeleteVacancyResponse
-	43, // [43:51] is the sub-list for method output_type
-	35, // [35:43] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+
+// Deprecated: Use CreateVacancyAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateVacancyAlertRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{36}
 }
 
-func init() { file_vacancy_vacancy_proto_init() }
-func file_vacancy_vacancy_proto_init() {
-	if File_vacancy_vacancy_proto != nil {
-		return
+func (x *CreateVacancyAlertRequest) GetFilter() *VacancyAlertFilter {
+	if x != nil {
+		return x.Filter
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_vacancy_vacancy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyCategoryRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+type CreateVacancyAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateVacancyAlertResponse) Reset() {
+	*x = CreateVacancyAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVacancyAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVacancyAlertResponse) ProtoMessage() {}
+
+func (x *CreateVacancyAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyCategoryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVacancyAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateVacancyAlertResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateVacancyAlertResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// List vacancy alerts
+type ListVacancyAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListVacancyAlertsRequest) Reset() {
+	*x = ListVacancyAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyAlertsRequest) ProtoMessage() {}
+
+func (x *ListVacancyAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyCategoryRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{38}
+}
+
+type ListVacancyAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts []*VacancyAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+}
+
+func (x *ListVacancyAlertsResponse) Reset() {
+	*x = ListVacancyAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyAlertsResponse) ProtoMessage() {}
+
+func (x *ListVacancyAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyCategoryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListVacancyAlertsResponse) GetAlerts() []*VacancyAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// Delete vacancy alert
+type DeleteVacancyAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteVacancyAlertRequest) Reset() {
+	*x = DeleteVacancyAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVacancyAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVacancyAlertRequest) ProtoMessage() {}
+
+func (x *DeleteVacancyAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVacancyCategoryRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVacancyAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVacancyAlertRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteVacancyAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteVacancyAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteVacancyAlertResponse) Reset() {
+	*x = DeleteVacancyAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVacancyAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVacancyAlertResponse) ProtoMessage() {}
+
+func (x *DeleteVacancyAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVacancyCategoryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVacancyAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVacancyAlertResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{41}
+}
+
+// Save vacancy
+// Only a live vacancy can be saved, saving it again does nothing
+type SaveVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+}
+
+func (x *SaveVacancyRequest) Reset() {
+	*x = SaveVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVacancyRequest) ProtoMessage() {}
+
+func (x *SaveVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyCategoriesListRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVacancyRequest.ProtoReflect.Descriptor instead.
+func (*SaveVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SaveVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type SaveVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveVacancyResponse) Reset() {
+	*x = SaveVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVacancyResponse) ProtoMessage() {}
+
+func (x *SaveVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyCategoriesListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVacancyResponse.ProtoReflect.Descriptor instead.
+func (*SaveVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{43}
+}
+
+// Unsave vacancy
+type UnsaveVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+}
+
+func (x *UnsaveVacancyRequest) Reset() {
+	*x = UnsaveVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsaveVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsaveVacancyRequest) ProtoMessage() {}
+
+func (x *UnsaveVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsaveVacancyRequest.ProtoReflect.Descriptor instead.
+func (*UnsaveVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnsaveVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type UnsaveVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnsaveVacancyResponse) Reset() {
+	*x = UnsaveVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsaveVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsaveVacancyResponse) ProtoMessage() {}
+
+func (x *UnsaveVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsaveVacancyResponse.ProtoReflect.Descriptor instead.
+func (*UnsaveVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{45}
+}
+
+// List saved vacancies
+// Live vacancies saved by the persona making the request, the most recently saved first
+type ListSavedVacanciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor *wrappers.StringValue `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListSavedVacanciesRequest) Reset() {
+	*x = ListSavedVacanciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedVacanciesRequest) ProtoMessage() {}
+
+func (x *ListSavedVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacanciesListRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListSavedVacanciesRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListSavedVacanciesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+// Similar vacancies, the most similar first. The vacancies are scored by the shared categories and cities,
+// the salary proximity, the title similarity and the distance from the vacancy.
+type GetSimilarVacanciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	// Up to 20, 20 when not set
+	Count *wrappers.Int32Value `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetSimilarVacanciesRequest) Reset() {
+	*x = GetSimilarVacanciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimilarVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarVacanciesRequest) ProtoMessage() {}
+
+func (x *GetSimilarVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacanciesListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSimilarVacanciesRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetSimilarVacanciesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+// Vacancies of the company, the newest first
+type GetCompanyVacanciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompanyId string                `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Cursor    *wrappers.StringValue `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count     *wrappers.Int32Value  `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetCompanyVacanciesRequest) Reset() {
+	*x = GetCompanyVacanciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompanyVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyVacanciesRequest) ProtoMessage() {}
+
+func (x *GetCompanyVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCompanyVacanciesRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetCompanyVacanciesRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *GetCompanyVacanciesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+// Explains how a cv matches a vacancy. Every component is from 0 to 1, an unknown feature scores 0,
+// and score is their weighted sum.
+type MatchScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	// The share of the job kinds of the cv named as a category of the vacancy
+	JobKinds float64 `protobuf:"fixed64,2,opt,name=job_kinds,json=jobKinds,proto3" json:"job_kinds,omitempty"`
+	// 1 when a job type of the cv fits the vacancy type
+	JobType float64 `protobuf:"fixed64,3,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	// The share of the required experience the cv has
+	Experience float64 `protobuf:"fixed64,4,opt,name=experience,proto3" json:"experience,omitempty"`
+	// The share of the salary expected by the cv the vacancy offers
+	Salary float64 `protobuf:"fixed64,5,opt,name=salary,proto3" json:"salary,omitempty"`
+	// The share of the words of the cv position in the vacancy title
+	Position float64 `protobuf:"fixed64,6,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *MatchScore) Reset() {
+	*x = MatchScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchScore) ProtoMessage() {}
+
+func (x *MatchScore) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchScore.ProtoReflect.Descriptor instead.
+func (*MatchScore) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MatchScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MatchScore) GetJobKinds() float64 {
+	if x != nil {
+		return x.JobKinds
+	}
+	return 0
+}
+
+func (x *MatchScore) GetJobType() float64 {
+	if x != nil {
+		return x.JobType
+	}
+	return 0
+}
+
+func (x *MatchScore) GetExperience() float64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *MatchScore) GetSalary() float64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *MatchScore) GetPosition() float64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// Recommended vacancies
+// Live vacancies matching the cv of the persona, the best match first
+type GetRecommendedVacanciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CvId string `protobuf:"bytes,1,opt,name=cv_id,json=cvId,proto3" json:"cv_id,omitempty"`
+	// Up to 50, 20 when not set
+	Count *wrappers.Int32Value `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetRecommendedVacanciesRequest) Reset() {
+	*x = GetRecommendedVacanciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendedVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedVacanciesRequest) ProtoMessage() {}
+
+func (x *GetRecommendedVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVacancyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_vacancy_vacancy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVacancyResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendedVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetRecommendedVacanciesRequest) GetCvId() string {
+	if x != nil {
+		return x.CvId
+	}
+	return ""
+}
+
+func (x *GetRecommendedVacanciesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type GetRecommendedVacanciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancies *GetVacanciesListResponse `protobuf:"bytes,1,opt,name=vacancies,proto3" json:"vacancies,omitempty"`
+	// Keyed by the vacancy id
+	Scores map[string]*MatchScore `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetRecommendedVacanciesResponse) Reset() {
+	*x = GetRecommendedVacanciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendedVacanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedVacanciesResponse) ProtoMessage() {}
+
+func (x *GetRecommendedVacanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedVacanciesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendedVacanciesResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetRecommendedVacanciesResponse) GetVacancies() *GetVacanciesListResponse {
+	if x != nil {
+		return x.Vacancies
+	}
+	return nil
+}
+
+func (x *GetRecommendedVacanciesResponse) GetScores() map[string]*MatchScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// Recommended candidates
+// Cvs matching the vacancy of the company, the best match first
+type GetRecommendedCandidatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	// Up to 50, 20 when not set
+	Count *wrappers.Int32Value `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetRecommendedCandidatesRequest) Reset() {
+	*x = GetRecommendedCandidatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendedCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedCandidatesRequest) ProtoMessage() {}
+
+func (x *GetRecommendedCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Company); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendedCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetRecommendedCandidatesRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetRecommendedCandidatesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type GetRecommendedCandidatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidates []*GetRecommendedCandidatesResponse_Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *GetRecommendedCandidatesResponse) Reset() {
+	*x = GetRecommendedCandidatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendedCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedCandidatesResponse) ProtoMessage() {}
+
+func (x *GetRecommendedCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VacancyCategory); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendedCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetRecommendedCandidatesResponse) GetCandidates() []*GetRecommendedCandidatesResponse_Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Reveal vacancy phone
+// Returns the phone of a live vacancy, the company of the vacancy and admins get the phone of any vacancy.
+// The reveal is counted in the vacancy stats
+type RevealVacancyPhoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+}
+
+func (x *RevealVacancyPhoneRequest) Reset() {
+	*x = RevealVacancyPhoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevealVacancyPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealVacancyPhoneRequest) ProtoMessage() {}
+
+func (x *RevealVacancyPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vacancy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealVacancyPhoneRequest.ProtoReflect.Descriptor instead.
+func (*RevealVacancyPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RevealVacancyPhoneRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type RevealVacancyPhoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *RevealVacancyPhoneResponse) Reset() {
+	*x = RevealVacancyPhoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevealVacancyPhoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealVacancyPhoneResponse) ProtoMessage() {}
+
+func (x *RevealVacancyPhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VacancyCategoryShort); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_vacancy_vacancy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*City); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealVacancyPhoneResponse.ProtoReflect.Descriptor instead.
+func (*RevealVacancyPhoneResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RevealVacancyPhoneResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+// Get vacancy stats
+// Available to the company owning the vacancy. Every viewer is counted once a day for each kind of event,
+// the range is the last 30 days by day when not set
+type GetVacancyStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId   string                  `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	From        *timestamp.Timestamp    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Till        *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
+	Granularity VacancyStatsGranularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=personaappapi.vacancy.VacancyStatsGranularity" json:"granularity,omitempty"`
+}
+
+func (x *GetVacancyStatsRequest) Reset() {
+	*x = GetVacancyStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyStatsRequest) ProtoMessage() {}
+
+func (x *GetVacancyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyRequest_VacancyLocation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetVacancyStatsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetVacancyStatsRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetVacancyStatsRequest) GetTill() *timestamp.Timestamp {
+	if x != nil {
+		return x.Till
+	}
+	return nil
+}
+
+func (x *GetVacancyStatsRequest) GetGranularity() VacancyStatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return VacancyStatsGranularity_VACANCY_STATS_GRANULARITY_UNKNOWN
+}
+
+type GetVacancyStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*GetVacancyStatsResponse_Point `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *GetVacancyStatsResponse) Reset() {
+	*x = GetVacancyStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyStatsResponse) ProtoMessage() {}
+
+func (x *GetVacancyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyRequest_VacancyDescription); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetVacancyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetVacancyStatsResponse) GetPoints() []*GetVacancyStatsResponse_Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// List moderation queue
+// Available to admins. Vacancies pending review, the longest waiting first
+type ListModerationQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor *wrappers.StringValue `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVacancyRequest_Vacancy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListModerationQueueRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListModerationQueueRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListModerationQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items  []*ListModerationQueueResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor *wrappers.StringValue               `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListModerationQueueResponse) Reset() {
+	*x = ListModerationQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacanciesListResponse_VacancyDetails); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListModerationQueueResponse) GetItems() []*ListModerationQueueResponse_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// Approve vacancy
+// Available to admins. The vacancy pending review goes live and its company is notified
+type ApproveVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+}
+
+func (x *ApproveVacancyRequest) Reset() {
+	*x = ApproveVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveVacancyRequest) ProtoMessage() {}
+
+func (x *ApproveVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsResponse_CompanyDescription); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveVacancyRequest.ProtoReflect.Descriptor instead.
+func (*ApproveVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApproveVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type ApproveVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApproveVacancyResponse) Reset() {
+	*x = ApproveVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveVacancyResponse) ProtoMessage() {}
+
+func (x *ApproveVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsResponse_VacancyLocation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveVacancyResponse.ProtoReflect.Descriptor instead.
+func (*ApproveVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{61}
+}
+
+// Reject vacancy
+// Available to admins. The vacancy pending review is sent back to its company with the reasons,
+// it may be edited and published again
+type RejectVacancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string             `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Reasons   []ModerationReason `protobuf:"varint,2,rep,packed,name=reasons,proto3,enum=personaappapi.vacancy.ModerationReason" json:"reasons,omitempty"`
+	Comment   string             `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *RejectVacancyRequest) Reset() {
+	*x = RejectVacancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectVacancyRequest) ProtoMessage() {}
+
+func (x *RejectVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsResponse_VacancyDescription); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectVacancyRequest.ProtoReflect.Descriptor instead.
+func (*RejectVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RejectVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *RejectVacancyRequest) GetReasons() []ModerationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *RejectVacancyRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RejectVacancyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RejectVacancyResponse) Reset() {
+	*x = RejectVacancyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectVacancyResponse) ProtoMessage() {}
+
+func (x *RejectVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsResponse_VacancyCompany); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectVacancyResponse.ProtoReflect.Descriptor instead.
+func (*RejectVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{63}
+}
+
+// Get vacancy moderation history
+// Available to admins and the company owning the vacancy, the oldest decision first
+type GetVacancyModerationHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+}
+
+func (x *GetVacancyModerationHistoryRequest) Reset() {
+	*x = GetVacancyModerationHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyModerationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyModerationHistoryRequest) ProtoMessage() {}
+
+func (x *GetVacancyModerationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_vacancy_vacancy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVacancyDetailsResponse_VacancyImage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyModerationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyModerationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetVacancyModerationHistoryRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type GetVacancyModerationHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decisions []*GetVacancyModerationHistoryResponse_Decision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+}
+
+func (x *GetVacancyModerationHistoryResponse) Reset() {
+	*x = GetVacancyModerationHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyModerationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyModerationHistoryResponse) ProtoMessage() {}
+
+func (x *GetVacancyModerationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyModerationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetVacancyModerationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetVacancyModerationHistoryResponse) GetDecisions() []*GetVacancyModerationHistoryResponse_Decision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+// List vacancy duplicates
+// Available to admins. Vacancies which were reposted with their reposts, the most recently reposted first
+type ListVacancyDuplicatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor *wrappers.StringValue `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListVacancyDuplicatesRequest) Reset() {
+	*x = ListVacancyDuplicatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyDuplicatesRequest) ProtoMessage() {}
+
+func (x *ListVacancyDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListVacancyDuplicatesRequest) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListVacancyDuplicatesRequest) GetCount() *wrappers.Int32Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListVacancyDuplicatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters []*ListVacancyDuplicatesResponse_Cluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Cursor   *wrappers.StringValue                    `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListVacancyDuplicatesResponse) Reset() {
+	*x = ListVacancyDuplicatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyDuplicatesResponse) ProtoMessage() {}
+
+func (x *ListVacancyDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyDuplicatesResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyDuplicatesResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListVacancyDuplicatesResponse) GetClusters() []*ListVacancyDuplicatesResponse_Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ListVacancyDuplicatesResponse) GetCursor() *wrappers.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type ImportVacanciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ImportVacanciesRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=personaappapi.vacancy.ImportVacanciesRequest_Format" json:"format,omitempty"`
+	Feed   []byte                        `protobuf:"bytes,2,opt,name=feed,proto3" json:"feed,omitempty"`
+	// The company to import the vacancies for, admin only. A company imports its own vacancies.
+	CompanyId string `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+}
+
+func (x *ImportVacanciesRequest) Reset() {
+	*x = ImportVacanciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVacanciesRequest) ProtoMessage() {}
+
+func (x *ImportVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*ImportVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ImportVacanciesRequest) GetFormat() ImportVacanciesRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ImportVacanciesRequest_FORMAT_UNKNOWN
+}
+
+func (x *ImportVacanciesRequest) GetFeed() []byte {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+func (x *ImportVacanciesRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type ImportVacanciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created int32                               `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32                               `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Errors  []*ImportVacanciesResponse_RowError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportVacanciesResponse) Reset() {
+	*x = ImportVacanciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportVacanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVacanciesResponse) ProtoMessage() {}
+
+func (x *ImportVacanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVacanciesResponse.ProtoReflect.Descriptor instead.
+func (*ImportVacanciesResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ImportVacanciesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportVacanciesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportVacanciesResponse) GetErrors() []*ImportVacanciesResponse_RowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// List vacancy revisions
+// Available to the vacancy company and admins. A revision is kept on every change of the vacancy content,
+// the latest first
+type ListVacancyRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+}
+
+func (x *ListVacancyRevisionsRequest) Reset() {
+	*x = ListVacancyRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyRevisionsRequest) ProtoMessage() {}
+
+func (x *ListVacancyRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListVacancyRevisionsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type ListVacancyRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*VacancyRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ListVacancyRevisionsResponse) Reset() {
+	*x = ListVacancyRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyRevisionsResponse) ProtoMessage() {}
+
+func (x *ListVacancyRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListVacancyRevisionsResponse) GetRevisions() []*VacancyRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Get vacancy revision
+type GetVacancyRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Revision  int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetVacancyRevisionRequest) Reset() {
+	*x = GetVacancyRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyRevisionRequest) ProtoMessage() {}
+
+func (x *GetVacancyRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetVacancyRevisionRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetVacancyRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetVacancyRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision    *VacancyRevision                               `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Vacancy     *Vacancy                                       `protobuf:"bytes,2,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	ImageUrls   []string                                       `protobuf:"bytes,3,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	Location    *GetVacancyRevisionResponse_VacancyLocation    `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Description *GetVacancyRevisionResponse_VacancyDescription `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CityIds     []string                                       `protobuf:"bytes,6,rep,name=city_ids,json=cityIds,proto3" json:"city_ids,omitempty"`
+}
+
+func (x *GetVacancyRevisionResponse) Reset() {
+	*x = GetVacancyRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyRevisionResponse) ProtoMessage() {}
+
+func (x *GetVacancyRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetVacancyRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetVacancyRevisionResponse) GetRevision() *VacancyRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *GetVacancyRevisionResponse) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *GetVacancyRevisionResponse) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *GetVacancyRevisionResponse) GetLocation() *GetVacancyRevisionResponse_VacancyLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetVacancyRevisionResponse) GetDescription() *GetVacancyRevisionResponse_VacancyDescription {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *GetVacancyRevisionResponse) GetCityIds() []string {
+	if x != nil {
+		return x.CityIds
+	}
+	return nil
+}
+
+// Diff vacancy revisions
+// The fields changed from one revision to another one, the values are formatted as text
+type DiffVacancyRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	From      int32  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        int32  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffVacancyRevisionsRequest) Reset() {
+	*x = DiffVacancyRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffVacancyRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVacancyRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffVacancyRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVacancyRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffVacancyRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DiffVacancyRevisionsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *DiffVacancyRevisionsRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffVacancyRevisionsRequest) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type DiffVacancyRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*DiffVacancyRevisionsResponse_FieldChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DiffVacancyRevisionsResponse) Reset() {
+	*x = DiffVacancyRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffVacancyRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVacancyRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffVacancyRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVacancyRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffVacancyRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DiffVacancyRevisionsResponse) GetChanges() []*DiffVacancyRevisionsResponse_FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Restore vacancy revision
+// Puts the content of the revision to the vacancy, it's kept as a new revision
+type RestoreVacancyRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Revision  int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RestoreVacancyRevisionRequest) Reset() {
+	*x = RestoreVacancyRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreVacancyRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVacancyRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreVacancyRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVacancyRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVacancyRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RestoreVacancyRevisionRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *RestoreVacancyRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RestoreVacancyRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreVacancyRevisionResponse) Reset() {
+	*x = RestoreVacancyRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreVacancyRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVacancyRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreVacancyRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVacancyRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreVacancyRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{77}
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{78}
+}
+
+type Company struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	LogoUrl string `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Company) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Company) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Company) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+// The categories are titled in the most preferred locale of the accept-language metadata they're
+// translated to, in the default title otherwise
+type VacancyCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	IconUrl string `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Rating  int32  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Empty for a root category
+	ParentId string `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// The ids from the root separated with "/", "/root/child/"
+	Path string `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	// The position among the children of the parent from 0
+	Position int32 `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	// The localized titles by lowercased BCP 47 locales
+	Titles map[string]string `protobuf:"bytes,8,rep,name=titles,proto3" json:"titles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *VacancyCategory) Reset() {
+	*x = VacancyCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VacancyCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyCategory) ProtoMessage() {}
+
+func (x *VacancyCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyCategory.ProtoReflect.Descriptor instead.
+func (*VacancyCategory) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *VacancyCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VacancyCategory) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VacancyCategory) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *VacancyCategory) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *VacancyCategory) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *VacancyCategory) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VacancyCategory) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *VacancyCategory) GetTitles() map[string]string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+type Vacancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Phone     string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	MinSalary int32  `protobuf:"varint,4,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary int32  `protobuf:"varint,5,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	CompanyId string `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// Set for UAH only, see currency_code
+	//
+	// Deprecated: Do not use.
+	Currency      Currency `protobuf:"varint,7,opt,name=currency,proto3,enum=personaappapi.vacancy.Currency" json:"currency,omitempty"`
+	CategoriesIds []string `protobuf:"bytes,8,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	// ISO 4217 code of the salary range
+	CurrencyCode string       `protobuf:"bytes,9,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	SalaryPeriod SalaryPeriod `protobuf:"varint,10,opt,name=salary_period,json=salaryPeriod,proto3,enum=personaappapi.vacancy.SalaryPeriod" json:"salary_period,omitempty"`
+	// Whether the salary is before taxes, not specified when unset
+	SalaryGross *wrappers.BoolValue `protobuf:"bytes,11,opt,name=salary_gross,json=salaryGross,proto3" json:"salary_gross,omitempty"`
+}
+
+func (x *Vacancy) Reset() {
+	*x = Vacancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vacancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vacancy) ProtoMessage() {}
+
+func (x *Vacancy) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vacancy.ProtoReflect.Descriptor instead.
+func (*Vacancy) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Vacancy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vacancy) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Vacancy) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Vacancy) GetMinSalary() int32 {
+	if x != nil {
+		return x.MinSalary
+	}
+	return 0
+}
+
+func (x *Vacancy) GetMaxSalary() int32 {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return 0
+}
+
+func (x *Vacancy) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Vacancy) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_CURRENCY_UNKNOWN
+}
+
+func (x *Vacancy) GetCategoriesIds() []string {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *Vacancy) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Vacancy) GetSalaryPeriod() SalaryPeriod {
+	if x != nil {
+		return x.SalaryPeriod
+	}
+	return SalaryPeriod_SALARY_PERIOD_UNKNOWN
+}
+
+func (x *Vacancy) GetSalaryGross() *wrappers.BoolValue {
+	if x != nil {
+		return x.SalaryGross
+	}
+	return nil
+}
+
+type VacancyRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision int32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Empty for the changes made by the system
+	AuthorId  string               `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The fields changed since the previous revision, set for the listed revisions
+	ChangedFields []string `protobuf:"bytes,4,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+}
+
+func (x *VacancyRevision) Reset() {
+	*x = VacancyRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VacancyRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyRevision) ProtoMessage() {}
+
+func (x *VacancyRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyRevision.ProtoReflect.Descriptor instead.
+func (*VacancyRevision) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *VacancyRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *VacancyRevision) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *VacancyRevision) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VacancyRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+type VacancyCategoryShort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *VacancyCategoryShort) Reset() {
+	*x = VacancyCategoryShort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VacancyCategoryShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyCategoryShort) ProtoMessage() {}
+
+func (x *VacancyCategoryShort) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyCategoryShort.ProtoReflect.Descriptor instead.
+func (*VacancyCategoryShort) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *VacancyCategoryShort) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type City struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CountryCode int32  `protobuf:"varint,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Rating      int32  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *City) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *City) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *City) GetCountryCode() int32 {
+	if x != nil {
+		return x.CountryCode
+	}
+	return 0
+}
+
+func (x *City) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type GeoRadius struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm  float64 `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+}
+
+func (x *GeoRadius) Reset() {
+	*x = GeoRadius{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoRadius) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoRadius) ProtoMessage() {}
+
+func (x *GeoRadius) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoRadius.ProtoReflect.Descriptor instead.
+func (*GeoRadius) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GeoRadius) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoRadius) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoRadius) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type BoundingBox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLatitude  float64 `protobuf:"fixed64,1,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MinLongitude float64 `protobuf:"fixed64,2,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLatitude  float64 `protobuf:"fixed64,3,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MaxLongitude float64 `protobuf:"fixed64,4,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *BoundingBox) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+type VacancyAlertFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoriesIds []string             `protobuf:"bytes,1,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	CitiesIds     []string             `protobuf:"bytes,2,rep,name=cities_ids,json=citiesIds,proto3" json:"cities_ids,omitempty"`
+	MinSalary     *wrappers.Int32Value `protobuf:"bytes,3,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary     *wrappers.Int32Value `protobuf:"bytes,4,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	Type          VacancyType          `protobuf:"varint,5,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	Keywords      string               `protobuf:"bytes,6,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	// ISO 4217 code of the monthly salary range, UAH when empty
+	CurrencyCode string `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+}
+
+func (x *VacancyAlertFilter) Reset() {
+	*x = VacancyAlertFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VacancyAlertFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyAlertFilter) ProtoMessage() {}
+
+func (x *VacancyAlertFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyAlertFilter.ProtoReflect.Descriptor instead.
+func (*VacancyAlertFilter) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VacancyAlertFilter) GetCategoriesIds() []string {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *VacancyAlertFilter) GetCitiesIds() []string {
+	if x != nil {
+		return x.CitiesIds
+	}
+	return nil
+}
+
+func (x *VacancyAlertFilter) GetMinSalary() *wrappers.Int32Value {
+	if x != nil {
+		return x.MinSalary
+	}
+	return nil
+}
+
+func (x *VacancyAlertFilter) GetMaxSalary() *wrappers.Int32Value {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return nil
+}
+
+func (x *VacancyAlertFilter) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
+}
+
+func (x *VacancyAlertFilter) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *VacancyAlertFilter) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type VacancyAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filter *VacancyAlertFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *VacancyAlert) Reset() {
+	*x = VacancyAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VacancyAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyAlert) ProtoMessage() {}
+
+func (x *VacancyAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyAlert.ProtoReflect.Descriptor instead.
+func (*VacancyAlert) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *VacancyAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VacancyAlert) GetFilter() *VacancyAlertFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type UpdateVacancyRequest_VacancyLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *UpdateVacancyRequest_VacancyLocation) Reset() {
+	*x = UpdateVacancyRequest_VacancyLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVacancyRequest_VacancyLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVacancyRequest_VacancyLocation) ProtoMessage() {}
+
+func (x *UpdateVacancyRequest_VacancyLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVacancyRequest_VacancyLocation.ProtoReflect.Descriptor instead.
+func (*UpdateVacancyRequest_VacancyLocation) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *UpdateVacancyRequest_VacancyLocation) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateVacancyRequest_VacancyLocation) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type UpdateVacancyRequest_VacancyDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description          string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	WorkMonthsExperience uint32      `protobuf:"varint,2,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
+	WorkSchedule         string      `protobuf:"bytes,3,opt,name=work_schedule,json=workSchedule,proto3" json:"work_schedule,omitempty"`
+	Type                 VacancyType `protobuf:"varint,4,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	Address              string      `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	CountryCode          int32       `protobuf:"varint,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) Reset() {
+	*x = UpdateVacancyRequest_VacancyDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVacancyRequest_VacancyDescription) ProtoMessage() {}
+
+func (x *UpdateVacancyRequest_VacancyDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVacancyRequest_VacancyDescription.ProtoReflect.Descriptor instead.
+func (*UpdateVacancyRequest_VacancyDescription) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) GetWorkMonthsExperience() uint32 {
+	if x != nil {
+		return x.WorkMonthsExperience
+	}
+	return 0
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) GetWorkSchedule() string {
+	if x != nil {
+		return x.WorkSchedule
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest_VacancyDescription) GetCountryCode() int32 {
+	if x != nil {
+		return x.CountryCode
+	}
+	return 0
+}
+
+type UpdateVacancyRequest_Vacancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *wrappers.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Phone     string                `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	MinSalary int32                 `protobuf:"varint,4,opt,name=min_salary,json=minSalary,proto3" json:"min_salary,omitempty"`
+	MaxSalary int32                 `protobuf:"varint,5,opt,name=max_salary,json=maxSalary,proto3" json:"max_salary,omitempty"`
+	CompanyId string                `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// Deprecated: Do not use.
+	Currency Currency `protobuf:"varint,7,opt,name=currency,proto3,enum=personaappapi.vacancy.Currency" json:"currency,omitempty"`
+	// ISO 4217 code of the salary range, UAH when empty. Takes precedence over currency
+	CurrencyCode string `protobuf:"bytes,8,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// A month when unknown
+	SalaryPeriod SalaryPeriod `protobuf:"varint,9,opt,name=salary_period,json=salaryPeriod,proto3,enum=personaappapi.vacancy.SalaryPeriod" json:"salary_period,omitempty"`
+	// Whether the salary is before taxes, not specified when unset
+	SalaryGross *wrappers.BoolValue `protobuf:"bytes,10,opt,name=salary_gross,json=salaryGross,proto3" json:"salary_gross,omitempty"`
+}
+
+func (x *UpdateVacancyRequest_Vacancy) Reset() {
+	*x = UpdateVacancyRequest_Vacancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVacancyRequest_Vacancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVacancyRequest_Vacancy) ProtoMessage() {}
+
+func (x *UpdateVacancyRequest_Vacancy) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVacancyRequest_Vacancy.ProtoReflect.Descriptor instead.
+func (*UpdateVacancyRequest_Vacancy) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetId() *wrappers.StringValue {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetMinSalary() int32 {
+	if x != nil {
+		return x.MinSalary
+	}
+	return 0
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetMaxSalary() int32 {
+	if x != nil {
+		return x.MaxSalary
+	}
+	return 0
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *UpdateVacancyRequest_Vacancy) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_CURRENCY_UNKNOWN
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetSalaryPeriod() SalaryPeriod {
+	if x != nil {
+		return x.SalaryPeriod
+	}
+	return SalaryPeriod_SALARY_PERIOD_UNKNOWN
+}
+
+func (x *UpdateVacancyRequest_Vacancy) GetSalaryGross() *wrappers.BoolValue {
+	if x != nil {
+		return x.SalaryGross
+	}
+	return nil
+}
+
+type GetVacanciesListResponse_VacancyDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancy   *Vacancy `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	ImageUrls []string `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	// Meters from the requested point, set when near is requested. Meters from the vacancy
+	// for the similar vacancies when both are located.
+	Distance *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Set for a persona who saved the vacancy
+	IsSaved bool `protobuf:"varint,4,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
+}
+
+func (x *GetVacanciesListResponse_VacancyDetails) Reset() {
+	*x = GetVacanciesListResponse_VacancyDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacanciesListResponse_VacancyDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacanciesListResponse_VacancyDetails) ProtoMessage() {}
+
+func (x *GetVacanciesListResponse_VacancyDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacanciesListResponse_VacancyDetails.ProtoReflect.Descriptor instead.
+func (*GetVacanciesListResponse_VacancyDetails) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetVacanciesListResponse_VacancyDetails) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *GetVacanciesListResponse_VacancyDetails) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *GetVacanciesListResponse_VacancyDetails) GetDistance() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Distance
+	}
+	return nil
+}
+
+func (x *GetVacanciesListResponse_VacancyDetails) GetIsSaved() bool {
+	if x != nil {
+		return x.IsSaved
+	}
+	return false
+}
+
+type SearchVacanciesResponse_VacancyDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancy   *Vacancy `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	ImageUrls []string `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	// Title and description fragments with the matched words wrapped in <b></b> tags
+	TitleHighlight     string `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionSnippet string `protobuf:"bytes,4,opt,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"`
+	// Set for a persona who saved the vacancy
+	IsSaved bool `protobuf:"varint,5,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) Reset() {
+	*x = SearchVacanciesResponse_VacancyDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVacanciesResponse_VacancyDetails) ProtoMessage() {}
+
+func (x *SearchVacanciesResponse_VacancyDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVacanciesResponse_VacancyDetails.ProtoReflect.Descriptor instead.
+func (*SearchVacanciesResponse_VacancyDetails) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) GetDescriptionSnippet() string {
+	if x != nil {
+		return x.DescriptionSnippet
+	}
+	return ""
+}
+
+func (x *SearchVacanciesResponse_VacancyDetails) GetIsSaved() bool {
+	if x != nil {
+		return x.IsSaved
+	}
+	return false
+}
+
+type GetVacanciesMapResponse_Marker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VacancyId string  `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Title     string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CompanyId string  `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GetVacanciesMapResponse_Marker) Reset() {
+	*x = GetVacanciesMapResponse_Marker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacanciesMapResponse_Marker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacanciesMapResponse_Marker) ProtoMessage() {}
+
+func (x *GetVacanciesMapResponse_Marker) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacanciesMapResponse_Marker.ProtoReflect.Descriptor instead.
+func (*GetVacanciesMapResponse_Marker) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetVacanciesMapResponse_Marker) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetVacanciesMapResponse_Marker) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetVacanciesMapResponse_Marker) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetVacanciesMapResponse_Marker) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetVacanciesMapResponse_Marker) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetVacanciesMapResponse_Cluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Count     int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetVacanciesMapResponse_Cluster) Reset() {
+	*x = GetVacanciesMapResponse_Cluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacanciesMapResponse_Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacanciesMapResponse_Cluster) ProtoMessage() {}
+
+func (x *GetVacanciesMapResponse_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacanciesMapResponse_Cluster.ProtoReflect.Descriptor instead.
+func (*GetVacanciesMapResponse_Cluster) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *GetVacanciesMapResponse_Cluster) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetVacanciesMapResponse_Cluster) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetVacanciesMapResponse_Cluster) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetVacancyFacetsResponse_TypeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  VacancyType `protobuf:"varint,1,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	Count int32       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetVacancyFacetsResponse_TypeCount) Reset() {
+	*x = GetVacancyFacetsResponse_TypeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyFacetsResponse_TypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyFacetsResponse_TypeCount) ProtoMessage() {}
+
+func (x *GetVacancyFacetsResponse_TypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyFacetsResponse_TypeCount.ProtoReflect.Descriptor instead.
+func (*GetVacancyFacetsResponse_TypeCount) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *GetVacancyFacetsResponse_TypeCount) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
+}
+
+func (x *GetVacancyFacetsResponse_TypeCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Vacancies with the upper salary in UAH from `from` up to `to` exclusive, to is empty for the last bucket
+type GetVacancyFacetsResponse_SalaryBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  int32                `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *wrappers.Int32Value `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Count int32                `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetVacancyFacetsResponse_SalaryBucket) Reset() {
+	*x = GetVacancyFacetsResponse_SalaryBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyFacetsResponse_SalaryBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyFacetsResponse_SalaryBucket) ProtoMessage() {}
+
+func (x *GetVacancyFacetsResponse_SalaryBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyFacetsResponse_SalaryBucket.ProtoReflect.Descriptor instead.
+func (*GetVacancyFacetsResponse_SalaryBucket) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *GetVacancyFacetsResponse_SalaryBucket) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetVacancyFacetsResponse_SalaryBucket) GetTo() *wrappers.Int32Value {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetVacancyFacetsResponse_SalaryBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetVacancyDetailsResponse_CompanyDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *GetVacancyDetailsResponse_CompanyDescription) Reset() {
+	*x = GetVacancyDetailsResponse_CompanyDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyDetailsResponse_CompanyDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyDetailsResponse_CompanyDescription) ProtoMessage() {}
+
+func (x *GetVacancyDetailsResponse_CompanyDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyDetailsResponse_CompanyDescription.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsResponse_CompanyDescription) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *GetVacancyDetailsResponse_CompanyDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetVacancyDetailsResponse_VacancyLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GetVacancyDetailsResponse_VacancyLocation) Reset() {
+	*x = GetVacancyDetailsResponse_VacancyLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyDetailsResponse_VacancyLocation) ProtoMessage() {}
+
+func (x *GetVacancyDetailsResponse_VacancyLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyDetailsResponse_VacancyLocation.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsResponse_VacancyLocation) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyLocation) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetVacancyDetailsResponse_VacancyLocation) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetVacancyDetailsResponse_VacancyDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description          string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	WorkMonthsExperience uint32      `protobuf:"varint,2,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
+	WorkSchedule         string      `protobuf:"bytes,3,opt,name=work_schedule,json=workSchedule,proto3" json:"work_schedule,omitempty"`
+	Type                 VacancyType `protobuf:"varint,4,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	Address              string      `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	CountryCode          int32       `protobuf:"varint,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) Reset() {
+	*x = GetVacancyDetailsResponse_VacancyDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyDetailsResponse_VacancyDescription) ProtoMessage() {}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyDetailsResponse_VacancyDescription.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsResponse_VacancyDescription) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21, 2}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) GetWorkMonthsExperience() uint32 {
+	if x != nil {
+		return x.WorkMonthsExperience
+	}
+	return 0
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) GetWorkSchedule() string {
+	if x != nil {
+		return x.WorkSchedule
+	}
+	return ""
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetVacancyDetailsResponse_VacancyDescription) GetCountryCode() int32 {
+	if x != nil {
+		return x.CountryCode
+	}
+	return 0
+}
+
+type GetVacancyDetailsResponse_VacancyCompany struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Company     *Company                                      `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Description *GetVacancyDetailsResponse_CompanyDescription `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *GetVacancyDetailsResponse_VacancyCompany) Reset() {
+	*x = GetVacancyDetailsResponse_VacancyCompany{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyCompany) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyDetailsResponse_VacancyCompany) ProtoMessage() {}
+
+func (x *GetVacancyDetailsResponse_VacancyCompany) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyDetailsResponse_VacancyCompany.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsResponse_VacancyCompany) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21, 3}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyCompany) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *GetVacancyDetailsResponse_VacancyCompany) GetDescription() *GetVacancyDetailsResponse_CompanyDescription {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type GetVacancyDetailsResponse_VacancyImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageUrls []string `protobuf:"bytes,1,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+}
+
+func (x *GetVacancyDetailsResponse_VacancyImage) Reset() {
+	*x = GetVacancyDetailsResponse_VacancyImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyDetailsResponse_VacancyImage) ProtoMessage() {}
+
+func (x *GetVacancyDetailsResponse_VacancyImage) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyDetailsResponse_VacancyImage.ProtoReflect.Descriptor instead.
+func (*GetVacancyDetailsResponse_VacancyImage) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{21, 4}
+}
+
+func (x *GetVacancyDetailsResponse_VacancyImage) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+type ListMyVacanciesResponse_Counters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Saved searches the vacancy was sent to
+	AlertMatches int32 `protobuf:"varint,1,opt,name=alert_matches,json=alertMatches,proto3" json:"alert_matches,omitempty"`
+	// Applications which aren't withdrawn
+	Applications int32 `protobuf:"varint,2,opt,name=applications,proto3" json:"applications,omitempty"`
+	// Every viewer is counted once a day
+	Views int32 `protobuf:"varint,3,opt,name=views,proto3" json:"views,omitempty"`
+	// Accounts having the vacancy saved
+	Saves int32 `protobuf:"varint,4,opt,name=saves,proto3" json:"saves,omitempty"`
+}
+
+func (x *ListMyVacanciesResponse_Counters) Reset() {
+	*x = ListMyVacanciesResponse_Counters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyVacanciesResponse_Counters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyVacanciesResponse_Counters) ProtoMessage() {}
+
+func (x *ListMyVacanciesResponse_Counters) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyVacanciesResponse_Counters.ProtoReflect.Descriptor instead.
+func (*ListMyVacanciesResponse_Counters) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *ListMyVacanciesResponse_Counters) GetAlertMatches() int32 {
+	if x != nil {
+		return x.AlertMatches
+	}
+	return 0
+}
+
+func (x *ListMyVacanciesResponse_Counters) GetApplications() int32 {
+	if x != nil {
+		return x.Applications
+	}
+	return 0
+}
+
+func (x *ListMyVacanciesResponse_Counters) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *ListMyVacanciesResponse_Counters) GetSaves() int32 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+type ListMyVacanciesResponse_MyVacancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancy     *Vacancy                          `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	ImageUrls   []string                          `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	Status      VacancyStatus                     `protobuf:"varint,3,opt,name=status,proto3,enum=personaappapi.vacancy.VacancyStatus" json:"status,omitempty"`
+	PublishedAt *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ExpiresAt   *timestamp.Timestamp              `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Counters    *ListMyVacanciesResponse_Counters `protobuf:"bytes,6,opt,name=counters,proto3" json:"counters,omitempty"`
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) Reset() {
+	*x = ListMyVacanciesResponse_MyVacancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyVacanciesResponse_MyVacancy) ProtoMessage() {}
+
+func (x *ListMyVacanciesResponse_MyVacancy) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyVacanciesResponse_MyVacancy.ProtoReflect.Descriptor instead.
+func (*ListMyVacanciesResponse_MyVacancy) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{35, 1}
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) GetStatus() VacancyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VacancyStatus_VACANCY_STATUS_UNKNOWN
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) GetPublishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ListMyVacanciesResponse_MyVacancy) GetCounters() *ListMyVacanciesResponse_Counters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type GetRecommendedCandidatesResponse_Candidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CvId                 string      `protobuf:"bytes,1,opt,name=cv_id,json=cvId,proto3" json:"cv_id,omitempty"`
+	PersonaId            string      `protobuf:"bytes,2,opt,name=persona_id,json=personaId,proto3" json:"persona_id,omitempty"`
+	Position             string      `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	WorkMonthsExperience int32       `protobuf:"varint,4,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
+	Score                *MatchScore `protobuf:"bytes,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) Reset() {
+	*x = GetRecommendedCandidatesResponse_Candidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedCandidatesResponse_Candidate) ProtoMessage() {}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedCandidatesResponse_Candidate.ProtoReflect.Descriptor instead.
+func (*GetRecommendedCandidatesResponse_Candidate) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) GetCvId() string {
+	if x != nil {
+		return x.CvId
+	}
+	return ""
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) GetPersonaId() string {
+	if x != nil {
+		return x.PersonaId
+	}
+	return ""
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) GetWorkMonthsExperience() int32 {
+	if x != nil {
+		return x.WorkMonthsExperience
+	}
+	return 0
+}
+
+func (x *GetRecommendedCandidatesResponse_Candidate) GetScore() *MatchScore {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+type GetVacancyStatsResponse_Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time         *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Views        int32                `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	Impressions  int32                `protobuf:"varint,3,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Saves        int32                `protobuf:"varint,4,opt,name=saves,proto3" json:"saves,omitempty"`
+	PhoneReveals int32                `protobuf:"varint,5,opt,name=phone_reveals,json=phoneReveals,proto3" json:"phone_reveals,omitempty"`
+}
+
+func (x *GetVacancyStatsResponse_Point) Reset() {
+	*x = GetVacancyStatsResponse_Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyStatsResponse_Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyStatsResponse_Point) ProtoMessage() {}
+
+func (x *GetVacancyStatsResponse_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyStatsResponse_Point.ProtoReflect.Descriptor instead.
+func (*GetVacancyStatsResponse_Point) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *GetVacancyStatsResponse_Point) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GetVacancyStatsResponse_Point) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *GetVacancyStatsResponse_Point) GetImpressions() int32 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *GetVacancyStatsResponse_Point) GetSaves() int32 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+func (x *GetVacancyStatsResponse_Point) GetPhoneReveals() int32 {
+	if x != nil {
+		return x.PhoneReveals
+	}
+	return 0
+}
+
+type ListModerationQueueResponse_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vacancy     *Vacancy             `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	SubmittedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// Found by the automatic checks
+	Reasons []ModerationReason `protobuf:"varint,3,rep,packed,name=reasons,proto3,enum=personaappapi.vacancy.ModerationReason" json:"reasons,omitempty"`
+}
+
+func (x *ListModerationQueueResponse_Item) Reset() {
+	*x = ListModerationQueueResponse_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationQueueResponse_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse_Item) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse_Item.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse_Item) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *ListModerationQueueResponse_Item) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse_Item) GetSubmittedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse_Item) GetReasons() []ModerationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type GetVacancyModerationHistoryResponse_Decision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for the automatic checks
+	ModeratorId string               `protobuf:"bytes,1,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Decision    ModerationDecision   `protobuf:"varint,2,opt,name=decision,proto3,enum=personaappapi.vacancy.ModerationDecision" json:"decision,omitempty"`
+	Reasons     []ModerationReason   `protobuf:"varint,3,rep,packed,name=reasons,proto3,enum=personaappapi.vacancy.ModerationReason" json:"reasons,omitempty"`
+	Comment     string               `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) Reset() {
+	*x = GetVacancyModerationHistoryResponse_Decision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyModerationHistoryResponse_Decision) ProtoMessage() {}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyModerationHistoryResponse_Decision.ProtoReflect.Descriptor instead.
+func (*GetVacancyModerationHistoryResponse_Decision) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{65, 0}
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) GetDecision() ModerationDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return ModerationDecision_MODERATION_DECISION_UNKNOWN
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) GetReasons() []ModerationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GetVacancyModerationHistoryResponse_Decision) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListVacancyDuplicatesResponse_Cluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Original *Vacancy `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	// The oldest repost first
+	DuplicatesIds []string             `protobuf:"bytes,2,rep,name=duplicates_ids,json=duplicatesIds,proto3" json:"duplicates_ids,omitempty"`
+	RepostedAt    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=reposted_at,json=repostedAt,proto3" json:"reposted_at,omitempty"`
+}
+
+func (x *ListVacancyDuplicatesResponse_Cluster) Reset() {
+	*x = ListVacancyDuplicatesResponse_Cluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVacancyDuplicatesResponse_Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyDuplicatesResponse_Cluster) ProtoMessage() {}
+
+func (x *ListVacancyDuplicatesResponse_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyDuplicatesResponse_Cluster.ProtoReflect.Descriptor instead.
+func (*ListVacancyDuplicatesResponse_Cluster) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *ListVacancyDuplicatesResponse_Cluster) GetOriginal() *Vacancy {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *ListVacancyDuplicatesResponse_Cluster) GetDuplicatesIds() []string {
+	if x != nil {
+		return x.DuplicatesIds
+	}
+	return nil
+}
+
+func (x *ListVacancyDuplicatesResponse_Cluster) GetRepostedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RepostedAt
+	}
+	return nil
+}
+
+type ImportVacanciesResponse_RowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The CSV line or the position of the XML job
+	Line        int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ExternalId  string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Field       string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ImportVacanciesResponse_RowError) Reset() {
+	*x = ImportVacanciesResponse_RowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportVacanciesResponse_RowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVacanciesResponse_RowError) ProtoMessage() {}
+
+func (x *ImportVacanciesResponse_RowError) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVacanciesResponse_RowError.ProtoReflect.Descriptor instead.
+func (*ImportVacanciesResponse_RowError) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *ImportVacanciesResponse_RowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportVacanciesResponse_RowError) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ImportVacanciesResponse_RowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportVacanciesResponse_RowError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetVacancyRevisionResponse_VacancyLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GetVacancyRevisionResponse_VacancyLocation) Reset() {
+	*x = GetVacancyRevisionResponse_VacancyLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyRevisionResponse_VacancyLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyRevisionResponse_VacancyLocation) ProtoMessage() {}
+
+func (x *GetVacancyRevisionResponse_VacancyLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyRevisionResponse_VacancyLocation.ProtoReflect.Descriptor instead.
+func (*GetVacancyRevisionResponse_VacancyLocation) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{73, 0}
+}
+
+func (x *GetVacancyRevisionResponse_VacancyLocation) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetVacancyRevisionResponse_VacancyLocation) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetVacancyRevisionResponse_VacancyDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description          string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	WorkMonthsExperience uint32      `protobuf:"varint,2,opt,name=work_months_experience,json=workMonthsExperience,proto3" json:"work_months_experience,omitempty"`
+	WorkSchedule         string      `protobuf:"bytes,3,opt,name=work_schedule,json=workSchedule,proto3" json:"work_schedule,omitempty"`
+	Type                 VacancyType `protobuf:"varint,4,opt,name=type,proto3,enum=personaappapi.vacancy.VacancyType" json:"type,omitempty"`
+	Address              string      `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	CountryCode          int32       `protobuf:"varint,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) Reset() {
+	*x = GetVacancyRevisionResponse_VacancyDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyRevisionResponse_VacancyDescription) ProtoMessage() {}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyRevisionResponse_VacancyDescription.ProtoReflect.Descriptor instead.
+func (*GetVacancyRevisionResponse_VacancyDescription) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{73, 1}
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) GetWorkMonthsExperience() uint32 {
+	if x != nil {
+		return x.WorkMonthsExperience
+	}
+	return 0
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) GetWorkSchedule() string {
+	if x != nil {
+		return x.WorkSchedule
+	}
+	return ""
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) GetType() VacancyType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyType_VACANCY_TYPE_UNKNOWN
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetVacancyRevisionResponse_VacancyDescription) GetCountryCode() int32 {
+	if x != nil {
+		return x.CountryCode
+	}
+	return 0
+}
+
+type DiffVacancyRevisionsResponse_FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From  string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffVacancyRevisionsResponse_FieldChange) Reset() {
+	*x = DiffVacancyRevisionsResponse_FieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vacancy_vacancy_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffVacancyRevisionsResponse_FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVacancyRevisionsResponse_FieldChange) ProtoMessage() {}
+
+func (x *DiffVacancyRevisionsResponse_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_vacancy_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVacancyRevisionsResponse_FieldChange.ProtoReflect.Descriptor instead.
+func (*DiffVacancyRevisionsResponse_FieldChange) Descriptor() ([]byte, []int) {
+	return file_vacancy_vacancy_proto_rawDescGZIP(), []int{75, 0}
+}
+
+func (x *DiffVacancyRevisionsResponse_FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *DiffVacancyRevisionsResponse_FieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DiffVacancyRevisionsResponse_FieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+var File_vacancy_vacancy_proto protoreflect.FileDescriptor
+
+var file_vacancy_vacancy_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2f, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xcc,
+	0x02, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x39,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x06, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a,
+	0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e,
+	0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f,
+	0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x91, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x16, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x4d, 0x6f, 0x76, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x4d,
+	0x6f, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x09, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x52, 0x4c, 0x73,
+	0x12, 0x57, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x1a, 0x4b, 0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x1a, 0x86, 0x02, 0x0a, 0x12, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x5f, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x77,
+	0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0xaf, 0x03,
+	0x0a, 0x07, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61,
+	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64,
+	0x12, 0x3f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x73, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0b, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x22,
+	0x27, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x84, 0x09, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65,
+	0x6f, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x5c, 0x0a,
+	0x0a, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c,
+	0x61, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4f, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x3b, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x1a, 0x5e, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9b, 0x07, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x49, 0x64,
+	0x73, 0x12, 0x5c, 0x0a, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x5c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x5f, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x1a, 0xbe, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x73,
+	0x12, 0x38, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
+	0x5f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x1a, 0x7c, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x6a, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe0, 0x02,
+	0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x67,
+	0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0x5e, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb6, 0x07, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x49, 0x64,
+	0x73, 0x12, 0x5b, 0x0a, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x5b,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x1a, 0xde, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x61,
+	0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x1a, 0x7b, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x53, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x5c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6a, 0x0a,
+	0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb1, 0x02, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x52, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x7a, 0x6f, 0x6f, 0x6d, 0x12, 0x67, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x1a, 0x5e, 0x0a,
+	0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x03,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x96,
+	0x01, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0x59, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xd3, 0x07, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x68,
+	0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x65, 0x61, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
+	0x65, 0x6f, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x5c,
+	0x0a, 0x0a, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x61,
+	0x6c, 0x61, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4f, 0x0a,
+	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x3b,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x5e, 0x0a, 0x12,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x0e,
+	0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc2, 0x05, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x0e,
+	0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x0d, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x1a, 0x59, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x65, 0x0a, 0x0c,
+	0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x2b, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x22, 0xf4, 0x0d, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x12, 0x53, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x5c, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x69, 0x74, 0x79,
+	0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x60, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x53, 0x61, 0x76, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x12, 0x4c, 0x0a, 0x14,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x75,
+	0x6d, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x75, 0x6d, 0x70, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x6d, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x75, 0x6d, 0x70, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x36, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x4b, 0x0a, 0x0f, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0x86, 0x02, 0x0a, 0x12, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x34, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x5f,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x1a, 0xb1, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x65, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2d, 0x0a, 0x0c, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55,
+	0x72, 0x6c, 0x73, 0x1a, 0x6a, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x62, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a,
+	0x0a, 0x1d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x12,
+	0x42, 0x75, 0x6d, 0x70, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x42, 0x75, 0x6d, 0x70, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x16, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x16, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9c, 0x05,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x09, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x79, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0x7f, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x61, 0x76, 0x65, 0x73, 0x1a, 0xf1, 0x02, 0x0a, 0x09, 0x4d, 0x79, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12,
+	0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
+	0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x1a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a,
+	0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x53,
+	0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64,
+	0x22, 0x15, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x61, 0x76,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x22, 0x17,
+	0x0a, 0x15, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6e,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa4,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f,
+	0x62, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6a,
+	0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x63, 0x76, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x76, 0x49, 0x64, 0x12, 0x31, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xaa, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a,
+	0x5c, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xd2, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x1a, 0xca, 0x01, 0x0a, 0x09, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x63, 0x76, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x76, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3a, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6c,
+	0x6c, 0x12, 0x50, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x94, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0xaa, 0x01,
+	0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x73, 0x61, 0x76, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xc2, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x15,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49,
+	0x64, 0x22, 0x97, 0x03, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x09, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x8c, 0x02, 0x0a,
+	0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xa9, 0x01, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12,
+	0x25, 0x0a, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x65, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x22,
+	0x3c, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x0e, 0x0a,
+	0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4d, 0x4c, 0x10, 0x02, 0x22, 0x97, 0x02,
+	0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4f, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f,
+	0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x77,
+	0x0a, 0x08, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x56, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x05, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12,
+	0x5d, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x41, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x66,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x73, 0x1a, 0x4b, 0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0x86,
+	0x02, 0x0a, 0x12, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x73, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x60, 0x0a, 0x1b, 0x44, 0x69, 0x66, 0x66, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x1c, 0x44, 0x69,
+	0x66, 0x66, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x47, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x5a,
+	0x0a, 0x1d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x07, 0x0a, 0x05,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4a, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72,
+	0x6c, 0x22, 0xbe, 0x02, 0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x06, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xb8, 0x03, 0x0a, 0x07, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3d,
+	0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x22, 0xac, 0x01,
+	0x0a, 0x0f, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x14,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x65, 0x0a, 0x04, 0x43, 0x69,
+	0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x62, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x4b, 0x6d, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x12, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49,
+	0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x3a,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x61, 0x0a, 0x0c, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2a, 0xd9, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x43, 0x41,
+	0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x41, 0x43, 0x41, 0x4e,
+	0x43, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x56,
+	0x49, 0x45, 0x57, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x06, 0x2a, 0xa6, 0x02, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x44, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x4e,
+	0x45, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x53, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x4f,
+	0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12,
+	0x24, 0x0a, 0x20, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x41, 0x4c,
+	0x41, 0x52, 0x59, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4c,
+	0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x4f, 0x44, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49,
+	0x53, 0x43, 0x52, 0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x06, 0x12, 0x1b,
+	0x0a, 0x17, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x07, 0x2a, 0x9c, 0x01, 0x0a, 0x12,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49,
+	0x54, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50,
+	0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x44, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x87, 0x01, 0x0a, 0x17, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a,
+	0x1e, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10,
+	0x01, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x44,
+	0x41, 0x59, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x0c, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50,
+	0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x41, 0x4c, 0x41, 0x52,
+	0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x03, 0x2a, 0x59, 0x0a, 0x0b, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x43, 0x41, 0x4e,
+	0x43, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41,
+	0x43, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41,
+	0x4c, 0x10, 0x02, 0x2a, 0xb3, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x41, 0x43, 0x41,
+	0x4e, 0x43, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x43, 0x41,
+	0x4e, 0x43, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e,
+	0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x43, 0x41, 0x4e,
+	0x43, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x41,
+	0x4c, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x41, 0x43, 0x41, 0x4e, 0x43,
+	0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4c,
+	0x45, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x41, 0x43, 0x41,
+	0x4e, 0x43, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44,
+	0x49, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x2a, 0x32, 0x0a, 0x08, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43,
+	0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43,
+	0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x41, 0x48, 0x10, 0x01, 0x32, 0x84, 0x26,
+	0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x41, 0x70, 0x70, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x12, 0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82,
+	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x13, 0x4d, 0x6f, 0x76, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x73, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x31, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6d, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x12, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61,
+	0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x34, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0b, 0x42, 0x75,
+	0x6d, 0x70, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x29, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x42, 0x75, 0x6d,
+	0x70, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x79, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x12, 0x2f, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x64, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12,
+	0x29, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70,
+	0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x55, 0x6e, 0x73,
+	0x61, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x77, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x52,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x94, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x39, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x33, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
+	0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x2d, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61,
+	0x6e, 0x63, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x14, 0x44,
+	0x69, 0x66, 0x66, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a,
+	0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x61,
+	0x63, 0x61, 0x6e, 0x63, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x63,
+	0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x61, 0x70, 0x70, 0x42, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x56,
+	0x61, 0x63, 0x61, 0x6e, 0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vacancy_vacancy_proto_rawDescOnce sync.Once
+	file_vacancy_vacancy_proto_rawDescData = file_vacancy_vacancy_proto_rawDesc
+)
+
+func file_vacancy_vacancy_proto_rawDescGZIP() []byte {
+	file_vacancy_vacancy_proto_rawDescOnce.Do(func() {
+		file_vacancy_vacancy_proto_rawDescData = protoimpl.X.CompressGZIP(file_vacancy_vacancy_proto_rawDescData)
+	})
+	return file_vacancy_vacancy_proto_rawDescData
+}
+
+var file_vacancy_vacancy_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_vacancy_vacancy_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
+var file_vacancy_vacancy_proto_goTypes = []interface{}{
+	(VacancyStatus)(0),                              // 0: personaappapi.vacancy.VacancyStatus
+	(ModerationReason)(0),                           // 1: personaappapi.vacancy.ModerationReason
+	(ModerationDecision)(0),                         // 2: personaappapi.vacancy.ModerationDecision
+	(VacancyStatsGranularity)(0),                    // 3: personaappapi.vacancy.VacancyStatsGranularity
+	(SalaryPeriod)(0),                               // 4: personaappapi.vacancy.SalaryPeriod
+	(VacancyType)(0),                                // 5: personaappapi.vacancy.VacancyType
+	(VacancySortOrder)(0),                           // 6: personaappapi.vacancy.VacancySortOrder
+	(Currency)(0),                                   // 7: personaappapi.vacancy.Currency
+	(ImportVacanciesRequest_Format)(0),              // 8: personaappapi.vacancy.ImportVacanciesRequest.Format
+	(*GetVacancyCategoryRequest)(nil),               // 9: personaappapi.vacancy.GetVacancyCategoryRequest
+	(*GetVacancyCategoryResponse)(nil),              // 10: personaappapi.vacancy.GetVacancyCategoryResponse
+	(*UpdateVacancyCategoryRequest)(nil),            // 11: personaappapi.vacancy.UpdateVacancyCategoryRequest
+	(*UpdateVacancyCategoryResponse)(nil),           // 12: personaappapi.vacancy.UpdateVacancyCategoryResponse
+	(*DeleteVacancyCategoryRequest)(nil),            // 13: personaappapi.vacancy.DeleteVacancyCategoryRequest
+	(*DeleteVacancyCategoryResponse)(nil),           // 14: personaappapi.vacancy.DeleteVacancyCategoryResponse
+	(*GetVacancyCategoriesListRequest)(nil),         // 15: personaappapi.vacancy.GetVacancyCategoriesListRequest
+	(*GetVacancyCategoriesListResponse)(nil),        // 16: personaappapi.vacancy.GetVacancyCategoriesListResponse
+	(*MoveVacancyCategoryRequest)(nil),              // 17: personaappapi.vacancy.MoveVacancyCategoryRequest
+	(*MoveVacancyCategoryResponse)(nil),             // 18: personaappapi.vacancy.MoveVacancyCategoryResponse
+	(*UpdateVacancyRequest)(nil),                    // 19: personaappapi.vacancy.UpdateVacancyRequest
+	(*UpdateVacancyResponse)(nil),                   // 20: personaappapi.vacancy.UpdateVacancyResponse
+	(*GetVacanciesListRequest)(nil),                 // 21: personaappapi.vacancy.GetVacanciesListRequest
+	(*GetVacanciesListResponse)(nil),                // 22: personaappapi.vacancy.GetVacanciesListResponse
+	(*SearchVacanciesRequest)(nil),                  // 23: personaappapi.vacancy.SearchVacanciesRequest
+	(*SearchVacanciesResponse)(nil),                 // 24: personaappapi.vacancy.SearchVacanciesResponse
+	(*GetVacanciesMapRequest)(nil),                  // 25: personaappapi.vacancy.GetVacanciesMapRequest
+	(*GetVacanciesMapResponse)(nil),                 // 26: personaappapi.vacancy.GetVacanciesMapResponse
+	(*GetVacancyFacetsRequest)(nil),                 // 27: personaappapi.vacancy.GetVacancyFacetsRequest
+	(*GetVacancyFacetsResponse)(nil),                // 28: personaappapi.vacancy.GetVacancyFacetsResponse
+	(*GetVacancyDetailsRequest)(nil),                // 29: personaappapi.vacancy.GetVacancyDetailsRequest
+	(*GetVacancyDetailsResponse)(nil),               // 30: personaappapi.vacancy.GetVacancyDetailsResponse
+	(*DeleteVacancyRequest)(nil),                    // 31: personaappapi.vacancy.DeleteVacancyRequest
+	(*DeleteVacancyResponse)(nil),                   // 32: personaappapi.vacancy.DeleteVacancyResponse
+	(*PublishVacancyRequest)(nil),                   // 33: personaappapi.vacancy.PublishVacancyRequest
+	(*PublishVacancyResponse)(nil),                  // 34: personaappapi.vacancy.PublishVacancyResponse
+	(*ScheduleVacancyPublishRequest)(nil),           // 35: personaappapi.vacancy.ScheduleVacancyPublishRequest
+	(*ScheduleVacancyPublishResponse)(nil),          // 36: personaappapi.vacancy.ScheduleVacancyPublishResponse
+	(*BumpVacancyRequest)(nil),                      // 37: personaappapi.vacancy.BumpVacancyRequest
+	(*BumpVacancyResponse)(nil),                     // 38: personaappapi.vacancy.BumpVacancyResponse
+	(*PauseVacancyRequest)(nil),                     // 39: personaappapi.vacancy.PauseVacancyRequest
+	(*PauseVacancyResponse)(nil),                    // 40: personaappapi.vacancy.PauseVacancyResponse
+	(*CloseVacancyRequest)(nil),                     // 41: personaappapi.vacancy.CloseVacancyRequest
+	(*CloseVacancyResponse)(nil),                    // 42: personaappapi.vacancy.CloseVacancyResponse
+	(*ListMyVacanciesRequest)(nil),                  // 43: personaappapi.vacancy.ListMyVacanciesRequest
+	(*ListMyVacanciesResponse)(nil),                 // 44: personaappapi.vacancy.ListMyVacanciesResponse
+	(*CreateVacancyAlertRequest)(nil),               // 45: personaappapi.vacancy.CreateVacancyAlertRequest
+	(*CreateVacancyAlertResponse)(nil),              // 46: personaappapi.vacancy.CreateVacancyAlertResponse
+	(*ListVacancyAlertsRequest)(nil),                // 47: personaappapi.vacancy.ListVacancyAlertsRequest
+	(*ListVacancyAlertsResponse)(nil),               // 48: personaappapi.vacancy.ListVacancyAlertsResponse
+	(*DeleteVacancyAlertRequest)(nil),               // 49: personaappapi.vacancy.DeleteVacancyAlertRequest
+	(*DeleteVacancyAlertResponse)(nil),              // 50: personaappapi.vacancy.DeleteVacancyAlertResponse
+	(*SaveVacancyRequest)(nil),                      // 51: personaappapi.vacancy.SaveVacancyRequest
+	(*SaveVacancyResponse)(nil),                     // 52: personaappapi.vacancy.SaveVacancyResponse
+	(*UnsaveVacancyRequest)(nil),                    // 53: personaappapi.vacancy.UnsaveVacancyRequest
+	(*UnsaveVacancyResponse)(nil),                   // 54: personaappapi.vacancy.UnsaveVacancyResponse
+	(*ListSavedVacanciesRequest)(nil),               // 55: personaappapi.vacancy.ListSavedVacanciesRequest
+	(*GetSimilarVacanciesRequest)(nil),              // 56: personaappapi.vacancy.GetSimilarVacanciesRequest
+	(*GetCompanyVacanciesRequest)(nil),              // 57: personaappapi.vacancy.GetCompanyVacanciesRequest
+	(*MatchScore)(nil),                              // 58: personaappapi.vacancy.MatchScore
+	(*GetRecommendedVacanciesRequest)(nil),          // 59: personaappapi.vacancy.GetRecommendedVacanciesRequest
+	(*GetRecommendedVacanciesResponse)(nil),         // 60: personaappapi.vacancy.GetRecommendedVacanciesResponse
+	(*GetRecommendedCandidatesRequest)(nil),         // 61: personaappapi.vacancy.GetRecommendedCandidatesRequest
+	(*GetRecommendedCandidatesResponse)(nil),        // 62: personaappapi.vacancy.GetRecommendedCandidatesResponse
+	(*RevealVacancyPhoneRequest)(nil),               // 63: personaappapi.vacancy.RevealVacancyPhoneRequest
+	(*RevealVacancyPhoneResponse)(nil),              // 64: personaappapi.vacancy.RevealVacancyPhoneResponse
+	(*GetVacancyStatsRequest)(nil),                  // 65: personaappapi.vacancy.GetVacancyStatsRequest
+	(*GetVacancyStatsResponse)(nil),                 // 66: personaappapi.vacancy.GetVacancyStatsResponse
+	(*ListModerationQueueRequest)(nil),              // 67: personaappapi.vacancy.ListModerationQueueRequest
+	(*ListModerationQueueResponse)(nil),             // 68: personaappapi.vacancy.ListModerationQueueResponse
+	(*ApproveVacancyRequest)(nil),                   // 69: personaappapi.vacancy.ApproveVacancyRequest
+	(*ApproveVacancyResponse)(nil),                  // 70: personaappapi.vacancy.ApproveVacancyResponse
+	(*RejectVacancyRequest)(nil),                    // 71: personaappapi.vacancy.RejectVacancyRequest
+	(*RejectVacancyResponse)(nil),                   // 72: personaappapi.vacancy.RejectVacancyResponse
+	(*GetVacancyModerationHistoryRequest)(nil),      // 73: personaappapi.vacancy.GetVacancyModerationHistoryRequest
+	(*GetVacancyModerationHistoryResponse)(nil),     // 74: personaappapi.vacancy.GetVacancyModerationHistoryResponse
+	(*ListVacancyDuplicatesRequest)(nil),            // 75: personaappapi.vacancy.ListVacancyDuplicatesRequest
+	(*ListVacancyDuplicatesResponse)(nil),           // 76: personaappapi.vacancy.ListVacancyDuplicatesResponse
+	(*ImportVacanciesRequest)(nil),                  // 77: personaappapi.vacancy.ImportVacanciesRequest
+	(*ImportVacanciesResponse)(nil),                 // 78: personaappapi.vacancy.ImportVacanciesResponse
+	(*ListVacancyRevisionsRequest)(nil),             // 79: personaappapi.vacancy.ListVacancyRevisionsRequest
+	(*ListVacancyRevisionsResponse)(nil),            // 80: personaappapi.vacancy.ListVacancyRevisionsResponse
+	(*GetVacancyRevisionRequest)(nil),               // 81: personaappapi.vacancy.GetVacancyRevisionRequest
+	(*GetVacancyRevisionResponse)(nil),              // 82: personaappapi.vacancy.GetVacancyRevisionResponse
+	(*DiffVacancyRevisionsRequest)(nil),             // 83: personaappapi.vacancy.DiffVacancyRevisionsRequest
+	(*DiffVacancyRevisionsResponse)(nil),            // 84: personaappapi.vacancy.DiffVacancyRevisionsResponse
+	(*RestoreVacancyRevisionRequest)(nil),           // 85: personaappapi.vacancy.RestoreVacancyRevisionRequest
+	(*RestoreVacancyRevisionResponse)(nil),          // 86: personaappapi.vacancy.RestoreVacancyRevisionResponse
+	(*Empty)(nil),                                   // 87: personaappapi.vacancy.Empty
+	(*Company)(nil),                                 // 88: personaappapi.vacancy.Company
+	(*VacancyCategory)(nil),                         // 89: personaappapi.vacancy.VacancyCategory
+	(*Vacancy)(nil),                                 // 90: personaappapi.vacancy.Vacancy
+	(*VacancyRevision)(nil),                         // 91: personaappapi.vacancy.VacancyRevision
+	(*VacancyCategoryShort)(nil),                    // 92: personaappapi.vacancy.VacancyCategoryShort
+	(*City)(nil),                                    // 93: personaappapi.vacancy.City
+	(*GeoRadius)(nil),                               // 94: personaappapi.vacancy.GeoRadius
+	(*BoundingBox)(nil),                             // 95: personaappapi.vacancy.BoundingBox
+	(*VacancyAlertFilter)(nil),                      // 96: personaappapi.vacancy.VacancyAlertFilter
+	(*VacancyAlert)(nil),                            // 97: personaappapi.vacancy.VacancyAlert
+	nil,                                             // 98: personaappapi.vacancy.UpdateVacancyCategoryRequest.TitlesEntry
+	nil,                                             // 99: personaappapi.vacancy.GetVacancyCategoriesListResponse.VacancyCategoriesEntry
+	(*UpdateVacancyRequest_VacancyLocation)(nil),    // 100: personaappapi.vacancy.UpdateVacancyRequest.VacancyLocation
+	(*UpdateVacancyRequest_VacancyDescription)(nil), // 101: personaappapi.vacancy.UpdateVacancyRequest.VacancyDescription
+	(*UpdateVacancyRequest_Vacancy)(nil),            // 102: personaappapi.vacancy.UpdateVacancyRequest.Vacancy
+	nil,                                             // 103: personaappapi.vacancy.GetVacanciesListRequest.CategoriesIdsEntry
+	nil,                                             // 104: personaappapi.vacancy.GetVacanciesListRequest.CitiesIdsEntry
+	(*GetVacanciesListResponse_VacancyDetails)(nil), // 105: personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails
+	nil, // 106: personaappapi.vacancy.GetVacanciesListResponse.VacanciesEntry
+	nil, // 107: personaappapi.vacancy.GetVacanciesListResponse.CompaniesEntry
+	nil, // 108: personaappapi.vacancy.GetVacanciesListResponse.CategoriesEntry
+	nil, // 109: personaappapi.vacancy.SearchVacanciesRequest.CategoriesIdsEntry
+	(*SearchVacanciesResponse_VacancyDetails)(nil), // 110: personaappapi.vacancy.SearchVacanciesResponse.VacancyDetails
+	nil,                                     // 111: personaappapi.vacancy.SearchVacanciesResponse.VacanciesEntry
+	nil,                                     // 112: personaappapi.vacancy.SearchVacanciesResponse.CompaniesEntry
+	nil,                                     // 113: personaappapi.vacancy.SearchVacanciesResponse.CategoriesEntry
+	nil,                                     // 114: personaappapi.vacancy.GetVacanciesMapRequest.CategoriesIdsEntry
+	(*GetVacanciesMapResponse_Marker)(nil),  // 115: personaappapi.vacancy.GetVacanciesMapResponse.Marker
+	(*GetVacanciesMapResponse_Cluster)(nil), // 116: personaappapi.vacancy.GetVacanciesMapResponse.Cluster
+	nil,                                     // 117: personaappapi.vacancy.GetVacancyFacetsRequest.CategoriesIdsEntry
+	nil,                                     // 118: personaappapi.vacancy.GetVacancyFacetsRequest.CitiesIdsEntry
+	(*GetVacancyFacetsResponse_TypeCount)(nil),    // 119: personaappapi.vacancy.GetVacancyFacetsResponse.TypeCount
+	(*GetVacancyFacetsResponse_SalaryBucket)(nil), // 120: personaappapi.vacancy.GetVacancyFacetsResponse.SalaryBucket
+	nil, // 121: personaappapi.vacancy.GetVacancyFacetsResponse.CategoriesEntry
+	nil, // 122: personaappapi.vacancy.GetVacancyFacetsResponse.CitiesEntry
+	(*GetVacancyDetailsResponse_CompanyDescription)(nil), // 123: personaappapi.vacancy.GetVacancyDetailsResponse.CompanyDescription
+	(*GetVacancyDetailsResponse_VacancyLocation)(nil),    // 124: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyLocation
+	(*GetVacancyDetailsResponse_VacancyDescription)(nil), // 125: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyDescription
+	(*GetVacancyDetailsResponse_VacancyCompany)(nil),     // 126: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany
+	(*GetVacancyDetailsResponse_VacancyImage)(nil),       // 127: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyImage
+	nil,                                      // 128: personaappapi.vacancy.GetVacancyDetailsResponse.CategoriesEntry
+	(*ListMyVacanciesResponse_Counters)(nil), // 129: personaappapi.vacancy.ListMyVacanciesResponse.Counters
+	(*ListMyVacanciesResponse_MyVacancy)(nil), // 130: personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy
+	nil, // 131: personaappapi.vacancy.GetRecommendedVacanciesResponse.ScoresEntry
+	(*GetRecommendedCandidatesResponse_Candidate)(nil),    // 132: personaappapi.vacancy.GetRecommendedCandidatesResponse.Candidate
+	(*GetVacancyStatsResponse_Point)(nil),                 // 133: personaappapi.vacancy.GetVacancyStatsResponse.Point
+	(*ListModerationQueueResponse_Item)(nil),              // 134: personaappapi.vacancy.ListModerationQueueResponse.Item
+	(*GetVacancyModerationHistoryResponse_Decision)(nil),  // 135: personaappapi.vacancy.GetVacancyModerationHistoryResponse.Decision
+	(*ListVacancyDuplicatesResponse_Cluster)(nil),         // 136: personaappapi.vacancy.ListVacancyDuplicatesResponse.Cluster
+	(*ImportVacanciesResponse_RowError)(nil),              // 137: personaappapi.vacancy.ImportVacanciesResponse.RowError
+	(*GetVacancyRevisionResponse_VacancyLocation)(nil),    // 138: personaappapi.vacancy.GetVacancyRevisionResponse.VacancyLocation
+	(*GetVacancyRevisionResponse_VacancyDescription)(nil), // 139: personaappapi.vacancy.GetVacancyRevisionResponse.VacancyDescription
+	(*DiffVacancyRevisionsResponse_FieldChange)(nil),      // 140: personaappapi.vacancy.DiffVacancyRevisionsResponse.FieldChange
+	nil,                          // 141: personaappapi.vacancy.VacancyCategory.TitlesEntry
+	(*wrappers.StringValue)(nil), // 142: google.protobuf.StringValue
+	(*wrappers.Int32Value)(nil),  // 143: google.protobuf.Int32Value
+	(*timestamp.Timestamp)(nil),  // 144: google.protobuf.Timestamp
+	(*wrappers.BoolValue)(nil),   // 145: google.protobuf.BoolValue
+	(*wrappers.DoubleValue)(nil), // 146: google.protobuf.DoubleValue
+}
+var file_vacancy_vacancy_proto_depIdxs = []int32{
+	89,  // 0: personaappapi.vacancy.GetVacancyCategoryResponse.category:type_name -> personaappapi.vacancy.VacancyCategory
+	142, // 1: personaappapi.vacancy.UpdateVacancyCategoryRequest.id:type_name -> google.protobuf.StringValue
+	142, // 2: personaappapi.vacancy.UpdateVacancyCategoryRequest.parent_id:type_name -> google.protobuf.StringValue
+	98,  // 3: personaappapi.vacancy.UpdateVacancyCategoryRequest.titles:type_name -> personaappapi.vacancy.UpdateVacancyCategoryRequest.TitlesEntry
+	143, // 4: personaappapi.vacancy.GetVacancyCategoriesListRequest.rating:type_name -> google.protobuf.Int32Value
+	142, // 5: personaappapi.vacancy.GetVacancyCategoriesListRequest.parent_id:type_name -> google.protobuf.StringValue
+	99,  // 6: personaappapi.vacancy.GetVacancyCategoriesListResponse.vacancy_categories:type_name -> personaappapi.vacancy.GetVacancyCategoriesListResponse.VacancyCategoriesEntry
+	142, // 7: personaappapi.vacancy.MoveVacancyCategoryRequest.parent_id:type_name -> google.protobuf.StringValue
+	102, // 8: personaappapi.vacancy.UpdateVacancyRequest.vacancy:type_name -> personaappapi.vacancy.UpdateVacancyRequest.Vacancy
+	100, // 9: personaappapi.vacancy.UpdateVacancyRequest.location:type_name -> personaappapi.vacancy.UpdateVacancyRequest.VacancyLocation
+	101, // 10: personaappapi.vacancy.UpdateVacancyRequest.description:type_name -> personaappapi.vacancy.UpdateVacancyRequest.VacancyDescription
+	103, // 11: personaappapi.vacancy.GetVacanciesListRequest.categories_ids:type_name -> personaappapi.vacancy.GetVacanciesListRequest.CategoriesIdsEntry
+	142, // 12: personaappapi.vacancy.GetVacanciesListRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 13: personaappapi.vacancy.GetVacanciesListRequest.count:type_name -> google.protobuf.Int32Value
+	94,  // 14: personaappapi.vacancy.GetVacanciesListRequest.near:type_name -> personaappapi.vacancy.GeoRadius
+	104, // 15: personaappapi.vacancy.GetVacanciesListRequest.cities_ids:type_name -> personaappapi.vacancy.GetVacanciesListRequest.CitiesIdsEntry
+	143, // 16: personaappapi.vacancy.GetVacanciesListRequest.min_salary:type_name -> google.protobuf.Int32Value
+	143, // 17: personaappapi.vacancy.GetVacanciesListRequest.max_salary:type_name -> google.protobuf.Int32Value
+	7,   // 18: personaappapi.vacancy.GetVacanciesListRequest.currency:type_name -> personaappapi.vacancy.Currency
+	5,   // 19: personaappapi.vacancy.GetVacanciesListRequest.type:type_name -> personaappapi.vacancy.VacancyType
+	143, // 20: personaappapi.vacancy.GetVacanciesListRequest.max_experience_months:type_name -> google.protobuf.Int32Value
+	142, // 21: personaappapi.vacancy.GetVacanciesListRequest.company_id:type_name -> google.protobuf.StringValue
+	143, // 22: personaappapi.vacancy.GetVacanciesListRequest.country_code:type_name -> google.protobuf.Int32Value
+	6,   // 23: personaappapi.vacancy.GetVacanciesListRequest.sort_order:type_name -> personaappapi.vacancy.VacancySortOrder
+	106, // 24: personaappapi.vacancy.GetVacanciesListResponse.vacancies:type_name -> personaappapi.vacancy.GetVacanciesListResponse.VacanciesEntry
+	107, // 25: personaappapi.vacancy.GetVacanciesListResponse.companies:type_name -> personaappapi.vacancy.GetVacanciesListResponse.CompaniesEntry
+	108, // 26: personaappapi.vacancy.GetVacanciesListResponse.categories:type_name -> personaappapi.vacancy.GetVacanciesListResponse.CategoriesEntry
+	142, // 27: personaappapi.vacancy.GetVacanciesListResponse.cursor:type_name -> google.protobuf.StringValue
+	109, // 28: personaappapi.vacancy.SearchVacanciesRequest.categories_ids:type_name -> personaappapi.vacancy.SearchVacanciesRequest.CategoriesIdsEntry
+	142, // 29: personaappapi.vacancy.SearchVacanciesRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 30: personaappapi.vacancy.SearchVacanciesRequest.count:type_name -> google.protobuf.Int32Value
+	111, // 31: personaappapi.vacancy.SearchVacanciesResponse.vacancies:type_name -> personaappapi.vacancy.SearchVacanciesResponse.VacanciesEntry
+	112, // 32: personaappapi.vacancy.SearchVacanciesResponse.companies:type_name -> personaappapi.vacancy.SearchVacanciesResponse.CompaniesEntry
+	113, // 33: personaappapi.vacancy.SearchVacanciesResponse.categories:type_name -> personaappapi.vacancy.SearchVacanciesResponse.CategoriesEntry
+	142, // 34: personaappapi.vacancy.SearchVacanciesResponse.cursor:type_name -> google.protobuf.StringValue
+	95,  // 35: personaappapi.vacancy.GetVacanciesMapRequest.bounds:type_name -> personaappapi.vacancy.BoundingBox
+	114, // 36: personaappapi.vacancy.GetVacanciesMapRequest.categories_ids:type_name -> personaappapi.vacancy.GetVacanciesMapRequest.CategoriesIdsEntry
+	115, // 37: personaappapi.vacancy.GetVacanciesMapResponse.markers:type_name -> personaappapi.vacancy.GetVacanciesMapResponse.Marker
+	116, // 38: personaappapi.vacancy.GetVacanciesMapResponse.clusters:type_name -> personaappapi.vacancy.GetVacanciesMapResponse.Cluster
+	117, // 39: personaappapi.vacancy.GetVacancyFacetsRequest.categories_ids:type_name -> personaappapi.vacancy.GetVacancyFacetsRequest.CategoriesIdsEntry
+	94,  // 40: personaappapi.vacancy.GetVacancyFacetsRequest.near:type_name -> personaappapi.vacancy.GeoRadius
+	118, // 41: personaappapi.vacancy.GetVacancyFacetsRequest.cities_ids:type_name -> personaappapi.vacancy.GetVacancyFacetsRequest.CitiesIdsEntry
+	143, // 42: personaappapi.vacancy.GetVacancyFacetsRequest.min_salary:type_name -> google.protobuf.Int32Value
+	143, // 43: personaappapi.vacancy.GetVacancyFacetsRequest.max_salary:type_name -> google.protobuf.Int32Value
+	7,   // 44: personaappapi.vacancy.GetVacancyFacetsRequest.currency:type_name -> personaappapi.vacancy.Currency
+	5,   // 45: personaappapi.vacancy.GetVacancyFacetsRequest.type:type_name -> personaappapi.vacancy.VacancyType
+	143, // 46: personaappapi.vacancy.GetVacancyFacetsRequest.max_experience_months:type_name -> google.protobuf.Int32Value
+	142, // 47: personaappapi.vacancy.GetVacancyFacetsRequest.company_id:type_name -> google.protobuf.StringValue
+	143, // 48: personaappapi.vacancy.GetVacancyFacetsRequest.country_code:type_name -> google.protobuf.Int32Value
+	121, // 49: personaappapi.vacancy.GetVacancyFacetsResponse.categories:type_name -> personaappapi.vacancy.GetVacancyFacetsResponse.CategoriesEntry
+	122, // 50: personaappapi.vacancy.GetVacancyFacetsResponse.cities:type_name -> personaappapi.vacancy.GetVacancyFacetsResponse.CitiesEntry
+	119, // 51: personaappapi.vacancy.GetVacancyFacetsResponse.types:type_name -> personaappapi.vacancy.GetVacancyFacetsResponse.TypeCount
+	120, // 52: personaappapi.vacancy.GetVacancyFacetsResponse.salary_buckets:type_name -> personaappapi.vacancy.GetVacancyFacetsResponse.SalaryBucket
+	90,  // 53: personaappapi.vacancy.GetVacancyDetailsResponse.vacancy:type_name -> personaappapi.vacancy.Vacancy
+	127, // 54: personaappapi.vacancy.GetVacancyDetailsResponse.image:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyImage
+	124, // 55: personaappapi.vacancy.GetVacancyDetailsResponse.location:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyLocation
+	125, // 56: personaappapi.vacancy.GetVacancyDetailsResponse.description:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyDescription
+	126, // 57: personaappapi.vacancy.GetVacancyDetailsResponse.company:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany
+	93,  // 58: personaappapi.vacancy.GetVacancyDetailsResponse.city:type_name -> personaappapi.vacancy.City
+	128, // 59: personaappapi.vacancy.GetVacancyDetailsResponse.categories:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.CategoriesEntry
+	0,   // 60: personaappapi.vacancy.GetVacancyDetailsResponse.status:type_name -> personaappapi.vacancy.VacancyStatus
+	144, // 61: personaappapi.vacancy.GetVacancyDetailsResponse.published_at:type_name -> google.protobuf.Timestamp
+	144, // 62: personaappapi.vacancy.GetVacancyDetailsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	142, // 63: personaappapi.vacancy.GetVacancyDetailsResponse.duplicate_of:type_name -> google.protobuf.StringValue
+	144, // 64: personaappapi.vacancy.GetVacancyDetailsResponse.scheduled_publish_at:type_name -> google.protobuf.Timestamp
+	144, // 65: personaappapi.vacancy.GetVacancyDetailsResponse.bumped_at:type_name -> google.protobuf.Timestamp
+	144, // 66: personaappapi.vacancy.PublishVacancyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	144, // 67: personaappapi.vacancy.ScheduleVacancyPublishRequest.publish_at:type_name -> google.protobuf.Timestamp
+	0,   // 68: personaappapi.vacancy.ListMyVacanciesRequest.statuses:type_name -> personaappapi.vacancy.VacancyStatus
+	142, // 69: personaappapi.vacancy.ListMyVacanciesRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 70: personaappapi.vacancy.ListMyVacanciesRequest.count:type_name -> google.protobuf.Int32Value
+	130, // 71: personaappapi.vacancy.ListMyVacanciesResponse.vacancies:type_name -> personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy
+	142, // 72: personaappapi.vacancy.ListMyVacanciesResponse.cursor:type_name -> google.protobuf.StringValue
+	96,  // 73: personaappapi.vacancy.CreateVacancyAlertRequest.filter:type_name -> personaappapi.vacancy.VacancyAlertFilter
+	97,  // 74: personaappapi.vacancy.ListVacancyAlertsResponse.alerts:type_name -> personaappapi.vacancy.VacancyAlert
+	142, // 75: personaappapi.vacancy.ListSavedVacanciesRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 76: personaappapi.vacancy.ListSavedVacanciesRequest.count:type_name -> google.protobuf.Int32Value
+	143, // 77: personaappapi.vacancy.GetSimilarVacanciesRequest.count:type_name -> google.protobuf.Int32Value
+	142, // 78: personaappapi.vacancy.GetCompanyVacanciesRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 79: personaappapi.vacancy.GetCompanyVacanciesRequest.count:type_name -> google.protobuf.Int32Value
+	143, // 80: personaappapi.vacancy.GetRecommendedVacanciesRequest.count:type_name -> google.protobuf.Int32Value
+	22,  // 81: personaappapi.vacancy.GetRecommendedVacanciesResponse.vacancies:type_name -> personaappapi.vacancy.GetVacanciesListResponse
+	131, // 82: personaappapi.vacancy.GetRecommendedVacanciesResponse.scores:type_name -> personaappapi.vacancy.GetRecommendedVacanciesResponse.ScoresEntry
+	143, // 83: personaappapi.vacancy.GetRecommendedCandidatesRequest.count:type_name -> google.protobuf.Int32Value
+	132, // 84: personaappapi.vacancy.GetRecommendedCandidatesResponse.candidates:type_name -> personaappapi.vacancy.GetRecommendedCandidatesResponse.Candidate
+	144, // 85: personaappapi.vacancy.GetVacancyStatsRequest.from:type_name -> google.protobuf.Timestamp
+	144, // 86: personaappapi.vacancy.GetVacancyStatsRequest.till:type_name -> google.protobuf.Timestamp
+	3,   // 87: personaappapi.vacancy.GetVacancyStatsRequest.granularity:type_name -> personaappapi.vacancy.VacancyStatsGranularity
+	133, // 88: personaappapi.vacancy.GetVacancyStatsResponse.points:type_name -> personaappapi.vacancy.GetVacancyStatsResponse.Point
+	142, // 89: personaappapi.vacancy.ListModerationQueueRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 90: personaappapi.vacancy.ListModerationQueueRequest.count:type_name -> google.protobuf.Int32Value
+	134, // 91: personaappapi.vacancy.ListModerationQueueResponse.items:type_name -> personaappapi.vacancy.ListModerationQueueResponse.Item
+	142, // 92: personaappapi.vacancy.ListModerationQueueResponse.cursor:type_name -> google.protobuf.StringValue
+	1,   // 93: personaappapi.vacancy.RejectVacancyRequest.reasons:type_name -> personaappapi.vacancy.ModerationReason
+	135, // 94: personaappapi.vacancy.GetVacancyModerationHistoryResponse.decisions:type_name -> personaappapi.vacancy.GetVacancyModerationHistoryResponse.Decision
+	142, // 95: personaappapi.vacancy.ListVacancyDuplicatesRequest.cursor:type_name -> google.protobuf.StringValue
+	143, // 96: personaappapi.vacancy.ListVacancyDuplicatesRequest.count:type_name -> google.protobuf.Int32Value
+	136, // 97: personaappapi.vacancy.ListVacancyDuplicatesResponse.clusters:type_name -> personaappapi.vacancy.ListVacancyDuplicatesResponse.Cluster
+	142, // 98: personaappapi.vacancy.ListVacancyDuplicatesResponse.cursor:type_name -> google.protobuf.StringValue
+	8,   // 99: personaappapi.vacancy.ImportVacanciesRequest.format:type_name -> personaappapi.vacancy.ImportVacanciesRequest.Format
+	137, // 100: personaappapi.vacancy.ImportVacanciesResponse.errors:type_name -> personaappapi.vacancy.ImportVacanciesResponse.RowError
+	91,  // 101: personaappapi.vacancy.ListVacancyRevisionsResponse.revisions:type_name -> personaappapi.vacancy.VacancyRevision
+	91,  // 102: personaappapi.vacancy.GetVacancyRevisionResponse.revision:type_name -> personaappapi.vacancy.VacancyRevision
+	90,  // 103: personaappapi.vacancy.GetVacancyRevisionResponse.vacancy:type_name -> personaappapi.vacancy.Vacancy
+	138, // 104: personaappapi.vacancy.GetVacancyRevisionResponse.location:type_name -> personaappapi.vacancy.GetVacancyRevisionResponse.VacancyLocation
+	139, // 105: personaappapi.vacancy.GetVacancyRevisionResponse.description:type_name -> personaappapi.vacancy.GetVacancyRevisionResponse.VacancyDescription
+	140, // 106: personaappapi.vacancy.DiffVacancyRevisionsResponse.changes:type_name -> personaappapi.vacancy.DiffVacancyRevisionsResponse.FieldChange
+	141, // 107: personaappapi.vacancy.VacancyCategory.titles:type_name -> personaappapi.vacancy.VacancyCategory.TitlesEntry
+	7,   // 108: personaappapi.vacancy.Vacancy.currency:type_name -> personaappapi.vacancy.Currency
+	4,   // 109: personaappapi.vacancy.Vacancy.salary_period:type_name -> personaappapi.vacancy.SalaryPeriod
+	145, // 110: personaappapi.vacancy.Vacancy.salary_gross:type_name -> google.protobuf.BoolValue
+	144, // 111: personaappapi.vacancy.VacancyRevision.created_at:type_name -> google.protobuf.Timestamp
+	143, // 112: personaappapi.vacancy.VacancyAlertFilter.min_salary:type_name -> google.protobuf.Int32Value
+	143, // 113: personaappapi.vacancy.VacancyAlertFilter.max_salary:type_name -> google.protobuf.Int32Value
+	5,   // 114: personaappapi.vacancy.VacancyAlertFilter.type:type_name -> personaappapi.vacancy.VacancyType
+	96,  // 115: personaappapi.vacancy.VacancyAlert.filter:type_name -> personaappapi.vacancy.VacancyAlertFilter
+	89,  // 116: personaappapi.vacancy.GetVacancyCategoriesListResponse.VacancyCategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategory
+	5,   // 117: personaappapi.vacancy.UpdateVacancyRequest.VacancyDescription.type:type_name -> personaappapi.vacancy.VacancyType
+	142, // 118: personaappapi.vacancy.UpdateVacancyRequest.Vacancy.id:type_name -> google.protobuf.StringValue
+	7,   // 119: personaappapi.vacancy.UpdateVacancyRequest.Vacancy.currency:type_name -> personaappapi.vacancy.Currency
+	4,   // 120: personaappapi.vacancy.UpdateVacancyRequest.Vacancy.salary_period:type_name -> personaappapi.vacancy.SalaryPeriod
+	145, // 121: personaappapi.vacancy.UpdateVacancyRequest.Vacancy.salary_gross:type_name -> google.protobuf.BoolValue
+	87,  // 122: personaappapi.vacancy.GetVacanciesListRequest.CategoriesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
+	87,  // 123: personaappapi.vacancy.GetVacanciesListRequest.CitiesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
+	90,  // 124: personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails.vacancy:type_name -> personaappapi.vacancy.Vacancy
+	146, // 125: personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails.distance:type_name -> google.protobuf.DoubleValue
+	105, // 126: personaappapi.vacancy.GetVacanciesListResponse.VacanciesEntry.value:type_name -> personaappapi.vacancy.GetVacanciesListResponse.VacancyDetails
+	88,  // 127: personaappapi.vacancy.GetVacanciesListResponse.CompaniesEntry.value:type_name -> personaappapi.vacancy.Company
+	92,  // 128: personaappapi.vacancy.GetVacanciesListResponse.CategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategoryShort
+	87,  // 129: personaappapi.vacancy.SearchVacanciesRequest.CategoriesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
+	90,  // 130: personaappapi.vacancy.SearchVacanciesResponse.VacancyDetails.vacancy:type_name -> personaappapi.vacancy.Vacancy
+	110, // 131: personaappapi.vacancy.SearchVacanciesResponse.VacanciesEntry.value:type_name -> personaappapi.vacancy.SearchVacanciesResponse.VacancyDetails
+	88,  // 132: personaappapi.vacancy.SearchVacanciesResponse.CompaniesEntry.value:type_name -> personaappapi.vacancy.Company
+	92,  // 133: personaappapi.vacancy.SearchVacanciesResponse.CategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategoryShort
+	87,  // 134: personaappapi.vacancy.GetVacanciesMapRequest.CategoriesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
+	87,  // 135: personaappapi.vacancy.GetVacancyFacetsRequest.CategoriesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
+	87,  // 136: personaappapi.vacancy.GetVacancyFacetsRequest.CitiesIdsEntry.value:type_name -> personaappapi.vacancy.Empty
+	5,   // 137: personaappapi.vacancy.GetVacancyFacetsResponse.TypeCount.type:type_name -> personaappapi.vacancy.VacancyType
+	143, // 138: personaappapi.vacancy.GetVacancyFacetsResponse.SalaryBucket.to:type_name -> google.protobuf.Int32Value
+	5,   // 139: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyDescription.type:type_name -> personaappapi.vacancy.VacancyType
+	88,  // 140: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany.company:type_name -> personaappapi.vacancy.Company
+	123, // 141: personaappapi.vacancy.GetVacancyDetailsResponse.VacancyCompany.description:type_name -> personaappapi.vacancy.GetVacancyDetailsResponse.CompanyDescription
+	92,  // 142: personaappapi.vacancy.GetVacancyDetailsResponse.CategoriesEntry.value:type_name -> personaappapi.vacancy.VacancyCategoryShort
+	90,  // 143: personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy.vacancy:type_name -> personaappapi.vacancy.Vacancy
+	0,   // 144: personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy.status:type_name -> personaappapi.vacancy.VacancyStatus
+	144, // 145: personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy.published_at:type_name -> google.protobuf.Timestamp
+	144, // 146: personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy.expires_at:type_name -> google.protobuf.Timestamp
+	129, // 147: personaappapi.vacancy.ListMyVacanciesResponse.MyVacancy.counters:type_name -> personaappapi.vacancy.ListMyVacanciesResponse.Counters
+	58,  // 148: personaappapi.vacancy.GetRecommendedVacanciesResponse.ScoresEntry.value:type_name -> personaappapi.vacancy.MatchScore
+	58,  // 149: personaappapi.vacancy.GetRecommendedCandidatesResponse.Candidate.score:type_name -> personaappapi.vacancy.MatchScore
+	144, // 150: personaappapi.vacancy.GetVacancyStatsResponse.Point.time:type_name -> google.protobuf.Timestamp
+	90,  // 151: personaappapi.vacancy.ListModerationQueueResponse.Item.vacancy:type_name -> personaappapi.vacancy.Vacancy
+	144, // 152: personaappapi.vacancy.ListModerationQueueResponse.Item.submitted_at:type_name -> google.protobuf.Timestamp
+	1,   // 153: personaappapi.vacancy.ListModerationQueueResponse.Item.reasons:type_name -> personaappapi.vacancy.ModerationReason
+	2,   // 154: personaappapi.vacancy.GetVacancyModerationHistoryResponse.Decision.decision:type_name -> personaappapi.vacancy.ModerationDecision
+	1,   // 155: personaappapi.vacancy.GetVacancyModerationHistoryResponse.Decision.reasons:type_name -> personaappapi.vacancy.ModerationReason
+	144, // 156: personaappapi.vacancy.GetVacancyModerationHistoryResponse.Decision.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 157: personaappapi.vacancy.ListVacancyDuplicatesResponse.Cluster.original:type_name -> personaappapi.vacancy.Vacancy
+	144, // 158: personaappapi.vacancy.ListVacancyDuplicatesResponse.Cluster.reposted_at:type_name -> google.protobuf.Timestamp
+	5,   // 159: personaappapi.vacancy.GetVacancyRevisionResponse.VacancyDescription.type:type_name -> personaappapi.vacancy.VacancyType
+	9,   // 160: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategory:input_type -> personaappapi.vacancy.GetVacancyCategoryRequest
+	11,  // 161: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancyCategory:input_type -> personaappapi.vacancy.UpdateVacancyCategoryRequest
+	13,  // 162: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancyCategory:input_type -> personaappapi.vacancy.DeleteVacancyCategoryRequest
+	15,  // 163: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategoriesList:input_type -> personaappapi.vacancy.GetVacancyCategoriesListRequest
+	17,  // 164: personaappapi.vacancy.PersonaAppVacancy.MoveVacancyCategory:input_type -> personaappapi.vacancy.MoveVacancyCategoryRequest
+	19,  // 165: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancy:input_type -> personaappapi.vacancy.UpdateVacancyRequest
+	21,  // 166: personaappapi.vacancy.PersonaAppVacancy.GetVacanciesList:input_type -> personaappapi.vacancy.GetVacanciesListRequest
+	23,  // 167: personaappapi.vacancy.PersonaAppVacancy.SearchVacancies:input_type -> personaappapi.vacancy.SearchVacanciesRequest
+	25,  // 168: personaappapi.vacancy.PersonaAppVacancy.GetVacanciesMap:input_type -> personaappapi.vacancy.GetVacanciesMapRequest
+	27,  // 169: personaappapi.vacancy.PersonaAppVacancy.GetVacancyFacets:input_type -> personaappapi.vacancy.GetVacancyFacetsRequest
+	29,  // 170: personaappapi.vacancy.PersonaAppVacancy.GetVacancyDetails:input_type -> personaappapi.vacancy.GetVacancyDetailsRequest
+	56,  // 171: personaappapi.vacancy.PersonaAppVacancy.GetSimilarVacancies:input_type -> personaappapi.vacancy.GetSimilarVacanciesRequest
+	57,  // 172: personaappapi.vacancy.PersonaAppVacancy.GetCompanyVacancies:input_type -> personaappapi.vacancy.GetCompanyVacanciesRequest
+	59,  // 173: personaappapi.vacancy.PersonaAppVacancy.GetRecommendedVacancies:input_type -> personaappapi.vacancy.GetRecommendedVacanciesRequest
+	61,  // 174: personaappapi.vacancy.PersonaAppVacancy.GetRecommendedCandidates:input_type -> personaappapi.vacancy.GetRecommendedCandidatesRequest
+	31,  // 175: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancy:input_type -> personaappapi.vacancy.DeleteVacancyRequest
+	33,  // 176: personaappapi.vacancy.PersonaAppVacancy.PublishVacancy:input_type -> personaappapi.vacancy.PublishVacancyRequest
+	39,  // 177: personaappapi.vacancy.PersonaAppVacancy.PauseVacancy:input_type -> personaappapi.vacancy.PauseVacancyRequest
+	41,  // 178: personaappapi.vacancy.PersonaAppVacancy.CloseVacancy:input_type -> personaappapi.vacancy.CloseVacancyRequest
+	35,  // 179: personaappapi.vacancy.PersonaAppVacancy.ScheduleVacancyPublish:input_type -> personaappapi.vacancy.ScheduleVacancyPublishRequest
+	37,  // 180: personaappapi.vacancy.PersonaAppVacancy.BumpVacancy:input_type -> personaappapi.vacancy.BumpVacancyRequest
+	43,  // 181: personaappapi.vacancy.PersonaAppVacancy.ListMyVacancies:input_type -> personaappapi.vacancy.ListMyVacanciesRequest
+	45,  // 182: personaappapi.vacancy.PersonaAppVacancy.CreateVacancyAlert:input_type -> personaappapi.vacancy.CreateVacancyAlertRequest
+	47,  // 183: personaappapi.vacancy.PersonaAppVacancy.ListVacancyAlerts:input_type -> personaappapi.vacancy.ListVacancyAlertsRequest
+	49,  // 184: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancyAlert:input_type -> personaappapi.vacancy.DeleteVacancyAlertRequest
+	51,  // 185: personaappapi.vacancy.PersonaAppVacancy.SaveVacancy:input_type -> personaappapi.vacancy.SaveVacancyRequest
+	53,  // 186: personaappapi.vacancy.PersonaAppVacancy.UnsaveVacancy:input_type -> personaappapi.vacancy.UnsaveVacancyRequest
+	55,  // 187: personaappapi.vacancy.PersonaAppVacancy.ListSavedVacancies:input_type -> personaappapi.vacancy.ListSavedVacanciesRequest
+	63,  // 188: personaappapi.vacancy.PersonaAppVacancy.RevealVacancyPhone:input_type -> personaappapi.vacancy.RevealVacancyPhoneRequest
+	65,  // 189: personaappapi.vacancy.PersonaAppVacancy.GetVacancyStats:input_type -> personaappapi.vacancy.GetVacancyStatsRequest
+	67,  // 190: personaappapi.vacancy.PersonaAppVacancy.ListModerationQueue:input_type -> personaappapi.vacancy.ListModerationQueueRequest
+	69,  // 191: personaappapi.vacancy.PersonaAppVacancy.ApproveVacancy:input_type -> personaappapi.vacancy.ApproveVacancyRequest
+	71,  // 192: personaappapi.vacancy.PersonaAppVacancy.RejectVacancy:input_type -> personaappapi.vacancy.RejectVacancyRequest
+	73,  // 193: personaappapi.vacancy.PersonaAppVacancy.GetVacancyModerationHistory:input_type -> personaappapi.vacancy.GetVacancyModerationHistoryRequest
+	75,  // 194: personaappapi.vacancy.PersonaAppVacancy.ListVacancyDuplicates:input_type -> personaappapi.vacancy.ListVacancyDuplicatesRequest
+	77,  // 195: personaappapi.vacancy.PersonaAppVacancy.ImportVacancies:input_type -> personaappapi.vacancy.ImportVacanciesRequest
+	79,  // 196: personaappapi.vacancy.PersonaAppVacancy.ListVacancyRevisions:input_type -> personaappapi.vacancy.ListVacancyRevisionsRequest
+	81,  // 197: personaappapi.vacancy.PersonaAppVacancy.GetVacancyRevision:input_type -> personaappapi.vacancy.GetVacancyRevisionRequest
+	83,  // 198: personaappapi.vacancy.PersonaAppVacancy.DiffVacancyRevisions:input_type -> personaappapi.vacancy.DiffVacancyRevisionsRequest
+	85,  // 199: personaappapi.vacancy.PersonaAppVacancy.RestoreVacancyRevision:input_type -> personaappapi.vacancy.RestoreVacancyRevisionRequest
+	10,  // 200: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategory:output_type -> personaappapi.vacancy.GetVacancyCategoryResponse
+	12,  // 201: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancyCategory:output_type -> personaappapi.vacancy.UpdateVacancyCategoryResponse
+	14,  // 202: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancyCategory:output_type -> personaappapi.vacancy.DeleteVacancyCategoryResponse
+	16,  // 203: personaappapi.vacancy.PersonaAppVacancy.GetVacancyCategoriesList:output_type -> personaappapi.vacancy.GetVacancyCategoriesListResponse
+	18,  // 204: personaappapi.vacancy.PersonaAppVacancy.MoveVacancyCategory:output_type -> personaappapi.vacancy.MoveVacancyCategoryResponse
+	20,  // 205: personaappapi.vacancy.PersonaAppVacancy.UpdateVacancy:output_type -> personaappapi.vacancy.UpdateVacancyResponse
+	22,  // 206: personaappapi.vacancy.PersonaAppVacancy.GetVacanciesList:output_type -> personaappapi.vacancy.GetVacanciesListResponse
+	24,  // 207: personaappapi.vacancy.PersonaAppVacancy.SearchVacancies:output_type -> personaappapi.vacancy.SearchVacanciesResponse
+	26,  // 208: personaappapi.vacancy.PersonaAppVacancy.GetVacanciesMap:output_type -> personaappapi.vacancy.GetVacanciesMapResponse
+	28,  // 209: personaappapi.vacancy.PersonaAppVacancy.GetVacancyFacets:output_type -> personaappapi.vacancy.GetVacancyFacetsResponse
+	30,  // 210: personaappapi.vacancy.PersonaAppVacancy.GetVacancyDetails:output_type -> personaappapi.vacancy.GetVacancyDetailsResponse
+	22,  // 211: personaappapi.vacancy.PersonaAppVacancy.GetSimilarVacancies:output_type -> personaappapi.vacancy.GetVacanciesListResponse
+	22,  // 212: personaappapi.vacancy.PersonaAppVacancy.GetCompanyVacancies:output_type -> personaappapi.vacancy.GetVacanciesListResponse
+	60,  // 213: personaappapi.vacancy.PersonaAppVacancy.GetRecommendedVacancies:output_type -> personaappapi.vacancy.GetRecommendedVacanciesResponse
+	62,  // 214: personaappapi.vacancy.PersonaAppVacancy.GetRecommendedCandidates:output_type -> personaappapi.vacancy.GetRecommendedCandidatesResponse
+	32,  // 215: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancy:output_type -> personaappapi.vacancy.DeleteVacancyResponse
+	34,  // 216: personaappapi.vacancy.PersonaAppVacancy.PublishVacancy:output_type -> personaappapi.vacancy.PublishVacancyResponse
+	40,  // 217: personaappapi.vacancy.PersonaAppVacancy.PauseVacancy:output_type -> personaappapi.vacancy.PauseVacancyResponse
+	42,  // 218: personaappapi.vacancy.PersonaAppVacancy.CloseVacancy:output_type -> personaappapi.vacancy.CloseVacancyResponse
+	36,  // 219: personaappapi.vacancy.PersonaAppVacancy.ScheduleVacancyPublish:output_type -> personaappapi.vacancy.ScheduleVacancyPublishResponse
+	38,  // 220: personaappapi.vacancy.PersonaAppVacancy.BumpVacancy:output_type -> personaappapi.vacancy.BumpVacancyResponse
+	44,  // 221: personaappapi.vacancy.PersonaAppVacancy.ListMyVacancies:output_type -> personaappapi.vacancy.ListMyVacanciesResponse
+	46,  // 222: personaappapi.vacancy.PersonaAppVacancy.CreateVacancyAlert:output_type -> personaappapi.vacancy.CreateVacancyAlertResponse
+	48,  // 223: personaappapi.vacancy.PersonaAppVacancy.ListVacancyAlerts:output_type -> personaappapi.vacancy.ListVacancyAlertsResponse
+	50,  // 224: personaappapi.vacancy.PersonaAppVacancy.DeleteVacancyAlert:output_type -> personaappapi.vacancy.DeleteVacancyAlertResponse
+	52,  // 225: personaappapi.vacancy.PersonaAppVacancy.SaveVacancy:output_type -> personaappapi.vacancy.SaveVacancyResponse
+	54,  // 226: personaappapi.vacancy.PersonaAppVacancy.UnsaveVacancy:output_type -> personaappapi.vacancy.UnsaveVacancyResponse
+	22,  // 227: personaappapi.vacancy.PersonaAppVacancy.ListSavedVacancies:output_type -> personaappapi.vacancy.GetVacanciesListResponse
+	64,  // 228: personaappapi.vacancy.PersonaAppVacancy.RevealVacancyPhone:output_type -> personaappapi.vacancy.RevealVacancyPhoneResponse
+	66,  // 229: personaappapi.vacancy.PersonaAppVacancy.GetVacancyStats:output_type -> personaappapi.vacancy.GetVacancyStatsResponse
+	68,  // 230: personaappapi.vacancy.PersonaAppVacancy.ListModerationQueue:output_type -> personaappapi.vacancy.ListModerationQueueResponse
+	70,  // 231: personaappapi.vacancy.PersonaAppVacancy.ApproveVacancy:output_type -> personaappapi.vacancy.ApproveVacancyResponse
+	72,  // 232: personaappapi.vacancy.PersonaAppVacancy.RejectVacancy:output_type -> personaappapi.vacancy.RejectVacancyResponse
+	74,  // 233: personaappapi.vacancy.PersonaAppVacancy.GetVacancyModerationHistory:output_type -> personaappapi.vacancy.GetVacancyModerationHistoryResponse
+	76,  // 234: personaappapi.vacancy.PersonaAppVacancy.ListVacancyDuplicates:output_type -> personaappapi.vacancy.ListVacancyDuplicatesResponse
+	78,  // 235: personaappapi.vacancy.PersonaAppVacancy.ImportVacancies:output_type -> personaappapi.vacancy.ImportVacanciesResponse
+	80,  // 236: personaappapi.vacancy.PersonaAppVacancy.ListVacancyRevisions:output_type -> personaappapi.vacancy.ListVacancyRevisionsResponse
+	82,  // 237: personaappapi.vacancy.PersonaAppVacancy.GetVacancyRevision:output_type -> personaappapi.vacancy.GetVacancyRevisionResponse
+	84,  // 238: personaappapi.vacancy.PersonaAppVacancy.DiffVacancyRevisions:output_type -> personaappapi.vacancy.DiffVacancyRevisionsResponse
+	86,  // 239: personaappapi.vacancy.PersonaAppVacancy.RestoreVacancyRevision:output_type -> personaappapi.vacancy.RestoreVacancyRevisionResponse
+	200, // [200:240] is the sub-list for method output_type
+	160, // [160:200] is the sub-list for method input_type
+	160, // [160:160] is the sub-list for extension type_name
+	160, // [160:160] is the sub-list for extension extendee
+	0,   // [0:160] is the sub-list for field type_name
+}
+
+func init() { file_vacancy_vacancy_proto_init() }
+func file_vacancy_vacancy_proto_init() {
+	if File_vacancy_vacancy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vacancy_vacancy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyCategoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyCategoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyCategoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyCategoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVacancyCategoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVacancyCategoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyCategoriesListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyCategoriesListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveVacancyCategoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveVacancyCategoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVacanciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyFacetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyFacetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleVacancyPublishRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleVacancyPublishResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyVacanciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVacancyAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVacancyAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyAlertsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVacancyAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVacancyAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsaveVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsaveVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSimilarVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompanyVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendedVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendedVacanciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendedCandidatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendedCandidatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevealVacancyPhoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevealVacancyPhoneResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModerationQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModerationQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectVacancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectVacancyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyModerationHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyModerationHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyDuplicatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyDuplicatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportVacanciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportVacanciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffVacancyRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffVacancyRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreVacancyRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreVacancyRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Company); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VacancyCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vacancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VacancyRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VacancyCategoryShort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*City); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoRadius); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoundingBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VacancyAlertFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VacancyAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyRequest_VacancyLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyRequest_VacancyDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVacancyRequest_Vacancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesListResponse_VacancyDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVacanciesResponse_VacancyDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesMapResponse_Marker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacanciesMapResponse_Cluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyFacetsResponse_TypeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyFacetsResponse_SalaryBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsResponse_CompanyDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsResponse_VacancyLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsResponse_VacancyDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsResponse_VacancyCompany); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyDetailsResponse_VacancyImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyVacanciesResponse_Counters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyVacanciesResponse_MyVacancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendedCandidatesResponse_Candidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyStatsResponse_Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModerationQueueResponse_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyModerationHistoryResponse_Decision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVacancyDuplicatesResponse_Cluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportVacanciesResponse_RowError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyRevisionResponse_VacancyLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVacancyRevisionResponse_VacancyDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vacancy_vacancy_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffVacancyRevisionsResponse_FieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vacancy_vacancy_proto_rawDesc,
+			NumEnums:      9,
+			NumMessages:   133,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vacancy_vacancy_proto_goTypes,
+		DependencyIndexes: file_vacancy_vacancy_proto_depIdxs,
+		EnumInfos:         file_vacancy_vacancy_proto_enumTypes,
+		MessageInfos:      file_vacancy_vacancy_proto_msgTypes,
+	}.Build()
+	File_vacancy_vacancy_proto = out.File
+	file_vacancy_vacancy_proto_rawDesc = nil
+	file_vacancy_vacancy_proto_goTypes = nil
+	file_vacancy_vacancy_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PersonaAppVacancyClient is the client API for PersonaAppVacancy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PersonaAppVacancyClient interface {
+	// Vacancy categories
+	GetVacancyCategory(ctx context.Context, in *GetVacancyCategoryRequest, opts ...grpc.CallOption) (*GetVacancyCategoryResponse, error)
+	UpdateVacancyCategory(ctx context.Context, in *UpdateVacancyCategoryRequest, opts ...grpc.CallOption) (*UpdateVacancyCategoryResponse, error)
+	DeleteVacancyCategory(ctx context.Context, in *DeleteVacancyCategoryRequest, opts ...grpc.CallOption) (*DeleteVacancyCategoryResponse, error)
+	GetVacancyCategoriesList(ctx context.Context, in *GetVacancyCategoriesListRequest, opts ...grpc.CallOption) (*GetVacancyCategoriesListResponse, error)
+	MoveVacancyCategory(ctx context.Context, in *MoveVacancyCategoryRequest, opts ...grpc.CallOption) (*MoveVacancyCategoryResponse, error)
+	// Vacancy
+	UpdateVacancy(ctx context.Context, in *UpdateVacancyRequest, opts ...grpc.CallOption) (*UpdateVacancyResponse, error)
+	GetVacanciesList(ctx context.Context, in *GetVacanciesListRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error)
+	SearchVacancies(ctx context.Context, in *SearchVacanciesRequest, opts ...grpc.CallOption) (*SearchVacanciesResponse, error)
+	GetVacanciesMap(ctx context.Context, in *GetVacanciesMapRequest, opts ...grpc.CallOption) (*GetVacanciesMapResponse, error)
+	GetVacancyFacets(ctx context.Context, in *GetVacancyFacetsRequest, opts ...grpc.CallOption) (*GetVacancyFacetsResponse, error)
+	GetVacancyDetails(ctx context.Context, in *GetVacancyDetailsRequest, opts ...grpc.CallOption) (*GetVacancyDetailsResponse, error)
+	GetSimilarVacancies(ctx context.Context, in *GetSimilarVacanciesRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error)
+	GetCompanyVacancies(ctx context.Context, in *GetCompanyVacanciesRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error)
+	GetRecommendedVacancies(ctx context.Context, in *GetRecommendedVacanciesRequest, opts ...grpc.CallOption) (*GetRecommendedVacanciesResponse, error)
+	GetRecommendedCandidates(ctx context.Context, in *GetRecommendedCandidatesRequest, opts ...grpc.CallOption) (*GetRecommendedCandidatesResponse, error)
+	DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*DeleteVacancyResponse, error)
+	PublishVacancy(ctx context.Context, in *PublishVacancyRequest, opts ...grpc.CallOption) (*PublishVacancyResponse, error)
+	PauseVacancy(ctx context.Context, in *PauseVacancyRequest, opts ...grpc.CallOption) (*PauseVacancyResponse, error)
+	CloseVacancy(ctx context.Context, in *CloseVacancyRequest, opts ...grpc.CallOption) (*CloseVacancyResponse, error)
+	ScheduleVacancyPublish(ctx context.Context, in *ScheduleVacancyPublishRequest, opts ...grpc.CallOption) (*ScheduleVacancyPublishResponse, error)
+	BumpVacancy(ctx context.Context, in *BumpVacancyRequest, opts ...grpc.CallOption) (*BumpVacancyResponse, error)
+	ListMyVacancies(ctx context.Context, in *ListMyVacanciesRequest, opts ...grpc.CallOption) (*ListMyVacanciesResponse, error)
+	// Vacancy alerts
+	CreateVacancyAlert(ctx context.Context, in *CreateVacancyAlertRequest, opts ...grpc.CallOption) (*CreateVacancyAlertResponse, error)
+	ListVacancyAlerts(ctx context.Context, in *ListVacancyAlertsRequest, opts ...grpc.CallOption) (*ListVacancyAlertsResponse, error)
+	DeleteVacancyAlert(ctx context.Context, in *DeleteVacancyAlertRequest, opts ...grpc.CallOption) (*DeleteVacancyAlertResponse, error)
+	// Saved vacancies
+	SaveVacancy(ctx context.Context, in *SaveVacancyRequest, opts ...grpc.CallOption) (*SaveVacancyResponse, error)
+	UnsaveVacancy(ctx context.Context, in *UnsaveVacancyRequest, opts ...grpc.CallOption) (*UnsaveVacancyResponse, error)
+	ListSavedVacancies(ctx context.Context, in *ListSavedVacanciesRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error)
+	RevealVacancyPhone(ctx context.Context, in *RevealVacancyPhoneRequest, opts ...grpc.CallOption) (*RevealVacancyPhoneResponse, error)
+	GetVacancyStats(ctx context.Context, in *GetVacancyStatsRequest, opts ...grpc.CallOption) (*GetVacancyStatsResponse, error)
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	ApproveVacancy(ctx context.Context, in *ApproveVacancyRequest, opts ...grpc.CallOption) (*ApproveVacancyResponse, error)
+	RejectVacancy(ctx context.Context, in *RejectVacancyRequest, opts ...grpc.CallOption) (*RejectVacancyResponse, error)
+	GetVacancyModerationHistory(ctx context.Context, in *GetVacancyModerationHistoryRequest, opts ...grpc.CallOption) (*GetVacancyModerationHistoryResponse, error)
+	ListVacancyDuplicates(ctx context.Context, in *ListVacancyDuplicatesRequest, opts ...grpc.CallOption) (*ListVacancyDuplicatesResponse, error)
+	ImportVacancies(ctx context.Context, in *ImportVacanciesRequest, opts ...grpc.CallOption) (*ImportVacanciesResponse, error)
+	ListVacancyRevisions(ctx context.Context, in *ListVacancyRevisionsRequest, opts ...grpc.CallOption) (*ListVacancyRevisionsResponse, error)
+	GetVacancyRevision(ctx context.Context, in *GetVacancyRevisionRequest, opts ...grpc.CallOption) (*GetVacancyRevisionResponse, error)
+	DiffVacancyRevisions(ctx context.Context, in *DiffVacancyRevisionsRequest, opts ...grpc.CallOption) (*DiffVacancyRevisionsResponse, error)
+	RestoreVacancyRevision(ctx context.Context, in *RestoreVacancyRevisionRequest, opts ...grpc.CallOption) (*RestoreVacancyRevisionResponse, error)
+}
+
+type personaAppVacancyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPersonaAppVacancyClient(cc grpc.ClientConnInterface) PersonaAppVacancyClient {
+	return &personaAppVacancyClient{cc}
+}
+
+func (c *personaAppVacancyClient) GetVacancyCategory(ctx context.Context, in *GetVacancyCategoryRequest, opts ...grpc.CallOption) (*GetVacancyCategoryResponse, error) {
+	out := new(GetVacancyCategoryResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) UpdateVacancyCategory(ctx context.Context, in *UpdateVacancyCategoryRequest, opts ...grpc.CallOption) (*UpdateVacancyCategoryResponse, error) {
+	out := new(UpdateVacancyCategoryResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancyCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) DeleteVacancyCategory(ctx context.Context, in *DeleteVacancyCategoryRequest, opts ...grpc.CallOption) (*DeleteVacancyCategoryResponse, error) {
+	out := new(DeleteVacancyCategoryResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancyCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacancyCategoriesList(ctx context.Context, in *GetVacancyCategoriesListRequest, opts ...grpc.CallOption) (*GetVacancyCategoriesListResponse, error) {
+	out := new(GetVacancyCategoriesListResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategoriesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) MoveVacancyCategory(ctx context.Context, in *MoveVacancyCategoryRequest, opts ...grpc.CallOption) (*MoveVacancyCategoryResponse, error) {
+	out := new(MoveVacancyCategoryResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/MoveVacancyCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) UpdateVacancy(ctx context.Context, in *UpdateVacancyRequest, opts ...grpc.CallOption) (*UpdateVacancyResponse, error) {
+	out := new(UpdateVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacanciesList(ctx context.Context, in *GetVacanciesListRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error) {
+	out := new(GetVacanciesListResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacanciesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) SearchVacancies(ctx context.Context, in *SearchVacanciesRequest, opts ...grpc.CallOption) (*SearchVacanciesResponse, error) {
+	out := new(SearchVacanciesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/SearchVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacanciesMap(ctx context.Context, in *GetVacanciesMapRequest, opts ...grpc.CallOption) (*GetVacanciesMapResponse, error) {
+	out := new(GetVacanciesMapResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacanciesMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacancyFacets(ctx context.Context, in *GetVacancyFacetsRequest, opts ...grpc.CallOption) (*GetVacancyFacetsResponse, error) {
+	out := new(GetVacancyFacetsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyFacets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacancyDetails(ctx context.Context, in *GetVacancyDetailsRequest, opts ...grpc.CallOption) (*GetVacancyDetailsResponse, error) {
+	out := new(GetVacancyDetailsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetSimilarVacancies(ctx context.Context, in *GetSimilarVacanciesRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error) {
+	out := new(GetVacanciesListResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetSimilarVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetCompanyVacancies(ctx context.Context, in *GetCompanyVacanciesRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error) {
+	out := new(GetVacanciesListResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetCompanyVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetRecommendedVacancies(ctx context.Context, in *GetRecommendedVacanciesRequest, opts ...grpc.CallOption) (*GetRecommendedVacanciesResponse, error) {
+	out := new(GetRecommendedVacanciesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetRecommendedVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetRecommendedCandidates(ctx context.Context, in *GetRecommendedCandidatesRequest, opts ...grpc.CallOption) (*GetRecommendedCandidatesResponse, error) {
+	out := new(GetRecommendedCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetRecommendedCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*DeleteVacancyResponse, error) {
+	out := new(DeleteVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) PublishVacancy(ctx context.Context, in *PublishVacancyRequest, opts ...grpc.CallOption) (*PublishVacancyResponse, error) {
+	out := new(PublishVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/PublishVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) PauseVacancy(ctx context.Context, in *PauseVacancyRequest, opts ...grpc.CallOption) (*PauseVacancyResponse, error) {
+	out := new(PauseVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/PauseVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) CloseVacancy(ctx context.Context, in *CloseVacancyRequest, opts ...grpc.CallOption) (*CloseVacancyResponse, error) {
+	out := new(CloseVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/CloseVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ScheduleVacancyPublish(ctx context.Context, in *ScheduleVacancyPublishRequest, opts ...grpc.CallOption) (*ScheduleVacancyPublishResponse, error) {
+	out := new(ScheduleVacancyPublishResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ScheduleVacancyPublish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) BumpVacancy(ctx context.Context, in *BumpVacancyRequest, opts ...grpc.CallOption) (*BumpVacancyResponse, error) {
+	out := new(BumpVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/BumpVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ListMyVacancies(ctx context.Context, in *ListMyVacanciesRequest, opts ...grpc.CallOption) (*ListMyVacanciesResponse, error) {
+	out := new(ListMyVacanciesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ListMyVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) CreateVacancyAlert(ctx context.Context, in *CreateVacancyAlertRequest, opts ...grpc.CallOption) (*CreateVacancyAlertResponse, error) {
+	out := new(CreateVacancyAlertResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/CreateVacancyAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ListVacancyAlerts(ctx context.Context, in *ListVacancyAlertsRequest, opts ...grpc.CallOption) (*ListVacancyAlertsResponse, error) {
+	out := new(ListVacancyAlertsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ListVacancyAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) DeleteVacancyAlert(ctx context.Context, in *DeleteVacancyAlertRequest, opts ...grpc.CallOption) (*DeleteVacancyAlertResponse, error) {
+	out := new(DeleteVacancyAlertResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancyAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) SaveVacancy(ctx context.Context, in *SaveVacancyRequest, opts ...grpc.CallOption) (*SaveVacancyResponse, error) {
+	out := new(SaveVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/SaveVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) UnsaveVacancy(ctx context.Context, in *UnsaveVacancyRequest, opts ...grpc.CallOption) (*UnsaveVacancyResponse, error) {
+	out := new(UnsaveVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/UnsaveVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ListSavedVacancies(ctx context.Context, in *ListSavedVacanciesRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error) {
+	out := new(GetVacanciesListResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ListSavedVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) RevealVacancyPhone(ctx context.Context, in *RevealVacancyPhoneRequest, opts ...grpc.CallOption) (*RevealVacancyPhoneResponse, error) {
+	out := new(RevealVacancyPhoneResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/RevealVacancyPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacancyStats(ctx context.Context, in *GetVacancyStatsRequest, opts ...grpc.CallOption) (*GetVacancyStatsResponse, error) {
+	out := new(GetVacancyStatsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ListModerationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ApproveVacancy(ctx context.Context, in *ApproveVacancyRequest, opts ...grpc.CallOption) (*ApproveVacancyResponse, error) {
+	out := new(ApproveVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ApproveVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) RejectVacancy(ctx context.Context, in *RejectVacancyRequest, opts ...grpc.CallOption) (*RejectVacancyResponse, error) {
+	out := new(RejectVacancyResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/RejectVacancy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacancyModerationHistory(ctx context.Context, in *GetVacancyModerationHistoryRequest, opts ...grpc.CallOption) (*GetVacancyModerationHistoryResponse, error) {
+	out := new(GetVacancyModerationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyModerationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ListVacancyDuplicates(ctx context.Context, in *ListVacancyDuplicatesRequest, opts ...grpc.CallOption) (*ListVacancyDuplicatesResponse, error) {
+	out := new(ListVacancyDuplicatesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ListVacancyDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ImportVacancies(ctx context.Context, in *ImportVacanciesRequest, opts ...grpc.CallOption) (*ImportVacanciesResponse, error) {
+	out := new(ImportVacanciesResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ImportVacancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) ListVacancyRevisions(ctx context.Context, in *ListVacancyRevisionsRequest, opts ...grpc.CallOption) (*ListVacancyRevisionsResponse, error) {
+	out := new(ListVacancyRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/ListVacancyRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) GetVacancyRevision(ctx context.Context, in *GetVacancyRevisionRequest, opts ...grpc.CallOption) (*GetVacancyRevisionResponse, error) {
+	out := new(GetVacancyRevisionResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) DiffVacancyRevisions(ctx context.Context, in *DiffVacancyRevisionsRequest, opts ...grpc.CallOption) (*DiffVacancyRevisionsResponse, error) {
+	out := new(DiffVacancyRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/DiffVacancyRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personaAppVacancyClient) RestoreVacancyRevision(ctx context.Context, in *RestoreVacancyRevisionRequest, opts ...grpc.CallOption) (*RestoreVacancyRevisionResponse, error) {
+	out := new(RestoreVacancyRevisionResponse)
+	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/RestoreVacancyRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PersonaAppVacancyServer is the server API for PersonaAppVacancy service.
+type PersonaAppVacancyServer interface {
+	// Vacancy categories
+	GetVacancyCategory(context.Context, *GetVacancyCategoryRequest) (*GetVacancyCategoryResponse, error)
+	UpdateVacancyCategory(context.Context, *UpdateVacancyCategoryRequest) (*UpdateVacancyCategoryResponse, error)
+	DeleteVacancyCategory(context.Context, *DeleteVacancyCategoryRequest) (*DeleteVacancyCategoryResponse, error)
+	GetVacancyCategoriesList(context.Context, *GetVacancyCategoriesListRequest) (*GetVacancyCategoriesListResponse, error)
+	MoveVacancyCategory(context.Context, *MoveVacancyCategoryRequest) (*MoveVacancyCategoryResponse, error)
+	// Vacancy
+	UpdateVacancy(context.Context, *UpdateVacancyRequest) (*UpdateVacancyResponse, error)
+	GetVacanciesList(context.Context, *GetVacanciesListRequest) (*GetVacanciesListResponse, error)
+	SearchVacancies(context.Context, *SearchVacanciesRequest) (*SearchVacanciesResponse, error)
+	GetVacanciesMap(context.Context, *GetVacanciesMapRequest) (*GetVacanciesMapResponse, error)
+	GetVacancyFacets(context.Context, *GetVacancyFacetsRequest) (*GetVacancyFacetsResponse, error)
+	GetVacancyDetails(context.Context, *GetVacancyDetailsRequest) (*GetVacancyDetailsResponse, error)
+	GetSimilarVacancies(context.Context, *GetSimilarVacanciesRequest) (*GetVacanciesListResponse, error)
+	GetCompanyVacancies(context.Context, *GetCompanyVacanciesRequest) (*GetVacanciesListResponse, error)
+	GetRecommendedVacancies(context.Context, *GetRecommendedVacanciesRequest) (*GetRecommendedVacanciesResponse, error)
+	GetRecommendedCandidates(context.Context, *GetRecommendedCandidatesRequest) (*GetRecommendedCandidatesResponse, error)
+	DeleteVacancy(context.Context, *DeleteVacancyRequest) (*DeleteVacancyResponse, error)
+	PublishVacancy(context.Context, *PublishVacancyRequest) (*PublishVacancyResponse, error)
+	PauseVacancy(context.Context, *PauseVacancyRequest) (*PauseVacancyResponse, error)
+	CloseVacancy(context.Context, *CloseVacancyRequest) (*CloseVacancyResponse, error)
+	ScheduleVacancyPublish(context.Context, *ScheduleVacancyPublishRequest) (*ScheduleVacancyPublishResponse, error)
+	BumpVacancy(context.Context, *BumpVacancyRequest) (*BumpVacancyResponse, error)
+	ListMyVacancies(context.Context, *ListMyVacanciesRequest) (*ListMyVacanciesResponse, error)
+	// Vacancy alerts
+	CreateVacancyAlert(context.Context, *CreateVacancyAlertRequest) (*CreateVacancyAlertResponse, error)
+	ListVacancyAlerts(context.Context, *ListVacancyAlertsRequest) (*ListVacancyAlertsResponse, error)
+	DeleteVacancyAlert(context.Context, *DeleteVacancyAlertRequest) (*DeleteVacancyAlertResponse, error)
+	// Saved vacancies
+	SaveVacancy(context.Context, *SaveVacancyRequest) (*SaveVacancyResponse, error)
+	UnsaveVacancy(context.Context, *UnsaveVacancyRequest) (*UnsaveVacancyResponse, error)
+	ListSavedVacancies(context.Context, *ListSavedVacanciesRequest) (*GetVacanciesListResponse, error)
+	RevealVacancyPhone(context.Context, *RevealVacancyPhoneRequest) (*RevealVacancyPhoneResponse, error)
+	GetVacancyStats(context.Context, *GetVacancyStatsRequest) (*GetVacancyStatsResponse, error)
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	ApproveVacancy(context.Context, *ApproveVacancyRequest) (*ApproveVacancyResponse, error)
+	RejectVacancy(context.Context, *RejectVacancyRequest) (*RejectVacancyResponse, error)
+	GetVacancyModerationHistory(context.Context, *GetVacancyModerationHistoryRequest) (*GetVacancyModerationHistoryResponse, error)
+	ListVacancyDuplicates(context.Context, *ListVacancyDuplicatesRequest) (*ListVacancyDuplicatesResponse, error)
+	ImportVacancies(context.Context, *ImportVacanciesRequest) (*ImportVacanciesResponse, error)
+	ListVacancyRevisions(context.Context, *ListVacancyRevisionsRequest) (*ListVacancyRevisionsResponse, error)
+	GetVacancyRevision(context.Context, *GetVacancyRevisionRequest) (*GetVacancyRevisionResponse, error)
+	DiffVacancyRevisions(context.Context, *DiffVacancyRevisionsRequest) (*DiffVacancyRevisionsResponse, error)
+	RestoreVacancyRevision(context.Context, *RestoreVacancyRevisionRequest) (*RestoreVacancyRevisionResponse, error)
+}
+
+// UnimplementedPersonaAppVacancyServer can be embedded to have forward compatible implementations.
+type UnimplementedPersonaAppVacancyServer struct {
+}
+
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyCategory(context.Context, *GetVacancyCategoryRequest) (*GetVacancyCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyCategory not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) UpdateVacancyCategory(context.Context, *UpdateVacancyCategoryRequest) (*UpdateVacancyCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVacancyCategory not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) DeleteVacancyCategory(context.Context, *DeleteVacancyCategoryRequest) (*DeleteVacancyCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancyCategory not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyCategoriesList(context.Context, *GetVacancyCategoriesListRequest) (*GetVacancyCategoriesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyCategoriesList not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) MoveVacancyCategory(context.Context, *MoveVacancyCategoryRequest) (*MoveVacancyCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveVacancyCategory not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) UpdateVacancy(context.Context, *UpdateVacancyRequest) (*UpdateVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacanciesList(context.Context, *GetVacanciesListRequest) (*GetVacanciesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacanciesList not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) SearchVacancies(context.Context, *SearchVacanciesRequest) (*SearchVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacanciesMap(context.Context, *GetVacanciesMapRequest) (*GetVacanciesMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacanciesMap not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyFacets(context.Context, *GetVacancyFacetsRequest) (*GetVacancyFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyFacets not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyDetails(context.Context, *GetVacancyDetailsRequest) (*GetVacancyDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyDetails not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetSimilarVacancies(context.Context, *GetSimilarVacanciesRequest) (*GetVacanciesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetCompanyVacancies(context.Context, *GetCompanyVacanciesRequest) (*GetVacanciesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetRecommendedVacancies(context.Context, *GetRecommendedVacanciesRequest) (*GetRecommendedVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetRecommendedCandidates(context.Context, *GetRecommendedCandidatesRequest) (*GetRecommendedCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedCandidates not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) DeleteVacancy(context.Context, *DeleteVacancyRequest) (*DeleteVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) PublishVacancy(context.Context, *PublishVacancyRequest) (*PublishVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) PauseVacancy(context.Context, *PauseVacancyRequest) (*PauseVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) CloseVacancy(context.Context, *CloseVacancyRequest) (*CloseVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ScheduleVacancyPublish(context.Context, *ScheduleVacancyPublishRequest) (*ScheduleVacancyPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleVacancyPublish not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) BumpVacancy(context.Context, *BumpVacancyRequest) (*BumpVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ListMyVacancies(context.Context, *ListMyVacanciesRequest) (*ListMyVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) CreateVacancyAlert(context.Context, *CreateVacancyAlertRequest) (*CreateVacancyAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVacancyAlert not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ListVacancyAlerts(context.Context, *ListVacancyAlertsRequest) (*ListVacancyAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVacancyAlerts not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) DeleteVacancyAlert(context.Context, *DeleteVacancyAlertRequest) (*DeleteVacancyAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancyAlert not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) SaveVacancy(context.Context, *SaveVacancyRequest) (*SaveVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) UnsaveVacancy(context.Context, *UnsaveVacancyRequest) (*UnsaveVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsaveVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ListSavedVacancies(context.Context, *ListSavedVacanciesRequest) (*GetVacanciesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) RevealVacancyPhone(context.Context, *RevealVacancyPhoneRequest) (*RevealVacancyPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealVacancyPhone not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyStats(context.Context, *GetVacancyStatsRequest) (*GetVacancyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyStats not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ApproveVacancy(context.Context, *ApproveVacancyRequest) (*ApproveVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) RejectVacancy(context.Context, *RejectVacancyRequest) (*RejectVacancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVacancy not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyModerationHistory(context.Context, *GetVacancyModerationHistoryRequest) (*GetVacancyModerationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyModerationHistory not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ListVacancyDuplicates(context.Context, *ListVacancyDuplicatesRequest) (*ListVacancyDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVacancyDuplicates not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ImportVacancies(context.Context, *ImportVacanciesRequest) (*ImportVacanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportVacancies not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) ListVacancyRevisions(context.Context, *ListVacancyRevisionsRequest) (*ListVacancyRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVacancyRevisions not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) GetVacancyRevision(context.Context, *GetVacancyRevisionRequest) (*GetVacancyRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyRevision not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) DiffVacancyRevisions(context.Context, *DiffVacancyRevisionsRequest) (*DiffVacancyRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffVacancyRevisions not implemented")
+}
+func (*UnimplementedPersonaAppVacancyServer) RestoreVacancyRevision(context.Context, *RestoreVacancyRevisionRequest) (*RestoreVacancyRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVacancyRevision not implemented")
+}
+
+func RegisterPersonaAppVacancyServer(s *grpc.Server, srv PersonaAppVacancyServer) {
+	s.RegisterService(&_PersonaAppVacancy_serviceDesc, srv)
+}
+
+func _PersonaAppVacancy_GetVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacancyCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacancyCategory(ctx, req.(*GetVacancyCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_UpdateVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVacancyCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).UpdateVacancyCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancyCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).UpdateVacancyCategory(ctx, req.(*UpdateVacancyCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_DeleteVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVacancyCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).DeleteVacancyCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancyCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).DeleteVacancyCategory(ctx, req.(*DeleteVacancyCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_GetVacancyCategoriesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyCategoriesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacancyCategoriesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategoriesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacancyCategoriesList(ctx, req.(*GetVacancyCategoriesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_MoveVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveVacancyCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).MoveVacancyCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/MoveVacancyCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).MoveVacancyCategory(ctx, req.(*MoveVacancyCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_UpdateVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).UpdateVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).UpdateVacancy(ctx, req.(*UpdateVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_GetVacanciesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacanciesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacanciesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacanciesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacanciesList(ctx, req.(*GetVacanciesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_SearchVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).SearchVacancies(ctx, in)
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_vacancy_vacancy_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   37,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_vacancy_vacancy_proto_goTypes,
-		DependencyIndexes: file_vacancy_vacancy_proto_depIdxs,
-		EnumInfos:         file_vacancy_vacancy_proto_enumTypes,
-		MessageInfos:      file_vacancy_vacancy_proto_msgTypes,
-	}.Build()
-	File_vacancy_vacancy_proto = out.File
-	file_vacancy_vacancy_proto_rawDesc = nil
-	file_vacancy_vacancy_proto_goTypes = nil
-	file_vacancy_vacancy_proto_depIdxs = nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/SearchVacancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).SearchVacancies(ctx, req.(*SearchVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _PersonaAppVacancy_GetVacanciesMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacanciesMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacanciesMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacanciesMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacanciesMap(ctx, req.(*GetVacanciesMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _PersonaAppVacancy_GetVacancyFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacancyFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyFacets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacancyFacets(ctx, req.(*GetVacancyFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// PersonaAppVacancyClient is the client API for PersonaAppVacancy service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PersonaAppVacancyClient interface {
-	// Vacancy categories
-	GetVacancyCategory(ctx context.Context, in *GetVacancyCategoryRequest, opts ...grpc.CallOption) (*GetVacancyCategoryResponse, error)
-	UpdateVacancyCategory(ctx context.Context, in *UpdateVacancyCategoryRequest, opts ...grpc.CallOption) (*UpdateVacancyCategoryResponse, error)
-	DeleteVacancyCategory(ctx context.Context, in *DeleteVacancyCategoryRequest, opts ...grpc.CallOption) (*DeleteVacancyCategoryResponse, error)
-	GetVacancyCategoriesList(ctx context.Context, in *GetVacancyCategoriesListRequest, opts ...grpc.CallOption) (*GetVacancyCategoriesListResponse, error)
-	// Vacancy
-	UpdateVacancy(ctx context.Context, in *UpdateVacancyRequest, opts ...grpc.CallOption) (*UpdateVacancyResponse, error)
-	GetVacanciesList(ctx context.Context, in *GetVacanciesListRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error)
-	GetVacancyDetails(ctx context.Context, in *GetVacancyDetailsRequest, opts ...grpc.CallOption) (*GetVacancyDetailsResponse, error)
-	DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*DeleteVacancyResponse, error)
+func _PersonaAppVacancy_GetVacancyDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacancyDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacancyDetails(ctx, req.(*GetVacancyDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type personaAppVacancyClient struct {
-	cc grpc.ClientConnInterface
+func _PersonaAppVacancy_GetSimilarVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetSimilarVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetSimilarVacancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetSimilarVacancies(ctx, req.(*GetSimilarVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPersonaAppVacancyClient(cc grpc.ClientConnInterface) PersonaAppVacancyClient {
-	return &personaAppVacancyClient{cc}
+func _PersonaAppVacancy_GetCompanyVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetCompanyVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetCompanyVacancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetCompanyVacancies(ctx, req.(*GetCompanyVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) GetVacancyCategory(ctx context.Context, in *GetVacancyCategoryRequest, opts ...grpc.CallOption) (*GetVacancyCategoryResponse, error) {
-	out := new(GetVacancyCategoryResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategory", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_GetRecommendedVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendedVacanciesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetRecommendedVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetRecommendedVacancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetRecommendedVacancies(ctx, req.(*GetRecommendedVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) UpdateVacancyCategory(ctx context.Context, in *UpdateVacancyCategoryRequest, opts ...grpc.CallOption) (*UpdateVacancyCategoryResponse, error) {
-	out := new(UpdateVacancyCategoryResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancyCategory", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_GetRecommendedCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendedCandidatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetRecommendedCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetRecommendedCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetRecommendedCandidates(ctx, req.(*GetRecommendedCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_DeleteVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).DeleteVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).DeleteVacancy(ctx, req.(*DeleteVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_PublishVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).PublishVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/PublishVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).PublishVacancy(ctx, req.(*PublishVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_PauseVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).PauseVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/PauseVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).PauseVacancy(ctx, req.(*PauseVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_CloseVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).CloseVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/CloseVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).CloseVacancy(ctx, req.(*CloseVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_ScheduleVacancyPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleVacancyPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).ScheduleVacancyPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ScheduleVacancyPublish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).ScheduleVacancyPublish(ctx, req.(*ScheduleVacancyPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_BumpVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).BumpVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/BumpVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).BumpVacancy(ctx, req.(*BumpVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_ListMyVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).ListMyVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ListMyVacancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).ListMyVacancies(ctx, req.(*ListMyVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_CreateVacancyAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVacancyAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).CreateVacancyAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/CreateVacancyAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).CreateVacancyAlert(ctx, req.(*CreateVacancyAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonaAppVacancy_ListVacancyAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVacancyAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).ListVacancyAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ListVacancyAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).ListVacancyAlerts(ctx, req.(*ListVacancyAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) DeleteVacancyCategory(ctx context.Context, in *DeleteVacancyCategoryRequest, opts ...grpc.CallOption) (*DeleteVacancyCategoryResponse, error) {
-	out := new(DeleteVacancyCategoryResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancyCategory", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_DeleteVacancyAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVacancyAlertRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).DeleteVacancyAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancyAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).DeleteVacancyAlert(ctx, req.(*DeleteVacancyAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) GetVacancyCategoriesList(ctx context.Context, in *GetVacancyCategoriesListRequest, opts ...grpc.CallOption) (*GetVacancyCategoriesListResponse, error) {
-	out := new(GetVacancyCategoriesListResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategoriesList", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_SaveVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveVacancyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).SaveVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/SaveVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).SaveVacancy(ctx, req.(*SaveVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) UpdateVacancy(ctx context.Context, in *UpdateVacancyRequest, opts ...grpc.CallOption) (*UpdateVacancyResponse, error) {
-	out := new(UpdateVacancyResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancy", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_UnsaveVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsaveVacancyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).UnsaveVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/UnsaveVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).UnsaveVacancy(ctx, req.(*UnsaveVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) GetVacanciesList(ctx context.Context, in *GetVacanciesListRequest, opts ...grpc.CallOption) (*GetVacanciesListResponse, error) {
-	out := new(GetVacanciesListResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacanciesList", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_ListSavedVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedVacanciesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).ListSavedVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ListSavedVacancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).ListSavedVacancies(ctx, req.(*ListSavedVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) GetVacancyDetails(ctx context.Context, in *GetVacancyDetailsRequest, opts ...grpc.CallOption) (*GetVacancyDetailsResponse, error) {
-	out := new(GetVacancyDetailsResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyDetails", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_RevealVacancyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealVacancyPhoneRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).RevealVacancyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/RevealVacancyPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).RevealVacancyPhone(ctx, req.(*RevealVacancyPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *personaAppVacancyClient) DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*DeleteVacancyResponse, error) {
-	out := new(DeleteVacancyResponse)
-	err := c.cc.Invoke(ctx, "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancy", in, out, opts...)
-	if err != nil {
+func _PersonaAppVacancy_GetVacancyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// PersonaAppVacancyServer is the server API for PersonaAppVacancy service.
-type PersonaAppVacancyServer interface {
-	// Vacancy categories
-	GetVacancyCategory(context.Context, *GetVacancyCategoryRequest) (*GetVacancyCategoryResponse, error)
-	UpdateVacancyCategory(context.Context, *UpdateVacancyCategoryRequest) (*UpdateVacancyCategoryResponse, error)
-	DeleteVacancyCategory(context.Context, *DeleteVacancyCategoryRequest) (*DeleteVacancyCategoryResponse, error)
-	GetVacancyCategoriesList(context.Context, *GetVacancyCategoriesListRequest) (*GetVacancyCategoriesListResponse, error)
-	// Vacancy
-	UpdateVacancy(context.Context, *UpdateVacancyRequest) (*UpdateVacancyResponse, error)
-	GetVacanciesList(context.Context, *GetVacanciesListRequest) (*GetVacanciesListResponse, error)
-	GetVacancyDetails(context.Context, *GetVacancyDetailsRequest) (*GetVacancyDetailsResponse, error)
-	DeleteVacancy(context.Context, *DeleteVacancyRequest) (*DeleteVacancyResponse, error)
-}
-
-// UnimplementedPersonaAppVacancyServer can be embedded to have forward compatible implementations.
-type UnimplementedPersonaAppVacancyServer struct {
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).GetVacancyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).GetVacancyStats(ctx, req.(*GetVacancyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedPersonaAppVacancyServer) GetVacancyCategory(context.Context, *GetVacancyCategoryRequest) (*GetVacancyCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyCategory not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) UpdateVacancyCategory(context.Context, *UpdateVacancyCategoryRequest) (*UpdateVacancyCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVacancyCategory not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) DeleteVacancyCategory(context.Context, *DeleteVacancyCategoryRequest) (*DeleteVacancyCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancyCategory not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) GetVacancyCategoriesList(context.Context, *GetVacancyCategoriesListRequest) (*GetVacancyCategoriesListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyCategoriesList not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) UpdateVacancy(context.Context, *UpdateVacancyRequest) (*UpdateVacancyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVacancy not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) GetVacanciesList(context.Context, *GetVacanciesListRequest) (*GetVacanciesListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVacanciesList not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) GetVacancyDetails(context.Context, *GetVacancyDetailsRequest) (*GetVacancyDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVacancyDetails not implemented")
-}
-func (*UnimplementedPersonaAppVacancyServer) DeleteVacancy(context.Context, *DeleteVacancyRequest) (*DeleteVacancyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancy not implemented")
+func _PersonaAppVacancy_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ListModerationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPersonaAppVacancyServer(s *grpc.Server, srv PersonaAppVacancyServer) {
-	s.RegisterService(&_PersonaAppVacancy_serviceDesc, srv)
+func _PersonaAppVacancy_ApproveVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonaAppVacancyServer).ApproveVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ApproveVacancy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonaAppVacancyServer).ApproveVacancy(ctx, req.(*ApproveVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_GetVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVacancyCategoryRequest)
+func _PersonaAppVacancy_RejectVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectVacancyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).GetVacancyCategory(ctx, in)
+		return srv.(PersonaAppVacancyServer).RejectVacancy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategory",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/RejectVacancy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).GetVacancyCategory(ctx, req.(*GetVacancyCategoryRequest))
+		return srv.(PersonaAppVacancyServer).RejectVacancy(ctx, req.(*RejectVacancyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_UpdateVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVacancyCategoryRequest)
+func _PersonaAppVacancy_GetVacancyModerationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyModerationHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).UpdateVacancyCategory(ctx, in)
+		return srv.(PersonaAppVacancyServer).GetVacancyModerationHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancyCategory",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyModerationHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).UpdateVacancyCategory(ctx, req.(*UpdateVacancyCategoryRequest))
+		return srv.(PersonaAppVacancyServer).GetVacancyModerationHistory(ctx, req.(*GetVacancyModerationHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_DeleteVacancyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVacancyCategoryRequest)
+func _PersonaAppVacancy_ListVacancyDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVacancyDuplicatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).DeleteVacancyCategory(ctx, in)
+		return srv.(PersonaAppVacancyServer).ListVacancyDuplicates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancyCategory",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ListVacancyDuplicates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).DeleteVacancyCategory(ctx, req.(*DeleteVacancyCategoryRequest))
+		return srv.(PersonaAppVacancyServer).ListVacancyDuplicates(ctx, req.(*ListVacancyDuplicatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_GetVacancyCategoriesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVacancyCategoriesListRequest)
+func _PersonaAppVacancy_ImportVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportVacanciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).GetVacancyCategoriesList(ctx, in)
+		return srv.(PersonaAppVacancyServer).ImportVacancies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyCategoriesList",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ImportVacancies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).GetVacancyCategoriesList(ctx, req.(*GetVacancyCategoriesListRequest))
+		return srv.(PersonaAppVacancyServer).ImportVacancies(ctx, req.(*ImportVacanciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_UpdateVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVacancyRequest)
+func _PersonaAppVacancy_ListVacancyRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVacancyRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).UpdateVacancy(ctx, in)
+		return srv.(PersonaAppVacancyServer).ListVacancyRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/UpdateVacancy",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/ListVacancyRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).UpdateVacancy(ctx, req.(*UpdateVacancyRequest))
+		return srv.(PersonaAppVacancyServer).ListVacancyRevisions(ctx, req.(*ListVacancyRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_GetVacanciesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVacanciesListRequest)
+func _PersonaAppVacancy_GetVacancyRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).GetVacanciesList(ctx, in)
+		return srv.(PersonaAppVacancyServer).GetVacancyRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacanciesList",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).GetVacanciesList(ctx, req.(*GetVacanciesListRequest))
+		return srv.(PersonaAppVacancyServer).GetVacancyRevision(ctx, req.(*GetVacancyRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_GetVacancyDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVacancyDetailsRequest)
+func _PersonaAppVacancy_DiffVacancyRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffVacancyRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).GetVacancyDetails(ctx, in)
+		return srv.(PersonaAppVacancyServer).DiffVacancyRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/GetVacancyDetails",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/DiffVacancyRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).GetVacancyDetails(ctx, req.(*GetVacancyDetailsRequest))
+		return srv.(PersonaAppVacancyServer).DiffVacancyRevisions(ctx, req.(*DiffVacancyRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PersonaAppVacancy_DeleteVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVacancyRequest)
+func _PersonaAppVacancy_RestoreVacancyRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVacancyRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PersonaAppVacancyServer).DeleteVacancy(ctx, in)
+		return srv.(PersonaAppVacancyServer).RestoreVacancyRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/DeleteVacancy",
+		FullMethod: "/personaappapi.vacancy.PersonaAppVacancy/RestoreVacancyRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PersonaAppVacancyServer).DeleteVacancy(ctx, req.(*DeleteVacancyRequest))
+		return srv.(PersonaAppVacancyServer).RestoreVacancyRevision(ctx, req.(*RestoreVacancyRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3226,6 +12362,10 @@ var _PersonaAppVacancy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVacancyCategoriesList",
 			Handler:    _PersonaAppVacancy_GetVacancyCategoriesList_Handler,
 		},
+		{
+			MethodName: "MoveVacancyCategory",
+			Handler:    _PersonaAppVacancy_MoveVacancyCategory_Handler,
+		},
 		{
 			MethodName: "UpdateVacancy",
 			Handler:    _PersonaAppVacancy_UpdateVacancy_Handler,
@@ -3234,14 +12374,138 @@ var _PersonaAppVacancy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVacanciesList",
 			Handler:    _PersonaAppVacancy_GetVacanciesList_Handler,
 		},
+		{
+			MethodName: "SearchVacancies",
+			Handler:    _PersonaAppVacancy_SearchVacancies_Handler,
+		},
+		{
+			MethodName: "GetVacanciesMap",
+			Handler:    _PersonaAppVacancy_GetVacanciesMap_Handler,
+		},
+		{
+			MethodName: "GetVacancyFacets",
+			Handler:    _PersonaAppVacancy_GetVacancyFacets_Handler,
+		},
 		{
 			MethodName: "GetVacancyDetails",
 			Handler:    _PersonaAppVacancy_GetVacancyDetails_Handler,
 		},
+		{
+			MethodName: "GetSimilarVacancies",
+			Handler:    _PersonaAppVacancy_GetSimilarVacancies_Handler,
+		},
+		{
+			MethodName: "GetCompanyVacancies",
+			Handler:    _PersonaAppVacancy_GetCompanyVacancies_Handler,
+		},
+		{
+			MethodName: "GetRecommendedVacancies",
+			Handler:    _PersonaAppVacancy_GetRecommendedVacancies_Handler,
+		},
+		{
+			MethodName: "GetRecommendedCandidates",
+			Handler:    _PersonaAppVacancy_GetRecommendedCandidates_Handler,
+		},
 		{
 			MethodName: "DeleteVacancy",
 			Handler:    _PersonaAppVacancy_DeleteVacancy_Handler,
 		},
+		{
+			MethodName: "PublishVacancy",
+			Handler:    _PersonaAppVacancy_PublishVacancy_Handler,
+		},
+		{
+			MethodName: "PauseVacancy",
+			Handler:    _PersonaAppVacancy_PauseVacancy_Handler,
+		},
+		{
+			MethodName: "CloseVacancy",
+			Handler:    _PersonaAppVacancy_CloseVacancy_Handler,
+		},
+		{
+			MethodName: "ScheduleVacancyPublish",
+			Handler:    _PersonaAppVacancy_ScheduleVacancyPublish_Handler,
+		},
+		{
+			MethodName: "BumpVacancy",
+			Handler:    _PersonaAppVacancy_BumpVacancy_Handler,
+		},
+		{
+			MethodName: "ListMyVacancies",
+			Handler:    _PersonaAppVacancy_ListMyVacancies_Handler,
+		},
+		{
+			MethodName: "CreateVacancyAlert",
+			Handler:    _PersonaAppVacancy_CreateVacancyAlert_Handler,
+		},
+		{
+			MethodName: "ListVacancyAlerts",
+			Handler:    _PersonaAppVacancy_ListVacancyAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteVacancyAlert",
+			Handler:    _PersonaAppVacancy_DeleteVacancyAlert_Handler,
+		},
+		{
+			MethodName: "SaveVacancy",
+			Handler:    _PersonaAppVacancy_SaveVacancy_Handler,
+		},
+		{
+			MethodName: "UnsaveVacancy",
+			Handler:    _PersonaAppVacancy_UnsaveVacancy_Handler,
+		},
+		{
+			MethodName: "ListSavedVacancies",
+			Handler:    _PersonaAppVacancy_ListSavedVacancies_Handler,
+		},
+		{
+			MethodName: "RevealVacancyPhone",
+			Handler:    _PersonaAppVacancy_RevealVacancyPhone_Handler,
+		},
+		{
+			MethodName: "GetVacancyStats",
+			Handler:    _PersonaAppVacancy_GetVacancyStats_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _PersonaAppVacancy_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ApproveVacancy",
+			Handler:    _PersonaAppVacancy_ApproveVacancy_Handler,
+		},
+		{
+			MethodName: "RejectVacancy",
+			Handler:    _PersonaAppVacancy_RejectVacancy_Handler,
+		},
+		{
+			MethodName: "GetVacancyModerationHistory",
+			Handler:    _PersonaAppVacancy_GetVacancyModerationHistory_Handler,
+		},
+		{
+			MethodName: "ListVacancyDuplicates",
+			Handler:    _PersonaAppVacancy_ListVacancyDuplicates_Handler,
+		},
+		{
+			MethodName: "ImportVacancies",
+			Handler:    _PersonaAppVacancy_ImportVacancies_Handler,
+		},
+		{
+			MethodName: "ListVacancyRevisions",
+			Handler:    _PersonaAppVacancy_ListVacancyRevisions_Handler,
+		},
+		{
+			MethodName: "GetVacancyRevision",
+			Handler:    _PersonaAppVacancy_GetVacancyRevision_Handler,
+		},
+		{
+			MethodName: "DiffVacancyRevisions",
+			Handler:    _PersonaAppVacancy_DiffVacancyRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreVacancyRevision",
+			Handler:    _PersonaAppVacancy_RestoreVacancyRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vacancy/vacancy.proto",
@@ -9,6 +9,7 @@ import (
 
 type Bus struct {
 	client *nats.Conn
+	cfg    Config
 }
 
 //nolint TODO: configure with TLS https://github.com/nats-io/go-nats#tls
@@ -18,13 +19,30 @@ func New(config Config) (*Bus, error) {
 		return nil, errors.Wrapf(err, "failed to connect by addr=%s", config.Addr)
 	}
 
-	return &Bus{client: client}, nil
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = config.MinBackoff
+	}
+
+	if config.DeadLetterPrefix == "" {
+		config.DeadLetterPrefix = "dead_letter."
+	}
+
+	return &Bus{client: client, cfg: config}, nil
 }
 
 func (b *Bus) Publish(subject string, msg []byte) error {
 	return b.client.Publish(subject, msg)
 }
 
+// Flush blocks until the server has processed everything published so far.
+func (b *Bus) Flush() error {
+	return b.client.Flush()
+}
+
 func (b *Bus) Close() {
 	b.client.Close()
 }
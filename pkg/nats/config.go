@@ -1,12 +1,19 @@
 package nats
 
 import (
+	"time"
+
 	"github.com/nats-io/go-nats"
 	"github.com/spf13/pflag"
 )
 
 type Config struct {
-	Addr string
+	Addr             string
+	HandlerTimeout   time.Duration
+	MaxAttempts      int
+	MinBackoff       time.Duration
+	MaxBackoff       time.Duration
+	DeadLetterPrefix string
 }
 
 func (c *Config) Flags(prefix string) *pflag.FlagSet {
@@ -17,6 +24,11 @@ func (c *Config) Flags(prefix string) *pflag.FlagSet {
 	}
 
 	f.StringVar(&c.Addr, prefix+"addr", nats.DefaultURL, "addr of the server to connect")
+	f.DurationVar(&c.HandlerTimeout, prefix+"handler_timeout", 30*time.Second, "Timeout of a single handler attempt")
+	f.IntVar(&c.MaxAttempts, prefix+"max_attempts", 5, "Handler attempts before a message is dead-lettered")
+	f.DurationVar(&c.MinBackoff, prefix+"min_backoff", 100*time.Millisecond, "Delay before the first retry")
+	f.DurationVar(&c.MaxBackoff, prefix+"max_backoff", 10*time.Second, "Max delay between retries")
+	f.StringVar(&c.DeadLetterPrefix, prefix+"dead_letter_prefix", "dead_letter.", "Prefix of dead-letter subjects")
 
 	return f
 }
@@ -0,0 +1,12 @@
+package event
+
+import (
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+const SubjectCompanyUpdated = "company.updated.v1"
+
+func CompanyUpdated(e *apievent.CompanyUpdatedV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectCompanyUpdated, Payload: e}
+}
@@ -0,0 +1,12 @@
+package event
+
+import (
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+const SubjectCVUpdated = "cv.updated.v1"
+
+func CVUpdated(e *apievent.CVUpdatedV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectCVUpdated, Payload: e}
+}
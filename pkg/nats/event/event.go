@@ -0,0 +1,54 @@
+package event
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	uuid "github.com/satori/go.uuid"
+
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+// Event is a domain event ready to be published to its subject.
+type Event struct {
+	ID      string
+	Subject string
+	Payload proto.Message
+}
+
+// Marshal encodes the payload the way it is put on the wire.
+func (e *Event) Marshal() ([]byte, error) {
+	data, err := proto.Marshal(e.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal event subject=%s", e.Subject)
+	}
+
+	return data, nil
+}
+
+func newMeta() *apievent.Meta {
+	return &apievent.Meta{
+		Id:         uuid.NewV4().String(),
+		OccurredAt: ptypes.TimestampNow(),
+	}
+}
+
+var payloads = map[string]func() proto.Message{
+	SubjectAccountRegistered: func() proto.Message { return &apievent.AccountRegisteredV1{} },
+	SubjectEmailChanged:      func() proto.Message { return &apievent.EmailChangedV1{} },
+	SubjectCompanyUpdated:    func() proto.Message { return &apievent.CompanyUpdatedV1{} },
+	SubjectVacancyCreated:    func() proto.Message { return &apievent.VacancyCreatedV1{} },
+	SubjectVacancyUpdated:    func() proto.Message { return &apievent.VacancyUpdatedV1{} },
+	SubjectVacancyDeleted:    func() proto.Message { return &apievent.VacancyDeletedV1{} },
+	SubjectCVUpdated:         func() proto.Message { return &apievent.CVUpdatedV1{} },
+}
+
+// NewPayload returns an empty payload message of the event subject to decode the event into.
+func NewPayload(subject string) (proto.Message, error) {
+	newPayload, ok := payloads[subject]
+	if !ok {
+		return nil, errors.Newf("unknown event subject=%s", subject)
+	}
+
+	return newPayload(), nil
+}
@@ -1 +1,20 @@
 package event
+
+import (
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+const (
+	SubjectAccountRegistered = "account.registered.v1"
+	SubjectEmailChanged      = "account.email_changed.v1"
+)
+
+func AccountRegistered(e *apievent.AccountRegisteredV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectAccountRegistered, Payload: e}
+}
+
+func EmailChanged(e *apievent.EmailChangedV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectEmailChanged, Payload: e}
+}
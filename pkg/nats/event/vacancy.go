@@ -0,0 +1,26 @@
+package event
+
+import (
+	apievent "personaapp/pkg/grpcapi/event"
+)
+
+const (
+	SubjectVacancyCreated = "vacancy.created.v1"
+	SubjectVacancyUpdated = "vacancy.updated.v1"
+	SubjectVacancyDeleted = "vacancy.deleted.v1"
+)
+
+func VacancyCreated(e *apievent.VacancyCreatedV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectVacancyCreated, Payload: e}
+}
+
+func VacancyUpdated(e *apievent.VacancyUpdatedV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectVacancyUpdated, Payload: e}
+}
+
+func VacancyDeleted(e *apievent.VacancyDeletedV1) *Event {
+	e.Meta = newMeta()
+	return &Event{ID: e.Meta.Id, Subject: SubjectVacancyDeleted, Payload: e}
+}
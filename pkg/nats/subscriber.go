@@ -0,0 +1,159 @@
+package nats
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/nats-io/go-nats"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"personaapp/pkg/nats/event"
+)
+
+const (
+	resultSuccess    = "success"
+	resultRetry      = "retry"
+	resultDeadLetter = "dead_letter"
+)
+
+var (
+	handledMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "personaapp",
+		Subsystem: "nats",
+		Name:      "handled_messages_total",
+		Help:      "Number of handler attempts by subject and result.",
+	}, []string{"subject", "result"})
+
+	handlerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "personaapp",
+		Subsystem: "nats",
+		Name:      "handler_duration_seconds",
+		Help:      "Duration of a single handler attempt by subject.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"subject"})
+)
+
+// Handler processes a decoded event payload, see event.NewPayload for the payload type of a subject.
+// A returned error makes the message retried.
+type Handler func(ctx context.Context, payload proto.Message) error
+
+// Subscription is an active subscription, Unsubscribe stops the delivery of new messages.
+type Subscription struct {
+	*nats.Subscription
+}
+
+// Subscribe delivers every message of the event subject to h. Messages of a subscription are handled
+// one by one in the order they arrive. A message which can't be decoded or whose handler has failed
+// all the attempts is published to the dead-letter subject and reported to onErr.
+func (b *Bus) Subscribe(ctx context.Context, subject string, h Handler, onErr func(error)) (*Subscription, error) {
+	return b.QueueSubscribe(ctx, subject, "", h, onErr)
+}
+
+// QueueSubscribe is Subscribe which shares the messages among all subscribers of the queue group,
+// so each message is handled by a single worker.
+func (b *Bus) QueueSubscribe(
+	ctx context.Context,
+	subject string,
+	queue string,
+	h Handler,
+	onErr func(error),
+) (*Subscription, error) {
+	if _, err := event.NewPayload(subject); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	cb := func(msg *nats.Msg) {
+		if err := b.handle(ctx, msg, h); err != nil {
+			onErr(err)
+		}
+	}
+
+	var (
+		sub *nats.Subscription
+		err error
+	)
+
+	if queue == "" {
+		sub, err = b.client.Subscribe(subject, cb)
+	} else {
+		sub, err = b.client.QueueSubscribe(subject, queue, cb)
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to subscribe to subject=%s", subject)
+	}
+
+	return &Subscription{Subscription: sub}, nil
+}
+
+func (b *Bus) handle(ctx context.Context, msg *nats.Msg, h Handler) error {
+	payload, err := event.NewPayload(msg.Subject)
+	if err != nil {
+		return b.deadLetter(msg, errors.WithStack(err))
+	}
+
+	if err := proto.Unmarshal(msg.Data, payload); err != nil {
+		return b.deadLetter(msg, errors.Wrapf(err, "failed to decode message of subject=%s", msg.Subject))
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := b.runHandler(ctx, msg.Subject, payload, h)
+		if err == nil {
+			handledMessages.WithLabelValues(msg.Subject, resultSuccess).Inc()
+			return nil
+		}
+
+		if attempt >= b.cfg.MaxAttempts {
+			return b.deadLetter(msg, errors.Wrapf(err, "handler of subject=%s failed %d times", msg.Subject, attempt))
+		}
+
+		handledMessages.WithLabelValues(msg.Subject, resultRetry).Inc()
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(err, "handler of subject=%s interrupted", msg.Subject)
+		case <-time.After(b.backoff(attempt)):
+		}
+	}
+}
+
+func (b *Bus) runHandler(ctx context.Context, subject string, payload proto.Message, h Handler) error {
+	if b.cfg.HandlerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.cfg.HandlerTimeout)
+
+		defer cancel()
+	}
+
+	defer prometheus.NewTimer(handlerDuration.WithLabelValues(subject)).ObserveDuration()
+
+	return h(ctx, payload)
+}
+
+func (b *Bus) deadLetter(msg *nats.Msg, cause error) error {
+	handledMessages.WithLabelValues(msg.Subject, resultDeadLetter).Inc()
+
+	if err := b.client.Publish(b.cfg.DeadLetterPrefix+msg.Subject, msg.Data); err != nil {
+		return errors.CombineErrors(cause, errors.Wrap(err, "failed to publish a dead letter"))
+	}
+
+	return cause
+}
+
+// backoff doubles the delay after each failed attempt, from MinBackoff up to MaxBackoff.
+func (b *Bus) backoff(attempt int) time.Duration {
+	delay := b.cfg.MinBackoff
+
+	for i := 1; i < attempt && delay < b.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > b.cfg.MaxBackoff {
+		delay = b.cfg.MaxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,231 @@
+package nats_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	gonats "github.com/nats-io/go-nats"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"personaapp/internal/testutils"
+	apievent "personaapp/pkg/grpcapi/event"
+	"personaapp/pkg/nats"
+	"personaapp/pkg/nats/event"
+)
+
+var errHandler = errors.New("handler failed")
+
+// handledMessages reads the personaapp_nats_handled_messages_total counter of the subject and result.
+func handledMessages(t *testing.T, subject, result string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "personaapp_nats_handled_messages_total" {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string, len(m.GetLabel()))
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+
+			if labels["subject"] == subject && labels["result"] == result {
+				return m.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestBus_Subscribe(t *testing.T) {
+	_, natsAddr, natsCloser := testutils.RunNats(t)
+	defer natsCloser()
+
+	bus, err := nats.New(nats.Config{
+		Addr:        natsAddr,
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer bus.Close()
+
+	conn, err := gonats.Connect(natsAddr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	publish := func(t *testing.T, e *event.Event) []byte {
+		data, err := e.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, bus.Publish(e.Subject, data))
+		require.NoError(t, bus.Flush())
+
+		return data
+	}
+
+	subscribe := func(t *testing.T, subject string, h nats.Handler) (errs chan error, unsubscribe func()) {
+		errs = make(chan error, 1)
+
+		sub, err := bus.Subscribe(context.TODO(), subject, h, func(err error) { errs <- err })
+		require.NoError(t, err)
+		require.NoError(t, bus.Flush())
+
+		return errs, func() { require.NoError(t, sub.Unsubscribe()) }
+	}
+
+	deadLetters := func(t *testing.T, subject string) *gonats.Subscription {
+		sub, err := conn.SubscribeSync("dead_letter." + subject)
+		require.NoError(t, err)
+		require.NoError(t, conn.Flush())
+
+		return sub
+	}
+
+	t.Run("unknown subject", func(t *testing.T) {
+		_, err := bus.Subscribe(context.TODO(), "unknown.v1", func(context.Context, proto.Message) error {
+			return nil
+		}, func(error) {})
+		require.Error(t, err)
+	})
+
+	t.Run("handle the message", func(t *testing.T) {
+		successes := handledMessages(t, event.SubjectVacancyCreated, "success")
+
+		handled := make(chan *apievent.VacancyCreatedV1, 1)
+		errs, unsubscribe := subscribe(t, event.SubjectVacancyCreated, func(_ context.Context, p proto.Message) error {
+			handled <- p.(*apievent.VacancyCreatedV1)
+			return nil
+		})
+		defer unsubscribe()
+
+		publish(t, event.VacancyCreated(&apievent.VacancyCreatedV1{Vacancy: &apievent.Vacancy{Id: "vacancy"}}))
+
+		select {
+		case e := <-handled:
+			require.Equal(t, "vacancy", e.GetVacancy().GetId())
+			require.NotEmpty(t, e.GetMeta().GetId())
+		case <-time.After(5 * time.Second):
+			t.Fatal("the message is not handled")
+		}
+
+		// The result is counted once the handler has returned
+		require.Eventually(t, func() bool {
+			return handledMessages(t, event.SubjectVacancyCreated, "success") == successes+1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Empty(t, errs)
+	})
+
+	t.Run("retry the failed handler", func(t *testing.T) {
+		successes := handledMessages(t, event.SubjectVacancyUpdated, "success")
+		retries := handledMessages(t, event.SubjectVacancyUpdated, "retry")
+
+		dl := deadLetters(t, event.SubjectVacancyUpdated)
+		defer func() { require.NoError(t, dl.Unsubscribe()) }()
+
+		attempts := make(chan int, 3)
+		attempt := 0
+		errs, unsubscribe := subscribe(t, event.SubjectVacancyUpdated, func(context.Context, proto.Message) error {
+			attempt++
+			attempts <- attempt
+
+			if attempt == 1 {
+				return errHandler
+			}
+
+			return nil
+		})
+		defer unsubscribe()
+
+		publish(t, event.VacancyUpdated(&apievent.VacancyUpdatedV1{Vacancy: &apievent.Vacancy{Id: "vacancy"}}))
+
+		for want := 1; want <= 2; want++ {
+			select {
+			case got := <-attempts:
+				require.Equal(t, want, got)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("attempt %d is not made", want)
+			}
+		}
+
+		require.Eventually(t, func() bool {
+			return handledMessages(t, event.SubjectVacancyUpdated, "success") == successes+1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, retries+1, handledMessages(t, event.SubjectVacancyUpdated, "retry"))
+
+		_, err := dl.NextMsg(100 * time.Millisecond)
+		require.Equal(t, gonats.ErrTimeout, err)
+		require.Empty(t, errs)
+		require.Empty(t, attempts)
+	})
+
+	t.Run("dead-letter the message after the last attempt", func(t *testing.T) {
+		retries := handledMessages(t, event.SubjectVacancyDeleted, "retry")
+		deadLettered := handledMessages(t, event.SubjectVacancyDeleted, "dead_letter")
+
+		dl := deadLetters(t, event.SubjectVacancyDeleted)
+		defer func() { require.NoError(t, dl.Unsubscribe()) }()
+
+		attempts := 0
+		errs, unsubscribe := subscribe(t, event.SubjectVacancyDeleted, func(context.Context, proto.Message) error {
+			attempts++
+			return errHandler
+		})
+		defer unsubscribe()
+
+		data := publish(t, event.VacancyDeleted(&apievent.VacancyDeletedV1{VacancyId: "vacancy"}))
+
+		msg, err := dl.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+		require.Equal(t, data, msg.Data)
+
+		select {
+		case err := <-errs:
+			require.True(t, errors.Is(err, errHandler))
+		case <-time.After(5 * time.Second):
+			t.Fatal("the error is not reported")
+		}
+
+		require.Equal(t, 3, attempts)
+		require.Equal(t, retries+2, handledMessages(t, event.SubjectVacancyDeleted, "retry"))
+		require.Equal(t, deadLettered+1, handledMessages(t, event.SubjectVacancyDeleted, "dead_letter"))
+	})
+
+	t.Run("dead-letter the undecodable message", func(t *testing.T) {
+		deadLettered := handledMessages(t, event.SubjectCVUpdated, "dead_letter")
+
+		dl := deadLetters(t, event.SubjectCVUpdated)
+		defer func() { require.NoError(t, dl.Unsubscribe()) }()
+
+		handled := false
+		errs, unsubscribe := subscribe(t, event.SubjectCVUpdated, func(context.Context, proto.Message) error {
+			handled = true
+			return nil
+		})
+		defer unsubscribe()
+
+		data := []byte{0xff}
+		require.NoError(t, bus.Publish(event.SubjectCVUpdated, data))
+		require.NoError(t, bus.Flush())
+
+		msg, err := dl.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+		require.Equal(t, data, msg.Data)
+
+		select {
+		case err := <-errs:
+			require.Error(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the error is not reported")
+		}
+
+		require.False(t, handled)
+		require.Equal(t, deadLettered+1, handledMessages(t, event.SubjectCVUpdated, "dead_letter"))
+	})
+}
@@ -0,0 +1,25 @@
+package periodic
+
+import (
+	"context"
+	"time"
+)
+
+// Run calls fn immediately and then every interval until ctx is done.
+// Errors returned by fn are passed to onErr and don't stop the loop.
+func Run(ctx context.Context, interval time.Duration, fn func(ctx context.Context) error, onErr func(err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := fn(ctx); err != nil && ctx.Err() == nil {
+			onErr(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cockroachdb/errors"
+)
+
+// AdvisoryLock is a session level advisory lock, e.g. to elect the leader among the instances. The lock
+// is held on a dedicated connection until it's unlocked or the connection is lost.
+type AdvisoryLock struct {
+	db   *sql.DB
+	key  int64
+	conn *sql.Conn
+}
+
+func (s *Storage) AdvisoryLock(key int64) *AdvisoryLock {
+	return &AdvisoryLock{db: s.DB, key: key}
+}
+
+// TryLock takes the lock unless another session holds it and tells whether the lock is held.
+// The lock taken before is checked to be still held.
+func (l *AdvisoryLock) TryLock(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+
+		// The lock is gone with the connection, the error of closing the broken one doesn't matter
+		_ = l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&locked); err != nil {
+		return false, errors.WithSecondaryError(errors.WithStack(err), conn.Close())
+	}
+
+	if !locked {
+		return false, errors.WithStack(conn.Close())
+	}
+
+	l.conn = conn
+
+	return true, nil
+}
+
+// Unlock releases the lock if it's held.
+func (l *AdvisoryLock) Unlock(ctx context.Context) error {
+	if l.conn == nil {
+		return nil
+	}
+
+	conn := l.conn
+	l.conn = nil
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, l.key); err != nil {
+		return errors.WithSecondaryError(errors.WithStack(err), conn.Close())
+	}
+
+	return errors.WithStack(conn.Close())
+}
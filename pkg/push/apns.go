@@ -0,0 +1,173 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
+)
+
+const (
+	apnsProductionURL = "https://api.push.apple.com/3/device/"
+	apnsSandboxURL    = "https://api.sandbox.push.apple.com/3/device/"
+	// apple rejects provider tokens older than an hour and throttles refreshes more often than every 20 minutes
+	apnsTokenTTL = 50 * time.Minute
+)
+
+// APNsSender delivers messages through the APNs HTTP/2 provider API using token based auth.
+type APNsSender struct {
+	client  *http.Client
+	cfg     APNsConfig
+	key     *ecdsa.PrivateKey
+	baseURL string
+	logger  *zap.SugaredLogger
+
+	m           sync.Mutex
+	bearer      string
+	bearerUntil time.Time
+}
+
+// NewAPNsSender creates the sender, the reasons the tokens are rejected for are logged with logger.
+func NewAPNsSender(cfg *APNsConfig, logger *zap.SugaredLogger) (*APNsSender, error) {
+	b, err := ioutil.ReadFile(cfg.KeyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read apns key file=%s", cfg.KeyFile)
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("apns key must be pem encoded")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse apns key")
+	}
+
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apns key is not an ecdsa key")
+	}
+
+	if cfg.KeyID == "" || cfg.TeamID == "" || cfg.Topic == "" {
+		return nil, errors.New("incomplete apns config")
+	}
+
+	baseURL := apnsProductionURL
+	if cfg.Sandbox {
+		baseURL = apnsSandboxURL
+	}
+
+	return &APNsSender{
+		client:  &http.Client{Timeout: 10 * time.Second, Transport: &http.Transport{ForceAttemptHTTP2: true}},
+		cfg:     *cfg,
+		key:     key,
+		baseURL: baseURL,
+		logger:  logger,
+	}, nil
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type apnsAps struct {
+	Alert apnsAlert `json:"alert"`
+	Sound string    `json:"sound"`
+}
+
+type apnsErrorResponse struct {
+	Reason string `json:"reason"`
+}
+
+func (s *APNsSender) Send(ctx context.Context, token string, msg *Message) error {
+	bearer, err := s.getBearer()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	payload := map[string]interface{}{
+		"aps": apnsAps{Alert: apnsAlert{Title: msg.Title, Body: msg.Body}, Sound: "default"},
+	}
+	for k, v := range msg.Data {
+		payload[k] = v
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.baseURL+token, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req.Header.Set("Authorization", "bearer "+bearer)
+	req.Header.Set("apns-topic", s.cfg.Topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var apnsErr apnsErrorResponse
+	_ = json.NewDecoder(resp.Body).Decode(&apnsErr)
+
+	if resp.StatusCode == http.StatusGone {
+		s.logger.Infow("apns rejected push token", "status", resp.StatusCode, "reason", apnsErr.Reason)
+		return errors.WithStack(ErrInvalidToken)
+	}
+
+	switch apnsErr.Reason {
+	case "BadDeviceToken", "DeviceTokenNotForTopic", "Unregistered":
+		s.logger.Infow("apns rejected push token", "status", resp.StatusCode, "reason", apnsErr.Reason)
+		return errors.WithStack(ErrInvalidToken)
+	}
+
+	return errors.Newf("apns request failed with status=%d: %s", resp.StatusCode, apnsErr.Reason)
+}
+
+func (s *APNsSender) getBearer() (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	now := time.Now()
+	if s.bearer != "" && now.Before(s.bearerUntil) {
+		return s.bearer, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": s.cfg.TeamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = s.cfg.KeyID
+
+	bearer, err := token.SignedString(s.key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	s.bearer = bearer
+	s.bearerUntil = now.Add(apnsTokenTTL)
+
+	return s.bearer, nil
+}
@@ -0,0 +1,97 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestAPNsSender(t *testing.T, baseURL string) *APNsSender {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "apns")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	keyFile := filepath.Join(dir, "key.p8")
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	s, err := NewAPNsSender(&APNsConfig{
+		KeyFile: keyFile,
+		KeyID:   "KEYID",
+		TeamID:  "TEAMID",
+		Topic:   "online.personaapp",
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	s.baseURL = baseURL
+
+	return s
+}
+
+func TestAPNsSender_Send(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		invalidToken bool
+	}{
+		{name: "delivered", status: http.StatusOK},
+		{name: "unregistered", status: http.StatusGone, body: `{"reason":"Unregistered","timestamp":1}`, invalidToken: true},
+		{name: "bad device token", status: http.StatusBadRequest, body: `{"reason":"BadDeviceToken"}`, invalidToken: true},
+		{
+			name:         "token not for topic",
+			status:       http.StatusBadRequest,
+			body:         `{"reason":"DeviceTokenNotForTopic"}`,
+			invalidToken: true,
+		},
+		{name: "provider failure", status: http.StatusInternalServerError, body: `{"reason":"InternalServerError"}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/3/device/device-token", r.URL.Path)
+				require.Equal(t, "online.personaapp", r.Header.Get("apns-topic"))
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			s := newTestAPNsSender(t, srv.URL+"/3/device/")
+
+			err := s.Send(context.TODO(), "device-token", &Message{Title: "Title", Body: "Body"})
+
+			switch {
+			case tt.status == http.StatusOK:
+				require.NoError(t, err)
+			case tt.invalidToken:
+				require.Equal(t, ErrInvalidToken, errors.Cause(err))
+			default:
+				require.Error(t, err)
+				require.NotEqual(t, ErrInvalidToken, errors.Cause(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package push
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type FCMConfig struct {
+	CredentialsFile string
+}
+
+func (c *FCMConfig) Flags(prefix string) *pflag.FlagSet {
+	f := pflag.NewFlagSet("FCMConfig", pflag.PanicOnError)
+
+	if prefix != "" {
+		prefix += "."
+	}
+
+	f.StringVar(
+		&c.CredentialsFile,
+		prefix+"credentials_file",
+		"",
+		"Path to the firebase service account json file. Empty value disables android push",
+	)
+
+	return f
+}
+
+type APNsConfig struct {
+	KeyFile string
+	KeyID   string
+	TeamID  string
+	Topic   string
+	Sandbox bool
+}
+
+func (c *APNsConfig) Flags(prefix string) *pflag.FlagSet {
+	f := pflag.NewFlagSet("APNsConfig", pflag.PanicOnError)
+
+	if prefix != "" {
+		prefix += "."
+	}
+
+	f.StringVar(&c.KeyFile, prefix+"key_file", "", "Path to the .p8 auth key. Empty value disables ios push")
+	f.StringVar(&c.KeyID, prefix+"key_id", "", "Id of the auth key")
+	f.StringVar(&c.TeamID, prefix+"team_id", "", "Apple developer team id")
+	f.StringVar(&c.Topic, prefix+"topic", "online.personaapp", "Bundle id of the app")
+	f.BoolVar(&c.Sandbox, prefix+"sandbox", false, "Use the apns development environment")
+
+	return f
+}
@@ -0,0 +1,210 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
+)
+
+const (
+	fcmScope       = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmSendURL     = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	fcmTokenLeeway = time.Minute
+)
+
+type fcmCredentials struct {
+	ProjectID   string `json:"project_id"`
+	PrivateKey  string `json:"private_key"`
+	ClientEmail string `json:"client_email"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMSender delivers messages through the FCM HTTP v1 API.
+type FCMSender struct {
+	client  *http.Client
+	creds   fcmCredentials
+	sendURL string
+	logger  *zap.SugaredLogger
+
+	m           sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMSender creates the sender, the reasons the tokens are rejected for are logged with logger.
+func NewFCMSender(cfg *FCMConfig, logger *zap.SugaredLogger) (*FCMSender, error) {
+	b, err := ioutil.ReadFile(cfg.CredentialsFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read fcm credentials file=%s", cfg.CredentialsFile)
+	}
+
+	var creds fcmCredentials
+	if err := json.Unmarshal(b, &creds); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fcm credentials")
+	}
+
+	if creds.ProjectID == "" || creds.PrivateKey == "" || creds.ClientEmail == "" || creds.TokenURI == "" {
+		return nil, errors.New("incomplete fcm credentials")
+	}
+
+	return &FCMSender{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		creds:   creds,
+		sendURL: fmt.Sprintf(fcmSendURL, creds.ProjectID),
+		logger:  logger,
+	}, nil
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (s *FCMSender) Send(ctx context.Context, token string, msg *Message) error {
+	accessToken, err := s.getAccessToken(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	body, err := json.Marshal(fcmRequest{Message: fcmMessage{
+		Token:        token,
+		Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+		Data:         msg.Data,
+	}})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.sendURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var fcmErr fcmErrorResponse
+	if err := json.Unmarshal(respBody, &fcmErr); err != nil {
+		return errors.Newf("fcm request failed with status=%d", resp.StatusCode)
+	}
+
+	for _, d := range fcmErr.Error.Details {
+		switch d.ErrorCode {
+		case "UNREGISTERED", "SENDER_ID_MISMATCH":
+			s.logger.Infow("fcm rejected push token", "status", fcmErr.Error.Status, "reason", d.ErrorCode)
+			return errors.WithStack(ErrInvalidToken)
+		}
+	}
+
+	return errors.Newf("fcm request failed with status=%s: %s", fcmErr.Error.Status, fcmErr.Error.Message)
+}
+
+// getAccessToken exchanges a self-signed service account assertion for an OAuth2 access token
+// and caches it until it is close to expiry.
+func (s *FCMSender) getAccessToken(ctx context.Context) (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	now := time.Now()
+	if s.accessToken != "" && now.Add(fcmTokenLeeway).Before(s.expiresAt) {
+		return s.accessToken, nil
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(s.creds.PrivateKey))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse fcm private key")
+	}
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   s.creds.ClientEmail,
+		"scope": fcmScope,
+		"aud":   s.creds.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.creds.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Newf("fcm token exchange failed with status=%d", resp.StatusCode)
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	s.accessToken = tokenResp.AccessToken
+	s.expiresAt = now.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	return s.accessToken, nil
+}
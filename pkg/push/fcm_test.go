@@ -0,0 +1,114 @@
+package push
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestFCMSender(t *testing.T, serverURL string) *FCMSender {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	creds, err := json.Marshal(fcmCredentials{
+		ProjectID:   "personaapp",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		ClientEmail: "push@personaapp.iam.gserviceaccount.com",
+		TokenURI:    serverURL + "/token",
+	})
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "fcm")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	credentialsFile := filepath.Join(dir, "credentials.json")
+	require.NoError(t, ioutil.WriteFile(credentialsFile, creds, 0600))
+
+	s, err := NewFCMSender(&FCMConfig{CredentialsFile: credentialsFile}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	s.sendURL = serverURL + "/send"
+
+	return s
+}
+
+func TestFCMSender_Send(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		invalidToken bool
+	}{
+		{name: "delivered", status: http.StatusOK, body: `{"name":"projects/personaapp/messages/1"}`},
+		{
+			name:   "unregistered",
+			status: http.StatusNotFound,
+			body: `{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND",` +
+				`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`,
+			invalidToken: true,
+		},
+		{
+			name:   "sender id mismatch",
+			status: http.StatusForbidden,
+			body: `{"error":{"code":403,"message":"SenderId mismatch","status":"PERMISSION_DENIED",` +
+				`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError",` +
+				`"errorCode":"SENDER_ID_MISMATCH"}]}}`,
+			invalidToken: true,
+		},
+		{
+			name:   "provider failure",
+			status: http.StatusServiceUnavailable,
+			body: `{"error":{"code":503,"message":"The service is currently unavailable.","status":"UNAVAILABLE",` +
+				`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNAVAILABLE"}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"access_token":"access-token","expires_in":3600,"token_type":"Bearer"}`))
+			})
+			mux.HandleFunc("/send", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			s := newTestFCMSender(t, srv.URL)
+
+			err := s.Send(context.TODO(), "device-token", &Message{Title: "Title", Body: "Body"})
+
+			switch {
+			case tt.status == http.StatusOK:
+				require.NoError(t, err)
+			case tt.invalidToken:
+				require.Equal(t, ErrInvalidToken, errors.Cause(err))
+			default:
+				require.Error(t, err)
+				require.NotEqual(t, ErrInvalidToken, errors.Cause(err))
+			}
+		})
+	}
+}
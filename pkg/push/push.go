@@ -0,0 +1,16 @@
+package push
+
+import (
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidToken is returned by senders when the provider reports that the push token
+// is no longer valid and should not be used again.
+var ErrInvalidToken = errors.New("invalid push token")
+
+// Message is a provider agnostic push notification payload.
+type Message struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
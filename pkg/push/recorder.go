@@ -0,0 +1,58 @@
+package push
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Sent is a message captured by the Recorder.
+type Sent struct {
+	Token   string
+	Message Message
+}
+
+// Recorder is a fake sender which keeps delivered messages in memory.
+// Tokens marked as invalid are rejected with ErrInvalidToken, the same way real providers do.
+type Recorder struct {
+	m       sync.Mutex
+	sent    []Sent
+	invalid map[string]bool
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{invalid: make(map[string]bool)}
+}
+
+func (r *Recorder) Send(_ context.Context, token string, msg *Message) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.invalid[token] {
+		return errors.WithStack(ErrInvalidToken)
+	}
+
+	r.sent = append(r.sent, Sent{Token: token, Message: *msg})
+
+	return nil
+}
+
+// Invalidate makes all following sends to the token fail with ErrInvalidToken.
+func (r *Recorder) Invalidate(token string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.invalid[token] = true
+}
+
+// Sent returns a copy of all messages delivered so far.
+func (r *Recorder) Sent() []Sent {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	sent := make([]Sent, len(r.sent))
+	copy(sent, r.sent)
+
+	return sent
+}
@@ -0,0 +1,31 @@
+package redis
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-redis/redis"
+)
+
+// Get returns the value of the key, ok is false when there is no such key.
+func (s *DefaultStorage) Get(key string) (value []byte, ok bool, err error) {
+	value, err = s.Client.Get(key).Bytes()
+
+	switch err {
+	case nil:
+		return value, true, nil
+	case redis.Nil:
+		return nil, false, nil
+	default:
+		return nil, false, errors.Wrapf(err, "failed to get key=%s", key)
+	}
+}
+
+// Set stores the value of the key, which expires after ttl unless ttl is zero.
+func (s *DefaultStorage) Set(key string, value []byte, ttl time.Duration) error {
+	if err := s.Client.Set(key, value, ttl).Err(); err != nil {
+		return errors.Wrapf(err, "failed to set key=%s", key)
+	}
+
+	return nil
+}